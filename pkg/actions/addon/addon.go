@@ -86,6 +86,12 @@ func (a *Manager) waitForAddonToBeActive(addon *api.Addon) error {
 	return nil
 }
 
+// LatestVersion returns the newest version of the addon that matches its version, or the newest
+// version available when its version is "latest"
+func (a *Manager) LatestVersion(addon *api.Addon) (string, error) {
+	return a.getLatestMatchingVersion(addon)
+}
+
 func (a *Manager) getLatestMatchingVersion(addon *api.Addon) (string, error) {
 	addonInfos, err := a.describeVersions(addon)
 	if err != nil {
@@ -0,0 +1,73 @@
+package addon
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// ClusterAutoscalerServiceAccount is the name of the IAM service account of cluster-autoscaler, which is managed
+// with the addon rather than as an iamserviceaccount
+var ClusterAutoscalerServiceAccount = (&api.ClusterIAMServiceAccount{
+	ClusterIAMMeta: api.ClusterIAMMeta{Name: api.ClusterAutoscalerAddon, Namespace: metav1.NamespaceSystem},
+}).NameString()
+
+// CreateClusterAutoscaler deploys cluster-autoscaler, which is not an EKS addon, with an IAM role restricted to the
+// ASGs of the cluster, and tags the ASGs of the nodegroups of the cluster so that cluster-autoscaler discovers them
+func CreateClusterAutoscaler(cfg *api.ClusterConfig, clusterProvider *eks.ClusterProvider, clientSet kubeclient.Interface, addon *api.Addon) error {
+	clusterAutoscaler, err := newClusterAutoscaler(cfg, clusterProvider, clientSet, addon.Version)
+	if err != nil {
+		return err
+	}
+	if err := clusterAutoscaler.Deploy(); err != nil {
+		return errors.Wrapf(err, "error installing %s", api.ClusterAutoscalerAddon)
+	}
+	return nodegroup.New(cfg, clusterProvider, clientSet).TagForClusterAutoscaler()
+}
+
+// DeleteClusterAutoscaler deletes cluster-autoscaler and its IAM role; the discovery tags of the ASGs are kept
+func DeleteClusterAutoscaler(cfg *api.ClusterConfig, clusterProvider *eks.ClusterProvider, clientSet kubeclient.Interface) error {
+	clusterAutoscaler, err := newClusterAutoscaler(cfg, clusterProvider, clientSet, "")
+	if err != nil {
+		return err
+	}
+	return errors.Wrapf(clusterAutoscaler.Delete(), "error deleting %s", api.ClusterAutoscalerAddon)
+}
+
+// GetClusterAutoscaler returns the summary of cluster-autoscaler, and false if it isn't deployed to the cluster
+func GetClusterAutoscaler(cfg *api.ClusterConfig, clusterProvider *eks.ClusterProvider, clientSet kubeclient.Interface) (Summary, bool, error) {
+	clusterAutoscaler, err := newClusterAutoscaler(cfg, clusterProvider, clientSet, "")
+	if err != nil {
+		return Summary{}, false, err
+	}
+	version, ok, err := clusterAutoscaler.InstalledVersion()
+	if err != nil || !ok {
+		return Summary{}, ok, err
+	}
+	return Summary{
+		Name:    api.ClusterAutoscalerAddon,
+		Version: version,
+		Status:  "DEPLOYED",
+	}, true, nil
+}
+
+func newClusterAutoscaler(cfg *api.ClusterConfig, clusterProvider *eks.ClusterProvider, clientSet kubeclient.Interface, version string) (*addons.ClusterAutoscaler, error) {
+	stackManager := clusterProvider.NewStackManager(cfg)
+	rawClient, err := clusterProvider.NewRawClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	oidc, err := clusterProvider.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+	irsaManager := irsa.New(cfg.Metadata.Name, stackManager, oidc, clientSet)
+	irsaHelper := addons.NewIRSAHelper(oidc, stackManager, irsaManager, cfg.Metadata.Name)
+	return addons.NewClusterAutoscaler(rawClient, irsaHelper, cfg.Metadata, version, false), nil
+}
@@ -190,6 +190,10 @@ func (a *Manager) getRecommendedPolicies(addon *api.Addon) []string {
 	// API isn't case sensitive
 	switch addon.CanonicalName() {
 	case vpcCNIName:
+		if a.clusterConfig.IPv6Enabled() {
+			// the IPv6 permissions of the VPC CNI are attached to the node roles
+			return []string{}
+		}
 		return []string{fmt.Sprintf("arn:%s:iam::aws:policy/%s", api.Partition(a.clusterConfig.Metadata.Region), api.IAMPolicyAmazonEKSCNIPolicy)}
 	default:
 		return []string{}
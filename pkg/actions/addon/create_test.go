@@ -32,10 +32,12 @@ var _ = Describe("Create", func() {
 		returnedErr            error
 		createStackReturnValue error
 		rawClient              *testutils.FakeRawClient
+		networkConfig          *api.KubernetesNetworkConfig
 	)
 
 	BeforeEach(func() {
 		withOIDC = true
+		networkConfig = nil
 		returnedErr = nil
 		fakeStackManager = new(fakes.FakeStackManager)
 		mockProvider = mockprovider.NewMockProvider()
@@ -84,7 +86,7 @@ var _ = Describe("Create", func() {
 		manager, err = addon.New(&api.ClusterConfig{Metadata: &api.ClusterMeta{
 			Version: "1.18",
 			Name:    "my-cluster",
-		}}, mockProvider.EKS(), fakeStackManager, withOIDC, oidc, rawClient.ClientSet(), 5*time.Minute)
+		}, KubernetesNetworkConfig: networkConfig}, mockProvider.EKS(), fakeStackManager, withOIDC, oidc, rawClient.ClientSet(), 5*time.Minute)
 		Expect(err).NotTo(HaveOccurred())
 		manager.SetTimeout(time.Second)
 
@@ -415,6 +417,24 @@ var _ = Describe("Create", func() {
 				Expect(*createAddonInput.AddonVersion).To(Equal("v1.0.0-eksbuild.1"))
 				Expect(*createAddonInput.ServiceAccountRoleArn).To(Equal("role-arn"))
 			})
+
+			When("the IP family of the cluster is IPv6", func() {
+				BeforeEach(func() {
+					networkConfig = &api.KubernetesNetworkConfig{IPFamily: api.IPV6Family}
+				})
+
+				It("does not provide a role, leaving the VPC CNI to use the IPv6 policy of the node roles", func() {
+					err := manager.Create(&api.Addon{
+						Name:    "vpc-cni",
+						Version: "v1.0.0-eksbuild.1",
+					}, false)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+					Expect(*createAddonInput.AddonName).To(Equal("vpc-cni"))
+					Expect(createAddonInput.ServiceAccountRoleArn).To(BeNil())
+				})
+			})
 		})
 	})
 
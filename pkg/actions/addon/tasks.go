@@ -1,6 +1,7 @@
 package addon
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -47,6 +48,19 @@ func CreateAddonTasks(cfg *api.ClusterConfig, clusterProvider *eks.ClusterProvid
 	return preTasks, postTasks
 }
 
+// NewAddonTask returns a task that runs doer to apply action to the addon, so that addon commands can print
+// their plan
+func NewAddonTask(action tasks.Action, a *api.Addon, doer func() error) tasks.Task {
+	return &tasks.TargetedTask{
+		GenericTask: tasks.GenericTask{
+			Description: fmt.Sprintf("%s addon %q", action, a.Name),
+			Doer:        doer,
+		},
+		Action:   action,
+		Resource: "addon/" + a.Name,
+	}
+}
+
 type createAddonTask struct {
 	info            string
 	cfg             *api.ClusterConfig
@@ -89,7 +103,12 @@ func (t *createAddonTask) Do(errorCh chan error) error {
 		if t.forceAll {
 			a.Force = true
 		}
-		err := addonManager.Create(a, true)
+		var err error
+		if a.IsClusterAutoscaler() {
+			err = CreateClusterAutoscaler(t.cfg, t.clusterProvider, clientSet, a)
+		} else {
+			err = addonManager.Create(a, true)
+		}
 		if err != nil {
 			go func() {
 				errorCh <- err
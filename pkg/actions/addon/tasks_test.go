@@ -0,0 +1,37 @@
+package addon_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("NewAddonTask", func() {
+	It("targets the addon in the plan", func() {
+		taskTree := &tasks.TaskTree{}
+		taskTree.Append(addon.NewAddonTask(tasks.ActionUpdate, &api.Addon{Name: "vpc-cni"}, func() error { return nil }))
+
+		Expect(taskTree.Plan().Tasks).To(Equal([]tasks.PlannedTask{
+			{
+				ID:          "task-1",
+				Description: `update addon "vpc-cni"`,
+				Action:      tasks.ActionUpdate,
+				Resource:    "addon/vpc-cni",
+			},
+		}))
+	})
+
+	It("runs the change when the task tree runs", func() {
+		taskTree := &tasks.TaskTree{}
+		taskTree.Append(addon.NewAddonTask(tasks.ActionDelete, &api.Addon{Name: "coredns"}, func() error {
+			return errors.New("failed")
+		}))
+
+		Expect(taskTree.DoAllSync()).To(ConsistOf(MatchError("failed")))
+	})
+})
@@ -0,0 +1,113 @@
+package apply
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// Options controls how the ClusterConfig is reconciled against the cluster
+type Options struct {
+	// Plan only prints the changes without applying them
+	Plan bool
+	// Prune deletes resources that exist in the cluster but are not present in the config
+	Prune bool
+	// Wait for deletions to complete
+	Wait bool
+	// PlanOutput is the format used to print the plan, nothing is printed if it is empty
+	PlanOutput printers.Type
+}
+
+// Manager reconciles a ClusterConfig against a live cluster
+type Manager struct {
+	cfg          *api.ClusterConfig
+	ctl          *eks.ClusterProvider
+	stackManager manager.StackManager
+	clientSet    kubernetes.Interface
+}
+
+// New creates a new manager.
+func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) *Manager {
+	return &Manager{
+		cfg:          cfg,
+		ctl:          ctl,
+		stackManager: ctl.NewStackManager(cfg),
+		clientSet:    clientSet,
+	}
+}
+
+// Apply diffs the ClusterConfig against the cluster and creates, updates or prunes resources
+// so that the cluster matches the config
+func (m *Manager) Apply(options Options) error {
+	state, err := m.getClusterState()
+	if err != nil {
+		return err
+	}
+
+	for _, drift := range DiffImmutable(m.cfg, state) {
+		logDrift(m.cfg.Metadata.Name, drift)
+	}
+
+	var changes []Change
+	for _, change := range Diff(m.cfg, state) {
+		if change.Action == ActionDelete && !options.Prune {
+			logger.Info("%s %q is not present in the config, run with '--prune' to delete it", change.Kind, change.Name)
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	if len(changes) == 0 {
+		logger.Success("cluster %q is up-to-date with the config", m.cfg.Metadata.Name)
+		return tasks.PrintPlan(options.PlanOutput, &tasks.TaskTree{})
+	}
+
+	for _, change := range changes {
+		cmdutils.LogIntendedAction(options.Plan, "%s %s %q", change.Action, change.Kind, change.Name)
+	}
+
+	taskTree, err := m.newTasks(changes, options.Wait)
+	if err != nil {
+		return err
+	}
+	taskTree.PlanMode = options.Plan
+
+	logger.Info(taskTree.Describe())
+	if err := tasks.PrintPlan(options.PlanOutput, taskTree); err != nil {
+		return err
+	}
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred while applying the config, you may wish to check CloudFormation console", len(errs))
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to apply config to cluster %q", m.cfg.Metadata.Name)
+	}
+
+	cmdutils.LogCompletedAction(options.Plan, "applied %d change(s) to cluster %q", len(changes), m.cfg.Metadata.Name)
+	cmdutils.LogPlanModeWarning(options.Plan)
+	return nil
+}
+
+// logDrift warns about a resource whose spec differs from the config in fields that apply can't change,
+// and how to change them
+func logDrift(clusterName string, drift Drift) {
+	fields := strings.Join(drift.Fields, ", ")
+	switch drift.Kind {
+	case NodeGroupKind:
+		logger.Warning("%s %q differs from the config in %s, which can't be updated in place; run 'eksctl replace nodegroup --cluster=%s --name=%s' to recreate it",
+			drift.Kind, drift.Name, fields, clusterName, drift.Name)
+	default:
+		logger.Warning("%s %q differs from the config in %s, which can't be updated in place; delete and recreate it to apply them",
+			drift.Kind, drift.Name, fields)
+	}
+}
@@ -0,0 +1,538 @@
+package apply
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+)
+
+// Action is the kind of change that apply makes to a resource
+type Action string
+
+const (
+	// ActionCreate creates a resource that is only present in the config
+	ActionCreate Action = "create"
+	// ActionUpdate updates a resource that is present in the config and the cluster
+	ActionUpdate Action = "update"
+	// ActionDelete deletes a resource that is only present in the cluster
+	ActionDelete Action = "delete"
+)
+
+// ResourceKind identifies the type of resource a change applies to
+type ResourceKind string
+
+const (
+	NodeGroupKind         ResourceKind = "nodegroup"
+	ManagedNodeGroupKind  ResourceKind = "managed nodegroup"
+	FargateProfileKind    ResourceKind = "fargateprofile"
+	AddonKind             ResourceKind = "addon"
+	IAMServiceAccountKind ResourceKind = "iamserviceaccount"
+	IdentityProviderKind  ResourceKind = "identityprovider"
+)
+
+// Change describes a single difference between the ClusterConfig and the live cluster
+type Change struct {
+	Action Action
+	Kind   ResourceKind
+	Name   string
+}
+
+// ClusterState holds the resources that currently exist in the cluster
+type ClusterState struct {
+	NodeGroups        []string
+	ManagedNodeGroups []string
+	FargateProfiles   []string
+	// Addons maps the addon name to its installed version
+	Addons            map[string]string
+	ServiceAccounts   []string
+	IdentityProviders []string
+	// ServiceAccountPolicies maps the iamserviceaccount name to the role policies of its stack
+	ServiceAccountPolicies map[string]string
+	// NodeGroupSpecs maps the name of an unmanaged nodegroup to its spec, as rebuilt from its stack
+	NodeGroupSpecs map[string]*api.NodeGroup
+	// ManagedNodeGroupSpecs maps the name of a managed nodegroup to its description
+	ManagedNodeGroupSpecs map[string]*awseks.Nodegroup
+	// FargateProfileSpecs maps the name of a Fargate profile to its spec
+	FargateProfileSpecs map[string]*api.FargateProfile
+	// IdentityProviderSpecs maps the name of an identity provider to its spec
+	IdentityProviderSpecs map[string]*api.OIDCIdentityProvider
+	// LatestAddonVersions holds the version that "latest" resolves to for the installed addons of the config
+	LatestAddonVersions map[string]string
+}
+
+// Drift describes a resource whose spec differs from the config in fields that can't be updated in place
+type Drift struct {
+	Kind   ResourceKind
+	Name   string
+	Fields []string
+}
+
+// Diff compares the desired ClusterConfig against the live cluster state and returns the
+// list of changes required to reconcile them, grouped by kind
+func Diff(cfg *api.ClusterConfig, state *ClusterState) []Change {
+	var changes []Change
+
+	var nodeGroups, managedNodeGroups []string
+	for _, ng := range cfg.NodeGroups {
+		nodeGroups = append(nodeGroups, ng.Name)
+		// only the scaling of unmanaged nodegroups can be updated, see DiffImmutable for the rest
+		if spec, ok := state.NodeGroupSpecs[ng.Name]; ok && scalingNeedsUpdate(ng.ScalingConfig, spec.ScalingConfig) {
+			changes = append(changes, Change{Action: ActionUpdate, Kind: NodeGroupKind, Name: ng.Name})
+		}
+	}
+	changes = append(changes, diffNames(NodeGroupKind, nodeGroups, state.NodeGroups)...)
+	for _, ng := range cfg.ManagedNodeGroups {
+		managedNodeGroups = append(managedNodeGroups, ng.Name)
+		if live, ok := state.ManagedNodeGroupSpecs[ng.Name]; ok && managedNodeGroupUpdate(cfg.Metadata.Name, ng, live) != nil {
+			changes = append(changes, Change{Action: ActionUpdate, Kind: ManagedNodeGroupKind, Name: ng.Name})
+		}
+	}
+	changes = append(changes, diffNames(ManagedNodeGroupKind, managedNodeGroups, state.ManagedNodeGroups)...)
+
+	var fargateProfiles []string
+	for _, fp := range cfg.FargateProfiles {
+		fargateProfiles = append(fargateProfiles, fp.Name)
+	}
+	changes = append(changes, diffNames(FargateProfileKind, fargateProfiles, state.FargateProfiles)...)
+
+	var addons, installedAddons []string
+	for _, a := range cfg.Addons {
+		addons = append(addons, a.Name)
+		desiredVersion := a.Version
+		if desiredVersion == "latest" {
+			desiredVersion = state.LatestAddonVersions[a.Name]
+		}
+		if installedVersion, ok := state.Addons[a.Name]; ok && addonNeedsUpdate(desiredVersion, installedVersion) {
+			changes = append(changes, Change{Action: ActionUpdate, Kind: AddonKind, Name: a.Name})
+		}
+	}
+	for name := range state.Addons {
+		installedAddons = append(installedAddons, name)
+	}
+	changes = append(changes, diffNames(AddonKind, addons, installedAddons)...)
+
+	if cfg.IAM != nil {
+		var serviceAccounts []string
+		existing := make(map[string]struct{}, len(state.ServiceAccounts))
+		for _, sa := range state.ServiceAccounts {
+			existing[sa] = struct{}{}
+		}
+		for _, sa := range cfg.IAM.ServiceAccounts {
+			serviceAccounts = append(serviceAccounts, sa.NameString())
+			if _, ok := existing[sa.NameString()]; ok && sa.AttachRoleARN == "" && serviceAccountNeedsUpdate(sa, state.ServiceAccountPolicies[sa.NameString()]) {
+				changes = append(changes, Change{Action: ActionUpdate, Kind: IAMServiceAccountKind, Name: sa.NameString()})
+			}
+		}
+		changes = append(changes, diffNames(IAMServiceAccountKind, serviceAccounts, state.ServiceAccounts)...)
+	}
+
+	var identityProviders []string
+	for _, idp := range cfg.IdentityProviders {
+		if oidc, ok := idp.Inner.(*api.OIDCIdentityProvider); ok {
+			identityProviders = append(identityProviders, oidc.Name)
+		}
+	}
+	changes = append(changes, diffNames(IdentityProviderKind, identityProviders, state.IdentityProviders)...)
+
+	return changes
+}
+
+// DiffImmutable returns the unmanaged nodegroups, Fargate profiles and identity providers whose spec differs
+// from the config in fields that can only be changed by recreating them
+func DiffImmutable(cfg *api.ClusterConfig, state *ClusterState) []Drift {
+	var drifts []Drift
+	addDrift := func(kind ResourceKind, name string, fields []string) {
+		if len(fields) > 0 {
+			drifts = append(drifts, Drift{Kind: kind, Name: name, Fields: fields})
+		}
+	}
+
+	for _, ng := range cfg.NodeGroups {
+		if spec, ok := state.NodeGroupSpecs[ng.Name]; ok {
+			addDrift(NodeGroupKind, ng.Name, nodeGroupDrift(ng, spec))
+		}
+	}
+	for _, fp := range cfg.FargateProfiles {
+		if spec, ok := state.FargateProfileSpecs[fp.Name]; ok {
+			addDrift(FargateProfileKind, fp.Name, fargateProfileDrift(fp, spec))
+		}
+	}
+	for _, idp := range cfg.IdentityProviders {
+		if oidc, ok := idp.Inner.(*api.OIDCIdentityProvider); ok {
+			if spec, ok := state.IdentityProviderSpecs[oidc.Name]; ok {
+				addDrift(IdentityProviderKind, oidc.Name, identityProviderDrift(oidc, spec))
+			}
+		}
+	}
+	return drifts
+}
+
+// nodeGroupDrift returns the fields of an unmanaged nodegroup that differ from its spec; fields that
+// are not set in the config are not compared
+func nodeGroupDrift(ng, spec *api.NodeGroup) []string {
+	var fields []string
+	if ng.InstancesDistribution != nil && len(ng.InstancesDistribution.InstanceTypes) > 0 {
+		var specInstanceTypes []string
+		if spec.InstancesDistribution != nil {
+			specInstanceTypes = spec.InstancesDistribution.InstanceTypes
+		}
+		if !reflect.DeepEqual(sortedCopy(ng.InstancesDistribution.InstanceTypes), sortedCopy(specInstanceTypes)) {
+			fields = append(fields, "instancesDistribution.instanceTypes")
+		}
+	} else if ng.InstanceType != "" && ng.InstanceType != spec.InstanceType {
+		fields = append(fields, "instanceType")
+	}
+	if ng.VolumeSize != nil && spec.VolumeSize != nil && *ng.VolumeSize != *spec.VolumeSize {
+		fields = append(fields, "volumeSize")
+	}
+	if ng.VolumeType != nil && spec.VolumeType != nil && *ng.VolumeType != *spec.VolumeType {
+		fields = append(fields, "volumeType")
+	}
+	if api.IsAMI(ng.AMI) && ng.AMI != spec.AMI {
+		fields = append(fields, "ami")
+	}
+	if !reflect.DeepEqual(userLabels(ng.Labels), userLabels(spec.Labels)) {
+		fields = append(fields, "labels")
+	}
+	if !sets.NewString(taintKeys(ng.Taints, true)...).Equal(sets.NewString(taintKeys(spec.Taints, true)...)) {
+		fields = append(fields, "taints")
+	}
+	return fields
+}
+
+// fargateProfileDrift returns the fields of a Fargate profile that differ from its spec; the subnets and
+// pod execution role are only compared when they are set in the config
+func fargateProfileDrift(fp, spec *api.FargateProfile) []string {
+	var fields []string
+	selectors := func(selectors []api.FargateProfileSelector) sets.String {
+		keys := sets.NewString()
+		for _, s := range selectors {
+			keys.Insert(s.Namespace + "|" + labels.Set(s.Labels).String())
+		}
+		return keys
+	}
+	if !selectors(fp.Selectors).Equal(selectors(spec.Selectors)) {
+		fields = append(fields, "selectors")
+	}
+	if len(fp.Subnets) > 0 && !sets.NewString(fp.Subnets...).Equal(sets.NewString(spec.Subnets...)) {
+		fields = append(fields, "subnets")
+	}
+	if fp.PodExecutionRoleARN != "" && fp.PodExecutionRoleARN != spec.PodExecutionRoleARN {
+		fields = append(fields, "podExecutionRoleARN")
+	}
+	return fields
+}
+
+// identityProviderDrift returns the fields of an identity provider that differ from its spec; optional
+// fields are only compared when they are set in the config
+func identityProviderDrift(idp, spec *api.OIDCIdentityProvider) []string {
+	var fields []string
+	for _, f := range []struct {
+		name            string
+		desired, actual string
+		required        bool
+	}{
+		{"issuerURL", idp.IssuerURL, spec.IssuerURL, true},
+		{"clientID", idp.ClientID, spec.ClientID, true},
+		{"usernameClaim", idp.UsernameClaim, spec.UsernameClaim, false},
+		{"usernamePrefix", idp.UsernamePrefix, spec.UsernamePrefix, false},
+		{"groupsClaim", idp.GroupsClaim, spec.GroupsClaim, false},
+		{"groupsPrefix", idp.GroupsPrefix, spec.GroupsPrefix, false},
+	} {
+		if (f.required || f.desired != "") && f.desired != f.actual {
+			fields = append(fields, f.name)
+		}
+	}
+	if len(idp.RequiredClaims) > 0 && !reflect.DeepEqual(idp.RequiredClaims, spec.RequiredClaims) {
+		fields = append(fields, "requiredClaims")
+	}
+	return fields
+}
+
+// managedNodeGroupUpdate returns the update that brings the scaling, labels, taints and updateConfig of a
+// managed nodegroup in line with the config, or nil when they already match; scaling and updateConfig
+// fields that are not set in the config are left as they are
+func managedNodeGroupUpdate(clusterName string, ng *api.ManagedNodeGroup, live *awseks.Nodegroup) *awseks.UpdateNodegroupConfigInput {
+	input := &awseks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(ng.Name),
+	}
+	changed := false
+
+	if current := live.ScalingConfig; current != nil && scalingNeedsUpdate(ng.ScalingConfig, &api.ScalingConfig{
+		DesiredCapacity: intValue(current.DesiredSize),
+		MinSize:         intValue(current.MinSize),
+		MaxSize:         intValue(current.MaxSize),
+	}) {
+		scalingConfig := &awseks.NodegroupScalingConfig{
+			DesiredSize: current.DesiredSize,
+			MinSize:     current.MinSize,
+			MaxSize:     current.MaxSize,
+		}
+		if ng.DesiredCapacity != nil {
+			scalingConfig.DesiredSize = aws.Int64(int64(*ng.DesiredCapacity))
+		}
+		if ng.MinSize != nil {
+			scalingConfig.MinSize = aws.Int64(int64(*ng.MinSize))
+		}
+		if ng.MaxSize != nil {
+			scalingConfig.MaxSize = aws.Int64(int64(*ng.MaxSize))
+		}
+		input.ScalingConfig = scalingConfig
+		changed = true
+	}
+
+	desiredLabels, currentLabels := userLabels(ng.Labels), userLabels(aws.StringValueMap(live.Labels))
+	labelsPayload := &awseks.UpdateLabelsPayload{}
+	for k, v := range desiredLabels {
+		if current, ok := currentLabels[k]; !ok || current != v {
+			if labelsPayload.AddOrUpdateLabels == nil {
+				labelsPayload.AddOrUpdateLabels = map[string]*string{}
+			}
+			labelsPayload.AddOrUpdateLabels[k] = aws.String(v)
+		}
+	}
+	for _, k := range sortedKeys(currentLabels) {
+		if _, ok := desiredLabels[k]; !ok {
+			labelsPayload.RemoveLabels = append(labelsPayload.RemoveLabels, aws.String(k))
+		}
+	}
+	if labelsPayload.AddOrUpdateLabels != nil || labelsPayload.RemoveLabels != nil {
+		input.Labels = labelsPayload
+		changed = true
+	}
+
+	var currentTaints []api.NodeGroupTaint
+	for _, t := range live.Taints {
+		currentTaints = append(currentTaints, api.NodeGroupTaint{
+			Key:    aws.StringValue(t.Key),
+			Value:  aws.StringValue(t.Value),
+			Effect: api.TaintEffectFromEKS(aws.StringValue(t.Effect)),
+		})
+	}
+	desiredTaints := sets.NewString(taintKeys(ng.Taints, true)...)
+	desiredTaintKeys := sets.NewString(taintKeys(ng.Taints, false)...)
+	currentTaintSet := sets.NewString(taintKeys(currentTaints, true)...)
+	taintsPayload := &awseks.UpdateTaintsPayload{}
+	for _, t := range ng.Taints {
+		if !currentTaintSet.Has(taintKey(t, true)) {
+			taintsPayload.AddOrUpdateTaints = append(taintsPayload.AddOrUpdateTaints, eksTaint(t))
+		}
+	}
+	for _, t := range currentTaints {
+		// taints whose value changed are updated, not removed
+		if !desiredTaints.Has(taintKey(t, true)) && !desiredTaintKeys.Has(taintKey(t, false)) {
+			taintsPayload.RemoveTaints = append(taintsPayload.RemoveTaints, eksTaint(t))
+		}
+	}
+	if taintsPayload.AddOrUpdateTaints != nil || taintsPayload.RemoveTaints != nil {
+		input.Taints = taintsPayload
+		changed = true
+	}
+
+	if ng.UpdateConfig != nil {
+		current := live.UpdateConfig
+		if current == nil {
+			current = &awseks.NodegroupUpdateConfig{}
+		}
+		differs := func(desired *int, current *int64) bool {
+			return desired != nil && int64(*desired) != aws.Int64Value(current)
+		}
+		if differs(ng.UpdateConfig.MaxUnavailable, current.MaxUnavailable) || differs(ng.UpdateConfig.MaxUnavailablePercentage, current.MaxUnavailablePercentage) {
+			updateConfig := &awseks.NodegroupUpdateConfig{}
+			if ng.UpdateConfig.MaxUnavailable != nil {
+				updateConfig.MaxUnavailable = aws.Int64(int64(*ng.UpdateConfig.MaxUnavailable))
+			}
+			if ng.UpdateConfig.MaxUnavailablePercentage != nil {
+				updateConfig.MaxUnavailablePercentage = aws.Int64(int64(*ng.UpdateConfig.MaxUnavailablePercentage))
+			}
+			input.UpdateConfig = updateConfig
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+	return input
+}
+
+// scalingNeedsUpdate returns true when a size that is set in the config differs from the current one;
+// sizes that are missing from the current scaling are not compared
+func scalingNeedsUpdate(desired, current *api.ScalingConfig) bool {
+	if desired == nil || current == nil {
+		return false
+	}
+	differs := func(desired, current *int) bool {
+		return desired != nil && current != nil && *desired != *current
+	}
+	return differs(desired.DesiredCapacity, current.DesiredCapacity) || differs(desired.MinSize, current.MinSize) || differs(desired.MaxSize, current.MaxSize)
+}
+
+func intValue(v *int64) *int {
+	if v == nil {
+		return nil
+	}
+	return aws.Int(int(*v))
+}
+
+// userLabels drops the labels that eksctl sets on every nodegroup
+func userLabels(nodeLabels map[string]string) map[string]string {
+	result := map[string]string{}
+	for k, v := range nodeLabels {
+		if !strings.HasPrefix(k, "alpha.eksctl.io/") {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// taintKey identifies a taint by its key and effect, and its value when withValue is set
+func taintKey(t api.NodeGroupTaint, withValue bool) string {
+	if withValue {
+		return t.Key + "=" + t.Value + ":" + string(t.Effect)
+	}
+	return t.Key + ":" + string(t.Effect)
+}
+
+func taintKeys(taints []api.NodeGroupTaint, withValue bool) []string {
+	var keys []string
+	for _, t := range taints {
+		keys = append(keys, taintKey(t, withValue))
+	}
+	return keys
+}
+
+func eksTaint(t api.NodeGroupTaint) *awseks.Taint {
+	taint := &awseks.Taint{
+		Key:    aws.String(t.Key),
+		Effect: aws.String(taintEffectToEKS(t.Effect)),
+	}
+	if t.Value != "" {
+		taint.Value = aws.String(t.Value)
+	}
+	return taint
+}
+
+func taintEffectToEKS(effect corev1.TaintEffect) string {
+	switch effect {
+	case corev1.TaintEffectNoSchedule:
+		return awseks.TaintEffectNoSchedule
+	case corev1.TaintEffectPreferNoSchedule:
+		return awseks.TaintEffectPreferNoSchedule
+	case corev1.TaintEffectNoExecute:
+		return awseks.TaintEffectNoExecute
+	default:
+		return string(effect)
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// diffNames returns a create change for every desired name that does not exist and a delete
+// change for every existing name that is not desired, sorted by name
+func diffNames(kind ResourceKind, desired, existing []string) []Change {
+	var changes []Change
+	desired = sortedCopy(desired)
+	existing = sortedCopy(existing)
+	existingSet := make(map[string]struct{}, len(existing))
+	for _, name := range existing {
+		existingSet[name] = struct{}{}
+	}
+	desiredSet := make(map[string]struct{}, len(desired))
+	for _, name := range desired {
+		desiredSet[name] = struct{}{}
+		if _, ok := existingSet[name]; !ok {
+			changes = append(changes, Change{Action: ActionCreate, Kind: kind, Name: name})
+		}
+	}
+	for _, name := range existing {
+		if _, ok := desiredSet[name]; !ok {
+			changes = append(changes, Change{Action: ActionDelete, Kind: kind, Name: name})
+		}
+	}
+	return changes
+}
+
+// serviceAccountNeedsUpdate returns true when the role policies of the iamserviceaccount differ from
+// the ones of its stack, or when either can't be determined
+func serviceAccountNeedsUpdate(sa *api.ClusterIAMServiceAccount, stackPolicies string) bool {
+	if stackPolicies == "" {
+		return true
+	}
+	// the trust policy is left out of the comparison, so any issuer renders the same policies
+	oidc, err := iamoidc.NewOpenIDConnectManager(nil, "", "https://oidc.eks.amazonaws.com", "", nil)
+	if err != nil {
+		return true
+	}
+	rs := builder.NewIAMRoleResourceSetForServiceAccount(sa, oidc)
+	if err := rs.AddAllResources(); err != nil {
+		return true
+	}
+	template, err := rs.RenderJSON()
+	if err != nil {
+		return true
+	}
+	policies, err := rolePolicies(template)
+	return err != nil || policies != stackPolicies
+}
+
+// rolePolicies returns the resources of an iamserviceaccount stack template, without the trust policy
+// of the role, as canonical JSON
+func rolePolicies(template []byte) (string, error) {
+	var t struct {
+		Resources map[string]struct {
+			Type       string
+			Properties map[string]interface{}
+		}
+	}
+	if err := json.Unmarshal(template, &t); err != nil {
+		return "", errors.Wrap(err, "parsing iamserviceaccount stack template")
+	}
+	for _, resource := range t.Resources {
+		if resource.Type == "AWS::IAM::Role" {
+			delete(resource.Properties, "AssumeRolePolicyDocument")
+		}
+	}
+	policies, err := json.Marshal(t.Resources)
+	if err != nil {
+		return "", err
+	}
+	return string(policies), nil
+}
+
+// addonNeedsUpdate returns true when a version is set in the config and it doesn't match the
+// installed version; partial versions such as "v1.7.5" match "v1.7.5-eksbuild.1"
+func addonNeedsUpdate(desiredVersion, installedVersion string) bool {
+	if desiredVersion == "" {
+		return false
+	}
+	desired := strings.TrimPrefix(desiredVersion, "v")
+	installed := strings.TrimPrefix(installedVersion, "v")
+	return installed != desired && !strings.HasPrefix(installed, desired+"-")
+}
+
+func sortedCopy(names []string) []string {
+	sorted := append([]string{}, names...)
+	sort.Strings(sorted)
+	return sorted
+}
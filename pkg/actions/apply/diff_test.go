@@ -0,0 +1,365 @@
+package apply_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/weaveworks/eksctl/pkg/actions/apply"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+)
+
+var _ = Describe("Diff", func() {
+	var (
+		cfg   *api.ClusterConfig
+		state *apply.ClusterState
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		state = &apply.ClusterState{Addons: map[string]string{}}
+	})
+
+	It("returns no changes when the cluster matches the config", func() {
+		ng := api.NewNodeGroup()
+		ng.Name = "ng-1"
+		cfg.NodeGroups = append(cfg.NodeGroups, ng)
+		state.NodeGroups = []string{"ng-1"}
+
+		Expect(apply.Diff(cfg, state)).To(BeEmpty())
+	})
+
+	It("creates nodegroups that only exist in the config and deletes nodegroups that only exist in the cluster", func() {
+		for _, name := range []string{"ng-2", "ng-1"} {
+			ng := api.NewNodeGroup()
+			ng.Name = name
+			cfg.NodeGroups = append(cfg.NodeGroups, ng)
+		}
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng-1"
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, mng)
+		state.NodeGroups = []string{"ng-1", "ng-old"}
+
+		Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+			{Action: apply.ActionCreate, Kind: apply.NodeGroupKind, Name: "ng-2"},
+			{Action: apply.ActionDelete, Kind: apply.NodeGroupKind, Name: "ng-old"},
+			{Action: apply.ActionCreate, Kind: apply.ManagedNodeGroupKind, Name: "mng-1"},
+		}))
+	})
+
+	It("diffs fargate profiles and identity providers", func() {
+		cfg.FargateProfiles = []*api.FargateProfile{{Name: "fp-new"}}
+		cfg.IdentityProviders = []api.IdentityProvider{
+			api.FromIdentityProvider(&api.OIDCIdentityProvider{Name: "idp-1"}),
+		}
+		state.FargateProfiles = []string{"fp-old"}
+		state.IdentityProviders = []string{"idp-1", "idp-2"}
+
+		Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+			{Action: apply.ActionCreate, Kind: apply.FargateProfileKind, Name: "fp-new"},
+			{Action: apply.ActionDelete, Kind: apply.FargateProfileKind, Name: "fp-old"},
+			{Action: apply.ActionDelete, Kind: apply.IdentityProviderKind, Name: "idp-2"},
+		}))
+	})
+
+	It("updates addons whose version differs from the installed version", func() {
+		cfg.Addons = []*api.Addon{
+			{Name: "vpc-cni", Version: "1.8.0"},
+			{Name: "coredns", Version: "v1.8.3"},
+			{Name: "kube-proxy"},
+		}
+		state.Addons = map[string]string{
+			"vpc-cni":    "v1.7.5-eksbuild.1",
+			"coredns":    "v1.8.3-eksbuild.1",
+			"kube-proxy": "v1.20.4-eksbuild.2",
+		}
+
+		Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+			{Action: apply.ActionUpdate, Kind: apply.AddonKind, Name: "vpc-cni"},
+		}))
+	})
+
+	It("updates addons set to latest only when a newer version is available", func() {
+		cfg.Addons = []*api.Addon{
+			{Name: "vpc-cni", Version: "latest"},
+			{Name: "coredns", Version: "latest"},
+		}
+		state.Addons = map[string]string{
+			"vpc-cni": "v1.8.0-eksbuild.1",
+			"coredns": "v1.8.0-eksbuild.1",
+		}
+		state.LatestAddonVersions = map[string]string{
+			"vpc-cni": "v1.8.0-eksbuild.1",
+			"coredns": "v1.8.3-eksbuild.1",
+		}
+
+		Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+			{Action: apply.ActionUpdate, Kind: apply.AddonKind, Name: "coredns"},
+		}))
+	})
+
+	It("updates existing iamserviceaccounts that eksctl created a role for", func() {
+		cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{
+			{ClusterIAMMeta: api.ClusterIAMMeta{Name: "sa-1", Namespace: "default"}},
+			{ClusterIAMMeta: api.ClusterIAMMeta{Name: "sa-2", Namespace: "default"}, AttachRoleARN: "arn:aws:iam::123:role/foo"},
+			{ClusterIAMMeta: api.ClusterIAMMeta{Name: "sa-3", Namespace: "kube-system"}},
+		}
+		state.ServiceAccounts = []string{"default/sa-1", "default/sa-2", "default/sa-old"}
+
+		Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+			{Action: apply.ActionUpdate, Kind: apply.IAMServiceAccountKind, Name: "default/sa-1"},
+			{Action: apply.ActionCreate, Kind: apply.IAMServiceAccountKind, Name: "kube-system/sa-3"},
+			{Action: apply.ActionDelete, Kind: apply.IAMServiceAccountKind, Name: "default/sa-old"},
+		}))
+	})
+
+	Context("iamserviceaccounts with a stack", func() {
+		stackPolicies := func(sa *api.ClusterIAMServiceAccount, issuer string) string {
+			oidc, err := iamoidc.NewOpenIDConnectManager(nil, "123456789012", issuer, "aws", nil)
+			Expect(err).NotTo(HaveOccurred())
+			rs := builder.NewIAMRoleResourceSetForServiceAccount(sa, oidc)
+			Expect(rs.AddAllResources()).To(Succeed())
+			template, err := rs.RenderJSON()
+			Expect(err).NotTo(HaveOccurred())
+			policies, err := apply.RolePolicies(template)
+			Expect(err).NotTo(HaveOccurred())
+			return policies
+		}
+
+		var sa *api.ClusterIAMServiceAccount
+
+		BeforeEach(func() {
+			sa = &api.ClusterIAMServiceAccount{
+				ClusterIAMMeta:   api.ClusterIAMMeta{Name: "sa-1", Namespace: "default"},
+				AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				WellKnownPolicies: api.WellKnownPolicies{
+					AutoScaler: true,
+				},
+			}
+			cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{sa}
+			state.ServiceAccounts = []string{"default/sa-1"}
+			state.ServiceAccountPolicies = map[string]string{
+				"default/sa-1": stackPolicies(sa, "https://oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE"),
+			}
+		})
+
+		It("doesn't update them when their role policies match the stack", func() {
+			Expect(apply.Diff(cfg, state)).To(BeEmpty())
+		})
+
+		It("updates them when their role policies differ from the stack", func() {
+			sa.AttachPolicyARNs = append(sa.AttachPolicyARNs, "arn:aws:iam::aws:policy/AmazonSQSFullAccess")
+
+			Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+				{Action: apply.ActionUpdate, Kind: apply.IAMServiceAccountKind, Name: "default/sa-1"},
+			}))
+		})
+
+		It("updates them when their inline policy differs from the stack", func() {
+			sa.AttachPolicy = api.InlineDocument{
+				"Version": "2012-10-17",
+				"Statement": []interface{}{
+					map[string]interface{}{"Effect": "Allow", "Action": []interface{}{"s3:GetObject"}, "Resource": "*"},
+				},
+			}
+
+			Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+				{Action: apply.ActionUpdate, Kind: apply.IAMServiceAccountKind, Name: "default/sa-1"},
+			}))
+		})
+	})
+
+	Context("managed nodegroups that exist in the cluster", func() {
+		var (
+			ng   *api.ManagedNodeGroup
+			live *awseks.Nodegroup
+		)
+
+		BeforeEach(func() {
+			ng = &api.ManagedNodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name:          "mng-1",
+					ScalingConfig: &api.ScalingConfig{MinSize: aws.Int(1), MaxSize: aws.Int(3)},
+					Labels:        map[string]string{"role": "web"},
+				},
+				Taints: []api.NodeGroupTaint{{Key: "dedicated", Value: "web", Effect: corev1.TaintEffectNoSchedule}},
+			}
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{ng}
+			live = &awseks.Nodegroup{
+				NodegroupName: aws.String("mng-1"),
+				ScalingConfig: &awseks.NodegroupScalingConfig{DesiredSize: aws.Int64(2), MinSize: aws.Int64(1), MaxSize: aws.Int64(3)},
+				Labels: aws.StringMap(map[string]string{
+					"role":                         "web",
+					"alpha.eksctl.io/cluster-name": "my-cluster",
+				}),
+				Taints:       []*awseks.Taint{{Key: aws.String("dedicated"), Value: aws.String("web"), Effect: aws.String(awseks.TaintEffectNoSchedule)}},
+				UpdateConfig: &awseks.NodegroupUpdateConfig{MaxUnavailable: aws.Int64(1)},
+			}
+			state.ManagedNodeGroups = []string{"mng-1"}
+			state.ManagedNodeGroupSpecs = map[string]*awseks.Nodegroup{"mng-1": live}
+		})
+
+		It("doesn't update them when they match the config", func() {
+			Expect(apply.Diff(cfg, state)).To(BeEmpty())
+			Expect(apply.ManagedNodeGroupUpdate("my-cluster", ng, live)).To(BeNil())
+		})
+
+		It("updates their scaling", func() {
+			ng.MaxSize = aws.Int(5)
+
+			Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+				{Action: apply.ActionUpdate, Kind: apply.ManagedNodeGroupKind, Name: "mng-1"},
+			}))
+			Expect(apply.ManagedNodeGroupUpdate("my-cluster", ng, live)).To(Equal(&awseks.UpdateNodegroupConfigInput{
+				ClusterName:   aws.String("my-cluster"),
+				NodegroupName: aws.String("mng-1"),
+				ScalingConfig: &awseks.NodegroupScalingConfig{DesiredSize: aws.Int64(2), MinSize: aws.Int64(1), MaxSize: aws.Int64(5)},
+			}))
+		})
+
+		It("only compares the sizes that are set in their scaling", func() {
+			live.ScalingConfig = &awseks.NodegroupScalingConfig{MaxSize: aws.Int64(3)}
+
+			Expect(apply.Diff(cfg, state)).To(BeEmpty())
+
+			ng.MaxSize = aws.Int(5)
+			Expect(apply.ManagedNodeGroupUpdate("my-cluster", ng, live).ScalingConfig).To(Equal(&awseks.NodegroupScalingConfig{
+				MinSize: aws.Int64(1),
+				MaxSize: aws.Int64(5),
+			}))
+		})
+
+		It("updates their labels, ignoring the labels set by eksctl", func() {
+			ng.Labels = map[string]string{"role": "api", "team": "a"}
+			live.Labels["stale"] = aws.String("true")
+
+			Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+				{Action: apply.ActionUpdate, Kind: apply.ManagedNodeGroupKind, Name: "mng-1"},
+			}))
+			Expect(apply.ManagedNodeGroupUpdate("my-cluster", ng, live).Labels).To(Equal(&awseks.UpdateLabelsPayload{
+				AddOrUpdateLabels: aws.StringMap(map[string]string{"role": "api", "team": "a"}),
+				RemoveLabels:      aws.StringSlice([]string{"stale"}),
+			}))
+		})
+
+		It("updates their taints", func() {
+			ng.Taints = []api.NodeGroupTaint{
+				{Key: "dedicated", Value: "api", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "spot", Effect: corev1.TaintEffectPreferNoSchedule},
+			}
+			live.Taints = append(live.Taints, &awseks.Taint{Key: aws.String("gpu"), Effect: aws.String(awseks.TaintEffectNoExecute)})
+
+			Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+				{Action: apply.ActionUpdate, Kind: apply.ManagedNodeGroupKind, Name: "mng-1"},
+			}))
+			Expect(apply.ManagedNodeGroupUpdate("my-cluster", ng, live).Taints).To(Equal(&awseks.UpdateTaintsPayload{
+				AddOrUpdateTaints: []*awseks.Taint{
+					{Key: aws.String("dedicated"), Value: aws.String("api"), Effect: aws.String(awseks.TaintEffectNoSchedule)},
+					{Key: aws.String("spot"), Effect: aws.String(awseks.TaintEffectPreferNoSchedule)},
+				},
+				RemoveTaints: []*awseks.Taint{
+					{Key: aws.String("gpu"), Effect: aws.String(awseks.TaintEffectNoExecute)},
+				},
+			}))
+		})
+
+		It("updates their updateConfig", func() {
+			ng.UpdateConfig = &api.NodeGroupUpdateConfig{MaxUnavailablePercentage: aws.Int(50)}
+
+			Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+				{Action: apply.ActionUpdate, Kind: apply.ManagedNodeGroupKind, Name: "mng-1"},
+			}))
+			Expect(apply.ManagedNodeGroupUpdate("my-cluster", ng, live).UpdateConfig).To(Equal(&awseks.NodegroupUpdateConfig{
+				MaxUnavailablePercentage: aws.Int64(50),
+			}))
+		})
+	})
+
+	Context("unmanaged nodegroups that exist in the cluster", func() {
+		var ng, spec *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.InstanceType = "m5.large"
+			ng.MaxSize = aws.Int(3)
+			ng.Labels = map[string]string{"role": "web"}
+			cfg.NodeGroups = []*api.NodeGroup{ng}
+
+			spec = &api.NodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name:          "ng-1",
+					InstanceType:  "m5.large",
+					ScalingConfig: &api.ScalingConfig{DesiredCapacity: aws.Int(2), MinSize: aws.Int(2), MaxSize: aws.Int(3)},
+					VolumeSize:    aws.Int(80),
+					Labels:        map[string]string{"role": "web"},
+				},
+			}
+			state.NodeGroups = []string{"ng-1"}
+			state.NodeGroupSpecs = map[string]*api.NodeGroup{"ng-1": spec}
+		})
+
+		It("doesn't update them when they match the config", func() {
+			Expect(apply.Diff(cfg, state)).To(BeEmpty())
+			Expect(apply.DiffImmutable(cfg, state)).To(BeEmpty())
+		})
+
+		It("updates their scaling", func() {
+			ng.MinSize = aws.Int(1)
+
+			Expect(apply.Diff(cfg, state)).To(Equal([]apply.Change{
+				{Action: apply.ActionUpdate, Kind: apply.NodeGroupKind, Name: "ng-1"},
+			}))
+			Expect(apply.DiffImmutable(cfg, state)).To(BeEmpty())
+		})
+
+		It("only compares the sizes that are set in their scaling", func() {
+			ng.MinSize = aws.Int(1)
+			spec.ScalingConfig = &api.ScalingConfig{MaxSize: aws.Int(3)}
+
+			Expect(apply.Diff(cfg, state)).To(BeEmpty())
+		})
+
+		It("reports the fields that can't be updated in place", func() {
+			ng.InstanceType = "m5.xlarge"
+			ng.VolumeSize = aws.Int(100)
+			ng.Labels = nil
+			ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule}}
+
+			Expect(apply.Diff(cfg, state)).To(BeEmpty())
+			Expect(apply.DiffImmutable(cfg, state)).To(Equal([]apply.Drift{
+				{Kind: apply.NodeGroupKind, Name: "ng-1", Fields: []string{"instanceType", "volumeSize", "labels", "taints"}},
+			}))
+		})
+	})
+
+	It("reports Fargate profiles and identity providers whose spec differs from the config", func() {
+		cfg.FargateProfiles = []*api.FargateProfile{
+			{Name: "fp-1", Selectors: []api.FargateProfileSelector{{Namespace: "default"}}},
+			{Name: "fp-2", Selectors: []api.FargateProfileSelector{{Namespace: "kube-system"}}, Subnets: []string{"subnet-1"}},
+		}
+		cfg.IdentityProviders = []api.IdentityProvider{
+			api.FromIdentityProvider(&api.OIDCIdentityProvider{Name: "idp-1", IssuerURL: "https://example.com", ClientID: "new-client"}),
+		}
+		state.FargateProfiles = []string{"fp-1", "fp-2"}
+		state.FargateProfileSpecs = map[string]*api.FargateProfile{
+			"fp-1": {Name: "fp-1", Selectors: []api.FargateProfileSelector{{Namespace: "default"}}, Subnets: []string{"subnet-1"}},
+			"fp-2": {Name: "fp-2", Selectors: []api.FargateProfileSelector{{Namespace: "kube-system", Labels: map[string]string{"app": "dns"}}}, Subnets: []string{"subnet-2"}},
+		}
+		state.IdentityProviders = []string{"idp-1"}
+		state.IdentityProviderSpecs = map[string]*api.OIDCIdentityProvider{
+			"idp-1": {Name: "idp-1", IssuerURL: "https://example.com", ClientID: "client", UsernameClaim: "sub"},
+		}
+
+		Expect(apply.Diff(cfg, state)).To(BeEmpty())
+		Expect(apply.DiffImmutable(cfg, state)).To(Equal([]apply.Drift{
+			{Kind: apply.FargateProfileKind, Name: "fp-2", Fields: []string{"selectors", "subnets"}},
+			{Kind: apply.IdentityProviderKind, Name: "idp-1", Fields: []string{"clientID"}},
+		}))
+	})
+})
@@ -0,0 +1,23 @@
+package apply
+
+import (
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// RolePolicies returns the role policies of an iamserviceaccount stack template.
+func RolePolicies(template []byte) (string, error) {
+	return rolePolicies(template)
+}
+
+// NodeGroupTasks returns the tasks that apply the nodegroup changes.
+func (m *Manager) NodeGroupTasks(changes []Change, wait bool) *tasks.TaskTree {
+	return m.nodeGroupTasks(changes, wait)
+}
+
+// ManagedNodeGroupUpdate returns the update that brings a managed nodegroup in line with the config.
+func ManagedNodeGroupUpdate(clusterName string, ng *api.ManagedNodeGroup, live *awseks.Nodegroup) *awseks.UpdateNodegroupConfigInput {
+	return managedNodeGroupUpdate(clusterName, ng, live)
+}
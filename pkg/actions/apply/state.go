@@ -0,0 +1,184 @@
+package apply
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/export"
+	"github.com/weaveworks/eksctl/pkg/actions/identityproviders"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/fargate"
+)
+
+// getClusterState collects the resources that currently exist in the cluster
+func (m *Manager) getClusterState() (*ClusterState, error) {
+	state := &ClusterState{
+		Addons:                 map[string]string{},
+		LatestAddonVersions:    map[string]string{},
+		ServiceAccountPolicies: map[string]string{},
+		NodeGroupSpecs:         map[string]*api.NodeGroup{},
+		ManagedNodeGroupSpecs:  map[string]*awseks.Nodegroup{},
+		FargateProfileSpecs:    map[string]*api.FargateProfile{},
+		IdentityProviderSpecs:  map[string]*api.OIDCIdentityProvider{},
+	}
+
+	nodeGroupStacks, err := m.stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodegroup stacks")
+	}
+	for _, s := range nodeGroupStacks {
+		switch s.Type {
+		case api.NodeGroupTypeUnmanaged:
+			state.NodeGroups = append(state.NodeGroups, s.NodeGroupName)
+		case api.NodeGroupTypeManaged:
+			state.ManagedNodeGroups = append(state.ManagedNodeGroups, s.NodeGroupName)
+		}
+	}
+
+	eksAPI := m.ctl.Provider.EKS()
+	exporter := export.New(m.cfg, m.ctl, m.stackManager)
+	// only the nodegroups that are in the config are compared against their spec
+	for _, ng := range m.cfg.NodeGroups {
+		if !contains(state.NodeGroups, ng.Name) {
+			continue
+		}
+		spec, err := exporter.NodeGroup(ng.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading spec of nodegroup %q", ng.Name)
+		}
+		state.NodeGroupSpecs[ng.Name] = spec
+	}
+	for _, ng := range m.cfg.ManagedNodeGroups {
+		if !contains(state.ManagedNodeGroups, ng.Name) {
+			continue
+		}
+		output, err := eksAPI.DescribeNodegroup(&awseks.DescribeNodegroupInput{
+			ClusterName:   &m.cfg.Metadata.Name,
+			NodegroupName: &ng.Name,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing managed nodegroup %q", ng.Name)
+		}
+		state.ManagedNodeGroupSpecs[ng.Name] = output.Nodegroup
+	}
+
+	fargateClient := fargate.NewFromProvider(m.cfg.Metadata.Name, m.ctl.Provider, m.stackManager)
+	profiles, err := fargateClient.ReadProfiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		state.FargateProfiles = append(state.FargateProfiles, profile.Name)
+		state.FargateProfileSpecs[profile.Name] = profile
+	}
+
+	addons, err := eksAPI.ListAddons(&awseks.ListAddonsInput{
+		ClusterName: &m.cfg.Metadata.Name,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing addons")
+	}
+	for _, name := range addons.Addons {
+		output, err := eksAPI.DescribeAddon(&awseks.DescribeAddonInput{
+			ClusterName: &m.cfg.Metadata.Name,
+			AddonName:   name,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing addon %q", *name)
+		}
+		state.Addons[*name] = aws.StringValue(output.Addon.AddonVersion)
+	}
+	if err := m.resolveLatestAddonVersions(state); err != nil {
+		return nil, err
+	}
+	// cluster-autoscaler is deployed by eksctl rather than EKS
+	clusterAutoscaler, ok, err := addon.GetClusterAutoscaler(m.cfg, m.ctl, m.clientSet)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting %s", api.ClusterAutoscalerAddon)
+	}
+	if ok {
+		state.Addons[api.ClusterAutoscalerAddon] = clusterAutoscaler.Version
+	}
+
+	serviceAccountStacks, err := m.stackManager.DescribeIAMServiceAccountStacks()
+	if err != nil {
+		return nil, errors.Wrap(err, "listing iamserviceaccount stacks")
+	}
+	for _, s := range serviceAccountStacks {
+		name := manager.GetIAMServiceAccountName(s)
+		if name == addon.ClusterAutoscalerServiceAccount {
+			// the IAM role of cluster-autoscaler is managed with the addon
+			continue
+		}
+		state.ServiceAccounts = append(state.ServiceAccounts, name)
+		template, err := m.stackManager.GetStackTemplate(*s.StackName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting template of iamserviceaccount %q", name)
+		}
+		policies, err := rolePolicies([]byte(template))
+		if err != nil {
+			logger.Debug("unable to read the role policies of iamserviceaccount %q: %v", name, err)
+			continue
+		}
+		state.ServiceAccountPolicies[name] = policies
+	}
+
+	idpManager := identityproviders.NewManager(*m.cfg.Metadata, eksAPI)
+	idps, err := idpManager.Get(identityproviders.GetIdentityProvidersOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing identity providers")
+	}
+	for _, idp := range idps {
+		state.IdentityProviders = append(state.IdentityProviders, idp.Name)
+		state.IdentityProviderSpecs[idp.Name] = &api.OIDCIdentityProvider{
+			Name:           idp.Name,
+			IssuerURL:      idp.IssuerURL,
+			ClientID:       idp.ClientID,
+			UsernameClaim:  aws.StringValue(idp.UsernameClaim),
+			UsernamePrefix: aws.StringValue(idp.UsernamePrefix),
+			GroupsClaim:    aws.StringValue(idp.GroupsClaim),
+			GroupsPrefix:   aws.StringValue(idp.GroupsPrefix),
+			RequiredClaims: idp.RequiredClaims,
+		}
+	}
+
+	return state, nil
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveLatestAddonVersions resolves the version of the installed addons whose version is "latest" in the config,
+// so that they are only updated when a newer version is available
+func (m *Manager) resolveLatestAddonVersions(state *ClusterState) error {
+	var addonManager *addon.Manager
+	for _, a := range m.cfg.Addons {
+		if _, installed := state.Addons[a.Name]; !installed || a.Version != "latest" {
+			continue
+		}
+		if addonManager == nil {
+			// the OIDC provider is only needed to create or update addons, not to look up their versions
+			var err error
+			addonManager, err = addon.New(m.cfg, m.ctl.Provider.EKS(), m.stackManager, false, nil, m.clientSet, m.ctl.Provider.WaitTimeout())
+			if err != nil {
+				return err
+			}
+		}
+		version, err := addonManager.LatestVersion(a)
+		if err != nil {
+			return errors.Wrapf(err, "getting latest version of addon %q", a.Name)
+		}
+		state.LatestAddonVersions[a.Name] = version
+	}
+	return nil
+}
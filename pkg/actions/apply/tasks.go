@@ -0,0 +1,395 @@
+package apply
+
+import (
+	"fmt"
+	"strings"
+
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/fargate"
+	"github.com/weaveworks/eksctl/pkg/actions/identityproviders"
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	fargateclient "github.com/weaveworks/eksctl/pkg/fargate"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// newTasks builds the task tree that reconciles the given changes; identity providers and
+// IAM service accounts come first, followed by addons, Fargate profiles and nodegroups
+func (m *Manager) newTasks(changes []Change, wait bool) (*tasks.TaskTree, error) {
+	byKind := map[ResourceKind][]Change{}
+	for _, change := range changes {
+		byKind[change.Kind] = append(byKind[change.Kind], change)
+	}
+
+	taskTree := &tasks.TaskTree{Parallel: false}
+
+	appendSubTree := func(subTree *tasks.TaskTree) {
+		if subTree.Len() > 0 {
+			subTree.IsSubTask = true
+			taskTree.Append(subTree)
+		}
+	}
+
+	appendSubTree(m.identityProviderTasks(byKind[IdentityProviderKind]))
+
+	serviceAccountTasks, err := m.serviceAccountTasks(byKind[IAMServiceAccountKind], wait)
+	if err != nil {
+		return nil, err
+	}
+	appendSubTree(serviceAccountTasks)
+
+	appendSubTree(m.addonTasks(byKind[AddonKind]))
+	appendSubTree(m.fargateProfileTasks(byKind[FargateProfileKind], wait))
+	appendSubTree(m.nodeGroupTasks(append(byKind[NodeGroupKind], byKind[ManagedNodeGroupKind]...), wait))
+
+	return taskTree, nil
+}
+
+// changeTask applies a single change to the cluster
+type changeTask struct {
+	change Change
+	doer   func() error
+}
+
+func (t *changeTask) Describe() string {
+	return fmt.Sprintf("%s %s %q", t.change.Action, t.change.Kind, t.change.Name)
+}
+
+func (t *changeTask) Target() tasks.Target {
+	return tasks.Target{
+		Action:   tasks.Action(t.change.Action),
+		Resource: fmt.Sprintf("%s/%s", strings.ReplaceAll(string(t.change.Kind), " ", "-"), t.change.Name),
+	}
+}
+
+func (t *changeTask) Do(errCh chan error) error {
+	close(errCh)
+	return t.doer()
+}
+
+func (m *Manager) identityProviderTasks(changes []Change) *tasks.TaskTree {
+	taskTree := &tasks.TaskTree{Parallel: true}
+	idpManager := identityproviders.NewManager(*m.cfg.Metadata, m.ctl.Provider.EKS())
+	timeout := m.ctl.Provider.WaitTimeout()
+
+	for _, change := range changes {
+		change := change
+		switch change.Action {
+		case ActionCreate:
+			idp := m.findIdentityProvider(change.Name)
+			taskTree.Append(&changeTask{
+				change: change,
+				doer: func() error {
+					return idpManager.Associate(identityproviders.AssociateIdentityProvidersOptions{
+						Providers:   []api.IdentityProvider{idp},
+						WaitTimeout: &timeout,
+					})
+				},
+			})
+		case ActionDelete:
+			taskTree.Append(&changeTask{
+				change: change,
+				doer: func() error {
+					return idpManager.Disassociate(identityproviders.DisassociateIdentityProvidersOptions{
+						Providers: []identityproviders.DisassociateIdentityProvider{{
+							Name: change.Name,
+							Type: api.OIDCIdentityProviderType,
+						}},
+						WaitTimeout: &timeout,
+					})
+				},
+			})
+		}
+	}
+	return taskTree
+}
+
+func (m *Manager) serviceAccountTasks(changes []Change, wait bool) (*tasks.TaskTree, error) {
+	taskTree := &tasks.TaskTree{Parallel: true}
+	if len(changes) == 0 {
+		return taskTree, nil
+	}
+
+	oidc, err := m.ctl.NewOpenIDConnectManager(m.cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkOIDCProvider(oidc, changes); err != nil {
+		return nil, err
+	}
+
+	clientSetGetter := kubernetes.NewCachedClientSet(m.clientSet)
+	var toCreate []*api.ClusterIAMServiceAccount
+	var toDelete []string
+	for _, change := range changes {
+		switch change.Action {
+		case ActionCreate:
+			toCreate = append(toCreate, m.findServiceAccount(change.Name))
+		case ActionUpdate:
+			updateTasks, err := irsa.NewUpdateIAMServiceAccountTask(m.cfg.Metadata.Name, m.findServiceAccount(change.Name), m.stackManager, oidc)
+			if err != nil {
+				return nil, err
+			}
+			taskTree.Append(updateTasks.Tasks...)
+		case ActionDelete:
+			toDelete = append(toDelete, change.Name)
+		}
+	}
+
+	if len(toCreate) > 0 {
+		taskTree.Append(m.stackManager.NewTasksToCreateIAMServiceAccounts(toCreate, oidc, clientSetGetter).Tasks...)
+	}
+	if len(toDelete) > 0 {
+		deleteTasks, err := m.stackManager.NewTasksToDeleteIAMServiceAccounts(toDelete, clientSetGetter, wait)
+		if err != nil {
+			return nil, err
+		}
+		taskTree.Append(deleteTasks.Tasks...)
+	}
+	return taskTree, nil
+}
+
+func (m *Manager) checkOIDCProvider(oidc *iamoidc.OpenIDConnectManager, changes []Change) error {
+	for _, change := range changes {
+		if change.Action == ActionDelete {
+			continue
+		}
+		providerExists, err := oidc.CheckProviderExists()
+		if err != nil {
+			return err
+		}
+		if !providerExists {
+			meta := m.cfg.Metadata
+			logger.Warning("no IAM OIDC provider associated with cluster, try 'eksctl utils associate-iam-oidc-provider --region=%s --cluster=%s'", meta.Region, meta.Name)
+			return errors.New("unable to create iamserviceaccount(s) without IAM OIDC provider enabled")
+		}
+		return nil
+	}
+	return nil
+}
+
+func (m *Manager) addonTasks(changes []Change) *tasks.TaskTree {
+	taskTree := &tasks.TaskTree{Parallel: false}
+	for _, change := range changes {
+		change := change
+		taskTree.Append(&changeTask{
+			change: change,
+			doer: func() error {
+				if (api.Addon{Name: change.Name}).IsClusterAutoscaler() {
+					return m.clusterAutoscalerTask(change)
+				}
+				addonManager, err := m.newAddonManager()
+				if err != nil {
+					return err
+				}
+				switch change.Action {
+				case ActionCreate:
+					return addonManager.Create(m.findAddon(change.Name), true)
+				case ActionUpdate:
+					return addonManager.Update(m.findAddon(change.Name), true)
+				default:
+					return addonManager.Delete(&api.Addon{Name: change.Name})
+				}
+			},
+		})
+	}
+	return taskTree
+}
+
+// clusterAutoscalerTask applies a change to cluster-autoscaler, which is deployed by eksctl rather than EKS
+func (m *Manager) clusterAutoscalerTask(change Change) error {
+	if change.Action == ActionDelete {
+		return addon.DeleteClusterAutoscaler(m.cfg, m.ctl, m.clientSet)
+	}
+	// deploying cluster-autoscaler again replaces its resources
+	return addon.CreateClusterAutoscaler(m.cfg, m.ctl, m.clientSet, m.findAddon(change.Name))
+}
+
+func (m *Manager) newAddonManager() (*addon.Manager, error) {
+	oidc, err := m.ctl.NewOpenIDConnectManager(m.cfg)
+	if err != nil {
+		return nil, err
+	}
+	oidcProviderExists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return nil, err
+	}
+	return addon.New(m.cfg, m.ctl.Provider.EKS(), m.stackManager, oidcProviderExists, oidc, m.clientSet, m.ctl.Provider.WaitTimeout())
+}
+
+func (m *Manager) fargateProfileTasks(changes []Change, wait bool) *tasks.TaskTree {
+	taskTree := &tasks.TaskTree{Parallel: false}
+	for _, change := range changes {
+		change := change
+		switch change.Action {
+		case ActionCreate:
+			taskTree.Append(&changeTask{
+				change: change,
+				doer: func() error {
+					cfg := m.cfg.DeepCopy()
+					cfg.FargateProfiles = []*api.FargateProfile{m.findFargateProfile(change.Name)}
+					return fargate.New(cfg, m.ctl, m.stackManager).Create()
+				},
+			})
+		case ActionDelete:
+			taskTree.Append(&changeTask{
+				change: change,
+				doer: func() error {
+					fargateClient := fargateclient.NewFromProvider(m.cfg.Metadata.Name, m.ctl.Provider, m.stackManager)
+					return fargateClient.DeleteProfile(change.Name, wait)
+				},
+			})
+		}
+	}
+	return taskTree
+}
+
+func (m *Manager) nodeGroupTasks(changes []Change, wait bool) *tasks.TaskTree {
+	taskTree := &tasks.TaskTree{Parallel: true}
+	// every nodegroup creation adds the nodegroup role to the aws-auth ConfigMap, so nodegroups
+	// are created one at a time to keep them from overwriting each other's entries
+	createTasks := &tasks.TaskTree{Parallel: false, IsSubTask: true}
+	for _, change := range changes {
+		change := change
+		switch change.Action {
+		case ActionCreate:
+			createTasks.Append(&changeTask{
+				change: change,
+				doer: func() error {
+					cfg := m.cfg.DeepCopy()
+					cfg.NodeGroups, cfg.ManagedNodeGroups = nil, nil
+					if change.Kind == NodeGroupKind {
+						cfg.NodeGroups = []*api.NodeGroup{m.findNodeGroup(change.Name)}
+					} else {
+						cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{m.findManagedNodeGroup(change.Name)}
+					}
+					return nodegroup.New(cfg, m.ctl, m.clientSet).Create(nodegroup.CreateOpts{
+						UpdateAuthConfigMap: true,
+						ConfigFileProvided:  true,
+					}, filter.NewNodeGroupFilter())
+				},
+			})
+		case ActionUpdate:
+			taskTree.Append(&changeTask{
+				change: change,
+				doer: func() error {
+					nodeGroupManager := nodegroup.New(m.cfg, m.ctl, m.clientSet)
+					if change.Kind == NodeGroupKind {
+						return nodeGroupManager.Scale(m.findNodeGroup(change.Name), nodegroup.ScaleOpts{ConfigFileProvided: true})
+					}
+					return m.updateManagedNodeGroup(nodeGroupManager, m.findManagedNodeGroup(change.Name))
+				},
+			})
+		case ActionDelete:
+			taskTree.Append(&changeTask{
+				change: change,
+				doer: func() error {
+					var nodeGroups []*api.NodeGroup
+					var managedNodeGroups []*api.ManagedNodeGroup
+					var kubeNodeGroup eks.KubeNodeGroup
+					base := &api.NodeGroupBase{Name: change.Name}
+					if change.Kind == NodeGroupKind {
+						ng := &api.NodeGroup{NodeGroupBase: base}
+						nodeGroups, kubeNodeGroup = append(nodeGroups, ng), ng
+					} else {
+						ng := &api.ManagedNodeGroup{NodeGroupBase: base}
+						managedNodeGroups, kubeNodeGroup = append(managedNodeGroups, ng), ng
+					}
+					nodeGroupManager := nodegroup.New(m.cfg, m.ctl, m.clientSet)
+					if err := nodeGroupManager.Drain(&nodegroup.DrainInput{
+						NodeGroups: []eks.KubeNodeGroup{kubeNodeGroup},
+						Parallel:   1,
+					}); err != nil {
+						return err
+					}
+					return nodeGroupManager.Delete(nodeGroups, managedNodeGroups, wait, false)
+				},
+			})
+		}
+	}
+	if createTasks.Len() > 0 {
+		taskTree.Append(createTasks)
+	}
+	return taskTree
+}
+
+// updateManagedNodeGroup updates a managed nodegroup from its current description, as it may have
+// changed since the plan was made
+func (m *Manager) updateManagedNodeGroup(nodeGroupManager *nodegroup.Manager, ng *api.ManagedNodeGroup) error {
+	output, err := m.ctl.Provider.EKS().DescribeNodegroup(&awseks.DescribeNodegroupInput{
+		ClusterName:   &m.cfg.Metadata.Name,
+		NodegroupName: &ng.Name,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing managed nodegroup %q", ng.Name)
+	}
+	input := managedNodeGroupUpdate(m.cfg.Metadata.Name, ng, output.Nodegroup)
+	if input == nil {
+		logger.Info("managed nodegroup %q is already up-to-date", ng.Name)
+		return nil
+	}
+	return nodeGroupManager.UpdateManagedConfig(input)
+}
+
+func (m *Manager) findNodeGroup(name string) *api.NodeGroup {
+	for _, ng := range m.cfg.NodeGroups {
+		if ng.Name == name {
+			return ng.DeepCopy()
+		}
+	}
+	return nil
+}
+
+func (m *Manager) findManagedNodeGroup(name string) *api.ManagedNodeGroup {
+	for _, ng := range m.cfg.ManagedNodeGroups {
+		if ng.Name == name {
+			return ng.DeepCopy()
+		}
+	}
+	return nil
+}
+
+func (m *Manager) findFargateProfile(name string) *api.FargateProfile {
+	for _, fp := range m.cfg.FargateProfiles {
+		if fp.Name == name {
+			return fp
+		}
+	}
+	return nil
+}
+
+func (m *Manager) findAddon(name string) *api.Addon {
+	for _, a := range m.cfg.Addons {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+func (m *Manager) findServiceAccount(name string) *api.ClusterIAMServiceAccount {
+	for _, sa := range m.cfg.IAM.ServiceAccounts {
+		if sa.NameString() == name {
+			return sa
+		}
+	}
+	return nil
+}
+
+func (m *Manager) findIdentityProvider(name string) api.IdentityProvider {
+	for _, idp := range m.cfg.IdentityProviders {
+		if oidc, ok := idp.Inner.(*api.OIDCIdentityProvider); ok && oidc.Name == name {
+			return idp
+		}
+	}
+	return api.IdentityProvider{}
+}
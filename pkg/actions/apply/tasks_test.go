@@ -0,0 +1,57 @@
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/apply"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("nodegroup tasks", func() {
+	It("creates nodegroups one at a time and deletes them in parallel", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		m := apply.New(cfg, &eks.ClusterProvider{Provider: mockprovider.NewMockProvider()}, nil)
+
+		taskTree := m.NodeGroupTasks([]apply.Change{
+			{Action: apply.ActionCreate, Kind: apply.NodeGroupKind, Name: "ng-1"},
+			{Action: apply.ActionCreate, Kind: apply.ManagedNodeGroupKind, Name: "mng-1"},
+			{Action: apply.ActionDelete, Kind: apply.NodeGroupKind, Name: "ng-old"},
+			{Action: apply.ActionDelete, Kind: apply.ManagedNodeGroupKind, Name: "mng-old"},
+		}, false)
+
+		Expect(taskTree.Parallel).To(BeTrue())
+		Expect(taskTree.Tasks).To(HaveLen(3))
+		Expect(taskTree.Tasks[0].Describe()).To(Equal(`delete nodegroup "ng-old"`))
+		Expect(taskTree.Tasks[1].Describe()).To(Equal(`delete managed nodegroup "mng-old"`))
+
+		createTasks, ok := taskTree.Tasks[2].(*tasks.TaskTree)
+		Expect(ok).To(BeTrue())
+		Expect(createTasks.Parallel).To(BeFalse())
+		Expect(createTasks.Tasks).To(HaveLen(2))
+		Expect(createTasks.Tasks[0].Describe()).To(Equal(`create nodegroup "ng-1"`))
+		Expect(createTasks.Tasks[1].Describe()).To(Equal(`create managed nodegroup "mng-1"`))
+	})
+
+	It("updates nodegroups in parallel with the deletions", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		m := apply.New(cfg, &eks.ClusterProvider{Provider: mockprovider.NewMockProvider()}, nil)
+
+		taskTree := m.NodeGroupTasks([]apply.Change{
+			{Action: apply.ActionUpdate, Kind: apply.NodeGroupKind, Name: "ng-1"},
+			{Action: apply.ActionUpdate, Kind: apply.ManagedNodeGroupKind, Name: "mng-1"},
+			{Action: apply.ActionDelete, Kind: apply.NodeGroupKind, Name: "ng-old"},
+		}, false)
+
+		Expect(taskTree.Parallel).To(BeTrue())
+		Expect(taskTree.Tasks).To(HaveLen(3))
+		Expect(taskTree.Tasks[0].Describe()).To(Equal(`update nodegroup "ng-1"`))
+		Expect(taskTree.Tasks[1].Describe()).To(Equal(`update managed nodegroup "mng-1"`))
+		Expect(taskTree.Tasks[2].Describe()).To(Equal(`delete nodegroup "ng-old"`))
+	})
+})
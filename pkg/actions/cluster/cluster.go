@@ -11,11 +11,14 @@ import (
 	"github.com/kris-nova/logger"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
 type Cluster interface {
-	Upgrade(dryRun bool) error
+	Upgrade(dryRun, force bool) error
 	Delete(waitInterval time.Duration, wait, force bool) error
+	// SetPlanOutput sets the format used to print the plan of the tasks, nothing is printed if it is empty
+	SetPlanOutput(output printers.Type)
 }
 
 func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider) (Cluster, error) {
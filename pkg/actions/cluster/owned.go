@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/kris-nova/logger"
@@ -12,6 +13,8 @@ import (
 	"github.com/weaveworks/eksctl/pkg/gitops"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
@@ -20,6 +23,7 @@ type OwnedCluster struct {
 	ctl          *eks.ClusterProvider
 	stackManager manager.StackManager
 	newClientSet func() (kubernetes.Interface, error)
+	planOutput   printers.Type
 }
 
 func NewOwnedCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager) *OwnedCluster {
@@ -33,12 +37,23 @@ func NewOwnedCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackMana
 	}
 }
 
-func (c *OwnedCluster) Upgrade(dryRun bool) error {
+func (c *OwnedCluster) SetPlanOutput(output printers.Type) {
+	c.planOutput = output
+}
+
+func (c *OwnedCluster) Upgrade(dryRun, force bool) error {
 	if err := c.ctl.LoadClusterVPC(c.cfg, c.stackManager); err != nil {
 		return errors.Wrapf(err, "getting VPC configuration for cluster %q", c.cfg.Metadata.Name)
 	}
 
-	versionUpdateRequired, err := upgrade(c.cfg, c.ctl, dryRun)
+	stackName := c.stackManager.MakeClusterStackName()
+	versionUpdateRequired, err := upgrade(c.cfg, c.ctl, dryRun, force, c.planOutput, &tasks.TargetedTask{
+		GenericTask: tasks.GenericTask{
+			Description: fmt.Sprintf("add missing resources to cluster stack %q", stackName),
+		},
+		Action:   tasks.ActionUpdate,
+		Resource: tasks.StackResource(stackName),
+	})
 	if err != nil {
 		return err
 	}
@@ -113,7 +128,7 @@ func (c *OwnedCluster) Delete(_ time.Duration, wait, force bool) error {
 	}
 
 	deleteOIDCProvider := clusterOperable && oidcSupported
-	tasks, err := c.stackManager.NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvider, oidc, kubernetes.NewCachedClientSet(clientSet), wait, func(errs chan error, _ string) error {
+	taskTree, err := c.stackManager.NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvider, oidc, kubernetes.NewCachedClientSet(clientSet), wait, func(errs chan error, _ string) error {
 		logger.Info("trying to cleanup dangling network interfaces")
 		if err := c.ctl.LoadClusterVPC(c.cfg, c.stackManager); err != nil {
 			return errors.Wrapf(err, "getting VPC configuration for cluster %q", c.cfg.Metadata.Name)
@@ -130,13 +145,16 @@ func (c *OwnedCluster) Delete(_ time.Duration, wait, force bool) error {
 		return err
 	}
 
-	if tasks.Len() == 0 {
+	if taskTree.Len() == 0 {
 		logger.Warning("no cluster resources were found for %q", c.cfg.Metadata.Name)
 		return nil
 	}
 
-	logger.Info(tasks.Describe())
-	if errs := tasks.DoAllSync(); len(errs) > 0 {
+	logger.Info(taskTree.Describe())
+	if err := tasks.PrintPlan(c.planOutput, taskTree); err != nil {
+		return err
+	}
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "cluster with nodegroup(s)")
 	}
 
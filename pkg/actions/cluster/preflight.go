@@ -0,0 +1,137 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+// upgradeReadinessIssue is a resource that would break or stop working once the control plane is upgraded
+type upgradeReadinessIssue struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Issue     string
+}
+
+// preflight checks whether the cluster is ready for its control plane to be upgraded to version, and reports
+// any issue found; the upgrade is refused when there are issues, unless force is set
+func preflight(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, version string, dryRun, force bool) error {
+	logger.Info("checking whether cluster %q is ready to be upgraded to version %q", cfg.Metadata.Name, version)
+	checkFailed := func(err error) error {
+		if force {
+			logger.Warning("skipping upgrade readiness checks as --force is set: %v", err)
+			return nil
+		}
+		return errors.Wrap(err, "checking upgrade readiness, use --force to upgrade anyway")
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return checkFailed(err)
+	}
+	issues, err := checkUpgradeReadiness(rawClient, rawClient.ClientSet(), version)
+	if err != nil {
+		return checkFailed(err)
+	}
+	return reportUpgradeReadiness(cfg.Metadata.Name, version, issues, dryRun, force)
+}
+
+func reportUpgradeReadiness(clusterName, version string, issues []upgradeReadinessIssue, dryRun, force bool) error {
+	if len(issues) == 0 {
+		logger.Info("no issues found that prevent upgrading cluster %q to version %q", clusterName, version)
+		return nil
+	}
+
+	printer := printers.NewTablePrinter().(*printers.TablePrinter)
+	printer.AddColumn("KIND", func(i upgradeReadinessIssue) string { return i.Kind })
+	printer.AddColumn("NAMESPACE", func(i upgradeReadinessIssue) string { return i.Namespace })
+	printer.AddColumn("NAME", func(i upgradeReadinessIssue) string { return i.Name })
+	printer.AddColumn("ISSUE", func(i upgradeReadinessIssue) string { return i.Issue })
+	msg := fmt.Sprintf("found the following issues that prevent upgrading cluster %q to version %q:", clusterName, version)
+	if err := printer.LogObj(logger.Warning, msg+"\n%s", issues); err != nil {
+		return err
+	}
+
+	switch {
+	case force:
+		logger.Warning("upgrading cluster %q to version %q anyway, as --force is set", clusterName, version)
+		return nil
+	case dryRun:
+		logger.Warning("the upgrade of cluster %q will be refused until these issues are fixed, unless --force is set", clusterName)
+		return nil
+	default:
+		return fmt.Errorf("found %d issue(s) that prevent upgrading cluster %q to version %q, fix them or use --force to upgrade anyway", len(issues), clusterName, version)
+	}
+}
+
+// checkUpgradeReadiness returns the objects that use an API version that is removed in version, and the
+// nodegroups whose nodes would no longer comply with the Kubernetes version skew policy
+func checkUpgradeReadiness(lister kubernetes.ObjectMetadataLister, clientSet kubernetes.Interface, version string) ([]upgradeReadinessIssue, error) {
+	var issues []upgradeReadinessIssue
+
+	objects, err := kubernetes.FindDeprecatedAPIObjects(lister, version)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding objects that use deprecated API versions")
+	}
+	for _, o := range objects {
+		issues = append(issues, upgradeReadinessIssue{
+			Kind:      o.Kind,
+			Namespace: o.Namespace,
+			Name:      o.Name,
+			Issue:     fmt.Sprintf("uses %s, which is removed in %s; migrate to %s", o.GroupVersion, o.RemovedIn, o.Replacement),
+		})
+	}
+
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+	kubeletVersions := map[string]map[string]string{}
+	for _, node := range nodes.Items {
+		nodeGroup := nodeGroupName(node.Labels)
+		if kubeletVersions[nodeGroup] == nil {
+			kubeletVersions[nodeGroup] = map[string]string{}
+		}
+		kubeletVersions[nodeGroup][node.Name] = node.Status.NodeInfo.KubeletVersion
+	}
+
+	var nodeGroups []string
+	for nodeGroup := range kubeletVersions {
+		nodeGroups = append(nodeGroups, nodeGroup)
+	}
+	sort.Strings(nodeGroups)
+	for _, nodeGroup := range nodeGroups {
+		if behind := nodesBehind(kubeletVersions[nodeGroup], version, maxKubeletSkew); len(behind) > 0 {
+			issues = append(issues, upgradeReadinessIssue{
+				Kind: "Nodegroup",
+				Name: nodeGroup,
+				Issue: fmt.Sprintf("nodes %s would be more than %d minor versions behind the control plane; upgrade the nodegroup first",
+					strings.Join(behind, ", "), maxKubeletSkew),
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+// nodeGroupName returns the name of the nodegroup of a node, or "-" if the node is not part of a nodegroup
+func nodeGroupName(labels map[string]string) string {
+	if name, ok := labels[api.NodeGroupNameLabel]; ok {
+		return name
+	}
+	if name, ok := labels[api.EKSNodeGroupNameLabel]; ok {
+		return name
+	}
+	return "-"
+}
@@ -0,0 +1,111 @@
+package cluster
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+type fakeObjectMetadataLister struct {
+	objects map[string][]metav1.PartialObjectMetadata
+}
+
+func (l *fakeObjectMetadataLister) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for key := range l.objects {
+		if resource := strings.TrimPrefix(key, groupVersion+"/"); resource != key {
+			list.APIResources = append(list.APIResources, metav1.APIResource{Name: resource})
+		}
+	}
+	if len(list.APIResources) == 0 {
+		return nil, apierrs.NewNotFound(schema.GroupResource{}, groupVersion)
+	}
+	return list, nil
+}
+
+func (l *fakeObjectMetadataLister) ListMetadata(groupVersion, resource string) ([]metav1.PartialObjectMetadata, error) {
+	return l.objects[groupVersion+"/"+resource], nil
+}
+
+var _ = Describe("upgrade readiness", func() {
+	var (
+		lister    *fakeObjectMetadataLister
+		clientSet *fake.Clientset
+	)
+
+	newNode := func(name, kubeletVersion string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: labels,
+			},
+			Status: corev1.NodeStatus{
+				NodeInfo: corev1.NodeSystemInfo{KubeletVersion: kubeletVersion},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		lister = &fakeObjectMetadataLister{
+			objects: map[string][]metav1.PartialObjectMetadata{
+				"networking.k8s.io/v1beta1/ingresses": {{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "web",
+						Name:      "frontend",
+						ManagedFields: []metav1.ManagedFieldsEntry{{
+							Manager:    "helm",
+							APIVersion: "networking.k8s.io/v1beta1",
+						}},
+					},
+				}},
+			},
+		}
+		clientSet = fake.NewSimpleClientset(
+			newNode("node-1", "v1.19.6-eks-49a6c0", map[string]string{api.NodeGroupNameLabel: "ng-1"}),
+			newNode("node-2", "v1.21.2-eks-55daa9", map[string]string{api.EKSNodeGroupNameLabel: "mng-1"}),
+		)
+	})
+
+	It("reports objects using removed API versions and nodegroups that would violate the version skew policy", func() {
+		issues, err := checkUpgradeReadiness(lister, clientSet, "1.22")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issues).To(Equal([]upgradeReadinessIssue{
+			{
+				Kind:      "Ingress",
+				Namespace: "web",
+				Name:      "frontend",
+				Issue:     "uses networking.k8s.io/v1beta1, which is removed in 1.22; migrate to networking.k8s.io/v1",
+			},
+			{
+				Kind:  "Nodegroup",
+				Name:  "ng-1",
+				Issue: "nodes node-1 would be more than 2 minor versions behind the control plane; upgrade the nodegroup first",
+			},
+		}))
+	})
+
+	It("reports no issues when the cluster is ready", func() {
+		issues, err := checkUpgradeReadiness(lister, clientSet, "1.21")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("refuses to upgrade when there are issues unless forced", func() {
+		issues := []upgradeReadinessIssue{{Kind: "Nodegroup", Name: "ng-1", Issue: "too old"}}
+
+		err := reportUpgradeReadiness("my-cluster", "1.22", issues, false, false)
+		Expect(err).To(MatchError(`found 1 issue(s) that prevent upgrading cluster "my-cluster" to version "1.22", fix them or use --force to upgrade anyway`))
+
+		Expect(reportUpgradeReadiness("my-cluster", "1.22", issues, false, true)).To(Succeed())
+		Expect(reportUpgradeReadiness("my-cluster", "1.22", issues, true, false)).To(Succeed())
+		Expect(reportUpgradeReadiness("my-cluster", "1.22", nil, false, false)).To(Succeed())
+	})
+})
@@ -26,6 +26,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
 type UnownedCluster struct {
@@ -33,6 +34,7 @@ type UnownedCluster struct {
 	ctl          *eks.ClusterProvider
 	stackManager manager.StackManager
 	newClientSet func() (kubernetes.Interface, error)
+	planOutput   printers.Type
 }
 
 func NewUnownedCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager) *UnownedCluster {
@@ -46,8 +48,12 @@ func NewUnownedCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackMa
 	}
 }
 
-func (c *UnownedCluster) Upgrade(dryRun bool) error {
-	versionUpdateRequired, err := upgrade(c.cfg, c.ctl, dryRun)
+func (c *UnownedCluster) SetPlanOutput(output printers.Type) {
+	c.planOutput = output
+}
+
+func (c *UnownedCluster) Upgrade(dryRun, force bool) error {
+	versionUpdateRequired, err := upgrade(c.cfg, c.ctl, dryRun, force, c.planOutput)
 	if err != nil {
 		return err
 	}
@@ -93,22 +99,56 @@ func (c *UnownedCluster) Delete(waitInterval time.Duration, wait, force bool) er
 
 	// we have to wait for nodegroups to delete before deleting the cluster
 	// so the `wait` value is ignored here
-	if err := c.deleteAndWaitForNodegroupsDeletion(waitInterval); err != nil {
+	nodeGroupTasks, err := c.newTasksToDeleteNodeGroups(waitInterval)
+	if err != nil {
 		return err
 	}
 
-	if err := c.deleteIAMAndOIDC(wait, clusterOperable, clientSet); err != nil {
-		if err != nil {
-			if force {
-				logger.Warning("error occurred during deletion: %v", err)
-			} else {
+	iamTasks, err := c.newTasksToDeleteIAMAndOIDC(wait, clusterOperable, clientSet)
+	if err != nil {
+		if force {
+			logger.Warning("error occurred during deletion: %v", err)
+		} else {
+			return err
+		}
+	}
+
+	clusterTasks := &tasks.TaskTree{Parallel: false}
+	clusterTasks.Append(&tasks.TargetedTask{
+		GenericTask: tasks.GenericTask{
+			Description: fmt.Sprintf("delete cluster %q", clusterName),
+			Doer:        func() error { return c.deleteCluster(wait) },
+		},
+		Action:   tasks.ActionDelete,
+		Resource: "cluster/" + clusterName,
+	})
+
+	if err := tasks.PrintPlan(c.planOutput, nodeGroupTasks, iamTasks, clusterTasks); err != nil {
+		return err
+	}
+
+	if nodeGroupTasks.Len() > 0 {
+		logger.Info(nodeGroupTasks.Describe())
+		if errs := nodeGroupTasks.DoAllSync(); len(errs) > 0 {
+			return handleErrors(errs, "nodegroup(s)")
+		}
+	}
+
+	if iamTasks.Len() > 0 {
+		logger.Info(iamTasks.Describe())
+		if errs := iamTasks.DoAllSync(); len(errs) > 0 {
+			err := handleErrors(errs, "cluster IAM and OIDC")
+			if !force {
 				return err
 			}
+			logger.Warning("error occurred during deletion: %v", err)
+		} else {
+			logger.Info("all IAM and OIDC resources were deleted")
 		}
 	}
 
-	if err := c.deleteCluster(wait); err != nil {
-		return err
+	if errs := clusterTasks.DoAllSync(); len(errs) > 0 {
+		return errs[0]
 	}
 
 	if err := checkForUndeletedStacks(c.stackManager); err != nil {
@@ -148,7 +188,8 @@ func (c *UnownedCluster) checkClusterExists(clusterName string) error {
 	return nil
 }
 
-func (c *UnownedCluster) deleteIAMAndOIDC(wait bool, clusterOperable bool, clientSet kubernetes.Interface) error {
+// newTasksToDeleteIAMAndOIDC returns the tasks that delete the iamserviceaccounts, the OIDC provider and the IAM roles of addons
+func (c *UnownedCluster) newTasksToDeleteIAMAndOIDC(wait bool, clusterOperable bool, clientSet kubernetes.Interface) (*tasks.TaskTree, error) {
 	var oidc *iamoidc.OpenIDConnectManager
 	oidcSupported := true
 
@@ -157,7 +198,7 @@ func (c *UnownedCluster) deleteIAMAndOIDC(wait bool, clusterOperable bool, clien
 		oidc, err = c.ctl.NewOpenIDConnectManager(c.cfg)
 		if err != nil {
 			if _, ok := err.(*eks.UnsupportedOIDCError); !ok {
-				return err
+				return nil, err
 			}
 			oidcSupported = false
 		}
@@ -169,7 +210,7 @@ func (c *UnownedCluster) deleteIAMAndOIDC(wait bool, clusterOperable bool, clien
 		clientSetGetter := kubernetes.NewCachedClientSet(clientSet)
 		serviceAccountAndOIDCTasks, err := c.stackManager.NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(oidc, clientSetGetter)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if serviceAccountAndOIDCTasks.Len() > 0 {
@@ -180,7 +221,7 @@ func (c *UnownedCluster) deleteIAMAndOIDC(wait bool, clusterOperable bool, clien
 
 	deleteAddonIAMtasks, err := c.stackManager.NewTaskToDeleteAddonIAM(wait)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if deleteAddonIAMtasks.Len() > 0 {
@@ -190,16 +231,8 @@ func (c *UnownedCluster) deleteIAMAndOIDC(wait bool, clusterOperable bool, clien
 
 	if tasksTree.Len() == 0 {
 		logger.Warning("no IAM and OIDC resources were found for %q", c.cfg.Metadata.Name)
-		return nil
-	}
-
-	logger.Info(tasksTree.Describe())
-	if errs := tasksTree.DoAllSync(); len(errs) > 0 {
-		return handleErrors(errs, "cluster IAM and OIDC")
 	}
-
-	logger.Info("all IAM and OIDC resources were deleted")
-	return nil
+	return tasksTree, nil
 }
 
 func (c *UnownedCluster) deleteCluster(wait bool) error {
@@ -237,7 +270,8 @@ func (c *UnownedCluster) deleteCluster(wait bool) error {
 	return waiters.Wait(clusterName, msg, acceptors, newRequest, c.ctl.Provider.WaitTimeout(), nil)
 }
 
-func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Duration) error {
+// newTasksToDeleteNodeGroups returns the tasks that delete all nodegroups of the cluster and wait for their deletion
+func (c *UnownedCluster) newTasksToDeleteNodeGroups(waitInterval time.Duration) (*tasks.TaskTree, error) {
 	clusterName := c.cfg.Metadata.Name
 	eksAPI := c.ctl.Provider.EKS()
 
@@ -246,24 +280,24 @@ func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Du
 		ClusterName: &clusterName,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// get all nodegroup stacks for this cluster
 	allStacks, err := c.stackManager.ListNodeGroupStacks()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(allStacks) == 0 && len(nodeGroups.Nodegroups) == 0 {
 		logger.Warning("no nodegroups found for %s", clusterName)
-		return nil
+		return &tasks.TaskTree{}, nil
 	}
 
 	// we kill every nodegroup with a stack the standard way. wait is always true
-	tasks, err := c.stackManager.NewTasksToDeleteNodeGroups(func(_ string) bool { return true }, true, nil)
+	taskTree, err := c.stackManager.NewTasksToDeleteNodeGroups(func(_ string) bool { return true }, true, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, n := range nodeGroups.Nodegroups {
@@ -278,17 +312,13 @@ func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Du
 
 		if isUnowned() {
 			// if a managed ng does not have a stack, we queue if for deletion via api
-			tasks.Append(c.stackManager.NewTaskToDeleteUnownedNodeGroup(clusterName, *n, eksAPI, c.waitForUnownedNgsDeletion(waitInterval)))
+			taskTree.Append(c.stackManager.NewTaskToDeleteUnownedNodeGroup(clusterName, *n, eksAPI, c.waitForUnownedNgsDeletion(waitInterval)))
 		}
 	}
 
 	// TODO what dis?
-	tasks.PlanMode = false
-	logger.Info(tasks.Describe())
-	if errs := tasks.DoAllSync(); len(errs) > 0 {
-		return handleErrors(errs, "nodegroup(s)")
-	}
-	return nil
+	taskTree.PlanMode = false
+	return taskTree, nil
 }
 
 func isNotFound(err error) bool {
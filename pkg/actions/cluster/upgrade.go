@@ -11,9 +11,12 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/utils"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
-func upgrade(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun bool) (bool, error) {
+// upgrade upgrades the control plane to the next version; the plan of the upgrade is printed in planOutput,
+// followed by stackTasks, which describe the stack updates that the caller makes after the upgrade
+func upgrade(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun, force bool, planOutput printers.Type, stackTasks ...tasks.Task) (bool, error) {
 	currentVersion := ctl.ControlPlaneVersion()
 	versionUpdateRequired, err := requiresVersionUpgrade(cfg.Metadata, currentVersion)
 	if err != nil {
@@ -25,6 +28,24 @@ func upgrade(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun bool) (boo
 		return false, err
 	}
 
+	planTree := &tasks.TaskTree{Parallel: false, PlanMode: dryRun}
+	if versionUpdateRequired {
+		if err := preflight(cfg, ctl, cfg.Metadata.Version, dryRun, force); err != nil {
+			return false, err
+		}
+		planTree.Append(&tasks.TargetedTask{
+			GenericTask: tasks.GenericTask{
+				Description: fmt.Sprintf("upgrade cluster %q control plane from current version %q to %q", cfg.Metadata.Name, currentVersion, cfg.Metadata.Version),
+			},
+			Action:   tasks.ActionUpdate,
+			Resource: "cluster/" + cfg.Metadata.Name,
+		})
+	}
+	planTree.Append(stackTasks...)
+	if err := tasks.PrintPlan(planOutput, planTree); err != nil {
+		return false, err
+	}
+
 	if versionUpdateRequired {
 		msgNodeGroupsAndAddons := "you will need to follow the upgrade procedure for all of nodegroups and add-ons"
 		cmdutils.LogIntendedAction(dryRun, "upgrade cluster %q control plane from current version %q to %q", cfg.Metadata.Name, currentVersion, cfg.Metadata.Version)
@@ -0,0 +1,397 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/blang/semver"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/actions/export"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/managed"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils"
+	"github.com/weaveworks/eksctl/pkg/utils/names"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// maxKubeletSkew is the number of minor versions the kubelet is allowed to be behind the control plane,
+// see https://kubernetes.io/releases/version-skew-policy/#kubelet
+const maxKubeletSkew = 2
+
+// UpgradeAllOptions controls the upgrade of a cluster along with its default addons and nodegroups
+type UpgradeAllOptions struct {
+	// ToVersion is the Kubernetes version to upgrade to, which may be several versions ahead
+	ToVersion string
+	DryRun    bool
+	// Force upgrades the control plane even if the upgrade readiness checks fail
+	Force           bool
+	MaxGracePeriod  time.Duration
+	DisableEviction bool
+	// PlanOutput is the format used to print the plan, nothing is printed if it is empty
+	PlanOutput printers.Type
+}
+
+// versionUpgrader performs the steps of moving a cluster to a Kubernetes version
+type versionUpgrader interface {
+	// controlPlaneVersion returns the current version of the control plane
+	controlPlaneVersion() (string, error)
+	// kubeletVersions returns the Kubernetes version of the kubelet of each node
+	kubeletVersions() (map[string]string, error)
+	upgradeControlPlane(version string) error
+	upgradeAddons(version string) error
+	upgradeNodeGroups(version string) error
+}
+
+// UpgradeAll upgrades the cluster to options.ToVersion one Kubernetes version at a time; for each version the
+// control plane is upgraded first, then the default addons, then the managed and unmanaged nodegroups.
+// Before upgrading the control plane, the kubelet of every node is checked against the version skew policy,
+// and the upgrade stops at the first failure, so that it can be resumed by running it again.
+func UpgradeAll(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.Interface, options UpgradeAllOptions) error {
+	c, err := New(cfg, ctl)
+	if err != nil {
+		return err
+	}
+	u := &clusterVersionUpgrader{
+		cfg:          cfg,
+		ctl:          ctl,
+		cluster:      c,
+		stackManager: ctl.NewStackManager(cfg),
+		clientSet:    clientSet,
+		options:      options,
+	}
+	return upgradeAll(u, cfg.Metadata.Name, options.ToVersion, options.DryRun, options.PlanOutput)
+}
+
+func upgradeAll(u versionUpgrader, clusterName, toVersion string, dryRun bool, planOutput printers.Type) error {
+	currentVersion, err := u.controlPlaneVersion()
+	if err != nil {
+		return err
+	}
+	versions, err := upgradePath(currentVersion, toVersion)
+	if err != nil {
+		return err
+	}
+
+	kubeletVersions, err := u.kubeletVersions()
+	if err != nil {
+		return err
+	}
+	// a previous run may have stopped before all nodegroups were upgraded to the current version
+	if behind := nodesBehind(kubeletVersions, currentVersion, 0); len(behind) > 0 {
+		logger.Info("nodes %s run an older version than control plane version %q", strings.Join(behind, ", "), currentVersion)
+		versions = append([]string{currentVersion}, versions...)
+	}
+
+	if len(versions) == 0 {
+		logger.Info("cluster %q and its nodes are already at version %q", clusterName, currentVersion)
+		return nil
+	}
+
+	plan := upgradePlan(clusterName, currentVersion, versions)
+	if err := tasks.PrintPlan(planOutput, plan); err != nil {
+		return err
+	}
+	if dryRun {
+		for _, task := range plan.Tasks {
+			cmdutils.LogIntendedAction(dryRun, "%s", task.Describe())
+		}
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+
+	for i, version := range versions {
+		logger.Info("[%d/%d] upgrading cluster %q to version %q", i+1, len(versions), clusterName, version)
+		if err := upgradeToVersion(u, currentVersion, version); err != nil {
+			return errors.Wrapf(err, "upgrading cluster %q to version %q; the control plane is at version %q, re-run the command to resume the upgrade", clusterName, version, currentVersion)
+		}
+		currentVersion = version
+		logger.Success("[%d/%d] upgraded cluster %q to version %q", i+1, len(versions), clusterName, version)
+	}
+	return nil
+}
+
+// upgradePlan returns the steps of the upgrade to each of versions; the tasks are only used to describe
+// the upgrade, which is run by upgradeToVersion
+func upgradePlan(clusterName, currentVersion string, versions []string) *tasks.TaskTree {
+	plan := &tasks.TaskTree{Parallel: false}
+	for _, version := range versions {
+		if version != currentVersion {
+			plan.Append(&tasks.TargetedTask{
+				GenericTask: tasks.GenericTask{
+					Description: fmt.Sprintf("upgrade cluster %q control plane to version %q", clusterName, version),
+				},
+				Action:   tasks.ActionUpdate,
+				Resource: "cluster/" + clusterName,
+			})
+		}
+		plan.Append(
+			&tasks.GenericTask{Description: fmt.Sprintf("update default addons of cluster %q to version %q", clusterName, version)},
+			&tasks.GenericTask{Description: fmt.Sprintf("upgrade managed and unmanaged nodegroups of cluster %q to version %q", clusterName, version)},
+		)
+	}
+	return plan
+}
+
+func upgradeToVersion(u versionUpgrader, currentVersion, version string) error {
+	if version != currentVersion {
+		kubeletVersions, err := u.kubeletVersions()
+		if err != nil {
+			return err
+		}
+		if behind := nodesBehind(kubeletVersions, version, maxKubeletSkew); len(behind) > 0 {
+			return fmt.Errorf("nodes %s would be more than %d minor versions behind control plane version %q, which is not allowed by the Kubernetes version skew policy; upgrade their nodegroups first",
+				strings.Join(behind, ", "), maxKubeletSkew, version)
+		}
+
+		if err := u.upgradeControlPlane(version); err != nil {
+			return errors.Wrap(err, "upgrading control plane")
+		}
+	}
+
+	if err := u.upgradeAddons(version); err != nil {
+		return errors.Wrap(err, "updating default addons")
+	}
+	if err := u.upgradeNodeGroups(version); err != nil {
+		return errors.Wrap(err, "upgrading nodegroups")
+	}
+	return nil
+}
+
+// upgradePath returns the versions the control plane has to go through to reach toVersion
+func upgradePath(currentVersion, toVersion string) ([]string, error) {
+	if c, err := utils.CompareVersions(toVersion, currentVersion); err != nil {
+		return nil, errors.Wrap(err, "couldn't compare versions for upgrade")
+	} else if c < 0 {
+		return nil, fmt.Errorf("cannot upgrade to a lower version. Found given target version %q, current cluster version %q", toVersion, currentVersion)
+	}
+	if api.IsDeprecatedVersion(toVersion) {
+		return nil, fmt.Errorf("control plane version %q has been deprecated", toVersion)
+	}
+	if !api.IsSupportedVersion(toVersion) {
+		return nil, fmt.Errorf("control plane version %q is not known to this version of eksctl, try to upgrade eksctl first", toVersion)
+	}
+
+	var versions []string
+	for version := currentVersion; version != toVersion; {
+		nextVersion, err := getNextVersion(version)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, nextVersion)
+		version = nextVersion
+	}
+	return versions, nil
+}
+
+// nodesBehind returns the names of the nodes whose kubelet is more than maxSkew minor versions behind version
+func nodesBehind(kubeletVersions map[string]string, version string, maxSkew uint64) []string {
+	target, err := semver.ParseTolerant(version)
+	if err != nil {
+		return nil
+	}
+	var behind []string
+	for node, kubeletVersion := range kubeletVersions {
+		v, err := semver.ParseTolerant(kubeletVersion)
+		if err != nil {
+			logger.Warning("ignoring node %q with unexpected kubelet version %q", node, kubeletVersion)
+			continue
+		}
+		if v.Major < target.Major || (v.Major == target.Major && v.Minor+maxSkew < target.Minor) {
+			behind = append(behind, node)
+		}
+	}
+	sort.Strings(behind)
+	return behind
+}
+
+type clusterVersionUpgrader struct {
+	cfg          *api.ClusterConfig
+	ctl          *eks.ClusterProvider
+	cluster      Cluster
+	stackManager manager.StackManager
+	clientSet    kubernetes.Interface
+	options      UpgradeAllOptions
+}
+
+func (u *clusterVersionUpgrader) controlPlaneVersion() (string, error) {
+	if err := u.ctl.RefreshClusterStatus(u.cfg); err != nil {
+		return "", err
+	}
+	return u.ctl.ControlPlaneVersion(), nil
+}
+
+func (u *clusterVersionUpgrader) kubeletVersions() (map[string]string, error) {
+	return kubeletVersions(u.clientSet, metav1.ListOptions{})
+}
+
+func (u *clusterVersionUpgrader) upgradeControlPlane(version string) error {
+	u.cfg.Metadata.Version = version
+	return u.cluster.Upgrade(false, u.options.Force)
+}
+
+func (u *clusterVersionUpgrader) upgradeAddons(version string) error {
+	rawClient, err := u.ctl.NewRawClient(u.cfg)
+	if err != nil {
+		return err
+	}
+	if _, err := defaultaddons.UpdateKubeProxy(rawClient.ClientSet(), version, false); err != nil {
+		return err
+	}
+	if _, err := defaultaddons.UpdateAWSNode(rawClient, u.cfg.Metadata.Region, false); err != nil {
+		return err
+	}
+	_, err = defaultaddons.UpdateCoreDNS(rawClient, u.cfg.Metadata.Region, version, false)
+	return err
+}
+
+func (u *clusterVersionUpgrader) upgradeNodeGroups(version string) error {
+	managedNodeGroups, err := u.managedNodeGroups()
+	if err != nil {
+		return errors.Wrap(err, "listing managed nodegroups")
+	}
+	for _, name := range managedNodeGroups {
+		options := nodegroup.UpgradeOpts{
+			UpgradeOptions: managed.UpgradeOptions{
+				NodegroupName:     *name,
+				KubernetesVersion: version,
+			},
+		}
+		if err := nodegroup.New(u.cfg, u.ctl, u.clientSet).Upgrade(options, true); err != nil {
+			return errors.Wrapf(err, "upgrading managed nodegroup %q", *name)
+		}
+	}
+
+	stacks, err := u.stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return errors.Wrap(err, "listing nodegroup stacks")
+	}
+	for _, s := range stacks {
+		if s.Type != api.NodeGroupTypeUnmanaged {
+			continue
+		}
+		if err := u.replaceNodeGroup(s.NodeGroupName, version); err != nil {
+			return errors.Wrapf(err, "replacing nodegroup %q", s.NodeGroupName)
+		}
+	}
+	return nil
+}
+
+// managedNodeGroups returns the names of the managed nodegroups of every page
+func (u *clusterVersionUpgrader) managedNodeGroups() ([]*string, error) {
+	var names []*string
+	err := u.ctl.Provider.EKS().ListNodegroupsPages(&awseks.ListNodegroupsInput{
+		ClusterName: &u.cfg.Metadata.Name,
+	}, func(page *awseks.ListNodegroupsOutput, _ bool) bool {
+		names = append(names, page.Nodegroups...)
+		return true
+	})
+	return names, err
+}
+
+// replaceNodeGroup replaces an unmanaged nodegroup whose nodes run an older version with a successor,
+// as the AMI of an unmanaged nodegroup can't be changed in place
+func (u *clusterVersionUpgrader) replaceNodeGroup(name, version string) error {
+	versions, err := kubeletVersions(u.clientSet, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", api.NodeGroupNameLabel, name),
+	})
+	if err != nil {
+		return err
+	}
+	if len(nodesBehind(versions, version, 0)) == 0 {
+		logger.Info("nodegroup %q is already up-to-date", name)
+		return nil
+	}
+
+	definition, err := u.nodeGroupDefinition(name)
+	if err != nil {
+		return err
+	}
+	if definition == nil {
+		logger.Warning("nodegroup %q uses a custom AMI and will not be upgraded, replace it with 'eksctl replace nodegroup' once a suitable AMI is available", name)
+		return nil
+	}
+
+	cfg := u.cfg.DeepCopy()
+	cfg.Metadata.Version = version
+	successor := definition.DeepCopy()
+	successor.Name = names.ForSuccessorNodeGroup(name)
+	cfg.NodeGroups = []*api.NodeGroup{successor}
+	cfg.ManagedNodeGroups = nil
+	if err := api.ValidateNodeGroup(0, successor); err != nil {
+		return err
+	}
+	api.SetNodeGroupDefaults(successor, cfg.Metadata)
+
+	options := nodegroup.ReplaceOpts{
+		MaxGracePeriod:  u.options.MaxGracePeriod,
+		DisableEviction: u.options.DisableEviction,
+	}
+	nodeGroup := &api.NodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{
+			Name: name,
+		},
+	}
+	return nodegroup.New(cfg, u.ctl, u.clientSet).Replace(nodeGroup, options, filter.NewNodeGroupFilter())
+}
+
+// nodeGroupDefinition returns the definition of the nodegroup from the config file if there is one,
+// otherwise the definition is exported from the nodegroup's stack; it returns nil if the nodegroup uses
+// a custom AMI, as the AMI for the new version can't be determined
+func (u *clusterVersionUpgrader) nodeGroupDefinition(name string) (*api.NodeGroup, error) {
+	for _, ng := range u.cfg.NodeGroups {
+		if ng.Name == name || ng.Name == names.BaseNodeGroupName(name) {
+			if api.IsAMI(ng.AMI) {
+				return nil, nil
+			}
+			return ng, nil
+		}
+	}
+
+	logger.Info("nodegroup %q is not defined in the config file, its definition will be exported from its stack", name)
+	exporter := export.New(u.cfg, u.ctl, u.stackManager)
+	ng, err := exporter.NodeGroup(name)
+	if err != nil {
+		return nil, err
+	}
+	if api.IsAMI(ng.AMI) {
+		family, err := exporter.ImageFamily(ng.AMI)
+		if err != nil {
+			return nil, err
+		}
+		if family == "" {
+			return nil, nil
+		}
+		// resolve the EKS-optimized AMI for the new version
+		ng.AMI = ""
+		ng.AMIFamily = family
+	}
+	return ng, nil
+}
+
+// kubeletVersions returns the version of the kubelet of each node
+func kubeletVersions(clientSet kubernetes.Interface, listOptions metav1.ListOptions) (map[string]string, error) {
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), listOptions)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+	versions := map[string]string{}
+	for _, node := range nodes.Items {
+		versions[node.Name] = node.Status.NodeInfo.KubeletVersion
+	}
+	return versions, nil
+}
@@ -0,0 +1,173 @@
+package cluster
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+type fakeVersionUpgrader struct {
+	version  string
+	kubelets map[string]string
+	stuck    map[string]bool
+	calls    []string
+	failOn   string
+}
+
+func (u *fakeVersionUpgrader) controlPlaneVersion() (string, error) {
+	return u.version, nil
+}
+
+func (u *fakeVersionUpgrader) kubeletVersions() (map[string]string, error) {
+	return u.kubelets, nil
+}
+
+func (u *fakeVersionUpgrader) record(call string) error {
+	u.calls = append(u.calls, call)
+	if call == u.failOn {
+		return errors.New("failed")
+	}
+	return nil
+}
+
+func (u *fakeVersionUpgrader) upgradeControlPlane(version string) error {
+	if err := u.record("control-plane " + version); err != nil {
+		return err
+	}
+	u.version = version
+	return nil
+}
+
+func (u *fakeVersionUpgrader) upgradeAddons(version string) error {
+	return u.record("addons " + version)
+}
+
+func (u *fakeVersionUpgrader) upgradeNodeGroups(version string) error {
+	if err := u.record("nodegroups " + version); err != nil {
+		return err
+	}
+	for node := range u.kubelets {
+		if u.stuck[node] {
+			continue
+		}
+		u.kubelets[node] = "v" + version + ".4-eks-6b7464"
+	}
+	return nil
+}
+
+var _ = Describe("upgrade cluster to a version several versions ahead", func() {
+	var u *fakeVersionUpgrader
+
+	BeforeEach(func() {
+		u = &fakeVersionUpgrader{
+			version: "1.18",
+			kubelets: map[string]string{
+				"node-1": "v1.18.9-eks-d1db3c",
+				"node-2": "v1.18.9-eks-d1db3c",
+			},
+		}
+	})
+
+	It("upgrades the control plane, addons and nodegroups one version at a time", func() {
+		Expect(upgradeAll(u, "my-cluster", "1.20", false, "")).To(Succeed())
+		Expect(u.calls).To(Equal([]string{
+			"control-plane 1.19", "addons 1.19", "nodegroups 1.19",
+			"control-plane 1.20", "addons 1.20", "nodegroups 1.20",
+		}))
+	})
+
+	It("does not change anything in plan mode", func() {
+		Expect(upgradeAll(u, "my-cluster", "1.20", true, "")).To(Succeed())
+		Expect(u.calls).To(BeEmpty())
+	})
+
+	It("plans the steps of each version", func() {
+		plan := upgradePlan("my-cluster", "1.19", []string{"1.19", "1.20"}).Plan()
+
+		var descriptions []string
+		for _, task := range plan.Tasks {
+			descriptions = append(descriptions, task.Description)
+		}
+		Expect(descriptions).To(Equal([]string{
+			`update default addons of cluster "my-cluster" to version "1.19"`,
+			`upgrade managed and unmanaged nodegroups of cluster "my-cluster" to version "1.19"`,
+			`upgrade cluster "my-cluster" control plane to version "1.20"`,
+			`update default addons of cluster "my-cluster" to version "1.20"`,
+			`upgrade managed and unmanaged nodegroups of cluster "my-cluster" to version "1.20"`,
+		}))
+		Expect(plan.Tasks[2].Action).To(Equal(tasks.ActionUpdate))
+		Expect(plan.Tasks[2].Resource).To(Equal("cluster/my-cluster"))
+	})
+
+	It("does nothing when the cluster and its nodes are at the given version", func() {
+		Expect(upgradeAll(u, "my-cluster", "1.18", false, "")).To(Succeed())
+		Expect(u.calls).To(BeEmpty())
+	})
+
+	It("stops at the first failure", func() {
+		u.failOn = "addons 1.19"
+
+		err := upgradeAll(u, "my-cluster", "1.21", false, "")
+		Expect(err).To(MatchError(ContainSubstring(`upgrading cluster "my-cluster" to version "1.19"; the control plane is at version "1.18"`)))
+		Expect(err).To(MatchError(ContainSubstring("updating default addons: failed")))
+		Expect(u.calls).To(Equal([]string{"control-plane 1.19", "addons 1.19"}))
+	})
+
+	It("upgrades the nodegroups that are behind the control plane before upgrading it", func() {
+		u.version = "1.19"
+
+		Expect(upgradeAll(u, "my-cluster", "1.20", false, "")).To(Succeed())
+		Expect(u.calls).To(Equal([]string{
+			"addons 1.19", "nodegroups 1.19",
+			"control-plane 1.20", "addons 1.20", "nodegroups 1.20",
+		}))
+	})
+
+	It("refuses to upgrade the control plane when nodes would be too far behind", func() {
+		u.version = "1.20"
+		// e.g. a nodegroup with a custom AMI, which is not upgraded
+		u.stuck = map[string]bool{"node-1": true}
+
+		err := upgradeAll(u, "my-cluster", "1.21", false, "")
+		Expect(err).To(MatchError(ContainSubstring(`nodes node-1 would be more than 2 minor versions behind control plane version "1.21"`)))
+		Expect(u.calls).To(Equal([]string{"addons 1.20", "nodegroups 1.20"}))
+	})
+
+	It("fails when the given version is lower than the current one", func() {
+		err := upgradeAll(u, "my-cluster", "1.17", false, "")
+		Expect(err).To(MatchError(`cannot upgrade to a lower version. Found given target version "1.17", current cluster version "1.18"`))
+	})
+
+	It("fails when the given version is not supported", func() {
+		err := upgradeAll(u, "my-cluster", "1.23", false, "")
+		Expect(err).To(MatchError(ContainSubstring(`control plane version "1.23" is not known to this version of eksctl`)))
+	})
+})
+
+var _ = Describe("cluster version upgrader", func() {
+	It("lists the managed nodegroups of every page", func() {
+		p := mockprovider.NewMockProvider()
+		p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*awseks.ListNodegroupsOutput, bool) bool)
+			if consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"mng-1"})}, false) {
+				consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"mng-2"})}, true)
+			}
+		}).Return(nil)
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		u := &clusterVersionUpgrader{cfg: cfg, ctl: &eks.ClusterProvider{Provider: p}}
+
+		names, err := u.managedNodeGroups()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(aws.StringValueSlice(names)).To(Equal([]string{"mng-1", "mng-2"}))
+	})
+})
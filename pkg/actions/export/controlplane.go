@@ -0,0 +1,98 @@
+package export
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+func (m *Manager) exportControlPlane(cfg *api.ClusterConfig, cluster *awseks.Cluster) error {
+	if err := m.exportVPC(cfg, cluster); err != nil {
+		return err
+	}
+
+	if vpcConfig := cluster.ResourcesVpcConfig; vpcConfig != nil {
+		cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+			PublicAccess:  vpcConfig.EndpointPublicAccess,
+			PrivateAccess: vpcConfig.EndpointPrivateAccess,
+		}
+		publicAccessCIDRs := aws.StringValueSlice(vpcConfig.PublicAccessCidrs)
+		if !(len(publicAccessCIDRs) == 1 && publicAccessCIDRs[0] == "0.0.0.0/0") {
+			cfg.VPC.PublicAccessCIDRs = publicAccessCIDRs
+		}
+	}
+
+	if knCfg := cluster.KubernetesNetworkConfig; knCfg != nil {
+		cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+			ServiceIPv4CIDR: aws.StringValue(knCfg.ServiceIpv4Cidr),
+		}
+	}
+
+	cfg.CloudWatch = &api.ClusterCloudWatch{
+		ClusterLogging: &api.ClusterCloudWatchLogging{},
+	}
+	if cluster.Logging != nil {
+		for _, logSetup := range cluster.Logging.ClusterLogging {
+			if aws.BoolValue(logSetup.Enabled) {
+				cfg.CloudWatch.ClusterLogging.EnableTypes = append(cfg.CloudWatch.ClusterLogging.EnableTypes, aws.StringValueSlice(logSetup.Types)...)
+			}
+		}
+	}
+
+	for _, encryptionConfig := range cluster.EncryptionConfig {
+		if encryptionConfig.Provider != nil {
+			cfg.SecretsEncryption = &api.SecretsEncryption{
+				KeyARN: aws.StringValue(encryptionConfig.Provider.KeyArn),
+			}
+		}
+	}
+
+	return nil
+}
+
+// exportVPC loads the VPC and subnets from the cluster stack, or from the EKS API if the
+// cluster was not created by eksctl
+func (m *Manager) exportVPC(cfg *api.ClusterConfig, cluster *awseks.Cluster) error {
+	stack, err := m.stackManager.DescribeClusterStack()
+	if err != nil {
+		return err
+	}
+
+	if stack != nil {
+		if err := vpc.UseFromClusterStack(m.ctl.Provider, stack, cfg); err != nil {
+			return errors.Wrap(err, "loading VPC configuration from cluster stack")
+		}
+		// the security groups are resources of the cluster stack and are created with it
+		cfg.VPC.SecurityGroup = ""
+		cfg.VPC.SharedNodeSecurityGroup = ""
+		return nil
+	}
+
+	cfg.VPC = api.NewClusterVPC()
+	cfg.VPC.CIDR = nil
+	cfg.VPC.ID = aws.StringValue(cluster.ResourcesVpcConfig.VpcId)
+
+	output, err := m.ctl.Provider.EC2().DescribeSubnets(&ec2.DescribeSubnetsInput{
+		SubnetIds: cluster.ResourcesVpcConfig.SubnetIds,
+	})
+	if err != nil {
+		return errors.Wrap(err, "describing cluster subnets")
+	}
+
+	var public, private []*ec2.Subnet
+	for _, subnet := range output.Subnets {
+		if aws.BoolValue(subnet.MapPublicIpOnLaunch) {
+			public = append(public, subnet)
+		} else {
+			private = append(private, subnet)
+		}
+	}
+	if err := vpc.ImportSubnets(m.ctl.Provider.EC2(), cfg, api.SubnetTopologyPublic, public); err != nil {
+		return err
+	}
+	return vpc.ImportSubnets(m.ctl.Provider.EC2(), cfg, api.SubnetTopologyPrivate, private)
+}
@@ -0,0 +1,109 @@
+package export
+
+import (
+	"strings"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// Manager rebuilds a ClusterConfig from the resources of a live cluster
+type Manager struct {
+	cfg          *api.ClusterConfig
+	ctl          *eks.ClusterProvider
+	stackManager manager.StackManager
+	// getClusterAutoscaler returns the summary of cluster-autoscaler, which is deployed by eksctl rather than EKS
+	getClusterAutoscaler func() (addon.Summary, bool, error)
+}
+
+// New creates a new manager.
+func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager) *Manager {
+	m := &Manager{
+		cfg:          cfg,
+		ctl:          ctl,
+		stackManager: stackManager,
+	}
+	m.getClusterAutoscaler = m.clusterAutoscaler
+	return m
+}
+
+// Export returns a ClusterConfig describing the cluster, its nodegroups, Fargate profiles, addons,
+// IAM service accounts and identity providers; only settings that differ from the defaults or
+// that can't be derived are set, so that the config can be passed to `create` or `apply`
+func (m *Manager) Export() (*api.ClusterConfig, error) {
+	if err := m.ctl.RefreshClusterStatus(m.cfg); err != nil {
+		return nil, err
+	}
+	cluster := m.ctl.Status.ClusterInfo.Cluster
+
+	cfg := &api.ClusterConfig{
+		TypeMeta: api.ClusterConfigTypeMeta(),
+		Metadata: &api.ClusterMeta{
+			Name:    m.cfg.Metadata.Name,
+			Region:  m.cfg.Metadata.Region,
+			Version: *cluster.Version,
+			Tags:    userTags(cluster.Tags),
+		},
+		IAM:            &api.ClusterIAM{},
+		PrivateCluster: &api.PrivateCluster{},
+	}
+
+	logger.Info("exporting control plane configuration of cluster %q", cfg.Metadata.Name)
+	if err := m.exportControlPlane(cfg, cluster); err != nil {
+		return nil, err
+	}
+
+	logger.Info("exporting nodegroups of cluster %q", cfg.Metadata.Name)
+	if err := m.exportNodeGroups(cfg); err != nil {
+		return nil, err
+	}
+	if err := m.exportManagedNodeGroups(cfg); err != nil {
+		return nil, err
+	}
+
+	logger.Info("exporting Fargate profiles, addons and identity providers of cluster %q", cfg.Metadata.Name)
+	if err := m.exportFargateProfiles(cfg); err != nil {
+		return nil, err
+	}
+	if err := m.exportAddons(cfg); err != nil {
+		return nil, err
+	}
+	if err := m.exportIdentityProviders(cfg); err != nil {
+		return nil, err
+	}
+
+	logger.Info("exporting IAM service accounts of cluster %q", cfg.Metadata.Name)
+	if err := m.exportIAMServiceAccounts(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// userTags drops the tags that are set by AWS and eksctl, as they are added again on creation
+func userTags(tags map[string]*string) map[string]string {
+	result := map[string]string{}
+	for k, v := range tags {
+		if isReservedTag(k) || v == nil {
+			continue
+		}
+		result[k] = *v
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func isReservedTag(key string) bool {
+	for _, prefix := range []string{"aws:", "alpha.eksctl.io/", "eksctl.io/", "eksctl.cluster.k8s.io/"} {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
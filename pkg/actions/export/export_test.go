@@ -0,0 +1,465 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/iam"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/export"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	vpcfakes "github.com/weaveworks/eksctl/pkg/vpc/fakes"
+)
+
+const nodeGroupTemplate = `{
+  "Resources": {
+    "NodeGroupLaunchTemplate": {
+      "Properties": {
+        "LaunchTemplateData": {
+          "BlockDeviceMappings": [{"DeviceName": "/dev/xvda", "Ebs": {"VolumeSize": 100, "VolumeType": "gp3"}}],
+          "KeyName": "my-key"
+        }
+      }
+    }
+  }
+}`
+
+var _ = Describe("Export", func() {
+	var (
+		p                *mockprovider.MockProvider
+		fakeStackManager *fakes.FakeStackManager
+		cfg              *api.ClusterConfig
+		exportManager    *export.Manager
+		nodeGroup        *awseks.Nodegroup
+		nodeGroupPages   [][]string
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		fakeStackManager = new(fakes.FakeStackManager)
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		exportManager = export.New(cfg, &eks.ClusterProvider{Provider: p, Status: &eks.ProviderStatus{}}, fakeStackManager)
+		exportManager.SetGetClusterAutoscaler(func() (addon.Summary, bool, error) {
+			return addon.Summary{}, false, nil
+		})
+
+		p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{
+			Cluster: &awseks.Cluster{
+				Name:                 aws.String("my-cluster"),
+				Arn:                  aws.String("arn:aws:eks:us-west-2:123456789012:cluster/my-cluster"),
+				Status:               aws.String(awseks.ClusterStatusActive),
+				Version:              aws.String("1.20"),
+				Endpoint:             aws.String("https://endpoint.eks.amazonaws.com"),
+				CertificateAuthority: &awseks.Certificate{Data: aws.String(base64.StdEncoding.EncodeToString([]byte("ca")))},
+				Identity: &awseks.Identity{
+					Oidc: &awseks.OIDC{Issuer: aws.String("https://oidc.eks.us-west-2.amazonaws.com/id/ABC")},
+				},
+				ResourcesVpcConfig: &awseks.VpcConfigResponse{
+					VpcId:                 aws.String("vpc-1"),
+					SubnetIds:             aws.StringSlice([]string{"subnet-public", "subnet-private"}),
+					EndpointPublicAccess:  aws.Bool(true),
+					EndpointPrivateAccess: aws.Bool(false),
+					PublicAccessCidrs:     aws.StringSlice([]string{"0.0.0.0/0"}),
+				},
+				Logging: &awseks.Logging{
+					ClusterLogging: []*awseks.LogSetup{
+						{Enabled: aws.Bool(true), Types: aws.StringSlice([]string{"api", "audit"})},
+						{Enabled: aws.Bool(false), Types: aws.StringSlice([]string{"scheduler"})},
+					},
+				},
+				Tags: aws.StringMap(map[string]string{
+					"team":             "platform",
+					api.ClusterNameTag: "my-cluster",
+				}),
+			},
+		}, nil)
+
+		p.MockEC2().On("DescribeSubnets", mock.Anything).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{
+				{SubnetId: aws.String("subnet-public"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2a"), CidrBlock: aws.String("10.0.0.0/24"), MapPublicIpOnLaunch: aws.Bool(true)},
+				{SubnetId: aws.String("subnet-private"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2b"), CidrBlock: aws.String("10.0.1.0/24"), MapPublicIpOnLaunch: aws.Bool(false)},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeVpcs", mock.Anything).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-1"), CidrBlock: aws.String("10.0.0.0/16")}},
+		}, nil)
+		p.MockEC2().On("DescribeImages", mock.Anything).Return(&ec2.DescribeImagesOutput{
+			Images: []*ec2.Image{{ImageId: aws.String("ami-123"), Name: aws.String("ubuntu-eks/k8s_1.20/images/hvm-ssd/ubuntu-focal-20.04-amd64-server-20210621")}},
+		}, nil)
+
+		fakeStackManager.DescribeClusterStackReturns(nil, nil)
+		fakeStackManager.GetUnmanagedNodeGroupSummariesReturns([]*manager.NodeGroupSummary{{
+			StackName:       "eksctl-my-cluster-nodegroup-ng-1",
+			Name:            "ng-1",
+			InstanceType:    "m5.xlarge",
+			ImageID:         "ami-123",
+			DesiredCapacity: 2,
+			MinSize:         1,
+			MaxSize:         3,
+		}}, nil)
+		fakeStackManager.GetStackTemplateReturns(nodeGroupTemplate, nil)
+		fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{
+			{NodeGroupName: "ng-1", Type: api.NodeGroupTypeUnmanaged},
+		}, nil)
+
+		nodeGroupPages = [][]string{{"mng-1"}}
+		p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*awseks.ListNodegroupsOutput, bool) bool)
+			for i, page := range nodeGroupPages {
+				if !consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice(page)}, i == len(nodeGroupPages)-1) {
+					break
+				}
+			}
+		}).Return(nil)
+		nodeGroup = &awseks.Nodegroup{
+			NodegroupName: aws.String("mng-1"),
+			InstanceTypes: aws.StringSlice([]string{"t3.large"}),
+			CapacityType:  aws.String(awseks.CapacityTypesSpot),
+			DiskSize:      aws.Int64(50),
+			ScalingConfig: &awseks.NodegroupScalingConfig{DesiredSize: aws.Int64(1), MinSize: aws.Int64(1), MaxSize: aws.Int64(2)},
+			Labels:        aws.StringMap(map[string]string{"role": "worker", api.NodeGroupNameLabel: "mng-1"}),
+			Taints: []*awseks.Taint{
+				{Key: aws.String("dedicated"), Value: aws.String("gpu"), Effect: aws.String(awseks.TaintEffectNoSchedule)},
+			},
+		}
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(func(input *awseks.DescribeNodegroupInput) *awseks.DescribeNodegroupOutput {
+			described := *nodeGroup
+			described.NodegroupName = input.NodegroupName
+			return &awseks.DescribeNodegroupOutput{Nodegroup: &described}
+		}, nil)
+
+		p.MockEKS().On("ListFargateProfiles", mock.Anything).Return(&awseks.ListFargateProfilesOutput{}, nil)
+		p.MockEKS().On("ListAddons", mock.Anything).Return(&awseks.ListAddonsOutput{
+			Addons: aws.StringSlice([]string{"vpc-cni"}),
+		}, nil)
+		p.MockEKS().On("DescribeAddon", mock.Anything).Return(&awseks.DescribeAddonOutput{
+			Addon: &awseks.Addon{
+				AddonName:    aws.String("vpc-cni"),
+				AddonVersion: aws.String("v1.7.10-eksbuild.1"),
+			},
+		}, nil)
+		p.MockEKS().On("ListIdentityProviderConfigs", mock.Anything).Return(&awseks.ListIdentityProviderConfigsOutput{}, nil)
+
+		p.MockIAM().On("GetOpenIDConnectProvider", mock.Anything).Return(&iam.GetOpenIDConnectProviderOutput{}, nil)
+		fakeStackManager.GetIAMServiceAccountsReturns([]*api.ClusterIAMServiceAccount{{
+			ClusterIAMMeta: api.ClusterIAMMeta{Name: "s3-reader", Namespace: "default"},
+			Status: &api.ClusterIAMServiceAccountStatus{
+				RoleARN: aws.String("arn:aws:iam::123456789012:role/eksctl-my-cluster-addon-iamserviceaccount-de-Role1-ABCDEF"),
+			},
+		}}, nil)
+		p.MockIAM().On("GetRole", mock.Anything).Return(&iam.GetRoleOutput{Role: &iam.Role{}}, nil)
+		p.MockIAM().On("ListAttachedRolePoliciesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*iam.ListAttachedRolePoliciesOutput, bool) bool)
+			consume(&iam.ListAttachedRolePoliciesOutput{
+				AttachedPolicies: []*iam.AttachedPolicy{{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess")}},
+			}, true)
+		}).Return(nil)
+		p.MockIAM().On("ListRolePoliciesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*iam.ListRolePoliciesOutput, bool) bool)
+			consume(&iam.ListRolePoliciesOutput{PolicyNames: aws.StringSlice([]string{"Policy1"})}, true)
+		}).Return(nil)
+		p.MockIAM().On("GetRolePolicy", mock.Anything).Return(&iam.GetRolePolicyOutput{
+			PolicyDocument: aws.String(url.QueryEscape(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"sqs:ReceiveMessage","Resource":"*"}}`)),
+		}, nil)
+	})
+
+	It("rebuilds the ClusterConfig from the live cluster", func() {
+		exported, err := exportManager.Export()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(exported.Metadata.Version).To(Equal("1.20"))
+		Expect(exported.Metadata.Tags).To(Equal(map[string]string{"team": "platform"}))
+		Expect(exported.Status).To(BeNil())
+		Expect(exported.CloudWatch.ClusterLogging.EnableTypes).To(ConsistOf("api", "audit"))
+
+		Expect(exported.VPC.ID).To(Equal("vpc-1"))
+		Expect(exported.VPC.CIDR.String()).To(Equal("10.0.0.0/16"))
+		Expect(exported.VPC.Subnets.Public).To(HaveKeyWithValue("us-west-2a", api.AZSubnetSpec{ID: "subnet-public", AZ: "us-west-2a", CIDR: exported.VPC.Subnets.Public["us-west-2a"].CIDR}))
+		Expect(exported.VPC.Subnets.Private).To(HaveKey("us-west-2b"))
+		Expect(exported.VPC.PublicAccessCIDRs).To(BeEmpty())
+
+		Expect(exported.NodeGroups).To(HaveLen(1))
+		ng := exported.NodeGroups[0]
+		Expect(ng.Name).To(Equal("ng-1"))
+		Expect(ng.InstanceType).To(Equal("m5.xlarge"))
+		Expect(ng.AMI).To(Equal("ami-123"))
+		Expect(ng.AMIFamily).To(Equal(api.NodeImageFamilyUbuntu2004))
+		Expect(*ng.DesiredCapacity).To(Equal(2))
+		Expect(*ng.VolumeSize).To(Equal(100))
+		Expect(*ng.VolumeType).To(Equal("gp3"))
+		Expect(*ng.SSH.PublicKeyName).To(Equal("my-key"))
+
+		Expect(exported.ManagedNodeGroups).To(HaveLen(1))
+		mng := exported.ManagedNodeGroups[0]
+		Expect(mng.Name).To(Equal("mng-1"))
+		Expect(mng.InstanceType).To(Equal("t3.large"))
+		Expect(mng.Spot).To(BeTrue())
+		Expect(*mng.VolumeSize).To(Equal(50))
+		Expect(mng.Labels).To(Equal(map[string]string{"role": "worker"}))
+		Expect(mng.Taints).To(Equal([]api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}}))
+		Expect(mng.LaunchTemplate).To(BeNil())
+
+		Expect(exported.Addons).To(Equal([]*api.Addon{{Name: "vpc-cni", Version: "v1.7.10-eksbuild.1"}}))
+
+		Expect(api.IsEnabled(exported.IAM.WithOIDC)).To(BeTrue())
+		Expect(exported.IAM.ServiceAccounts).To(HaveLen(1))
+		sa := exported.IAM.ServiceAccounts[0]
+		Expect(sa.NameString()).To(Equal("default/s3-reader"))
+		Expect(sa.RoleName).To(BeEmpty())
+		Expect(sa.AttachPolicyARNs).To(ConsistOf("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"))
+		Expect(sa.AttachPolicy["Statement"]).To(HaveLen(1))
+	})
+
+	It("exports cluster-autoscaler as an addon along with its IAM role", func() {
+		exportManager.SetGetClusterAutoscaler(func() (addon.Summary, bool, error) {
+			return addon.Summary{Name: api.ClusterAutoscalerAddon, Version: "v1.20.0", Status: "DEPLOYED"}, true, nil
+		})
+		fakeStackManager.GetIAMServiceAccountsReturns([]*api.ClusterIAMServiceAccount{{
+			ClusterIAMMeta: api.ClusterIAMMeta{Name: api.ClusterAutoscalerAddon, Namespace: "kube-system"},
+			Status: &api.ClusterIAMServiceAccountStatus{
+				RoleARN: aws.String("arn:aws:iam::123456789012:role/eksctl-my-cluster-addon-iamserviceaccount-ku-Role1-ABCDEF"),
+			},
+		}}, nil)
+
+		exported, err := exportManager.Export()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(exported.Addons).To(Equal([]*api.Addon{
+			{Name: "vpc-cni", Version: "v1.7.10-eksbuild.1"},
+			{Name: api.ClusterAutoscalerAddon, Version: "v1.20.0"},
+		}))
+		Expect(exported.IAM.ServiceAccounts).To(BeEmpty())
+	})
+
+	It("can be loaded and validated as a ClusterConfig", func() {
+		exported, err := exportManager.Export()
+		Expect(err).NotTo(HaveOccurred())
+
+		out := &bytes.Buffer{}
+		Expect(printers.NewYAMLPrinter().PrintObj(exported, out)).To(Succeed())
+
+		Expect(api.Register()).To(Succeed())
+		loaded, err := eks.ParseConfig(out.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		api.SetClusterConfigDefaults(loaded)
+		Expect(api.ValidateClusterConfig(loaded)).To(Succeed())
+		for i, ng := range loaded.NodeGroups {
+			api.SetNodeGroupDefaults(ng, loaded.Metadata)
+			Expect(api.ValidateNodeGroup(i, ng)).To(Succeed())
+		}
+		for _, ng := range loaded.ManagedNodeGroups {
+			api.SetManagedNodeGroupDefaults(ng, loaded.Metadata)
+			Expect(api.ValidateManagedNodeGroup(ng, 0)).To(Succeed())
+		}
+	})
+
+	It("exports the managed nodegroups of every page", func() {
+		nodeGroupPages = [][]string{{"mng-1"}, {"mng-2"}}
+
+		exported, err := exportManager.Export()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(exported.ManagedNodeGroups).To(HaveLen(2))
+		Expect(exported.ManagedNodeGroups[0].Name).To(Equal("mng-1"))
+		Expect(exported.ManagedNodeGroups[1].Name).To(Equal("mng-2"))
+	})
+
+	It("keeps the launch template of managed nodegroups not created by eksctl", func() {
+		nodeGroup.DiskSize = nil
+		nodeGroup.LaunchTemplate = &awseks.LaunchTemplateSpecification{Id: aws.String("lt-1"), Version: aws.String("3")}
+
+		exported, err := exportManager.Export()
+		Expect(err).NotTo(HaveOccurred())
+
+		mng := exported.ManagedNodeGroups[0]
+		Expect(mng.LaunchTemplate).To(Equal(&api.LaunchTemplate{ID: "lt-1", Version: aws.String("3")}))
+		Expect(mng.InstanceType).To(BeEmpty())
+		Expect(mng.InstanceTypes).To(ConsistOf("t3.large"))
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeLaunchTemplateVersions", mock.Anything)
+	})
+
+	It("reads the settings of managed nodegroups created by eksctl from their launch template", func() {
+		fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{
+			{NodeGroupName: "ng-1", Type: api.NodeGroupTypeUnmanaged},
+			{NodeGroupName: "mng-1", Type: api.NodeGroupTypeManaged},
+		}, nil)
+		nodeGroup.DiskSize = nil
+		nodeGroup.InstanceTypes = nil
+		nodeGroup.LaunchTemplate = &awseks.LaunchTemplateSpecification{Id: aws.String("lt-1"), Version: aws.String("1")}
+		p.MockEC2().On("DescribeLaunchTemplateVersions", mock.Anything).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{{
+				VersionNumber: aws.Int64(1),
+				LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+					InstanceType: aws.String("c5.large"),
+					BlockDeviceMappings: []*ec2.LaunchTemplateBlockDeviceMapping{
+						{Ebs: &ec2.LaunchTemplateEbsBlockDevice{VolumeSize: aws.Int64(80), VolumeType: aws.String("gp3")}},
+					},
+				},
+			}},
+		}, nil)
+
+		exported, err := exportManager.Export()
+		Expect(err).NotTo(HaveOccurred())
+
+		mng := exported.ManagedNodeGroups[0]
+		Expect(mng.LaunchTemplate).To(BeNil())
+		Expect(mng.InstanceType).To(Equal("c5.large"))
+		Expect(*mng.VolumeSize).To(Equal(80))
+		Expect(*mng.VolumeType).To(Equal("gp3"))
+	})
+
+	It("exports the nodegroups it creates with the same settings", func() {
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "my-cluster"
+		clusterConfig.Metadata.Region = "us-west-2"
+		clusterConfig.Metadata.Tags = map[string]string{"team": "platform"}
+		clusterConfig.VPC.ID = "vpc-1"
+		clusterConfig.VPC.Subnets = &api.ClusterSubnets{
+			Private: api.AZSubnetMapping{"us-west-2b": api.AZSubnetSpec{ID: "subnet-private", AZ: "us-west-2b"}},
+		}
+		clusterConfig.Status = &api.ClusterStatus{
+			Endpoint:                 "https://endpoint.eks.amazonaws.com",
+			CertificateAuthorityData: []byte("ca"),
+		}
+
+		ng := api.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.xlarge"
+		ng.AMI = "ami-123"
+		ng.AMIFamily = api.NodeImageFamilyUbuntu2004
+		ng.ScalingConfig = &api.ScalingConfig{DesiredCapacity: aws.Int(2), MinSize: aws.Int(1), MaxSize: aws.Int(3)}
+		ng.PrivateNetworking = true
+		ng.Subnets = []string{"subnet-private"}
+		ng.Labels = map[string]string{"role": "worker", "tier": "backend"}
+		ng.Taints = []api.NodeGroupTaint{
+			{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "spot", Effect: corev1.TaintEffectPreferNoSchedule},
+		}
+		ng.IAM.AttachPolicyARNs = []string{"arn:aws:iam::123456789012:policy/node-policy"}
+		ng.IAM.WithAddonPolicies.AutoScaler = api.Enabled()
+		ng.IAM.WithAddonPolicies.ImageBuilder = api.Enabled()
+		ng.IAM.InstanceRolePermissionsBoundary = "arn:aws:iam::123456789012:policy/boundary"
+		ng.Tags = map[string]string{"env": "prod"}
+		api.SetNodeGroupDefaults(ng, clusterConfig.Metadata)
+
+		bootstrapper, err := nodebootstrap.NewBootstrapper(clusterConfig, ng)
+		Expect(err).NotTo(HaveOccurred())
+		resourceSet := builder.NewNodeGroupResourceSet(p.EC2(), p.IAM(), clusterConfig, ng, bootstrapper, false, new(vpcfakes.FakeImporter))
+		Expect(resourceSet.AddAllResources()).To(Succeed())
+		template, err := resourceSet.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		fakeStackManager.GetStackTemplateReturns(string(template), nil)
+
+		stackTags := []*cloudformation.Tag{
+			{Key: aws.String(api.ClusterNameTag), Value: aws.String("my-cluster")},
+			{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+		}
+		for k, v := range ng.Tags {
+			stackTags = append(stackTags, &cloudformation.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		fakeStackManager.DescribeStackReturns(&manager.Stack{Tags: stackTags}, nil)
+
+		exported, err := exportManager.Export()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(exported.NodeGroups).To(HaveLen(1))
+		exportedNG := exported.NodeGroups[0]
+		Expect(exportedNG.AMIFamily).To(Equal(api.NodeImageFamilyUbuntu2004))
+		Expect(exportedNG.PrivateNetworking).To(BeTrue())
+		Expect(exportedNG.Subnets).To(Equal([]string{"subnet-private"}))
+		Expect(exportedNG.Labels).To(Equal(map[string]string{"role": "worker", "tier": "backend"}))
+		Expect(exportedNG.Taints).To(Equal(ng.Taints))
+		Expect(exportedNG.Tags).To(Equal(map[string]string{"env": "prod"}))
+		Expect(exportedNG.IAM.AttachPolicyARNs).To(Equal(ng.IAM.AttachPolicyARNs))
+		Expect(exportedNG.IAM.InstanceRolePermissionsBoundary).To(Equal(ng.IAM.InstanceRolePermissionsBoundary))
+		Expect(api.IsEnabled(exportedNG.IAM.WithAddonPolicies.AutoScaler)).To(BeTrue())
+		Expect(api.IsEnabled(exportedNG.IAM.WithAddonPolicies.ImageBuilder)).To(BeTrue())
+		Expect(exportedNG.IAM.WithAddonPolicies.EBS).To(BeNil())
+
+		api.SetNodeGroupDefaults(exportedNG, exported.Metadata)
+		Expect(api.ValidateNodeGroup(0, exportedNG)).To(Succeed())
+	})
+
+	It("exports the instance profile of nodegroups created with an existing one", func() {
+		fakeStackManager.GetStackTemplateReturns(`{
+  "Resources": {
+    "NodeGroupLaunchTemplate": {
+      "Properties": {
+        "LaunchTemplateData": {
+          "IamInstanceProfile": {"Arn": "arn:aws:iam::123456789012:instance-profile/nodes"}
+        }
+      }
+    }
+  },
+  "Outputs": {
+    "InstanceRoleARN": {"Value": "arn:aws:iam::123456789012:role/nodes"}
+  }
+}`, nil)
+
+		exported, err := exportManager.Export()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(exported.NodeGroups[0].IAM).To(Equal(&api.NodeGroupIAM{
+			InstanceProfileARN: "arn:aws:iam::123456789012:instance-profile/nodes",
+			InstanceRoleARN:    "arn:aws:iam::123456789012:role/nodes",
+		}))
+	})
+
+	DescribeTable("reads the labels and taints from the user data", func(newBootstrapper func(*api.ClusterConfig, *api.NodeGroup) nodebootstrap.Bootstrapper) {
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "my-cluster"
+		clusterConfig.Status = &api.ClusterStatus{
+			Endpoint:                 "https://endpoint.eks.amazonaws.com",
+			CertificateAuthorityData: []byte("ca"),
+		}
+		ng := api.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.Labels = map[string]string{"role": "worker", api.NodeGroupNameLabel: "ng-1"}
+		ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute}}
+
+		userData, err := newBootstrapper(clusterConfig, ng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+		fakeStackManager.GetStackTemplateReturns(fmt.Sprintf(`{
+  "Resources": {
+    "NodeGroupLaunchTemplate": {
+      "Properties": {"LaunchTemplateData": {"UserData": %q}}
+    }
+  }
+}`, userData), nil)
+
+		exported, err := exportManager.Export()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(exported.NodeGroups[0].Labels).To(Equal(map[string]string{"role": "worker"}))
+		Expect(exported.NodeGroups[0].Taints).To(Equal(ng.Taints))
+	},
+		Entry("of Windows nodes", func(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) nodebootstrap.Bootstrapper {
+			return nodebootstrap.NewWindowsBootstrapper(clusterConfig.Metadata.Name, ng)
+		}),
+		Entry("of Bottlerocket nodes", func(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) nodebootstrap.Bootstrapper {
+			ng.Bottlerocket = &api.NodeGroupBottlerocket{}
+			return nodebootstrap.NewBottlerocketBootstrapper(clusterConfig, ng, ng.MaxPodsPerNode)
+		}),
+	)
+})
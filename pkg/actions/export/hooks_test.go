@@ -0,0 +1,7 @@
+package export
+
+import "github.com/weaveworks/eksctl/pkg/actions/addon"
+
+func (m *Manager) SetGetClusterAutoscaler(getClusterAutoscaler func() (addon.Summary, bool, error)) {
+	m.getClusterAutoscaler = getClusterAutoscaler
+}
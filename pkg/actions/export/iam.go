@@ -0,0 +1,151 @@
+package export
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+func (m *Manager) exportIAMServiceAccounts(cfg *api.ClusterConfig) error {
+	oidc, err := m.ctl.NewOpenIDConnectManager(m.cfg)
+	if err != nil {
+		if _, ok := err.(*eks.UnsupportedOIDCError); ok {
+			return nil
+		}
+		return err
+	}
+	providerExists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return errors.Wrap(err, "checking OIDC provider")
+	}
+	if !providerExists {
+		return nil
+	}
+	cfg.IAM.WithOIDC = api.Enabled()
+
+	serviceAccounts, err := m.stackManager.GetIAMServiceAccounts()
+	if err != nil {
+		return errors.Wrap(err, "getting iamserviceaccounts")
+	}
+
+	for _, sa := range serviceAccounts {
+		if sa.Status == nil || sa.Status.RoleARN == nil {
+			continue
+		}
+		if sa.NameString() == addon.ClusterAutoscalerServiceAccount {
+			// the IAM role of cluster-autoscaler is managed with the addon
+			continue
+		}
+		serviceAccount, err := m.exportIAMServiceAccount(sa.ClusterIAMMeta, *sa.Status.RoleARN)
+		if err != nil {
+			return errors.Wrapf(err, "exporting iamserviceaccount %q", sa.NameString())
+		}
+		cfg.IAM.ServiceAccounts = append(cfg.IAM.ServiceAccounts, serviceAccount)
+	}
+	return nil
+}
+
+// exportIAMServiceAccount reads the policies of the role of an iamserviceaccount from IAM, as the
+// well-known policies in its stack template can't be mapped back to the config
+func (m *Manager) exportIAMServiceAccount(meta api.ClusterIAMMeta, roleARN string) (*api.ClusterIAMServiceAccount, error) {
+	parsedARN, err := arn.Parse(roleARN)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing role ARN %q", roleARN)
+	}
+	roleName := parsedARN.Resource[strings.LastIndex(parsedARN.Resource, "/")+1:]
+
+	serviceAccount := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      meta.Name,
+			Namespace: meta.Namespace,
+		},
+	}
+	// CloudFormation generates names that contain the logical ID of the role
+	if !strings.Contains(roleName, "-Role1-") {
+		serviceAccount.RoleName = roleName
+	}
+
+	iamAPI := m.ctl.Provider.IAM()
+	role, err := iamAPI.GetRole(&iam.GetRoleInput{RoleName: &roleName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting role %q", roleName)
+	}
+	if role.Role.PermissionsBoundary != nil {
+		serviceAccount.PermissionsBoundary = aws.StringValue(role.Role.PermissionsBoundary.PermissionsBoundaryArn)
+	}
+
+	err = iamAPI.ListAttachedRolePoliciesPages(&iam.ListAttachedRolePoliciesInput{RoleName: &roleName}, func(p *iam.ListAttachedRolePoliciesOutput, _ bool) bool {
+		for _, policy := range p.AttachedPolicies {
+			serviceAccount.AttachPolicyARNs = append(serviceAccount.AttachPolicyARNs, aws.StringValue(policy.PolicyArn))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing policies attached to role %q", roleName)
+	}
+
+	var policyNames []*string
+	err = iamAPI.ListRolePoliciesPages(&iam.ListRolePoliciesInput{RoleName: &roleName}, func(p *iam.ListRolePoliciesOutput, _ bool) bool {
+		policyNames = append(policyNames, p.PolicyNames...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing inline policies of role %q", roleName)
+	}
+
+	// inline policies are merged into a single document
+	var statements []interface{}
+	for _, policyName := range policyNames {
+		policy, err := iamAPI.GetRolePolicy(&iam.GetRolePolicyInput{
+			RoleName:   &roleName,
+			PolicyName: policyName,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting inline policy %q of role %q", *policyName, roleName)
+		}
+		policyStatements, err := parsePolicyStatements(aws.StringValue(policy.PolicyDocument))
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing inline policy %q of role %q", *policyName, roleName)
+		}
+		statements = append(statements, policyStatements...)
+	}
+	if len(statements) > 0 {
+		serviceAccount.AttachPolicy = api.InlineDocument{
+			"Version":   "2012-10-17",
+			"Statement": statements,
+		}
+	}
+
+	return serviceAccount, nil
+}
+
+// parsePolicyStatements decodes a URL-encoded policy document as returned by IAM
+func parsePolicyStatements(policyDocument string) ([]interface{}, error) {
+	document, err := url.QueryUnescape(policyDocument)
+	if err != nil {
+		return nil, err
+	}
+	var policy struct {
+		Statement interface{}
+	}
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return nil, err
+	}
+	switch statement := policy.Statement.(type) {
+	case []interface{}:
+		return statement, nil
+	case nil:
+		return nil, nil
+	default:
+		return []interface{}{statement}, nil
+	}
+}
@@ -0,0 +1,398 @@
+package export
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/ami"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+)
+
+const (
+	launchTemplateDataPath = "Resources.NodeGroupLaunchTemplate.Properties.LaunchTemplateData"
+	mixedInstanceTypesPath = "Resources.NodeGroup.Properties.MixedInstancesPolicy.LaunchTemplate.Overrides.#.InstanceType"
+	vpcZoneIdentifierPath  = "Resources.NodeGroup.Properties.VPCZoneIdentifier"
+	instanceRolePath       = "Resources.NodeInstanceRole.Properties"
+	instanceProfilePath    = "Resources.NodeInstanceProfile"
+
+	iamPolicyAmazonEC2ContainerRegistryPowerUser = "AmazonEC2ContainerRegistryPowerUser"
+	iamPolicyCloudWatchAgentServerPolicy         = "CloudWatchAgentServerPolicy"
+)
+
+func (m *Manager) exportNodeGroups(cfg *api.ClusterConfig) error {
+	summaries, err := m.stackManager.GetUnmanagedNodeGroupSummaries("")
+	if err != nil {
+		return errors.Wrap(err, "getting nodegroup stack summaries")
+	}
+
+	for _, summary := range summaries {
+		ng, err := m.exportNodeGroup(summary, cfg.Metadata.Tags)
+		if err != nil {
+			return errors.Wrapf(err, "exporting nodegroup %q", summary.Name)
+		}
+		cfg.NodeGroups = append(cfg.NodeGroups, ng)
+	}
+	return nil
+}
+
+// NodeGroup returns the definition of the unmanaged nodegroup with the given name, as it would be
+// exported by Export
+func (m *Manager) NodeGroup(name string) (*api.NodeGroup, error) {
+	summaries, err := m.stackManager.GetUnmanagedNodeGroupSummaries(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting nodegroup stack summaries")
+	}
+	if len(summaries) == 0 {
+		return nil, errors.Errorf("nodegroup %q not found", name)
+	}
+	return m.exportNodeGroup(summaries[0], m.cfg.Metadata.Tags)
+}
+
+// exportNodeGroup rebuilds an unmanaged nodegroup from its stack; tags that the nodegroup inherits from
+// clusterTags are left out
+func (m *Manager) exportNodeGroup(summary *manager.NodeGroupSummary, clusterTags map[string]string) (*api.NodeGroup, error) {
+	ng := &api.NodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{
+			Name:          summary.Name,
+			InstanceType:  summary.InstanceType,
+			ScalingConfig: newScalingConfig(summary.DesiredCapacity, summary.MinSize, summary.MaxSize),
+		},
+	}
+
+	template, err := m.stackManager.GetStackTemplate(summary.StackName)
+	if err != nil {
+		return nil, err
+	}
+
+	launchTemplateData := gjson.Get(template, launchTemplateDataPath)
+	if volumeSize := launchTemplateData.Get("BlockDeviceMappings.0.Ebs.VolumeSize"); volumeSize.Exists() {
+		ng.VolumeSize = aws.Int(int(volumeSize.Int()))
+	}
+	if volumeType := launchTemplateData.Get("BlockDeviceMappings.0.Ebs.VolumeType"); volumeType.Exists() {
+		ng.VolumeType = aws.String(volumeType.String())
+	}
+	if keyName := launchTemplateData.Get("KeyName"); keyName.Type == gjson.String {
+		ng.SSH = &api.NodeGroupSSH{
+			Allow:         api.Enabled(),
+			PublicKeyName: aws.String(keyName.String()),
+		}
+	}
+
+	if instanceTypes := gjson.Get(template, mixedInstanceTypesPath).Array(); len(instanceTypes) > 0 {
+		ng.InstanceType = "mixed"
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{}
+		for _, instanceType := range instanceTypes {
+			ng.InstancesDistribution.InstanceTypes = append(ng.InstancesDistribution.InstanceTypes, instanceType.String())
+		}
+	}
+
+	ng.PrivateNetworking = gjson.Get(template, "Outputs."+outputs.NodeGroupFeaturePrivateNetworking+".Value").Bool()
+	// subnets are only listed in the template when the nodegroup was created with availability zones or
+	// subnets, otherwise they are imported from the cluster stack
+	for _, subnet := range gjson.Get(template, vpcZoneIdentifierPath).Array() {
+		if subnet.Type == gjson.String {
+			ng.Subnets = append(ng.Subnets, subnet.String())
+		}
+	}
+
+	labels, taints, err := nodeLabelsAndTaints(launchTemplateData.Get("UserData").String())
+	if err != nil {
+		return nil, errors.Wrap(err, "reading labels and taints")
+	}
+	ng.Labels = labels
+	ng.Taints = taints
+
+	if iam := exportNodeGroupIAM(template); !reflect.DeepEqual(*iam, api.NodeGroupIAM{}) {
+		ng.IAM = iam
+	}
+
+	stack, err := m.stackManager.DescribeStack(&manager.Stack{StackName: aws.String(summary.StackName)})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing stack %q", summary.StackName)
+	}
+	if stack != nil {
+		ng.Tags = nodeGroupTags(stack.Tags, clusterTags)
+	}
+
+	if api.IsAMI(summary.ImageID) {
+		ng.AMI = summary.ImageID
+		family, err := m.ImageFamily(summary.ImageID)
+		if err != nil {
+			return nil, err
+		}
+		switch family {
+		case "":
+			logger.Warning("could not determine the image family of AMI %q used by nodegroup %q, set amiFamily and overrideBootstrapCommand if it is not based on %s",
+				summary.ImageID, summary.Name, api.NodeImageFamilyAmazonLinux2)
+		case api.NodeImageFamilyAmazonLinux2:
+		default:
+			ng.AMIFamily = family
+		}
+	}
+
+	return ng, nil
+}
+
+// exportNodeGroupIAM returns the IAM settings of an unmanaged nodegroup from the IAM resources of its stack
+func exportNodeGroupIAM(template string) *api.NodeGroupIAM {
+	iam := &api.NodeGroupIAM{}
+
+	role := gjson.Get(template, instanceRolePath)
+	if !role.Exists() {
+		// the nodegroup uses an existing instance role, and an existing instance profile unless the stack creates one
+		if roleARN := gjson.Get(template, "Outputs."+outputs.NodeGroupInstanceRoleARN+".Value"); roleARN.Type == gjson.String {
+			iam.InstanceRoleARN = roleARN.String()
+		}
+		if !gjson.Get(template, instanceProfilePath).Exists() {
+			iam.InstanceProfileARN = gjson.Get(template, launchTemplateDataPath+".IamInstanceProfile.Arn").String()
+		}
+		return iam
+	}
+
+	iam.InstanceRoleName = role.Get("RoleName").String()
+	iam.InstanceRolePermissionsBoundary = role.Get("PermissionsBoundary").String()
+
+	// policies attached by the user are plain ARNs, while the policies attached by eksctl are built with the partition
+	wellKnownPolicies := sets.NewString()
+	for _, policyARN := range role.Get("ManagedPolicyArns").Array() {
+		if policyARN.Type == gjson.String {
+			iam.AttachPolicyARNs = append(iam.AttachPolicyARNs, policyARN.String())
+			continue
+		}
+		sub := policyARN.Get("Fn::Sub").String()
+		wellKnownPolicies.Insert(sub[strings.LastIndex(sub, "/")+1:])
+	}
+	if wellKnownPolicies.Has(iamPolicyAmazonEC2ContainerRegistryPowerUser) {
+		iam.WithAddonPolicies.ImageBuilder = api.Enabled()
+	}
+	if wellKnownPolicies.Has(iamPolicyCloudWatchAgentServerPolicy) {
+		iam.WithAddonPolicies.CloudWatch = api.Enabled()
+	}
+
+	addonPolicies := map[string]**bool{
+		"PolicyAutoScaling":               &iam.WithAddonPolicies.AutoScaler,
+		"PolicyCertManagerChangeSet":      &iam.WithAddonPolicies.CertManager,
+		"PolicyExternalDNSChangeSet":      &iam.WithAddonPolicies.ExternalDNS,
+		"PolicyAppMesh":                   &iam.WithAddonPolicies.AppMesh,
+		"PolicyAppMeshPreview":            &iam.WithAddonPolicies.AppMeshPreview,
+		"PolicyEBS":                       &iam.WithAddonPolicies.EBS,
+		"PolicyFSX":                       &iam.WithAddonPolicies.FSX,
+		"PolicyEFS":                       &iam.WithAddonPolicies.EFS,
+		"PolicyAWSLoadBalancerController": &iam.WithAddonPolicies.AWSLoadBalancerController,
+		"PolicyXRay":                      &iam.WithAddonPolicies.XRay,
+	}
+	for resource, enabled := range addonPolicies {
+		if gjson.Get(template, "Resources."+resource).Exists() {
+			*enabled = api.Enabled()
+		}
+	}
+	return iam
+}
+
+// nodeGroupTags returns the user tags of a nodegroup stack that are not inherited from the cluster
+func nodeGroupTags(stackTags []*cloudformation.Tag, clusterTags map[string]string) map[string]string {
+	tags := map[string]*string{}
+	for _, tag := range stackTags {
+		if value, ok := clusterTags[aws.StringValue(tag.Key)]; ok && value == aws.StringValue(tag.Value) {
+			continue
+		}
+		tags[aws.StringValue(tag.Key)] = tag.Value
+	}
+	return userTags(tags)
+}
+
+// ImageFamily returns the family of an EKS-optimized image, or an empty string if the image
+// does not match any of the known families
+func (m *Manager) ImageFamily(imageID string) (string, error) {
+	output, err := m.ctl.Provider.EC2().DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{imageID}),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "describing image %q", imageID)
+	}
+	if len(output.Images) == 0 {
+		return "", nil
+	}
+
+	name := aws.StringValue(output.Images[0].Name)
+	if strings.HasPrefix(name, "bottlerocket-") {
+		return api.NodeImageFamilyBottlerocket, nil
+	}
+	for family, patterns := range ami.MakeImageSearchPatterns("*") {
+		for _, pattern := range patterns {
+			// wildcards in image name filters match any sequence of characters, including slashes
+			re := "^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$"
+			if regexp.MustCompile(re).MatchString(name) {
+				return family, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func (m *Manager) exportManagedNodeGroups(cfg *api.ClusterConfig) error {
+	stacks, err := m.stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return errors.Wrap(err, "listing nodegroup stacks")
+	}
+	owned := sets.NewString()
+	for _, s := range stacks {
+		if s.Type == api.NodeGroupTypeManaged {
+			owned.Insert(s.NodeGroupName)
+		}
+	}
+
+	eksAPI := m.ctl.Provider.EKS()
+	var names []*string
+	err = eksAPI.ListNodegroupsPages(&awseks.ListNodegroupsInput{
+		ClusterName: &cfg.Metadata.Name,
+	}, func(page *awseks.ListNodegroupsOutput, _ bool) bool {
+		names = append(names, page.Nodegroups...)
+		return true
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing managed nodegroups")
+	}
+
+	for _, name := range names {
+		describeOutput, err := eksAPI.DescribeNodegroup(&awseks.DescribeNodegroupInput{
+			ClusterName:   &cfg.Metadata.Name,
+			NodegroupName: name,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "describing managed nodegroup %q", *name)
+		}
+		ng, err := m.exportManagedNodeGroup(describeOutput.Nodegroup, owned.Has(*name))
+		if err != nil {
+			return errors.Wrapf(err, "exporting managed nodegroup %q", *name)
+		}
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, ng)
+	}
+	return nil
+}
+
+func (m *Manager) exportManagedNodeGroup(nodeGroup *awseks.Nodegroup, owned bool) (*api.ManagedNodeGroup, error) {
+	ng := &api.ManagedNodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{
+			Name:   *nodeGroup.NodegroupName,
+			Tags:   userTags(nodeGroup.Tags),
+			Labels: userTags(nodeGroup.Labels),
+		},
+		Spot: aws.StringValue(nodeGroup.CapacityType) == awseks.CapacityTypesSpot,
+	}
+
+	if scalingConfig := nodeGroup.ScalingConfig; scalingConfig != nil {
+		ng.ScalingConfig = newScalingConfig(int(aws.Int64Value(scalingConfig.DesiredSize)), int(aws.Int64Value(scalingConfig.MinSize)), int(aws.Int64Value(scalingConfig.MaxSize)))
+	}
+
+	instanceTypes := aws.StringValueSlice(nodeGroup.InstanceTypes)
+	switch len(instanceTypes) {
+	case 0:
+	case 1:
+		ng.InstanceType = instanceTypes[0]
+	default:
+		ng.InstanceTypes = instanceTypes
+	}
+
+	for _, t := range nodeGroup.Taints {
+		ng.Taints = append(ng.Taints, api.NodeGroupTaint{
+			Key:    aws.StringValue(t.Key),
+			Value:  aws.StringValue(t.Value),
+			Effect: api.TaintEffectFromEKS(aws.StringValue(t.Effect)),
+		})
+	}
+
+	if nodeGroup.DiskSize != nil {
+		ng.VolumeSize = aws.Int(int(*nodeGroup.DiskSize))
+	}
+	if remoteAccess := nodeGroup.RemoteAccess; remoteAccess != nil && remoteAccess.Ec2SshKey != nil {
+		ng.SSH = &api.NodeGroupSSH{
+			Allow:         api.Enabled(),
+			PublicKeyName: remoteAccess.Ec2SshKey,
+		}
+	}
+
+	if nodeGroup.LaunchTemplate == nil {
+		return ng, nil
+	}
+
+	if !owned {
+		// these settings are part of the user-supplied launch template and can't be set with it
+		if ng.InstanceType != "" {
+			ng.InstanceTypes = []string{ng.InstanceType}
+			ng.InstanceType = ""
+		}
+		ng.VolumeSize = nil
+		ng.SSH = nil
+		ng.LaunchTemplate = &api.LaunchTemplate{
+			ID:      aws.StringValue(nodeGroup.LaunchTemplate.Id),
+			Version: nodeGroup.LaunchTemplate.Version,
+		}
+		return ng, nil
+	}
+
+	// the launch template was created by eksctl from the nodegroup config
+	launchTemplateData, err := m.launchTemplateData(nodeGroup.LaunchTemplate)
+	if err != nil {
+		return nil, err
+	}
+	if ng.InstanceType == "" && len(ng.InstanceTypes) == 0 && launchTemplateData.InstanceType != nil {
+		ng.InstanceType = *launchTemplateData.InstanceType
+	}
+	if len(launchTemplateData.BlockDeviceMappings) > 0 && launchTemplateData.BlockDeviceMappings[0].Ebs != nil {
+		ebs := launchTemplateData.BlockDeviceMappings[0].Ebs
+		if ebs.VolumeSize != nil {
+			ng.VolumeSize = aws.Int(int(*ebs.VolumeSize))
+		}
+		ng.VolumeType = ebs.VolumeType
+	}
+	if launchTemplateData.KeyName != nil {
+		ng.SSH = &api.NodeGroupSSH{
+			Allow:         api.Enabled(),
+			PublicKeyName: launchTemplateData.KeyName,
+		}
+	}
+	if aws.StringValue(nodeGroup.AmiType) == awseks.AMITypesCustom {
+		logger.Warning("managed nodegroup %q uses custom AMI %q, set ami and overrideBootstrapCommand to use it when recreating the nodegroup",
+			ng.Name, aws.StringValue(launchTemplateData.ImageId))
+	}
+	return ng, nil
+}
+
+// launchTemplateData returns the data of the launch template version used by a managed nodegroup
+func (m *Manager) launchTemplateData(launchTemplate *awseks.LaunchTemplateSpecification) (*ec2.ResponseLaunchTemplateData, error) {
+	output, err := m.ctl.Provider.EC2().DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: launchTemplate.Id,
+		Versions:         []*string{launchTemplate.Version},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing launch template %q", aws.StringValue(launchTemplate.Id))
+	}
+	for _, version := range output.LaunchTemplateVersions {
+		if strconv.FormatInt(aws.Int64Value(version.VersionNumber), 10) == aws.StringValue(launchTemplate.Version) {
+			return version.LaunchTemplateData, nil
+		}
+	}
+	return nil, errors.Errorf("version %s of launch template %q not found", aws.StringValue(launchTemplate.Version), aws.StringValue(launchTemplate.Id))
+}
+
+func newScalingConfig(desiredCapacity, minSize, maxSize int) *api.ScalingConfig {
+	return &api.ScalingConfig{
+		DesiredCapacity: &desiredCapacity,
+		MinSize:         &minSize,
+		MaxSize:         &maxSize,
+	}
+}
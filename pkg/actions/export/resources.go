@@ -0,0 +1,125 @@
+package export
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/identityproviders"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/fargate"
+)
+
+func (m *Manager) exportFargateProfiles(cfg *api.ClusterConfig) error {
+	fargateClient := fargate.NewFromProvider(cfg.Metadata.Name, m.ctl.Provider, m.stackManager)
+	profiles, err := fargateClient.ReadProfiles()
+	if err != nil {
+		return errors.Wrap(err, "getting Fargate profiles")
+	}
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	// the default pod execution role is created along with the cluster
+	var defaultPodExecutionRoleARN string
+	stack, err := m.stackManager.DescribeClusterStack()
+	if err != nil {
+		return err
+	}
+	if stack != nil {
+		collectors := map[string]outputs.Collector{
+			outputs.FargatePodExecutionRoleARN: func(v string) error {
+				defaultPodExecutionRoleARN = v
+				return nil
+			},
+		}
+		if err := outputs.Collect(*stack, nil, collectors); err != nil {
+			return err
+		}
+	}
+
+	for _, profile := range profiles {
+		if profile.PodExecutionRoleARN == defaultPodExecutionRoleARN {
+			profile.PodExecutionRoleARN = ""
+		}
+		profile.Tags = userTags(aws.StringMap(profile.Tags))
+		profile.Status = ""
+		cfg.FargateProfiles = append(cfg.FargateProfiles, profile)
+	}
+	return nil
+}
+
+func (m *Manager) exportAddons(cfg *api.ClusterConfig) error {
+	eksAPI := m.ctl.Provider.EKS()
+	output, err := eksAPI.ListAddons(&awseks.ListAddonsInput{
+		ClusterName: &cfg.Metadata.Name,
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing addons")
+	}
+
+	for _, name := range output.Addons {
+		describeOutput, err := eksAPI.DescribeAddon(&awseks.DescribeAddonInput{
+			ClusterName: &cfg.Metadata.Name,
+			AddonName:   name,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "describing addon %q", *name)
+		}
+		eksAddon := describeOutput.Addon
+		cfg.Addons = append(cfg.Addons, &api.Addon{
+			Name:                  *name,
+			Version:               aws.StringValue(eksAddon.AddonVersion),
+			ServiceAccountRoleARN: aws.StringValue(eksAddon.ServiceAccountRoleArn),
+			Tags:                  userTags(eksAddon.Tags),
+		})
+	}
+
+	clusterAutoscaler, ok, err := m.getClusterAutoscaler()
+	if err != nil {
+		return errors.Wrapf(err, "getting %s", api.ClusterAutoscalerAddon)
+	}
+	if ok {
+		cfg.Addons = append(cfg.Addons, &api.Addon{
+			Name:    api.ClusterAutoscalerAddon,
+			Version: clusterAutoscaler.Version,
+		})
+	}
+	return nil
+}
+
+func (m *Manager) clusterAutoscaler() (addon.Summary, bool, error) {
+	clientSet, err := m.ctl.NewStdClientSet(m.cfg)
+	if err != nil {
+		return addon.Summary{}, false, err
+	}
+	return addon.GetClusterAutoscaler(m.cfg, m.ctl, clientSet)
+}
+
+func (m *Manager) exportIdentityProviders(cfg *api.ClusterConfig) error {
+	idpManager := identityproviders.NewManager(*cfg.Metadata, m.ctl.Provider.EKS())
+	summaries, err := idpManager.Get(identityproviders.GetIdentityProvidersOptions{})
+	if err != nil {
+		return errors.Wrap(err, "getting identity providers")
+	}
+
+	for _, idp := range summaries {
+		if idp.Type != api.OIDCIdentityProviderType {
+			continue
+		}
+		cfg.IdentityProviders = append(cfg.IdentityProviders, api.FromIdentityProvider(&api.OIDCIdentityProvider{
+			Name:           idp.Name,
+			IssuerURL:      idp.IssuerURL,
+			ClientID:       idp.ClientID,
+			UsernameClaim:  aws.StringValue(idp.UsernameClaim),
+			UsernamePrefix: aws.StringValue(idp.UsernamePrefix),
+			GroupsClaim:    aws.StringValue(idp.GroupsClaim),
+			GroupsPrefix:   aws.StringValue(idp.GroupsPrefix),
+			RequiredClaims: idp.RequiredClaims,
+			Tags:           userTags(aws.StringMap(idp.Tags)),
+		}))
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package export
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/utils/taints"
+)
+
+const (
+	kubeletEnvFile = "/etc/eksctl/kubelet.env"
+	nodeLabelsEnv  = "NODE_LABELS"
+	nodeTaintsEnv  = "NODE_TAINTS"
+)
+
+var (
+	windowsNodeLabelsArg = regexp.MustCompile(`--node-labels=([^\s"]*)`)
+	windowsNodeTaintsArg = regexp.MustCompile(`--register-with-taints=([^\s"]*)`)
+)
+
+// nodeLabelsAndTaints returns the labels and taints passed to the kubelet by the user data of an unmanaged
+// nodegroup, as generated by the AmazonLinux2, Ubuntu, Bottlerocket or Windows bootstrappers; labels set by
+// eksctl itself are left out
+func nodeLabelsAndTaints(userData string) (map[string]string, []api.NodeGroupTaint, error) {
+	if userData == "" {
+		return nil, nil, nil
+	}
+
+	var (
+		labels    map[string]string
+		taintsMap map[string]string
+	)
+	if config, err := cloudconfig.DecodeCloudConfig(userData); err == nil {
+		labels, taintsMap = linuxLabelsAndTaints(config)
+	} else {
+		data, err := base64.StdEncoding.DecodeString(userData)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "decoding user data")
+		}
+		if strings.HasPrefix(string(data), "<powershell>") {
+			labels, taintsMap = windowsLabelsAndTaints(string(data))
+		} else if labels, taintsMap, err = bottlerocketLabelsAndTaints(data); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for k := range labels {
+		if isReservedTag(k) {
+			delete(labels, k)
+		}
+	}
+	if len(labels) == 0 {
+		labels = nil
+	}
+	return labels, parseTaints(taintsMap), nil
+}
+
+// linuxLabelsAndTaints reads the labels and taints from the kubelet environment file written by cloud-config
+func linuxLabelsAndTaints(config *cloudconfig.CloudConfig) (map[string]string, map[string]string) {
+	var labels, taintsMap map[string]string
+	for _, file := range config.WriteFiles {
+		if file.Path != kubeletEnvFile {
+			continue
+		}
+		scanner := bufio.NewScanner(strings.NewReader(file.Content))
+		for scanner.Scan() {
+			name, value, ok := splitKeyValue(scanner.Text())
+			if !ok {
+				continue
+			}
+			switch name {
+			case nodeLabelsEnv:
+				labels = parseKeyValues(value)
+			case nodeTaintsEnv:
+				taintsMap = parseKeyValues(value)
+			}
+		}
+	}
+	return labels, taintsMap
+}
+
+// windowsLabelsAndTaints reads the labels and taints from the kubelet arguments of the bootstrap script
+func windowsLabelsAndTaints(script string) (map[string]string, map[string]string) {
+	var labels, taintsMap map[string]string
+	if match := windowsNodeLabelsArg.FindStringSubmatch(script); match != nil {
+		labels = parseKeyValues(match[1])
+	}
+	if match := windowsNodeTaintsArg.FindStringSubmatch(script); match != nil {
+		taintsMap = parseKeyValues(match[1])
+	}
+	return labels, taintsMap
+}
+
+// bottlerocketLabelsAndTaints reads the labels and taints from the kubernetes settings of Bottlerocket
+func bottlerocketLabelsAndTaints(data []byte) (map[string]string, map[string]string, error) {
+	tree, err := toml.LoadBytes(data)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parsing Bottlerocket settings")
+	}
+	return tomlStringMap(tree, "node-labels"), tomlStringMap(tree, "node-taints"), nil
+}
+
+func tomlStringMap(tree *toml.Tree, key string) map[string]string {
+	subtree, ok := tree.GetPath([]string{"settings", "kubernetes", key}).(*toml.Tree)
+	if !ok {
+		return nil
+	}
+	result := map[string]string{}
+	for k, v := range subtree.ToMap() {
+		result[k] = fmt.Sprint(v)
+	}
+	return result
+}
+
+// parseKeyValues parses a comma-separated list of key=value pairs
+func parseKeyValues(s string) map[string]string {
+	result := map[string]string{}
+	for _, kv := range strings.Split(s, ",") {
+		if k, v, ok := splitKeyValue(kv); ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+func splitKeyValue(kv string) (string, string, bool) {
+	parts := strings.SplitN(strings.TrimSpace(kv), "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// parseTaints converts taints of the form key: value:effect, sorted by key
+func parseTaints(taintsMap map[string]string) []api.NodeGroupTaint {
+	var result []api.NodeGroupTaint
+	for _, t := range taints.Parse(taintsMap) {
+		result = append(result, api.NodeGroupTaint{
+			Key:    t.Key,
+			Value:  t.Value,
+			Effect: t.Effect,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
@@ -5,11 +5,13 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 
+	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 func (m *Manager) Create() error {
@@ -41,35 +43,61 @@ func (m *Manager) Create() error {
 		}
 	}
 
+	taskTree := &tasks.TaskTree{Parallel: false}
 	if fargateRoleNeeded {
-		if clusterStack != nil {
-			if !m.fargateRoleExistsOnClusterStack(clusterStack) {
-				err := ensureFargateRoleStackExists(cfg, ctl.Provider, m.stackManager)
-				if err != nil {
-					return errors.Wrap(err, "couldn't ensure fargate role exists")
-				}
-			}
-			if err := ctl.LoadClusterIntoSpecFromStack(cfg, m.stackManager); err != nil {
-				return errors.Wrap(err, "couldn't load cluster into spec")
+		if clusterStack == nil || !m.fargateRoleExistsOnClusterStack(clusterStack) {
+			roleStackTask, err := newFargateRoleStackTask(cfg, ctl.Provider, m.stackManager)
+			if err != nil {
+				return errors.Wrap(err, "couldn't ensure fargate role exists")
 			}
-		} else {
-			if err := ensureFargateRoleStackExists(cfg, ctl.Provider, m.stackManager); err != nil {
-				return errors.Wrap(err, "couldn't ensure unowned cluster is ready for fargate")
+			if roleStackTask != nil {
+				taskTree.Append(roleStackTask)
 			}
 		}
 
-		if !api.IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRoleARN) {
-			// Read back the default Fargate pod execution role ARN from CloudFormation:
-			if err := m.stackManager.RefreshFargatePodExecutionRoleARN(); err != nil {
-				return errors.Wrap(err, "couldn't refresh role arn")
-			}
-		}
+		taskTree.Append(&tasks.GenericTask{
+			Description: "load fargate pod execution role",
+			Doer: func() error {
+				if clusterStack != nil {
+					if err := ctl.LoadClusterIntoSpecFromStack(cfg, m.stackManager); err != nil {
+						return errors.Wrap(err, "couldn't load cluster into spec")
+					}
+				}
+				if !api.IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRoleARN) {
+					// Read back the default Fargate pod execution role ARN from CloudFormation:
+					if err := m.stackManager.RefreshFargatePodExecutionRoleARN(); err != nil {
+						return errors.Wrap(err, "couldn't refresh role arn")
+					}
+				}
+				return nil
+			},
+		})
 	}
 
 	fargateClient := fargate.NewFromProvider(cfg.Metadata.Name, ctl.Provider, m.stackManager)
-	if err := eks.DoCreateFargateProfiles(cfg, &fargateClient); err != nil {
-		return errors.Wrap(err, "could not create fargate profiles")
+	for _, profile := range cfg.FargateProfiles {
+		profile := profile
+		taskTree.Append(&tasks.TargetedTask{
+			GenericTask: tasks.GenericTask{
+				Description: fmt.Sprintf("create fargate profile %q", profile.Name),
+				Doer: func() error {
+					return eks.DoCreateFargateProfile(cfg, &fargateClient, profile)
+				},
+			},
+			Action:   tasks.ActionCreate,
+			Resource: "fargateprofile/" + profile.Name,
+		})
+	}
+
+	logger.Info(taskTree.Describe())
+	if err := tasks.PrintPlan(m.planOutput, taskTree); err != nil {
+		return err
 	}
+	// the tasks are sequential, so they stop at the first error
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		return errors.Wrap(errs[0], "could not create fargate profiles")
+	}
+
 	clientSet, err := m.newStdClientSet()
 	if err != nil {
 		return errors.Wrap(err, "couldn't create kubernetes client")
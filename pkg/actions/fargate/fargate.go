@@ -4,6 +4,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/printers"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -12,6 +13,7 @@ type Manager struct {
 	cfg             *api.ClusterConfig
 	stackManager    manager.StackManager
 	newStdClientSet func() (kubernetes.Interface, error)
+	planOutput      printers.Type
 }
 
 func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager) *Manager {
@@ -22,3 +24,8 @@ func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.
 		newStdClientSet: func() (kubernetes.Interface, error) { return ctl.NewStdClientSet(cfg) },
 	}
 }
+
+// SetPlanOutput sets the format used to print the plan of the tasks, nothing is printed if it is empty
+func (m *Manager) SetPlanOutput(output printers.Type) {
+	m.planOutput = output
+}
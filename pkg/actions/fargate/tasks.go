@@ -1,7 +1,6 @@
 package fargate
 
 import (
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
@@ -17,6 +16,13 @@ type createFargateStackTask struct {
 
 func (t *createFargateStackTask) Describe() string { return "create fargate IAM stacK" }
 
+func (t *createFargateStackTask) Target() tasks.Target {
+	return tasks.Target{
+		Action:   tasks.ActionCreate,
+		Resource: tasks.StackResource(makeClusterStackName(t.cfg.Metadata.Name)),
+	}
+}
+
 func makeClusterStackName(clusterName string) string {
 	return "eksctl-" + clusterName + "-fargate"
 }
@@ -29,35 +35,25 @@ func (t *createFargateStackTask) Do(errs chan error) error {
 	return t.stackManager.CreateStack(makeClusterStackName(t.cfg.Metadata.Name), rs, nil, nil, errs)
 }
 
-// ensureFargateRoleStackExists creates fargate IAM resources if they
-func ensureFargateRoleStackExists(
+// newFargateRoleStackTask returns a task that creates the fargate IAM resources, or nil if they already exist
+func newFargateRoleStackTask(
 	cfg *api.ClusterConfig, provider api.ClusterProvider, stackManager manager.StackManager,
-) error {
+) (tasks.Task, error) {
 	if api.IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRoleARN) {
-		return nil
+		return nil, nil
 	}
 
 	fargateStack, err := stackManager.GetFargateStack()
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	if fargateStack == nil {
-		var taskTree tasks.TaskTree
-		taskTree.Append(&createFargateStackTask{
-			cfg:          cfg,
-			provider:     provider,
-			stackManager: stackManager,
-		})
-
-		errs := taskTree.DoAllSync()
-		for _, e := range errs {
-			logger.Critical("%s\n", e.Error())
-		}
-
-		if len(errs) > 0 {
-			return errors.New("couldn't create fargate stack")
-		}
+	if fargateStack != nil {
+		return nil, nil
 	}
-	return nil
+
+	return &createFargateStackTask{
+		cfg:          cfg,
+		provider:     provider,
+		stackManager: stackManager,
+	}, nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kris-nova/logger"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 func (m *Manager) Delete(serviceAccounts []string, plan, wait bool) error {
@@ -15,6 +16,9 @@ func (m *Manager) Delete(serviceAccounts []string, plan, wait bool) error {
 	taskTree.PlanMode = plan
 
 	logger.Info(taskTree.Describe())
+	if err := tasks.PrintPlan(m.planOutput, taskTree); err != nil {
+		return err
+	}
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		logger.Info("%d error(s) occurred and IAM Role stacks haven't been deleted properly, you may wish to check CloudFormation console", len(errs))
 		for _, err := range errs {
@@ -6,6 +6,7 @@ import (
 	"github.com/kris-nova/logger"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	kubeclient "k8s.io/client-go/kubernetes"
 )
@@ -15,6 +16,7 @@ type Manager struct {
 	oidcManager  *iamoidc.OpenIDConnectManager
 	stackManager manager.StackManager
 	clientSet    kubeclient.Interface
+	planOutput   printers.Type
 }
 
 func New(clusterName string, stackManager manager.StackManager, oidcManager *iamoidc.OpenIDConnectManager, clientSet kubeclient.Interface) *Manager {
@@ -26,8 +28,16 @@ func New(clusterName string, stackManager manager.StackManager, oidcManager *iam
 	}
 }
 
-func doTasks(taskTree *tasks.TaskTree) error {
+// SetPlanOutput sets the format used to print the plan of the tasks, nothing is printed if it is empty
+func (m *Manager) SetPlanOutput(output printers.Type) {
+	m.planOutput = output
+}
+
+func (m *Manager) doTasks(taskTree *tasks.TaskTree) error {
 	logger.Info(taskTree.Describe())
+	if err := tasks.PrintPlan(m.planOutput, taskTree); err != nil {
+		return err
+	}
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		logger.Info("%d error(s) occurred and IAM Role stacks haven't been updated properly, you may wish to check CloudFormation console", len(errs))
 		for _, err := range errs {
@@ -52,6 +52,11 @@ type updateIAMServiceAccountTask struct {
 
 func (t *updateIAMServiceAccountTask) Describe() string { return t.info }
 
+func (t *updateIAMServiceAccountTask) Target() tasks.Target {
+	stackName := makeIAMServiceAccountStackName(t.clusterName, t.sa.Namespace, t.sa.Name)
+	return tasks.Target{Action: tasks.ActionUpdate, Resource: tasks.StackResource(stackName)}
+}
+
 func (t *updateIAMServiceAccountTask) Do(errorCh chan error) error {
 	stackName := makeIAMServiceAccountStackName(t.clusterName, t.sa.Namespace, t.sa.Name)
 	go func() {
@@ -46,7 +46,7 @@ func (a *Manager) UpdateIAMServiceAccounts(iamServiceAccounts []*api.ClusterIAMS
 	}
 
 	defer logPlanModeWarning(plan && len(iamServiceAccounts) > 0)
-	return doTasks(updateTasks)
+	return a.doTasks(updateTasks)
 
 }
 
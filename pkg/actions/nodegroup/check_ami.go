@@ -0,0 +1,280 @@
+package nodegroup
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/blang/semver"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/ami"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/managed"
+)
+
+var (
+	cvePattern         = regexp.MustCompile(`CVE-\d{4}-\d{4,}`)
+	releaseDatePattern = regexp.MustCompile(`(\d{8})$`)
+)
+
+// managedImageClasses maps the AMI types of managed nodegroups to the class of their EKS-optimized images
+var managedImageClasses = map[string]int{
+	awseks.AMITypesAl2X8664:    ami.ImageClassGeneral,
+	awseks.AMITypesAl2X8664Gpu: ami.ImageClassGPU,
+	awseks.AMITypesAl2Arm64:    ami.ImageClassARM,
+}
+
+// AMIStatus describes how far the AMI of a nodegroup is behind the AMI that would be picked for it today
+type AMIStatus struct {
+	NodeGroup string
+	// Current is the AMI ID of an unmanaged nodegroup, or the release version of a managed nodegroup
+	Current string
+	// Latest is the AMI ID, or release version, that would be used for the nodegroup today
+	Latest string
+	// ReleasesBehind is the number of images released between Current and Latest
+	ReleasesBehind int
+	// FixedCVEs lists the CVEs mentioned in the descriptions of the newer images
+	FixedCVEs []string
+	// Note explains why a nodegroup could not be checked
+	Note string
+}
+
+// CheckAMIs compares the AMI used by each nodegroup with the latest EKS-optimized AMI for it
+func (m *Manager) CheckAMIs(summaries []*manager.NodeGroupSummary) ([]*AMIStatus, error) {
+	var statuses []*AMIStatus
+	for _, summary := range summaries {
+		var (
+			status *AMIStatus
+			err    error
+		)
+		switch {
+		case api.IsAMI(summary.ImageID):
+			status, err = m.checkUnmanagedAMI(summary)
+		case isManagedAMIType(summary.ImageID):
+			status, err = m.checkManagedAMI(summary)
+		default:
+			status = &AMIStatus{
+				NodeGroup: summary.Name,
+				Current:   summary.ImageID,
+				Note:      "unable to determine the AMI in use",
+			}
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "checking AMI of nodegroup %q", summary.Name)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func (m *Manager) checkUnmanagedAMI(summary *manager.NodeGroupSummary) (*AMIStatus, error) {
+	status := &AMIStatus{
+		NodeGroup: summary.Name,
+		Current:   summary.ImageID,
+	}
+
+	output, err := m.ctl.Provider.EC2().DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{summary.ImageID}),
+	})
+	if err != nil && !isImageNotFoundError(err) {
+		return nil, errors.Wrapf(err, "describing image %q", summary.ImageID)
+	}
+	if err != nil || len(output.Images) == 0 {
+		status.Note = "AMI no longer exists"
+		return status, nil
+	}
+	current := output.Images[0]
+
+	version, family, class, ok := m.findImageFamily(kubernetesMinorVersion(summary.Version), aws.StringValue(current.Name))
+	if !ok {
+		status.Note = "custom AMI"
+		return status, nil
+	}
+
+	region := m.ctl.Provider.Region()
+	resolver := ami.NewMultiResolver(ami.NewSSMResolver(m.ctl.Provider.SSM()), ami.NewAutoResolver(m.ctl.Provider.EC2()))
+	status.Latest, err = resolver.Resolve(region, version, summary.InstanceType, family)
+	if err != nil {
+		return nil, err
+	}
+	if status.Latest == status.Current {
+		return status, nil
+	}
+
+	images, err := m.listImages(family, region, ami.MakeImageSearchPatterns(version)[family][class])
+	if err != nil {
+		return nil, err
+	}
+	latestCreationDate := ""
+	for _, image := range images {
+		if aws.StringValue(image.ImageId) == status.Latest {
+			latestCreationDate = aws.StringValue(image.CreationDate)
+		}
+	}
+	newer := imagesReleasedBetween(images, func(image *ec2.Image) string {
+		return aws.StringValue(image.CreationDate)
+	}, aws.StringValue(current.CreationDate), latestCreationDate)
+
+	status.ReleasesBehind = len(newer)
+	status.FixedCVEs = fixedCVEs(aws.StringValue(current.Description), newer)
+	return status, nil
+}
+
+// findImageFamily returns the Kubernetes version, family and class of an EKS-optimized image; when the version
+// of the nodes is not known, the version is taken from the image name by trying the control plane version and
+// then every supported version, so that nodes lagging behind the control plane are not reported as custom AMIs
+func (m *Manager) findImageFamily(version, imageName string) (string, string, int, bool) {
+	versions := []string{version}
+	if version == "" {
+		versions = append([]string{m.ctl.ControlPlaneVersion()}, api.SupportedVersions()...)
+	}
+	for _, v := range versions {
+		if v == "" {
+			continue
+		}
+		if family, class, ok := ami.FindImageFamily(v, imageName); ok {
+			return v, family, class, true
+		}
+	}
+	return "", "", 0, false
+}
+
+func (m *Manager) checkManagedAMI(summary *manager.NodeGroupSummary) (*AMIStatus, error) {
+	output, err := m.ctl.Provider.EKS().DescribeNodegroup(&awseks.DescribeNodegroupInput{
+		ClusterName:   &m.cfg.Metadata.Name,
+		NodegroupName: &summary.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ng := output.Nodegroup
+
+	status := &AMIStatus{
+		NodeGroup: summary.Name,
+		Current:   aws.StringValue(ng.ReleaseVersion),
+	}
+	class, ok := managedImageClasses[aws.StringValue(ng.AmiType)]
+	if !ok {
+		status.Note = fmt.Sprintf("AMI type %s is not checked", aws.StringValue(ng.AmiType))
+		return status, nil
+	}
+
+	managedService := managed.NewService(m.ctl.Provider.EKS(), m.ctl.Provider.SSM(), m.ctl.Provider.EC2(), m.stackManager, m.cfg.Metadata.Name)
+	version := aws.StringValue(ng.Version)
+	status.Latest, err = managedService.GetLatestReleaseVersion(version, ng)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting latest release version")
+	}
+	if status.Latest == status.Current {
+		return status, nil
+	}
+
+	family := api.NodeImageFamilyAmazonLinux2
+	images, err := m.listImages(family, m.ctl.Provider.Region(), ami.MakeImageSearchPatterns(version)[family][class])
+	if err != nil {
+		return nil, err
+	}
+	releaseDate := func(image *ec2.Image) string {
+		return releaseDatePattern.FindString(aws.StringValue(image.Name))
+	}
+	currentDate := releaseDatePattern.FindString(status.Current)
+	newer := imagesReleasedBetween(images, releaseDate, currentDate, releaseDatePattern.FindString(status.Latest))
+
+	var currentDescription string
+	for _, image := range images {
+		if releaseDate(image) == currentDate {
+			currentDescription = aws.StringValue(image.Description)
+		}
+	}
+	status.ReleasesBehind = len(newer)
+	status.FixedCVEs = fixedCVEs(currentDescription, newer)
+	return status, nil
+}
+
+// listImages returns the EKS-optimized images of the family that match the name pattern
+func (m *Manager) listImages(family, region, namePattern string) ([]*ec2.Image, error) {
+	ownerAccount, err := ami.OwnerAccountID(family, region)
+	if err != nil {
+		return nil, err
+	}
+	output, err := m.ctl.Provider.EC2().DescribeImages(&ec2.DescribeImagesInput{
+		Owners: aws.StringSlice([]string{ownerAccount}),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("name"),
+				Values: aws.StringSlice([]string{namePattern}),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing images matching %q", namePattern)
+	}
+	logger.Debug("found %d images matching %q", len(output.Images), namePattern)
+	return output.Images, nil
+}
+
+// imagesReleasedBetween returns the images released after current and up to latest, by comparing the
+// release key of each image; all the images released after current are returned if latest is empty
+func imagesReleasedBetween(images []*ec2.Image, releaseKey func(*ec2.Image) string, current, latest string) []*ec2.Image {
+	var newer []*ec2.Image
+	for _, image := range images {
+		key := releaseKey(image)
+		if key == "" || key <= current || (latest != "" && key > latest) {
+			continue
+		}
+		newer = append(newer, image)
+	}
+	return newer
+}
+
+// fixedCVEs returns the CVEs mentioned in the descriptions of the newer images that aren't mentioned
+// in the description of the current image
+func fixedCVEs(currentDescription string, newer []*ec2.Image) []string {
+	known := map[string]bool{}
+	for _, cve := range cvePattern.FindAllString(currentDescription, -1) {
+		known[cve] = true
+	}
+
+	var cves []string
+	for _, image := range newer {
+		for _, cve := range cvePattern.FindAllString(aws.StringValue(image.Description), -1) {
+			if !known[cve] {
+				known[cve] = true
+				cves = append(cves, cve)
+			}
+		}
+	}
+	sort.Strings(cves)
+	return cves
+}
+
+// isImageNotFoundError returns true when DescribeImages fails because the image was deregistered
+func isImageNotFoundError(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && (awsErr.Code() == "InvalidAMIID.NotFound" || awsErr.Code() == "InvalidAMIID.Unavailable")
+}
+
+func isManagedAMIType(imageID string) bool {
+	for _, amiType := range awseks.AMITypes_Values() {
+		if imageID == amiType {
+			return true
+		}
+	}
+	return false
+}
+
+// kubernetesMinorVersion returns the major and minor parts of a Kubernetes version, e.g. 1.20 for 1.20.4
+func kubernetesMinorVersion(version string) string {
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
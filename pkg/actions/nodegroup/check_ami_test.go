@@ -0,0 +1,189 @@
+package nodegroup_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Check AMIs", func() {
+	var (
+		p *mockprovider.MockProvider
+		m *nodegroup.Manager
+	)
+
+	image := func(id, name, creationDate, description string) *ec2.Image {
+		return &ec2.Image{
+			ImageId:      aws.String(id),
+			Name:         aws.String(name),
+			CreationDate: aws.String(creationDate),
+			Description:  aws.String(description),
+		}
+	}
+
+	mockListImages := func(namePattern string, images ...*ec2.Image) {
+		p.MockEC2().On("DescribeImages", mock.MatchedBy(func(input *ec2.DescribeImagesInput) bool {
+			return len(input.Owners) == 1 && len(input.Filters) == 1 && *input.Filters[0].Values[0] == namePattern
+		})).Return(&ec2.DescribeImagesOutput{Images: images}, nil)
+	}
+
+	mockGetParameter := func(name, value string) {
+		p.MockSSM().On("GetParameter", &ssm.GetParameterInput{
+			Name: aws.String(name),
+		}).Return(&ssm.GetParameterOutput{
+			Parameter: &ssm.Parameter{Value: aws.String(value)},
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		p = mockprovider.NewMockProvider()
+		m = nodegroup.New(cfg, &eks.ClusterProvider{
+			Provider: p,
+			Status: &eks.ProviderStatus{
+				ClusterInfo: &eks.ClusterInfo{Cluster: &awseks.Cluster{Version: aws.String("1.21")}},
+			},
+		}, fake.NewSimpleClientset())
+		m.SetStackManager(new(fakes.FakeStackManager))
+	})
+
+	Context("unmanaged nodegroups", func() {
+		var current *ec2.Image
+
+		BeforeEach(func() {
+			current = image("ami-current", "amazon-eks-node-1.20-v20210501", "2021-05-01T10:00:00.000Z", "EKS Kubernetes Worker AMI, fixes CVE-2021-0001")
+			p.MockEC2().On("DescribeImages", &ec2.DescribeImagesInput{
+				ImageIds: aws.StringSlice([]string{"ami-current"}),
+			}).Return(&ec2.DescribeImagesOutput{Images: []*ec2.Image{current}}, nil)
+		})
+
+		It("counts the releases between the AMI in use and the resolved AMI, and the CVEs they fix", func() {
+			mockGetParameter("/aws/service/eks/optimized-ami/1.20/amazon-linux-2/recommended/image_id", "ami-latest")
+			mockListImages("amazon-eks-node-1.20-v*",
+				current,
+				image("ami-june", "amazon-eks-node-1.20-v20210601", "2021-06-01T10:00:00.000Z", "fixes CVE-2021-0001 and CVE-2021-1111"),
+				image("ami-latest", "amazon-eks-node-1.20-v20210701", "2021-07-01T10:00:00.000Z", "fixes CVE-2021-2222"),
+				image("ami-unreleased", "amazon-eks-node-1.20-v20210801", "2021-08-01T10:00:00.000Z", "fixes CVE-2021-3333"),
+			)
+
+			statuses, err := m.CheckAMIs([]*manager.NodeGroupSummary{
+				{Name: "ng-1", ImageID: "ami-current", Version: "1.20.4", InstanceType: "m5.large"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(ConsistOf(&nodegroup.AMIStatus{
+				NodeGroup:      "ng-1",
+				Current:        "ami-current",
+				Latest:         "ami-latest",
+				ReleasesBehind: 2,
+				FixedCVEs:      []string{"CVE-2021-1111", "CVE-2021-2222"},
+			}))
+		})
+
+		It("takes the Kubernetes version from the AMI name when the version of the nodes is not known", func() {
+			mockGetParameter("/aws/service/eks/optimized-ami/1.20/amazon-linux-2/recommended/image_id", "ami-current")
+
+			statuses, err := m.CheckAMIs([]*manager.NodeGroupSummary{
+				{Name: "ng-1", ImageID: "ami-current", InstanceType: "m5.large"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(ConsistOf(&nodegroup.AMIStatus{
+				NodeGroup: "ng-1",
+				Current:   "ami-current",
+				Latest:    "ami-current",
+			}))
+		})
+
+		It("reports AMIs that no longer exist", func() {
+			p.MockEC2().On("DescribeImages", &ec2.DescribeImagesInput{
+				ImageIds: aws.StringSlice([]string{"ami-deleted"}),
+			}).Return(nil, awserr.New("InvalidAMIID.NotFound", "The image id '[ami-deleted]' does not exist", nil))
+
+			statuses, err := m.CheckAMIs([]*manager.NodeGroupSummary{
+				{Name: "ng-1", ImageID: "ami-deleted", Version: "1.20.4", InstanceType: "m5.large"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(ConsistOf(&nodegroup.AMIStatus{
+				NodeGroup: "ng-1",
+				Current:   "ami-deleted",
+				Note:      "AMI no longer exists",
+			}))
+		})
+
+		It("does not check custom AMIs", func() {
+			current.Name = aws.String("my-org-eks-node-1.20")
+
+			statuses, err := m.CheckAMIs([]*manager.NodeGroupSummary{
+				{Name: "ng-1", ImageID: "ami-current", Version: "1.20.4", InstanceType: "m5.large"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].Note).To(Equal("custom AMI"))
+			p.MockSSM().AssertNotCalled(GinkgoT(), "GetParameter", mock.Anything)
+		})
+	})
+
+	Context("managed nodegroups", func() {
+		mockDescribeNodegroup := func(amiType, releaseVersion string) {
+			p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+				ClusterName:   aws.String("my-cluster"),
+				NodegroupName: aws.String("mng-1"),
+			}).Return(&awseks.DescribeNodegroupOutput{
+				Nodegroup: &awseks.Nodegroup{
+					NodegroupName:  aws.String("mng-1"),
+					AmiType:        aws.String(amiType),
+					ReleaseVersion: aws.String(releaseVersion),
+					Version:        aws.String("1.20"),
+				},
+			}, nil)
+		}
+
+		It("counts the releases between the release version in use and the latest one", func() {
+			mockDescribeNodegroup(awseks.AMITypesAl2X8664Gpu, "1.20.4-20210501")
+			mockGetParameter("/aws/service/eks/optimized-ami/1.20/amazon-linux-2-gpu/recommended/release_version", "1.20.4-20210701")
+			mockListImages("amazon-eks-gpu-node-1.20-*",
+				image("ami-may", "amazon-eks-gpu-node-1.20-v20210501", "2021-05-01T10:00:00.000Z", ""),
+				image("ami-june", "amazon-eks-gpu-node-1.20-v20210601", "2021-06-01T10:00:00.000Z", "fixes CVE-2021-1111"),
+				image("ami-july", "amazon-eks-gpu-node-1.20-v20210701", "2021-07-01T10:00:00.000Z", ""),
+			)
+
+			statuses, err := m.CheckAMIs([]*manager.NodeGroupSummary{
+				{Name: "mng-1", ImageID: awseks.AMITypesAl2X8664Gpu},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(ConsistOf(&nodegroup.AMIStatus{
+				NodeGroup:      "mng-1",
+				Current:        "1.20.4-20210501",
+				Latest:         "1.20.4-20210701",
+				ReleasesBehind: 2,
+				FixedCVEs:      []string{"CVE-2021-1111"},
+			}))
+		})
+
+		It("reports nodegroups that are up-to-date", func() {
+			mockDescribeNodegroup(awseks.AMITypesAl2X8664, "1.20.4-20210701")
+			mockGetParameter("/aws/service/eks/optimized-ami/1.20/amazon-linux-2/recommended/release_version", "1.20.4-20210701")
+
+			statuses, err := m.CheckAMIs([]*manager.NodeGroupSummary{
+				{Name: "mng-1", ImageID: awseks.AMITypesAl2X8664},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].ReleasesBehind).To(BeZero())
+			p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeImages", mock.Anything)
+		})
+	})
+})
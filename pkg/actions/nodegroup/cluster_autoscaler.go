@@ -0,0 +1,98 @@
+package nodegroup
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// TagForClusterAutoscaler tags the ASGs of all nodegroups of the cluster with the tags cluster-autoscaler discovers
+// them by, and with the labels, taints and resources of their nodes so that cluster-autoscaler can scale them up
+// from zero. The node-template tags of unmanaged nodegroups are only known for the nodegroups in the config file
+func (m *Manager) TagForClusterAutoscaler() error {
+	summaries, err := m.stackManager.GetUnmanagedNodeGroupSummaries("")
+	if err != nil {
+		return errors.Wrap(err, "getting nodegroup stack summaries")
+	}
+	for _, summary := range summaries {
+		if err := m.tagASGForClusterAutoscaler(summary.AutoScalingGroupName); err != nil {
+			return errors.Wrapf(err, "tagging ASG of nodegroup %q", summary.Name)
+		}
+		ng := m.findUnmanagedNodeGroup(summary.Name)
+		if ng == nil {
+			logger.Warning("nodegroup %q is not in the config file, its ASG will not be tagged with its labels and taints", summary.Name)
+			continue
+		}
+		if err := m.syncUnmanagedNodeTemplateTags(summary.AutoScalingGroupName, ng); err != nil {
+			return err
+		}
+	}
+
+	var managedNodeGroups []*string
+	err = m.ctl.Provider.EKS().ListNodegroupsPages(&awseks.ListNodegroupsInput{
+		ClusterName: &m.cfg.Metadata.Name,
+	}, func(page *awseks.ListNodegroupsOutput, _ bool) bool {
+		managedNodeGroups = append(managedNodeGroups, page.Nodegroups...)
+		return true
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing managed nodegroups")
+	}
+	// EKS tags the ASGs of managed nodegroups for cluster-autoscaler discovery
+	for _, name := range managedNodeGroups {
+		if err := eks.SyncManagedNodeTemplateTags(m.ctl.Provider, m.cfg.Metadata.Name, aws.StringValue(name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SyncNodeTemplateTags syncs the node-template tags of the ASGs of a managed nodegroup with its labels and taints
+func (m *Manager) SyncNodeTemplateTags(nodeGroupName string) error {
+	return eks.SyncManagedNodeTemplateTags(m.ctl.Provider, m.cfg.Metadata.Name, nodeGroupName)
+}
+
+// UpdateNodeTemplateLabelTags updates the node-template tags of the ASGs of a managed nodegroup after its labels
+// were changed
+func (m *Manager) UpdateNodeTemplateLabelTags(nodeGroupName string, labelsToAdd map[string]string, labelsToRemove []string) error {
+	return eks.UpdateManagedNodeTemplateTags(m.ctl.Provider, m.cfg.Metadata.Name, nodeGroupName, labelsToAdd, labelsToRemove)
+}
+
+func (m *Manager) syncUnmanagedNodeTemplateTags(asgName string, ng *api.NodeGroup) error {
+	return eks.SyncNodeTemplateTags(m.ctl.Provider.ASG(), asgName, api.ClusterAutoscalerNodePoolTemplateTags(ng))
+}
+
+func (m *Manager) tagASGForClusterAutoscaler(asgName string) error {
+	if asgName == "" {
+		return errors.New("ASG not found")
+	}
+	var tags []*autoscaling.Tag
+	for _, tag := range api.ClusterAutoscalerDiscoveryTags(m.cfg.Metadata.Name) {
+		tags = append(tags, &autoscaling.Tag{
+			ResourceId:        aws.String(asgName),
+			ResourceType:      aws.String("auto-scaling-group"),
+			Key:               aws.String(tag.Key),
+			Value:             aws.String(tag.Value),
+			PropagateAtLaunch: aws.Bool(tag.PropagateAtLaunch),
+		})
+	}
+	logger.Info("tagging ASG %q for cluster-autoscaler", asgName)
+	_, err := m.ctl.Provider.ASG().CreateOrUpdateTags(&autoscaling.CreateOrUpdateTagsInput{
+		Tags: tags,
+	})
+	return err
+}
+
+func (m *Manager) findUnmanagedNodeGroup(name string) *api.NodeGroup {
+	for _, ng := range m.cfg.NodeGroups {
+		if ng.Name == name {
+			return ng
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package nodegroup_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Tag for cluster-autoscaler", func() {
+	var (
+		p            *mockprovider.MockProvider
+		cfg          *api.ClusterConfig
+		m            *nodegroup.Manager
+		stackManager *fakes.FakeStackManager
+		taggedASGs   map[string]map[string]string
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		p = mockprovider.NewMockProvider()
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, fake.NewSimpleClientset())
+		stackManager = new(fakes.FakeStackManager)
+		m.SetStackManager(stackManager)
+
+		taggedASGs = map[string]map[string]string{}
+		mockASGTags(p, taggedASGs)
+	})
+
+	It("tags the ASGs of unmanaged and managed nodegroups with discovery and node-template tags", func() {
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.Labels = map[string]string{"role": "worker"}
+		ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "batch", Effect: "NoSchedule"}}
+		stackManager.GetUnmanagedNodeGroupSummariesReturns([]*manager.NodeGroupSummary{
+			{Name: "ng-1", AutoScalingGroupName: "asg-ng-1"},
+		}, nil)
+
+		taggedASGs["asg-mng-1"] = map[string]string{
+			"k8s.io/cluster-autoscaler/enabled":    "true",
+			"k8s.io/cluster-autoscaler/my-cluster": "owned",
+		}
+		mockListNodegroupsPages(p, []string{"mng-1"})
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &awseks.Nodegroup{
+				NodegroupName: aws.String("mng-1"),
+				Labels:        aws.StringMap(map[string]string{"gpu": "true"}),
+				Taints: []*awseks.Taint{
+					{Key: aws.String("nvidia.com/gpu"), Value: aws.String("present"), Effect: aws.String(awseks.TaintEffectNoExecute)},
+				},
+				Resources: &awseks.NodegroupResources{
+					AutoScalingGroups: []*awseks.AutoScalingGroup{{Name: aws.String("asg-mng-1")}},
+				},
+			},
+		}, nil)
+
+		Expect(m.TagForClusterAutoscaler()).To(Succeed())
+		Expect(taggedASGs).To(Equal(map[string]map[string]string{
+			"asg-ng-1": {
+				"k8s.io/cluster-autoscaler/enabled":                                   "true",
+				"k8s.io/cluster-autoscaler/my-cluster":                                "owned",
+				"k8s.io/cluster-autoscaler/node-template/label/role":                  "worker",
+				"k8s.io/cluster-autoscaler/node-template/resources/ephemeral-storage": "80Gi",
+				"k8s.io/cluster-autoscaler/node-template/taint/dedicated":             "batch:NoSchedule",
+			},
+			"asg-mng-1": {
+				"k8s.io/cluster-autoscaler/enabled":                            "true",
+				"k8s.io/cluster-autoscaler/my-cluster":                         "owned",
+				"k8s.io/cluster-autoscaler/node-template/label/gpu":            "true",
+				"k8s.io/cluster-autoscaler/node-template/taint/nvidia.com/gpu": "present:NoExecute",
+			},
+		}))
+	})
+
+	It("only tags unmanaged nodegroups missing from the config file with discovery tags", func() {
+		stackManager.GetUnmanagedNodeGroupSummariesReturns([]*manager.NodeGroupSummary{
+			{Name: "ng-unknown", AutoScalingGroupName: "asg-ng-unknown"},
+		}, nil)
+		mockListNodegroupsPages(p)
+
+		Expect(m.TagForClusterAutoscaler()).To(Succeed())
+		Expect(taggedASGs).To(Equal(map[string]map[string]string{
+			"asg-ng-unknown": {
+				"k8s.io/cluster-autoscaler/enabled":    "true",
+				"k8s.io/cluster-autoscaler/my-cluster": "owned",
+			},
+		}))
+	})
+
+	It("tags the ASGs of the managed nodegroups of every page", func() {
+		stackManager.GetUnmanagedNodeGroupSummariesReturns(nil, nil)
+		taggedASGs["asg-mng-1"] = map[string]string{"k8s.io/cluster-autoscaler/enabled": "true"}
+		taggedASGs["asg-mng-2"] = map[string]string{"k8s.io/cluster-autoscaler/enabled": "true"}
+		mockListNodegroupsPages(p, []string{"mng-1"}, []string{"mng-2"})
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(func(input *awseks.DescribeNodegroupInput) *awseks.DescribeNodegroupOutput {
+			return &awseks.DescribeNodegroupOutput{
+				Nodegroup: &awseks.Nodegroup{
+					NodegroupName: input.NodegroupName,
+					Labels:        aws.StringMap(map[string]string{"nodegroup": *input.NodegroupName}),
+					Resources: &awseks.NodegroupResources{
+						AutoScalingGroups: []*awseks.AutoScalingGroup{{Name: aws.String("asg-" + *input.NodegroupName)}},
+					},
+				},
+			}
+		}, nil)
+
+		Expect(m.TagForClusterAutoscaler()).To(Succeed())
+		Expect(taggedASGs).To(Equal(map[string]map[string]string{
+			"asg-mng-1": {
+				"k8s.io/cluster-autoscaler/enabled":                       "true",
+				"k8s.io/cluster-autoscaler/node-template/label/nodegroup": "mng-1",
+			},
+			"asg-mng-2": {
+				"k8s.io/cluster-autoscaler/enabled":                       "true",
+				"k8s.io/cluster-autoscaler/node-template/label/nodegroup": "mng-2",
+			},
+		}))
+	})
+
+	It("updates the node-template tags of a managed nodegroup whose labels are being changed", func() {
+		taggedASGs["asg-mng-1"] = map[string]string{
+			"k8s.io/cluster-autoscaler/enabled":                     "true",
+			"k8s.io/cluster-autoscaler/node-template/label/old":     "value",
+			"k8s.io/cluster-autoscaler/node-template/label/changed": "before",
+		}
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &awseks.Nodegroup{
+				NodegroupName: aws.String("mng-1"),
+				Labels:        aws.StringMap(map[string]string{"old": "value", "changed": "before"}),
+				Resources: &awseks.NodegroupResources{
+					AutoScalingGroups: []*awseks.AutoScalingGroup{{Name: aws.String("asg-mng-1")}},
+				},
+			},
+		}, nil)
+
+		Expect(m.UpdateNodeTemplateLabelTags("mng-1", map[string]string{"changed": "after"}, []string{"old"})).To(Succeed())
+		Expect(taggedASGs["asg-mng-1"]).To(Equal(map[string]string{
+			"k8s.io/cluster-autoscaler/enabled":                     "true",
+			"k8s.io/cluster-autoscaler/node-template/label/changed": "after",
+		}))
+	})
+})
+
+// mockListNodegroupsPages returns each of pages as a page of managed nodegroup names
+func mockListNodegroupsPages(p *mockprovider.MockProvider, pages ...[]string) {
+	p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		consume := args.Get(1).(func(*awseks.ListNodegroupsOutput, bool) bool)
+		for i, page := range pages {
+			if !consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice(page)}, i == len(pages)-1) {
+				return
+			}
+		}
+	}).Return(nil)
+}
+
+// mockASGTags simulates the tags of ASGs, keyed by ASG name
+func mockASGTags(p *mockprovider.MockProvider, asgTags map[string]map[string]string) {
+	p.MockASG().On("DescribeTagsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		asgName := *args.Get(0).(*autoscaling.DescribeTagsInput).Filters[0].Values[0]
+		output := &autoscaling.DescribeTagsOutput{}
+		for key, value := range asgTags[asgName] {
+			output.Tags = append(output.Tags, &autoscaling.TagDescription{Key: aws.String(key), Value: aws.String(value)})
+		}
+		args.Get(1).(func(*autoscaling.DescribeTagsOutput, bool) bool)(output, true)
+	}).Return(nil)
+	p.MockASG().On("CreateOrUpdateTags", mock.Anything).Run(func(args mock.Arguments) {
+		for _, tag := range args.Get(0).(*autoscaling.CreateOrUpdateTagsInput).Tags {
+			asgName := aws.StringValue(tag.ResourceId)
+			if asgTags[asgName] == nil {
+				asgTags[asgName] = map[string]string{}
+			}
+			asgTags[asgName][*tag.Key] = *tag.Value
+		}
+	}).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+	p.MockASG().On("DeleteTags", mock.Anything).Run(func(args mock.Arguments) {
+		for _, tag := range args.Get(0).(*autoscaling.DeleteTagsInput).Tags {
+			delete(asgTags[aws.StringValue(tag.ResourceId)], *tag.Key)
+		}
+	}).Return(&autoscaling.DeleteTagsOutput{}, nil)
+}
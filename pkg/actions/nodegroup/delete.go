@@ -3,7 +3,10 @@ package nodegroup
 import (
 	"fmt"
 
+	"github.com/pkg/errors"
+
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 
@@ -17,7 +20,7 @@ func (m *Manager) Delete(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.M
 		nodeGroupsWithStacks = append(nodeGroupsWithStacks, n)
 	}
 
-	tasks := &tasks.TaskTree{Parallel: true}
+	taskTree := &tasks.TaskTree{Parallel: true}
 
 	for _, n := range managedNodeGroups {
 		hasStacks, err := m.hasStacks(n.Name)
@@ -28,7 +31,7 @@ func (m *Manager) Delete(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.M
 		if hasStacks {
 			nodeGroupsWithStacks = append(nodeGroupsWithStacks, n)
 		} else {
-			tasks.Append(m.stackManager.NewTaskToDeleteUnownedNodeGroup(m.cfg.Metadata.Name, n.Name, m.ctl.Provider.EKS(), nil))
+			taskTree.Append(m.stackManager.NewTaskToDeleteUnownedNodeGroup(m.cfg.Metadata.Name, n.Name, m.ctl.Provider.EKS(), nil))
 		}
 	}
 
@@ -45,16 +48,69 @@ func (m *Manager) Delete(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.M
 	if err != nil {
 		return err
 	}
-	tasks.Append(deleteTasks)
+	taskTree.Append(deleteTasks)
+
+	spotInterruptionHandlerTasks, err := m.newTasksToDeleteSpotInterruptionHandlers(nodeGroups)
+	if err != nil {
+		return err
+	}
+	if spotInterruptionHandlerTasks.Len() > 0 {
+		// the handlers process the queues of the nodegroup stacks, so they are deleted first
+		taskTree.IsSubTask = true
+		taskTree = &tasks.TaskTree{Tasks: []tasks.Task{spotInterruptionHandlerTasks, taskTree}}
+	}
 
-	tasks.PlanMode = plan
-	logger.Info(tasks.Describe())
-	if errs := tasks.DoAllSync(); len(errs) > 0 {
+	taskTree.PlanMode = plan
+	logger.Info(taskTree.Describe())
+	if err := tasks.PrintPlan(m.planOutput, taskTree); err != nil {
+		return err
+	}
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "nodegroup(s)")
 	}
 	return nil
 }
 
+// newTasksToDeleteSpotInterruptionHandlers returns the tasks that delete the spot interruption handlers of the
+// nodegroups whose stacks have a spot interruption queue, along with their IAM service accounts
+func (m *Manager) newTasksToDeleteSpotInterruptionHandlers(nodeGroups []*api.NodeGroup) (*tasks.TaskTree, error) {
+	taskTree := &tasks.TaskTree{Parallel: true, IsSubTask: true}
+	if len(nodeGroups) == 0 {
+		return taskTree, nil
+	}
+
+	stacks, err := m.stackManager.DescribeNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stacks {
+		name := m.stackManager.GetNodeGroupName(s)
+		if !hasNodeGroup(nodeGroups, name) || !outputs.Exists(*s, outputs.NodeGroupSpotInterruptionQueueURL) {
+			continue
+		}
+		taskTree.Append(&tasks.GenericTask{
+			Description: fmt.Sprintf("delete spot interruption handler of nodegroup %q", name),
+			Doer: func() error {
+				handler, err := m.newSpotInterruptionHandler(name)
+				if err != nil {
+					return err
+				}
+				return errors.Wrapf(handler.Delete(), "deleting spot interruption handler of nodegroup %q", name)
+			},
+		})
+	}
+	return taskTree, nil
+}
+
+func hasNodeGroup(nodeGroups []*api.NodeGroup, name string) bool {
+	for _, ng := range nodeGroups {
+		if ng.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func handleErrors(errs []error, subject string) error {
 	logger.Info("%d error(s) occurred while deleting %s", len(errs), subject)
 	for _, err := range errs {
@@ -0,0 +1,92 @@
+package nodegroup_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/addons"
+	addonfakes "github.com/weaveworks/eksctl/pkg/addons/fakes"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("Delete", func() {
+	var (
+		m                *nodegroup.Manager
+		fakeStackManager *fakes.FakeStackManager
+		fakeIRSAHelper   *addonfakes.FakeIRSAHelper
+		handlerNames     []string
+		nodeGroups       []*api.NodeGroup
+	)
+
+	nodeGroupStack := func(name string, stackOutputs ...string) *manager.Stack {
+		stack := &manager.Stack{StackName: aws.String("eksctl-my-cluster-nodegroup-" + name)}
+		for _, key := range stackOutputs {
+			stack.Outputs = append(stack.Outputs, &cfn.Output{OutputKey: aws.String(key), OutputValue: aws.String("value")})
+		}
+		return stack
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: mockprovider.NewMockProvider()}, nil)
+
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.NewTasksToDeleteNodeGroupsReturns(&tasks.TaskTree{}, nil)
+		fakeStackManager.DescribeNodeGroupStacksReturns([]*manager.Stack{
+			nodeGroupStack("spot-ng", outputs.NodeGroupSpotInterruptionQueueURL, outputs.NodeGroupSpotInterruptionQueueARN),
+			nodeGroupStack("on-demand-ng"),
+		}, nil)
+		fakeStackManager.GetNodeGroupNameStub = func(s *manager.Stack) string {
+			return (*s.StackName)[len("eksctl-my-cluster-nodegroup-"):]
+		}
+		m.SetStackManager(fakeStackManager)
+
+		fakeIRSAHelper = new(addonfakes.FakeIRSAHelper)
+		handlerNames = nil
+		m.MockSpotInterruptionHandler(func(nodeGroupName string) (*addons.SpotInterruptionHandler, error) {
+			handlerNames = append(handlerNames, nodeGroupName)
+			rawClient := testutils.NewFakeRawClient()
+			rawClient.UseUnionTracker = true
+			return addons.NewSpotInterruptionHandler(rawClient, fakeIRSAHelper, "us-west-2", nodeGroupName, "", "", false), nil
+		})
+
+		nodeGroups = []*api.NodeGroup{
+			{NodeGroupBase: &api.NodeGroupBase{Name: "spot-ng"}},
+			{NodeGroupBase: &api.NodeGroupBase{Name: "on-demand-ng"}},
+		}
+	})
+
+	It("deletes the spot interruption handlers of the nodegroups that have one", func() {
+		Expect(m.Delete(nodeGroups, nil, false, false)).To(Succeed())
+
+		Expect(handlerNames).To(ConsistOf("spot-ng"))
+		Expect(fakeIRSAHelper.DeleteCallCount()).To(Equal(1))
+		Expect(fakeIRSAHelper.DeleteArgsForCall(0).NameString()).To(Equal("kube-system/spot-interruption-handler-spot-ng"))
+		Expect(fakeStackManager.NewTasksToDeleteNodeGroupsCallCount()).To(Equal(1))
+	})
+
+	It("doesn't delete the spot interruption handlers of other nodegroups", func() {
+		Expect(m.Delete(nodeGroups[1:], nil, false, false)).To(Succeed())
+
+		Expect(handlerNames).To(BeEmpty())
+		Expect(fakeIRSAHelper.DeleteCallCount()).To(BeZero())
+	})
+
+	It("doesn't delete anything in plan mode", func() {
+		Expect(m.Delete(nodeGroups, nil, false, true)).To(Succeed())
+
+		Expect(handlerNames).To(BeEmpty())
+		Expect(fakeIRSAHelper.DeleteCallCount()).To(BeZero())
+	})
+})
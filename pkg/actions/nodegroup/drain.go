@@ -10,13 +10,33 @@ import (
 	"github.com/weaveworks/eksctl/pkg/drain"
 )
 
-func (m *Manager) Drain(nodeGroups []eks.KubeNodeGroup, plan bool, maxGracePeriod time.Duration, disableEviction bool) error {
-	cmdutils.LogIntendedAction(plan, "drain %d nodegroup(s) in cluster %q", len(nodeGroups), m.cfg.Metadata.Name)
+// DrainInput holds the options of a drain
+type DrainInput struct {
+	NodeGroups      []eks.KubeNodeGroup
+	Plan            bool
+	MaxGracePeriod  time.Duration
+	DisableEviction bool
+	Undo            bool
+	Parallel        int
+	// NodeSelector selects the nodes to drain in each nodegroup, all the nodes are drained when it is empty
+	NodeSelector drain.NodeSelector
+}
 
-	if !plan {
-		for _, n := range nodeGroups {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, n, m.ctl.Provider.WaitTimeout(), maxGracePeriod, false, disableEviction)
-			if err := nodeGroupDrainer.Drain(); err != nil {
+func (m *Manager) Drain(input *DrainInput) error {
+	verb := "drain"
+	if input.Undo {
+		verb = "uncordon"
+	}
+	if input.NodeSelector.IsEmpty() {
+		cmdutils.LogIntendedAction(input.Plan, "%s %d nodegroup(s) in cluster %q", verb, len(input.NodeGroups), m.cfg.Metadata.Name)
+	} else {
+		cmdutils.LogIntendedAction(input.Plan, "%s the selected nodes of %d nodegroup(s) in cluster %q", verb, len(input.NodeGroups), m.cfg.Metadata.Name)
+	}
+
+	if !input.Plan {
+		for _, n := range input.NodeGroups {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, n, m.ctl.Provider.WaitTimeout(), input.MaxGracePeriod, input.Undo, input.DisableEviction, input.Parallel, m.cfg.Drain)
+			if err := m.drainNodeGroup(&nodeGroupDrainer, n, input.NodeSelector); err != nil {
 				logger.Warning("error occurred during drain, to skip drain use '--drain=false' flag")
 				return err
 			}
@@ -24,3 +44,19 @@ func (m *Manager) Drain(nodeGroups []eks.KubeNodeGroup, plan bool, maxGracePerio
 	}
 	return nil
 }
+
+func (m *Manager) drainNodeGroup(nodeGroupDrainer *drain.NodeGroupDrainer, ng eks.KubeNodeGroup, selector drain.NodeSelector) error {
+	if selector.IsEmpty() {
+		return nodeGroupDrainer.Drain()
+	}
+	nodeNames, err := nodeGroupDrainer.SelectNodes(selector)
+	if err != nil {
+		return err
+	}
+	if len(nodeNames) == 0 {
+		logger.Info("no nodes of nodegroup %q match the given selection", ng.NameString())
+		return nil
+	}
+	logger.Info("selected %d node(s) of nodegroup %q: %v", len(nodeNames), ng.NameString(), nodeNames)
+	return nodeGroupDrainer.DrainNodes(nodeNames)
+}
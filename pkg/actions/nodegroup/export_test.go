@@ -1,6 +1,7 @@
 package nodegroup
 
 import (
+	"github.com/weaveworks/eksctl/pkg/addons"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
 )
@@ -22,3 +23,8 @@ func (m *Manager) MockKubeProvider(k eks.KubeProvider) {
 func (m *Manager) MockNodeGroupService(ngSvc eks.NodeGroupInitialiser) {
 	m.init = ngSvc
 }
+
+// MockSpotInterruptionHandler can be used for passing the spot interruption handler of the nodegroups.
+func (m *Manager) MockSpotInterruptionHandler(newHandler func(nodeGroupName string) (*addons.SpotInterruptionHandler, error)) {
+	m.newSpotInterruptionHandler = newHandler
+}
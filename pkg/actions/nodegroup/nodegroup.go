@@ -8,9 +8,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/weaveworks/eksctl/pkg/addons"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
 type Manager struct {
@@ -21,6 +23,9 @@ type Manager struct {
 	wait         WaitFunc
 	init         eks.NodeGroupInitialiser
 	kubeProvider eks.KubeProvider
+	planOutput   printers.Type
+	// newSpotInterruptionHandler creates the spot interruption handler of a nodegroup, in order to delete it
+	newSpotInterruptionHandler func(nodeGroupName string) (*addons.SpotInterruptionHandler, error)
 }
 
 type WaitFunc func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error
@@ -37,9 +42,17 @@ func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.
 			Provider: ctl.Provider,
 		},
 		kubeProvider: ctl,
+		newSpotInterruptionHandler: func(nodeGroupName string) (*addons.SpotInterruptionHandler, error) {
+			return ctl.NewSpotInterruptionHandler(cfg, nodeGroupName, "", "")
+		},
 	}
 }
 
+// SetPlanOutput sets the format used to print the plan of the tasks, nothing is printed if it is empty
+func (m *Manager) SetPlanOutput(output printers.Type) {
+	m.planOutput = output
+}
+
 func (m *Manager) hasStacks(name string) (bool, error) {
 	stacks, err := m.stackManager.ListNodeGroupStacks()
 	if err != nil {
@@ -0,0 +1,94 @@
+package nodegroup
+
+import (
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/drain"
+)
+
+// ReplaceOpts controls the replacement of a nodegroup
+type ReplaceOpts struct {
+	CreateOpts
+	MaxGracePeriod  time.Duration
+	DisableEviction bool
+}
+
+// Replace replaces an unmanaged nodegroup with the successor defined in the config: the successor is
+// created and added to the auth ConfigMap, and once its nodes are ready the nodegroup is drained and
+// deleted. If the successor can't be created or its nodes never become ready, it is deleted and the
+// nodegroup is left untouched.
+func (m *Manager) Replace(nodeGroup *api.NodeGroup, options ReplaceOpts, nodegroupFilter filter.NodegroupFilter) error {
+	if len(m.cfg.NodeGroups) != 1 {
+		return errors.Errorf("expected exactly one successor for nodegroup %q, got %d", nodeGroup.Name, len(m.cfg.NodeGroups))
+	}
+	successor := m.cfg.NodeGroups[0]
+
+	stackType, err := m.stackManager.GetNodeGroupStackType(nodeGroup.Name)
+	if err != nil {
+		return errors.Wrapf(err, "getting stack of nodegroup %q", nodeGroup.Name)
+	}
+	if stackType == api.NodeGroupTypeManaged {
+		return errors.Errorf("managed nodegroup %q cannot be replaced, use 'eksctl upgrade nodegroup' instead", nodeGroup.Name)
+	}
+
+	if err := m.ctl.GetNodeGroupIAM(m.stackManager, nodeGroup); err != nil {
+		return err
+	}
+
+	logger.Info("replacing nodegroup %q with %q in cluster %q", nodeGroup.Name, successor.Name, m.cfg.Metadata.Name)
+
+	// the nodes only join the cluster once the successor is added to the auth ConfigMap
+	options.UpdateAuthConfigMap = true
+	if err := m.Create(options.CreateOpts, nodegroupFilter); err != nil {
+		logger.Warning("nodegroup %q was not created successfully, rolling back", successor.Name)
+		if rollbackErr := m.rollbackSuccessor(successor); rollbackErr != nil {
+			logger.Critical("failed to delete nodegroup %q, it must be deleted manually: %v", successor.Name, rollbackErr)
+		}
+		return errors.Wrapf(err, "creating successor of nodegroup %q", nodeGroup.Name)
+	}
+
+	nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, nodeGroup, m.ctl.Provider.WaitTimeout(), options.MaxGracePeriod, false, options.DisableEviction, 1, m.cfg.Drain)
+	if err := nodeGroupDrainer.Drain(); err != nil {
+		logger.Warning("failed to drain nodegroup %q, uncordoning its nodes; nodegroup %q was created and is left in place", nodeGroup.Name, successor.Name)
+		undoDrainer := drain.NewNodeGroupDrainer(m.clientSet, nodeGroup, m.ctl.Provider.WaitTimeout(), options.MaxGracePeriod, true, options.DisableEviction, 1, m.cfg.Drain)
+		if undoErr := undoDrainer.Drain(); undoErr != nil {
+			logger.Warning("failed to uncordon nodegroup %q: %v", nodeGroup.Name, undoErr)
+		}
+		return errors.Wrapf(err, "draining nodegroup %q", nodeGroup.Name)
+	}
+
+	if err := m.Delete([]*api.NodeGroup{nodeGroup}, nil, true, false); err != nil {
+		return err
+	}
+	if err := authconfigmap.RemoveNodeGroup(m.clientSet, nodeGroup); err != nil {
+		logger.Warning(err.Error())
+	}
+
+	logger.Success("replaced nodegroup %q with %q in cluster %q", nodeGroup.Name, successor.Name, m.cfg.Metadata.Name)
+	return nil
+}
+
+// rollbackSuccessor deletes the stack of a successor that failed to become ready, along with its
+// entry in the auth ConfigMap
+func (m *Manager) rollbackSuccessor(successor *api.NodeGroup) error {
+	hasStacks, err := m.hasStacks(successor.Name)
+	if err != nil {
+		return err
+	}
+	if !hasStacks {
+		return nil
+	}
+
+	if successor.IAM != nil && successor.IAM.InstanceRoleARN != "" {
+		if err := authconfigmap.RemoveNodeGroup(m.clientSet, successor); err != nil {
+			logger.Warning(err.Error())
+		}
+	}
+	return m.Delete([]*api.NodeGroup{successor}, nil, true, false)
+}
@@ -0,0 +1,188 @@
+package nodegroup_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	utilFakes "github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	eksfakes "github.com/weaveworks/eksctl/pkg/eks/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("Replace", func() {
+	const (
+		oldRoleARN       = "arn:aws:iam::123456789012:role/ng-1-role"
+		successorRoleARN = "arn:aws:iam::123456789012:role/ng-1-3f9a2-role"
+	)
+
+	var (
+		cfg              *api.ClusterConfig
+		oldNodeGroup     *api.NodeGroup
+		successor        *api.NodeGroup
+		clientSet        *fake.Clientset
+		fakeStackManager *fakes.FakeStackManager
+		kubeProvider     *eksfakes.FakeKubeProvider
+		ngFilter         *utilFakes.FakeNodegroupFilter
+		m                *nodegroup.Manager
+		options          nodegroup.ReplaceOpts
+	)
+
+	BeforeEach(func() {
+		cfg = newClusterConfig()
+		successor = &api.NodeGroup{
+			NodeGroupBase: &api.NodeGroupBase{
+				Name: "ng-1-3f9a2",
+			},
+		}
+		cfg.NodeGroups = []*api.NodeGroup{successor}
+		cfg.ManagedNodeGroups = nil
+
+		oldNodeGroup = api.NewNodeGroup()
+		oldNodeGroup.Name = "ng-1"
+
+		p := mockprovider.NewMockProvider()
+		ctl := &eks.ClusterProvider{
+			Provider: p,
+			Status: &eks.ProviderStatus{
+				ClusterInfo: &eks.ClusterInfo{
+					Cluster: testutils.NewFakeCluster("my-cluster", ""),
+				},
+			},
+		}
+		clientSet = fake.NewSimpleClientset()
+		m = nodegroup.New(cfg, ctl, clientSet)
+
+		fakeStackManager = new(fakes.FakeStackManager)
+		m.SetStackManager(fakeStackManager)
+		fakeStackManager.GetNodeGroupStackTypeReturns(api.NodeGroupTypeUnmanaged, nil)
+		fakeStackManager.DescribeNodeGroupStacksReturns([]*manager.Stack{{
+			StackName:   aws.String("eksctl-my-cluster-nodegroup-ng-1"),
+			StackStatus: aws.String(cloudformation.StackStatusCreateComplete),
+			Outputs: []*cloudformation.Output{{
+				OutputKey:   aws.String(outputs.NodeGroupInstanceRoleARN),
+				OutputValue: aws.String(oldRoleARN),
+			}},
+		}}, nil)
+		fakeStackManager.GetNodeGroupNameReturns("ng-1")
+		fakeStackManager.NewUnmanagedNodeGroupTaskReturns(&tasks.TaskTree{})
+		fakeStackManager.NewManagedNodeGroupTaskReturns(&tasks.TaskTree{})
+		fakeStackManager.NewTasksToDeleteNodeGroupsReturns(&tasks.TaskTree{}, nil)
+
+		kubeProvider = &eksfakes.FakeKubeProvider{}
+		kubeProvider.SupportsManagedNodesReturns(true, nil)
+		m.MockKubeProvider(kubeProvider)
+		m.MockNodeGroupService(&eksfakes.FakeNodeGroupInitialiser{})
+
+		ngFilter = &utilFakes.FakeNodegroupFilter{}
+		ngFilter.MatchReturns(true)
+		options = nodegroup.ReplaceOpts{
+			MaxGracePeriod: time.Minute,
+		}
+
+		authConfigMap := &corev1.ConfigMap{
+			ObjectMeta: authconfigmap.ObjectMeta(),
+			Data: map[string]string{
+				"mapRoles": fmt.Sprintf("- rolearn: %s\n  username: system:node:{{EC2PrivateDNSName}}\n  groups:\n  - system:bootstrappers\n  - system:nodes\n", oldRoleARN),
+			},
+		}
+		authConfigMap.UID = "18b9e60c-2057-11e7-8868-0eba8ef9df1a"
+		_, err := clientSet.CoreV1().ConfigMaps(authconfigmap.ObjectNamespace).Create(context.TODO(), authConfigMap, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	mapRoles := func() string {
+		cm, err := clientSet.CoreV1().ConfigMaps(authconfigmap.ObjectNamespace).Get(context.TODO(), authconfigmap.ObjectName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return cm.Data["mapRoles"]
+	}
+
+	It("creates the successor before draining and deleting the nodegroup", func() {
+		Expect(m.Replace(oldNodeGroup, options, ngFilter)).To(Succeed())
+
+		Expect(kubeProvider.UpdateAuthConfigMapCallCount()).To(Equal(1))
+		nodeGroups, _ := kubeProvider.UpdateAuthConfigMapArgsForCall(0)
+		Expect(nodeGroups).To(ConsistOf(successor))
+
+		Expect(fakeStackManager.NewTasksToDeleteNodeGroupsCallCount()).To(Equal(1))
+		shouldDelete, wait, _ := fakeStackManager.NewTasksToDeleteNodeGroupsArgsForCall(0)
+		Expect(wait).To(BeTrue())
+		Expect(shouldDelete("ng-1")).To(BeTrue())
+		Expect(shouldDelete("ng-1-3f9a2")).To(BeFalse())
+
+		Expect(mapRoles()).NotTo(ContainSubstring(oldRoleARN))
+	})
+
+	It("rejects managed nodegroups", func() {
+		fakeStackManager.GetNodeGroupStackTypeReturns(api.NodeGroupTypeManaged, nil)
+
+		err := m.Replace(oldNodeGroup, options, ngFilter)
+		Expect(err).To(MatchError(`managed nodegroup "ng-1" cannot be replaced, use 'eksctl upgrade nodegroup' instead`))
+		Expect(kubeProvider.UpdateAuthConfigMapCallCount()).To(Equal(0))
+		Expect(fakeStackManager.NewTasksToDeleteNodeGroupsCallCount()).To(Equal(0))
+	})
+
+	It("deletes the successor and keeps the nodegroup when the successor's nodes don't become ready", func() {
+		kubeProvider.UpdateAuthConfigMapStub = func(nodeGroups []*api.NodeGroup, _ kubernetes.Interface) error {
+			nodeGroups[0].IAM = &api.NodeGroupIAM{InstanceRoleARN: successorRoleARN}
+			return errors.New("timed out waiting for nodes")
+		}
+		fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{
+			{NodeGroupName: "ng-1", Type: api.NodeGroupTypeUnmanaged},
+			{NodeGroupName: "ng-1-3f9a2", Type: api.NodeGroupTypeUnmanaged},
+		}, nil)
+
+		err := m.Replace(oldNodeGroup, options, ngFilter)
+		Expect(err).To(MatchError(ContainSubstring("timed out waiting for nodes")))
+
+		Expect(fakeStackManager.NewTasksToDeleteNodeGroupsCallCount()).To(Equal(1))
+		shouldDelete, wait, _ := fakeStackManager.NewTasksToDeleteNodeGroupsArgsForCall(0)
+		Expect(wait).To(BeTrue())
+		Expect(shouldDelete("ng-1-3f9a2")).To(BeTrue())
+		Expect(shouldDelete("ng-1")).To(BeFalse())
+
+		Expect(mapRoles()).To(ContainSubstring(oldRoleARN))
+	})
+
+	It("does not delete anything if the successor's stack was never created", func() {
+		kubeProvider.UpdateAuthConfigMapReturns(errors.New("err"))
+		fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{
+			{NodeGroupName: "ng-1", Type: api.NodeGroupTypeUnmanaged},
+		}, nil)
+
+		Expect(m.Replace(oldNodeGroup, options, ngFilter)).NotTo(Succeed())
+		Expect(fakeStackManager.NewTasksToDeleteNodeGroupsCallCount()).To(Equal(0))
+	})
+
+	It("keeps both nodegroups when the nodegroup can't be drained", func() {
+		clientSet.PrependReactor("list", "nodes", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("API server unavailable")
+		})
+
+		err := m.Replace(oldNodeGroup, options, ngFilter)
+		Expect(err).To(MatchError(ContainSubstring("draining nodegroup \"ng-1\"")))
+		Expect(fakeStackManager.NewTasksToDeleteNodeGroupsCallCount()).To(Equal(0))
+		Expect(mapRoles()).To(ContainSubstring(oldRoleARN))
+	})
+})
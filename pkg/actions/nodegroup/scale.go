@@ -16,7 +16,15 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 )
 
-func (m *Manager) Scale(ng *api.NodeGroup) error {
+// ScaleOpts controls the scaling of a nodegroup
+type ScaleOpts struct {
+	// ConfigFileProvided is set when the nodegroup spec comes from a config file, in which case the node-template
+	// tags of an unmanaged nodegroup are synced with its labels, taints and volume size
+	ConfigFileProvided bool
+}
+
+// Scale scales a nodegroup, and syncs the node-template tags cluster-autoscaler needs to scale it up from zero
+func (m *Manager) Scale(ng *api.NodeGroup, options ScaleOpts) error {
 	logger.Info("scaling nodegroup %q in cluster %s", ng.Name, m.cfg.Metadata.Name)
 
 	nodegroupStackInfos, err := m.stackManager.DescribeNodeGroupStacksAndResources()
@@ -36,7 +44,7 @@ func (m *Manager) Scale(ng *api.NodeGroup) error {
 	}
 
 	if isUnmanagedNodegroup {
-		err = m.scaleUnmanagedNodeGroup(ng, stackInfo)
+		err = m.scaleUnmanagedNodeGroup(ng, stackInfo, options)
 	} else {
 		err = m.scaleManagedNodeGroup(ng)
 	}
@@ -48,7 +56,7 @@ func (m *Manager) Scale(ng *api.NodeGroup) error {
 	return nil
 }
 
-func (m *Manager) scaleUnmanagedNodeGroup(ng *api.NodeGroup, stackInfo manager.StackInfo) error {
+func (m *Manager) scaleUnmanagedNodeGroup(ng *api.NodeGroup, stackInfo manager.StackInfo, options ScaleOpts) error {
 	asgName := ""
 	for _, resource := range stackInfo.Resources {
 		if *resource.LogicalResourceId == "NodeGroup" {
@@ -83,7 +91,11 @@ func (m *Manager) scaleUnmanagedNodeGroup(ng *api.NodeGroup, stackInfo manager.S
 	}
 	logger.Info("nodegroup successfully scaled")
 
-	return nil
+	if !options.ConfigFileProvided {
+		logger.Debug("the labels and taints of nodegroup %q are unknown, not syncing its node-template tags", ng.Name)
+		return nil
+	}
+	return m.syncUnmanagedNodeTemplateTags(asgName, ng)
 }
 
 func (m *Manager) scaleManagedNodeGroup(ng *api.NodeGroup) error {
@@ -135,5 +147,5 @@ func (m *Manager) scaleManagedNodeGroup(ng *api.NodeGroup) error {
 		return err
 	}
 	logger.Info("nodegroup successfully scaled")
-	return nil
+	return m.SyncNodeTemplateTags(ng.Name)
 }
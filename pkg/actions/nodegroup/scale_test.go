@@ -87,13 +87,18 @@ var _ = Describe("Scale", func() {
 				NodegroupName: &ngName,
 			}).Return(&request.Request{}, nil)
 
+			p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+			}).Return(&awseks.DescribeNodegroupOutput{Nodegroup: &awseks.Nodegroup{}}, nil)
+
 			waitCallCount := 0
 			m.SetWaiter(func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error {
 				waitCallCount++
 				return nil
 			})
 
-			err := m.Scale(ng)
+			err := m.Scale(ng, nodegroup.ScaleOpts{})
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(waitCallCount).To(Equal(1))
@@ -110,7 +115,7 @@ var _ = Describe("Scale", func() {
 					NodegroupName: &ngName,
 				}).Return(nil, fmt.Errorf("foo"))
 
-				err := m.Scale(ng)
+				err := m.Scale(ng, nodegroup.ScaleOpts{})
 
 				Expect(err).To(MatchError(fmt.Sprintf("failed to scale nodegroup for cluster %q, error: foo", clusterName)))
 			})
@@ -152,9 +157,33 @@ var _ = Describe("Scale", func() {
 			})
 
 			It("scales the nodegroup", func() {
-				err := m.Scale(ng)
+				err := m.Scale(ng, nodegroup.ScaleOpts{})
 				Expect(err).NotTo(HaveOccurred())
 			})
+
+			When("the nodegroup comes from a config file", func() {
+				It("syncs the node-template tags of the ASG", func() {
+					asgTags := map[string]map[string]string{
+						"asg-name": {
+							api.ClusterAutoscalerEnabledTag:                                    "true",
+							"k8s.io/cluster-autoscaler/node-template/label/old":                "value",
+							"k8s.io/cluster-autoscaler/node-template/resources/nvidia.com/gpu": "1",
+						},
+					}
+					mockASGTags(p, asgTags)
+					ng.Labels = map[string]string{"role": "worker"}
+					ng.VolumeSize = aws.Int(80)
+
+					err := m.Scale(ng, nodegroup.ScaleOpts{ConfigFileProvided: true})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(asgTags["asg-name"]).To(Equal(map[string]string{
+						api.ClusterAutoscalerEnabledTag:                                       "true",
+						"k8s.io/cluster-autoscaler/node-template/label/role":                  "worker",
+						"k8s.io/cluster-autoscaler/node-template/resources/ephemeral-storage": "80Gi",
+						"k8s.io/cluster-autoscaler/node-template/resources/nvidia.com/gpu":    "1",
+					}))
+				})
+			})
 		})
 
 		When("the asg resource doesn't exist", func() {
@@ -180,7 +209,7 @@ var _ = Describe("Scale", func() {
 			})
 
 			It("returns an error", func() {
-				err := m.Scale(ng)
+				err := m.Scale(ng, nodegroup.ScaleOpts{})
 				Expect(err).To(MatchError(ContainSubstring("failed to find NodeGroup auto scaling group")))
 			})
 		})
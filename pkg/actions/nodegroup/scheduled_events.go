@@ -0,0 +1,254 @@
+package nodegroup
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/drain"
+)
+
+// Actions taken on instances with a scheduled event
+const (
+	ScheduledEventActionReplaced = "replaced"
+	ScheduledEventActionPlanned  = "replace (plan mode)"
+	ScheduledEventActionDeferred = "deferred (not due yet)"
+	ScheduledEventActionFailed   = "failed"
+)
+
+// describeInstanceStatusBatchSize is the maximum number of instance IDs that can be passed to DescribeInstanceStatus
+const describeInstanceStatusBatchSize = 100
+
+// scheduledEventCodes are the codes of the events that make AWS stop, reboot or retire an instance
+var scheduledEventCodes = []string{
+	ec2.EventCodeInstanceRetirement,
+	ec2.EventCodeInstanceReboot,
+	ec2.EventCodeInstanceStop,
+	ec2.EventCodeSystemReboot,
+	ec2.EventCodeSystemMaintenance,
+}
+
+// ScheduledEventsOptions holds the options of HandleScheduledEvents
+type ScheduledEventsOptions struct {
+	Plan            bool
+	MaxGracePeriod  time.Duration
+	DisableEviction bool
+	// Within only handles the events that are due to start within this duration, all events are handled when it is zero
+	Within time.Duration
+}
+
+// ScheduledEvent is an instance of a nodegroup that has a scheduled event, along with what was done about it
+type ScheduledEvent struct {
+	NodeGroup   string
+	InstanceID  string
+	NodeName    string
+	Code        string
+	Description string
+	NotBefore   time.Time
+	Action      string
+}
+
+// nodeGroupInstances are the instances of the ASG of a nodegroup
+type nodeGroupInstances struct {
+	name            string
+	asgName         string
+	desiredCapacity int
+	instanceIDs     []string
+}
+
+// HandleScheduledEvents finds the instances of the nodegroups of the cluster that have a scheduled
+// retirement, reboot, stop or maintenance event, and replaces them ahead of the event: each instance is
+// cordoned and drained, then terminated so that its ASG launches a replacement. It returns what was done
+// for each instance, including when an error occurred part way through
+func (m *Manager) HandleScheduledEvents(options ScheduledEventsOptions) ([]ScheduledEvent, error) {
+	nodeGroups, err := m.listNodeGroupInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	var instanceIDs []string
+	for _, ng := range nodeGroups {
+		instanceIDs = append(instanceIDs, ng.instanceIDs...)
+	}
+	events, err := m.describeScheduledEvents(instanceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name := range nodeGroups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var report []ScheduledEvent
+	for _, name := range names {
+		ng := nodeGroups[name]
+		for _, instanceID := range ng.instanceIDs {
+			if event, ok := events[instanceID]; ok {
+				report = append(report, ScheduledEvent{
+					NodeGroup:   ng.name,
+					InstanceID:  instanceID,
+					Code:        aws.StringValue(event.Code),
+					Description: aws.StringValue(event.Description),
+					NotBefore:   aws.TimeValue(event.NotBefore),
+				})
+			}
+		}
+	}
+	if len(report) == 0 {
+		logger.Info("no instances of the nodegroups of cluster %q have scheduled events", m.cfg.Metadata.Name)
+		return nil, nil
+	}
+
+	cmdutils.LogIntendedAction(options.Plan, "replace %d instance(s) with scheduled events in cluster %q", len(report), m.cfg.Metadata.Name)
+	for i := range report {
+		if err := m.handleScheduledEvent(&report[i], nodeGroups[report[i].NodeGroup], options); err != nil {
+			report[i].Action = ScheduledEventActionFailed
+			return report, err
+		}
+	}
+	cmdutils.LogPlanModeWarning(options.Plan)
+	return report, nil
+}
+
+func (m *Manager) handleScheduledEvent(event *ScheduledEvent, ng *nodeGroupInstances, options ScheduledEventsOptions) error {
+	kubeNodeGroup := &api.NodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{
+			Name: ng.name,
+		},
+	}
+	nodeNames, err := m.nodeNamesForInstances(kubeNodeGroup, []string{event.InstanceID})
+	if err != nil {
+		return err
+	}
+	event.NodeName = strings.Join(nodeNames, ", ")
+
+	if options.Within > 0 && event.NotBefore.After(time.Now().Add(options.Within)) {
+		event.Action = ScheduledEventActionDeferred
+		return nil
+	}
+	if options.Plan {
+		event.Action = ScheduledEventActionPlanned
+		return nil
+	}
+
+	logger.Info("replacing instance %q of nodegroup %q, which has a scheduled %s event from %s", event.InstanceID, ng.name, event.Code, event.NotBefore.Format(time.RFC3339))
+	if len(nodeNames) > 0 {
+		nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, kubeNodeGroup, m.ctl.Provider.WaitTimeout(), options.MaxGracePeriod, false, options.DisableEviction, 1, m.cfg.Drain)
+		if err := nodeGroupDrainer.DrainNodes(nodeNames); err != nil {
+			return errors.Wrapf(err, "draining instance %q of nodegroup %q", event.InstanceID, ng.name)
+		}
+	}
+
+	_, err = m.ctl.Provider.ASG().TerminateInstanceInAutoScalingGroup(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     aws.String(event.InstanceID),
+		ShouldDecrementDesiredCapacity: aws.Bool(false),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "terminating instance %q of nodegroup %q", event.InstanceID, ng.name)
+	}
+
+	if err := m.waitForReplacements(kubeNodeGroup, ng.asgName, []string{event.InstanceID}, ng.desiredCapacity); err != nil {
+		return err
+	}
+	event.Action = ScheduledEventActionReplaced
+	logger.Success("replaced instance %q of nodegroup %q", event.InstanceID, ng.name)
+	return nil
+}
+
+// listNodeGroupInstances returns the instances of the nodegroups of the cluster that are not being terminated,
+// indexed by nodegroup name
+func (m *Manager) listNodeGroupInstances() (map[string]*nodeGroupInstances, error) {
+	stacks, err := m.stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	nodeGroups := map[string]*nodeGroupInstances{}
+	for _, s := range stacks {
+		stack, err := m.stackManager.DescribeNodeGroupStack(s.NodeGroupName)
+		if err != nil {
+			return nil, err
+		}
+		asgName, err := m.stackManager.GetAutoScalingGroupName(stack)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting the auto scaling group of nodegroup %q", s.NodeGroupName)
+		}
+		group, err := m.describeAutoScalingGroup(asgName)
+		if err != nil {
+			return nil, err
+		}
+
+		ng := &nodeGroupInstances{
+			name:            s.NodeGroupName,
+			asgName:         asgName,
+			desiredCapacity: int(aws.Int64Value(group.DesiredCapacity)),
+		}
+		for _, instance := range group.Instances {
+			if !isTerminating(instance) {
+				ng.instanceIDs = append(ng.instanceIDs, aws.StringValue(instance.InstanceId))
+			}
+		}
+		sort.Strings(ng.instanceIDs)
+		nodeGroups[s.NodeGroupName] = ng
+	}
+	return nodeGroups, nil
+}
+
+// describeScheduledEvents returns the earliest pending scheduled event of each of the given instances that has one
+func (m *Manager) describeScheduledEvents(instanceIDs []string) (map[string]*ec2.InstanceStatusEvent, error) {
+	events := map[string]*ec2.InstanceStatusEvent{}
+	if len(instanceIDs) == 0 {
+		return events, nil
+	}
+
+	for _, batch := range splitIntoBatches(instanceIDs, describeInstanceStatusBatchSize) {
+		output, err := m.ctl.Provider.EC2().DescribeInstanceStatus(&ec2.DescribeInstanceStatusInput{
+			InstanceIds:         aws.StringSlice(batch),
+			IncludeAllInstances: aws.Bool(true),
+			Filters: []*ec2.Filter{{
+				Name:   aws.String("event.code"),
+				Values: aws.StringSlice(scheduledEventCodes),
+			}},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "describing instance status")
+		}
+		for _, status := range output.InstanceStatuses {
+			for _, event := range status.Events {
+				if !isPendingScheduledEvent(event) {
+					continue
+				}
+				instanceID := aws.StringValue(status.InstanceId)
+				if earliest, ok := events[instanceID]; !ok || aws.TimeValue(event.NotBefore).Before(aws.TimeValue(earliest.NotBefore)) {
+					events[instanceID] = event
+				}
+			}
+		}
+	}
+	return events, nil
+}
+
+// isPendingScheduledEvent returns true if the event is one that affects the instance and hasn't happened
+// or been canceled yet; EC2 keeps such events for a while, prefixing their description with their state
+func isPendingScheduledEvent(event *ec2.InstanceStatusEvent) bool {
+	description := aws.StringValue(event.Description)
+	if strings.HasPrefix(description, "[Completed]") || strings.HasPrefix(description, "[Canceled]") {
+		return false
+	}
+	for _, code := range scheduledEventCodes {
+		if aws.StringValue(event.Code) == code {
+			return true
+		}
+	}
+	return false
+}
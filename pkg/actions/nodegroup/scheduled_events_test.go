@@ -0,0 +1,179 @@
+package nodegroup_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Handle scheduled events", func() {
+	var (
+		p          *mockprovider.MockProvider
+		clientSet  *fake.Clientset
+		m          *nodegroup.Manager
+		terminated []string
+		notBefore  time.Time
+	)
+
+	newGroup := func(instanceIDs ...string) *autoscaling.DescribeAutoScalingGroupsOutput {
+		var instances []*autoscaling.Instance
+		for _, id := range instanceIDs {
+			instances = append(instances, &autoscaling.Instance{
+				InstanceId:     aws.String(id),
+				LifecycleState: aws.String(autoscaling.LifecycleStateInService),
+			})
+		}
+		return &autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{{
+				AutoScalingGroupName: aws.String("asg-ng-1"),
+				DesiredCapacity:      aws.Int64(int64(len(instances))),
+				Instances:            instances,
+			}},
+		}
+	}
+
+	addNode := func(instanceID string) {
+		_, err := clientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-" + instanceID,
+				Labels: map[string]string{api.NodeGroupNameLabel: "ng-1"},
+			},
+			Spec: corev1.NodeSpec{
+				ProviderID: fmt.Sprintf("aws:///us-west-2a/%s", instanceID),
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{
+					Type:   corev1.NodeReady,
+					Status: corev1.ConditionTrue,
+				}},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	newStatus := func(instanceID, code, description string) *ec2.InstanceStatus {
+		return &ec2.InstanceStatus{
+			InstanceId: aws.String(instanceID),
+			Events: []*ec2.InstanceStatusEvent{{
+				Code:        aws.String(code),
+				Description: aws.String(description),
+				NotBefore:   aws.Time(notBefore),
+			}},
+		}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		clientSet = fake.NewSimpleClientset()
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, clientSet)
+
+		fakeStackManager := new(fakes.FakeStackManager)
+		m.SetStackManager(fakeStackManager)
+		fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{
+			{NodeGroupName: "ng-1", Type: api.NodeGroupTypeUnmanaged},
+		}, nil)
+		fakeStackManager.DescribeNodeGroupStackReturns(&manager.Stack{StackName: aws.String("eksctl-my-cluster-nodegroup-ng-1")}, nil)
+		fakeStackManager.GetAutoScalingGroupNameReturns("asg-ng-1", nil)
+
+		notBefore = time.Now().Add(48 * time.Hour).Truncate(time.Second)
+		for _, id := range []string{"i-1", "i-2", "i-3"} {
+			addNode(id)
+		}
+
+		terminated = nil
+		p.MockASG().On("TerminateInstanceInAutoScalingGroup", mock.Anything).Run(func(args mock.Arguments) {
+			input := args.Get(0).(*autoscaling.TerminateInstanceInAutoScalingGroupInput)
+			Expect(*input.ShouldDecrementDesiredCapacity).To(BeFalse())
+
+			instanceID := *input.InstanceId
+			node, err := clientSet.CoreV1().Nodes().Get(context.TODO(), "node-"+instanceID, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(node.Spec.Unschedulable).To(BeTrue(), "node must be cordoned before its instance is terminated")
+
+			Expect(clientSet.CoreV1().Nodes().Delete(context.TODO(), node.Name, metav1.DeleteOptions{})).To(Succeed())
+			terminated = append(terminated, instanceID)
+			addNode(fmt.Sprintf("i-new%d", len(terminated)))
+		}).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+	})
+
+	It("replaces the instances that have pending scheduled events and reports what it did", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup("i-1", "i-2", "i-3"), nil).Once()
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup("i-2", "i-3", "i-new1"), nil).Once()
+		p.MockEC2().On("DescribeInstanceStatus", mock.MatchedBy(func(input *ec2.DescribeInstanceStatusInput) bool {
+			return Expect(aws.StringValueSlice(input.InstanceIds)).To(ConsistOf("i-1", "i-2", "i-3"))
+		})).Return(&ec2.DescribeInstanceStatusOutput{
+			InstanceStatuses: []*ec2.InstanceStatus{
+				newStatus("i-1", ec2.EventCodeInstanceRetirement, "The instance is running on degraded hardware"),
+				newStatus("i-2", ec2.EventCodeSystemReboot, "[Completed] Scheduled reboot"),
+			},
+		}, nil)
+
+		report, err := m.HandleScheduledEvents(nodegroup.ScheduledEventsOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(terminated).To(Equal([]string{"i-1"}))
+		Expect(report).To(Equal([]nodegroup.ScheduledEvent{{
+			NodeGroup:   "ng-1",
+			InstanceID:  "i-1",
+			NodeName:    "node-i-1",
+			Code:        ec2.EventCodeInstanceRetirement,
+			Description: "The instance is running on degraded hardware",
+			NotBefore:   notBefore,
+			Action:      nodegroup.ScheduledEventActionReplaced,
+		}}))
+	})
+
+	It("only reports the instances in plan mode or whose events are not due yet", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup("i-1", "i-2", "i-3"), nil)
+		p.MockEC2().On("DescribeInstanceStatus", mock.Anything).Return(&ec2.DescribeInstanceStatusOutput{
+			InstanceStatuses: []*ec2.InstanceStatus{
+				newStatus("i-1", ec2.EventCodeInstanceRetirement, "The instance is running on degraded hardware"),
+			},
+		}, nil)
+
+		report, err := m.HandleScheduledEvents(nodegroup.ScheduledEventsOptions{Plan: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report).To(HaveLen(1))
+		Expect(report[0].Action).To(Equal(nodegroup.ScheduledEventActionPlanned))
+
+		report, err = m.HandleScheduledEvents(nodegroup.ScheduledEventsOptions{Within: 24 * time.Hour})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report).To(HaveLen(1))
+		Expect(report[0].Action).To(Equal(nodegroup.ScheduledEventActionDeferred))
+
+		Expect(terminated).To(BeEmpty())
+		p.MockASG().AssertNotCalled(GinkgoT(), "TerminateInstanceInAutoScalingGroup", mock.Anything)
+	})
+
+	It("does nothing when no instances have scheduled events", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup("i-1", "i-2", "i-3"), nil)
+		p.MockEC2().On("DescribeInstanceStatus", mock.Anything).Return(&ec2.DescribeInstanceStatusOutput{}, nil)
+
+		report, err := m.HandleScheduledEvents(nodegroup.ScheduledEventsOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report).To(BeEmpty())
+		Expect(terminated).To(BeEmpty())
+	})
+})
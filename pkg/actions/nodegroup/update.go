@@ -4,11 +4,13 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/kris-nova/logger"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/managed"
+	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 )
 
 func (m *Manager) Update() error {
@@ -71,3 +73,36 @@ func updateUpdateConfig(ng *api.ManagedNodeGroup) (*eks.NodegroupUpdateConfig, e
 
 	return updateConfig, nil
 }
+
+// UpdateManagedConfig applies a config update to a managed nodegroup, waits for the nodegroup to become
+// active again and syncs its node-template tags
+func (m *Manager) UpdateManagedConfig(input *eks.UpdateNodegroupConfigInput) error {
+	name := aws.StringValue(input.NodegroupName)
+	if _, err := m.ctl.Provider.EKS().UpdateNodegroupConfig(input); err != nil {
+		return fmt.Errorf("failed to update nodegroup %s: %w", name, err)
+	}
+
+	newRequest := func() *request.Request {
+		req, _ := m.ctl.Provider.EKS().DescribeNodegroupRequest(&eks.DescribeNodegroupInput{
+			ClusterName:   input.ClusterName,
+			NodegroupName: input.NodegroupName,
+		})
+		return req
+	}
+
+	msg := fmt.Sprintf("waiting for update of nodegroup %q to complete", name)
+
+	acceptors := waiters.MakeAcceptors(
+		"Nodegroup.Status",
+		eks.NodegroupStatusActive,
+		[]string{
+			eks.NodegroupStatusDegraded,
+		},
+	)
+
+	if err := m.wait(name, msg, acceptors, newRequest, m.ctl.Provider.WaitTimeout(), nil); err != nil {
+		return err
+	}
+	logger.Info("nodegroup %s successfully updated", name)
+	return m.SyncNodeTemplateTags(name)
+}
@@ -2,6 +2,7 @@ package nodegroup
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 
@@ -11,41 +12,98 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/blang/semver"
 	"github.com/kris-nova/logger"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/managed"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 )
 
-func (m *Manager) Upgrade(options managed.UpgradeOptions, wait bool) error {
+// UpgradeOpts controls the upgrade of a nodegroup
+type UpgradeOpts struct {
+	managed.UpgradeOptions
+	// UpdateConfig sets how many instances of an unmanaged nodegroup are replaced at a time
+	UpdateConfig    *api.NodeGroupUpdateConfig
+	MaxGracePeriod  time.Duration
+	DisableEviction bool
+}
+
+// Upgrade upgrades a managed nodegroup through the EKS API, or replaces the instances of an unmanaged
+// nodegroup that don't use the current version of its launch template
+func (m *Manager) Upgrade(options UpgradeOpts, wait bool) error {
 	stackCollection := manager.NewStackCollection(m.ctl.Provider, m.cfg)
 	hasStacks, err := m.hasStacks(options.NodegroupName)
 	if err != nil {
 		return err
 	}
 
+	if hasStacks {
+		stackType, err := m.stackManager.GetNodeGroupStackType(options.NodegroupName)
+		if err != nil {
+			return err
+		}
+		if stackType == api.NodeGroupTypeUnmanaged {
+			return m.runUpgrade(fmt.Sprintf("replace the outdated instances of nodegroup %q", options.NodegroupName), "nodegroup/"+options.NodegroupName, func() error {
+				return m.upgradeUnmanaged(options)
+			})
+		}
+	}
+
+	if options.UpdateConfig != nil {
+		return errors.New("--max-unavailable and --max-unavailable-percentage are only supported for unmanaged nodegroups, use 'eksctl update nodegroup' to set the update config of a managed nodegroup")
+	}
+
 	if options.KubernetesVersion != "" {
 		if _, err := semver.ParseTolerant(options.KubernetesVersion); err != nil {
 			return errors.Wrap(err, "invalid Kubernetes version")
 		}
 	}
 
+	description := fmt.Sprintf("upgrade managed nodegroup %q", options.NodegroupName)
+	resource := "managed-nodegroup/" + options.NodegroupName
 	if hasStacks {
 		managedService := managed.NewService(m.ctl.Provider.EKS(), m.ctl.Provider.SSM(), m.ctl.Provider.EC2(), stackCollection, m.cfg.Metadata.Name)
-		return managedService.UpgradeNodeGroup(options)
+		return m.runUpgrade(description, resource, func() error {
+			return managedService.UpgradeNodeGroup(options.UpgradeOptions)
+		})
 	}
 
-	if err := m.upgrade(options); err != nil {
-		return err
+	if options.CustomAMIResolver != nil {
+		return errors.New("custom AMIs can only be resolved for nodegroups created by eksctl")
 	}
 
-	if wait {
-		return m.waitForUpgrade(options)
-	}
+	return m.runUpgrade(description, resource, func() error {
+		if err := m.upgrade(options.UpgradeOptions); err != nil {
+			return err
+		}
 
-	logger.Info("nodegroup upgrade request submitted successfully")
+		if wait {
+			return m.waitForUpgrade(options.UpgradeOptions)
+		}
 
-	return nil
+		logger.Info("nodegroup upgrade request submitted successfully")
+		return nil
+	})
+}
 
+// runUpgrade prints the plan of the nodegroup upgrade and runs it
+func (m *Manager) runUpgrade(description, resource string, doer func() error) error {
+	taskTree := &tasks.TaskTree{Parallel: false}
+	taskTree.Append(&tasks.TargetedTask{
+		GenericTask: tasks.GenericTask{
+			Description: description,
+			Doer:        doer,
+		},
+		Action:   tasks.ActionUpdate,
+		Resource: resource,
+	})
+	if err := tasks.PrintPlan(m.planOutput, taskTree); err != nil {
+		return err
+	}
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
 }
 
 func (m *Manager) upgrade(options managed.UpgradeOptions) error {
@@ -0,0 +1,282 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// replacementPollInterval is how often the nodegroup is checked while waiting for replacement instances
+var replacementPollInterval = 15 * time.Second
+
+// upgradeUnmanaged replaces the instances of an unmanaged nodegroup that were launched from an older
+// version of the launch template of its ASG; the instances are replaced in batches of at most
+// maxUnavailable instances, and each batch is cordoned and drained before it is terminated. The batch
+// size is set by options.UpdateConfig, or else by the updateConfig of the nodegroup in the config file
+func (m *Manager) upgradeUnmanaged(options UpgradeOpts) error {
+	if options.KubernetesVersion != "" || options.LaunchTemplateVersion != "" || options.ReleaseVersion != "" {
+		return errors.New("--kubernetes-version, --launch-template-version and --release-version are not supported for unmanaged nodegroups, " +
+			"update the nodegroup's stack to change its launch template")
+	}
+	if options.CustomAMIResolver != nil {
+		return errors.New("--custom-ami-ssm-parameter and --custom-ami-name-pattern are not supported for unmanaged nodegroups, " +
+			"update the nodegroup's stack to change its AMI")
+	}
+
+	stack, err := m.stackManager.DescribeNodeGroupStack(options.NodegroupName)
+	if err != nil {
+		return err
+	}
+	asgName, err := m.stackManager.GetAutoScalingGroupName(stack)
+	if err != nil {
+		return errors.Wrapf(err, "getting the auto scaling group of nodegroup %q", options.NodegroupName)
+	}
+
+	group, err := m.describeAutoScalingGroup(asgName)
+	if err != nil {
+		return err
+	}
+	launchTemplateVersion, err := m.currentLaunchTemplateVersion(group)
+	if err != nil {
+		return err
+	}
+
+	var outdated []string
+	for _, instance := range group.Instances {
+		if !isTerminating(instance) && (instance.LaunchTemplate == nil || aws.StringValue(instance.LaunchTemplate.Version) != launchTemplateVersion) {
+			outdated = append(outdated, aws.StringValue(instance.InstanceId))
+		}
+	}
+	if len(outdated) == 0 {
+		logger.Info("all instances of nodegroup %q use version %s of its launch template, nothing to upgrade", options.NodegroupName, launchTemplateVersion)
+		return nil
+	}
+
+	desiredCapacity := int(aws.Int64Value(group.DesiredCapacity))
+	updateConfig := options.UpdateConfig
+	if ng := m.cfg.FindNodegroup(options.NodegroupName); updateConfig == nil && ng != nil {
+		updateConfig = ng.UpdateConfig
+	}
+	batchSize, err := maxUnavailable(updateConfig, desiredCapacity)
+	if err != nil {
+		return err
+	}
+	batches := splitIntoBatches(outdated, batchSize)
+	logger.Info("will replace %d instance(s) of nodegroup %q in %d batch(es) of at most %d instance(s) to use version %s of its launch template",
+		len(outdated), options.NodegroupName, len(batches), batchSize, launchTemplateVersion)
+
+	ng := &api.NodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{
+			Name: options.NodegroupName,
+		},
+	}
+	nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, ng, m.ctl.Provider.WaitTimeout(), options.MaxGracePeriod, false, options.DisableEviction, batchSize, m.cfg.Drain)
+
+	for i, batch := range batches {
+		logger.Info("[%d/%d] replacing instance(s) %s of nodegroup %q", i+1, len(batches), strings.Join(batch, ", "), options.NodegroupName)
+
+		nodeNames, err := m.nodeNamesForInstances(ng, batch)
+		if err != nil {
+			return err
+		}
+		if len(nodeNames) > 0 {
+			if err := nodeGroupDrainer.DrainNodes(nodeNames); err != nil {
+				return errors.Wrapf(err, "draining instance(s) %s of nodegroup %q", strings.Join(batch, ", "), options.NodegroupName)
+			}
+		}
+
+		for _, instanceID := range batch {
+			_, err := m.ctl.Provider.ASG().TerminateInstanceInAutoScalingGroup(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+				InstanceId:                     aws.String(instanceID),
+				ShouldDecrementDesiredCapacity: aws.Bool(false),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "terminating instance %q of nodegroup %q", instanceID, options.NodegroupName)
+			}
+		}
+
+		if err := m.waitForReplacements(ng, asgName, batch, desiredCapacity); err != nil {
+			return err
+		}
+		logger.Success("[%d/%d] replaced %d instance(s) of nodegroup %q", i+1, len(batches), len(batch), options.NodegroupName)
+	}
+
+	logger.Success("upgraded all instances of nodegroup %q to version %s of its launch template", options.NodegroupName, launchTemplateVersion)
+	return nil
+}
+
+func (m *Manager) describeAutoScalingGroup(name string) (*autoscaling.Group, error) {
+	output, err := m.ctl.Provider.ASG().DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []*string{&name},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing auto scaling group %q", name)
+	}
+	if len(output.AutoScalingGroups) != 1 {
+		return nil, fmt.Errorf("couldn't find auto scaling group %q", name)
+	}
+	return output.AutoScalingGroups[0], nil
+}
+
+// currentLaunchTemplateVersion returns the version number of the launch template that is used by the
+// ASG to launch new instances
+func (m *Manager) currentLaunchTemplateVersion(group *autoscaling.Group) (string, error) {
+	launchTemplate := group.LaunchTemplate
+	if launchTemplate == nil && group.MixedInstancesPolicy != nil && group.MixedInstancesPolicy.LaunchTemplate != nil {
+		launchTemplate = group.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+	}
+	if launchTemplate == nil {
+		return "", fmt.Errorf("auto scaling group %q does not use a launch template", aws.StringValue(group.AutoScalingGroupName))
+	}
+
+	version := aws.StringValue(launchTemplate.Version)
+	if version != "$Latest" && version != "$Default" {
+		return version, nil
+	}
+
+	input := &ec2.DescribeLaunchTemplatesInput{}
+	if launchTemplate.LaunchTemplateId != nil {
+		input.LaunchTemplateIds = []*string{launchTemplate.LaunchTemplateId}
+	} else {
+		input.LaunchTemplateNames = []*string{launchTemplate.LaunchTemplateName}
+	}
+	output, err := m.ctl.Provider.EC2().DescribeLaunchTemplates(input)
+	if err != nil {
+		return "", errors.Wrap(err, "describing launch template")
+	}
+	if len(output.LaunchTemplates) != 1 {
+		return "", fmt.Errorf("couldn't find the launch template of auto scaling group %q", aws.StringValue(group.AutoScalingGroupName))
+	}
+	if version == "$Latest" {
+		return strconv.FormatInt(aws.Int64Value(output.LaunchTemplates[0].LatestVersionNumber), 10), nil
+	}
+	return strconv.FormatInt(aws.Int64Value(output.LaunchTemplates[0].DefaultVersionNumber), 10), nil
+}
+
+// nodeNamesForInstances returns the names of the nodes that have joined the cluster from the given instances
+func (m *Manager) nodeNamesForInstances(ng eks.KubeNodeGroup, instanceIDs []string) ([]string, error) {
+	nodes, err := m.clientSet.CoreV1().Nodes().List(context.TODO(), ng.ListOptions())
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing nodes of nodegroup %q", ng.NameString())
+	}
+	instances := sets.NewString(instanceIDs...)
+	var nodeNames []string
+	for _, node := range nodes.Items {
+		if instances.Has(eks.InstanceIDFromProviderID(node.Spec.ProviderID)) {
+			nodeNames = append(nodeNames, node.Name)
+		}
+	}
+	return nodeNames, nil
+}
+
+// waitForReplacements waits until the terminated instances have left the ASG and the nodegroup has as
+// many ready nodes as its desired capacity
+func (m *Manager) waitForReplacements(ng eks.KubeNodeGroup, asgName string, terminated []string, desiredCapacity int) error {
+	terminatedInstances := sets.NewString(terminated...)
+	timeout := time.After(m.ctl.Provider.WaitTimeout())
+
+	logger.Info("waiting for %d replacement instance(s) to join nodegroup %q and become ready", len(terminated), ng.NameString())
+	for {
+		done, err := m.replacementsReady(ng, asgName, terminatedInstances, desiredCapacity)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-timeout:
+			return fmt.Errorf("timed out (after %s) waiting for replacements of instance(s) %s of nodegroup %q to become ready",
+				m.ctl.Provider.WaitTimeout(), strings.Join(terminated, ", "), ng.NameString())
+		case <-time.After(replacementPollInterval):
+		}
+	}
+}
+
+func (m *Manager) replacementsReady(ng eks.KubeNodeGroup, asgName string, terminatedInstances sets.String, desiredCapacity int) (bool, error) {
+	group, err := m.describeAutoScalingGroup(asgName)
+	if err != nil {
+		return false, err
+	}
+	inService := 0
+	for _, instance := range group.Instances {
+		instanceID := aws.StringValue(instance.InstanceId)
+		if terminatedInstances.Has(instanceID) {
+			logger.Debug("instance %q is %s", instanceID, aws.StringValue(instance.LifecycleState))
+			return false, nil
+		}
+		if aws.StringValue(instance.LifecycleState) == autoscaling.LifecycleStateInService {
+			inService++
+		}
+	}
+
+	nodes, err := m.clientSet.CoreV1().Nodes().List(context.TODO(), ng.ListOptions())
+	if err != nil {
+		return false, errors.Wrapf(err, "listing nodes of nodegroup %q", ng.NameString())
+	}
+	ready := 0
+	for _, node := range nodes.Items {
+		if !terminatedInstances.Has(eks.InstanceIDFromProviderID(node.Spec.ProviderID)) && eks.IsNodeReady(&node) {
+			ready++
+		}
+	}
+
+	logger.Debug("nodegroup %q has %d instance(s) in service and %d ready node(s), desired capacity is %d", ng.NameString(), inService, ready, desiredCapacity)
+	return inService >= desiredCapacity && ready >= desiredCapacity, nil
+}
+
+// maxUnavailable returns the number of instances that can be replaced at a time
+func maxUnavailable(updateConfig *api.NodeGroupUpdateConfig, desiredCapacity int) (int, error) {
+	if updateConfig == nil {
+		return 1, nil
+	}
+	if updateConfig.MaxUnavailable != nil && updateConfig.MaxUnavailablePercentage != nil {
+		return 0, fmt.Errorf("cannot use maxUnavailable=%d and maxUnavailablePercentage=%d at the same time", *updateConfig.MaxUnavailable, *updateConfig.MaxUnavailablePercentage)
+	}
+
+	var n int
+	switch {
+	case updateConfig.MaxUnavailable != nil:
+		n = *updateConfig.MaxUnavailable
+	case updateConfig.MaxUnavailablePercentage != nil:
+		if *updateConfig.MaxUnavailablePercentage > 100 {
+			return 0, fmt.Errorf("maxUnavailablePercentage=%d cannot be greater than 100", *updateConfig.MaxUnavailablePercentage)
+		}
+		n = int(math.Floor(float64(desiredCapacity) * float64(*updateConfig.MaxUnavailablePercentage) / 100))
+	default:
+		return 0, errors.New("invalid UpdateConfig: maxUnavailable or maxUnavailablePercentage must be defined")
+	}
+	if n < 1 {
+		// replace at least one instance at a time, so that the upgrade makes progress
+		n = 1
+	}
+	return n, nil
+}
+
+func splitIntoBatches(instanceIDs []string, size int) [][]string {
+	var batches [][]string
+	for len(instanceIDs) > size {
+		batches = append(batches, instanceIDs[:size])
+		instanceIDs = instanceIDs[size:]
+	}
+	return append(batches, instanceIDs)
+}
+
+func isTerminating(instance *autoscaling.Instance) bool {
+	return strings.HasPrefix(aws.StringValue(instance.LifecycleState), "Terminating")
+}
@@ -0,0 +1,212 @@
+package nodegroup_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/managed"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Upgrade unmanaged nodegroup", func() {
+	var (
+		p                *mockprovider.MockProvider
+		cfg              *api.ClusterConfig
+		clientSet        *fake.Clientset
+		fakeStackManager *fakes.FakeStackManager
+		m                *nodegroup.Manager
+		options          nodegroup.UpgradeOpts
+		terminated       []string
+	)
+
+	newInstance := func(id, version string) *autoscaling.Instance {
+		return &autoscaling.Instance{
+			InstanceId:     aws.String(id),
+			LifecycleState: aws.String(autoscaling.LifecycleStateInService),
+			LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+				LaunchTemplateId: aws.String("lt-1234"),
+				Version:          aws.String(version),
+			},
+		}
+	}
+
+	newGroup := func(instances ...*autoscaling.Instance) *autoscaling.DescribeAutoScalingGroupsOutput {
+		return &autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{{
+				AutoScalingGroupName: aws.String("asg-ng-1"),
+				DesiredCapacity:      aws.Int64(int64(len(instances))),
+				LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+					LaunchTemplateId: aws.String("lt-1234"),
+					Version:          aws.String("2"),
+				},
+				Instances: instances,
+			}},
+		}
+	}
+
+	addNode := func(instanceID string) {
+		_, err := clientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-" + instanceID,
+				Labels: map[string]string{api.NodeGroupNameLabel: "ng-1"},
+			},
+			Spec: corev1.NodeSpec{
+				ProviderID: fmt.Sprintf("aws:///us-west-2a/%s", instanceID),
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{
+					Type:   corev1.NodeReady,
+					Status: corev1.ConditionTrue,
+				}},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		clientSet = fake.NewSimpleClientset()
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, clientSet)
+
+		fakeStackManager = new(fakes.FakeStackManager)
+		m.SetStackManager(fakeStackManager)
+		fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{
+			{NodeGroupName: "ng-1", Type: api.NodeGroupTypeUnmanaged},
+		}, nil)
+		fakeStackManager.GetNodeGroupStackTypeReturns(api.NodeGroupTypeUnmanaged, nil)
+		fakeStackManager.DescribeNodeGroupStackReturns(&manager.Stack{StackName: aws.String("eksctl-my-cluster-nodegroup-ng-1")}, nil)
+		fakeStackManager.GetAutoScalingGroupNameReturns("asg-ng-1", nil)
+
+		options = nodegroup.UpgradeOpts{
+			UpgradeOptions: managed.UpgradeOptions{
+				NodegroupName: "ng-1",
+			},
+		}
+
+		terminated = nil
+		p.MockASG().On("TerminateInstanceInAutoScalingGroup", mock.Anything).Run(func(args mock.Arguments) {
+			input := args.Get(0).(*autoscaling.TerminateInstanceInAutoScalingGroupInput)
+			Expect(*input.ShouldDecrementDesiredCapacity).To(BeFalse())
+
+			instanceID := *input.InstanceId
+			node, err := clientSet.CoreV1().Nodes().Get(context.TODO(), "node-"+instanceID, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(node.Spec.Unschedulable).To(BeTrue(), "node must be cordoned before its instance is terminated")
+
+			Expect(clientSet.CoreV1().Nodes().Delete(context.TODO(), node.Name, metav1.DeleteOptions{})).To(Succeed())
+			terminated = append(terminated, instanceID)
+			addNode(fmt.Sprintf("i-new%d", len(terminated)))
+		}).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+	})
+
+	It("replaces outdated instances one batch at a time", func() {
+		for _, id := range []string{"i-1", "i-2", "i-3"} {
+			addNode(id)
+		}
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup(newInstance("i-1", "1"), newInstance("i-2", "1"), newInstance("i-3", "2")), nil).Once()
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup(newInstance("i-2", "1"), newInstance("i-3", "2"), newInstance("i-new1", "2")), nil).Once()
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup(newInstance("i-3", "2"), newInstance("i-new1", "2"), newInstance("i-new2", "2")), nil).Once()
+
+		Expect(m.Upgrade(options, true)).To(Succeed())
+		Expect(terminated).To(Equal([]string{"i-1", "i-2"}))
+		p.MockASG().AssertNumberOfCalls(GinkgoT(), "DescribeAutoScalingGroups", 3)
+	})
+
+	It("replaces several instances at a time with maxUnavailable", func() {
+		for _, id := range []string{"i-1", "i-2", "i-3"} {
+			addNode(id)
+		}
+		options.UpdateConfig = &api.NodeGroupUpdateConfig{MaxUnavailable: aws.Int(2)}
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup(newInstance("i-1", "1"), newInstance("i-2", "1"), newInstance("i-3", "1")), nil).Once()
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup(newInstance("i-3", "1"), newInstance("i-new1", "2"), newInstance("i-new2", "2")), nil).Once()
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup(newInstance("i-new1", "2"), newInstance("i-new2", "2"), newInstance("i-new3", "2")), nil).Once()
+
+		Expect(m.Upgrade(options, true)).To(Succeed())
+		Expect(terminated).To(Equal([]string{"i-1", "i-2", "i-3"}))
+		p.MockASG().AssertNumberOfCalls(GinkgoT(), "DescribeAutoScalingGroups", 3)
+	})
+
+	Context("when the nodegroup has an updateConfig in the config file", func() {
+		BeforeEach(func() {
+			ng := api.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.UpdateConfig = &api.NodeGroupUpdateConfig{MaxUnavailablePercentage: aws.Int(100)}
+			cfg.NodeGroups = []*api.NodeGroup{ng}
+
+			for _, id := range []string{"i-1", "i-2"} {
+				addNode(id)
+			}
+		})
+
+		It("replaces as many instances at a time as the updateConfig allows", func() {
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+				Return(newGroup(newInstance("i-1", "1"), newInstance("i-2", "1")), nil).Once()
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+				Return(newGroup(newInstance("i-new1", "2"), newInstance("i-new2", "2")), nil).Once()
+
+			Expect(m.Upgrade(options, true)).To(Succeed())
+			Expect(terminated).To(Equal([]string{"i-1", "i-2"}))
+			p.MockASG().AssertNumberOfCalls(GinkgoT(), "DescribeAutoScalingGroups", 2)
+		})
+
+		It("lets the options override the updateConfig", func() {
+			options.UpdateConfig = &api.NodeGroupUpdateConfig{MaxUnavailable: aws.Int(1)}
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+				Return(newGroup(newInstance("i-1", "1"), newInstance("i-2", "1")), nil).Once()
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+				Return(newGroup(newInstance("i-2", "1"), newInstance("i-new1", "2")), nil).Once()
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+				Return(newGroup(newInstance("i-new1", "2"), newInstance("i-new2", "2")), nil).Once()
+
+			Expect(m.Upgrade(options, true)).To(Succeed())
+			Expect(terminated).To(Equal([]string{"i-1", "i-2"}))
+			p.MockASG().AssertNumberOfCalls(GinkgoT(), "DescribeAutoScalingGroups", 3)
+		})
+	})
+
+	It("does nothing when all instances use the current launch template version", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).
+			Return(newGroup(newInstance("i-1", "2"), newInstance("i-2", "2")), nil)
+
+		Expect(m.Upgrade(options, true)).To(Succeed())
+		Expect(terminated).To(BeEmpty())
+	})
+
+	It("rejects options that only apply to managed nodegroups", func() {
+		options.KubernetesVersion = "1.20"
+
+		err := m.Upgrade(options, true)
+		Expect(err).To(MatchError(ContainSubstring("not supported for unmanaged nodegroups")))
+		Expect(fakeStackManager.GetAutoScalingGroupNameCallCount()).To(Equal(0))
+	})
+
+	It("rejects maxUnavailable for managed nodegroups", func() {
+		fakeStackManager.GetNodeGroupStackTypeReturns(api.NodeGroupTypeManaged, nil)
+		options.UpdateConfig = &api.NodeGroupUpdateConfig{MaxUnavailable: aws.Int(2)}
+
+		err := m.Upgrade(options, true)
+		Expect(err).To(MatchError(ContainSubstring("only supported for unmanaged nodegroups")))
+	})
+})
@@ -1,8 +1,10 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
+// assets/cluster-autoscaler.yaml (4.374kB)
 // assets/efa-device-plugin.yaml (3.084kB)
 // assets/neuron-device-plugin.yaml (3.623kB)
 // assets/nvidia-device-plugin.yaml (2.369kB)
+// assets/spot-interruption-handler.yaml (2.796kB)
 // assets/vpc-admission-webhook-config.yaml (524B)
 // assets/vpc-admission-webhook-csr.yaml (234B)
 // assets/vpc-admission-webhook-dep.yaml (1.675kB)
@@ -77,6 +79,26 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
+var _clusterAutoscalerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\x4d\x6f\x23\x37\x0f\xbe\xfb\x57\x08\xbe\xcb\x4e\xf0\xbe\x59\x04\x03\xe4\xb0\xdd\x02\x45\x81\x6e\x1a\x64\xd1\xde\x69\x89\xb6\xb5\xd1\x88\xaa\x48\x39\x71\x7f\x7d\xa1\xf1\x8c\x3d\x13\x7f\xc4\xf1\x2e\xda\xc5\xf8\xe0\x11\xa9\x87\x14\xf9\x88\xe4\x68\xad\x47\x10\xdd\x9f\x98\xd8\x51\xa8\x54\x9a\x81\x99\x40\x96\x25\x25\xf7\x37\x88\xa3\x30\x79\xba\xe5\x89\xa3\xe9\xea\x7a\xf4\xe4\x82\xad\xd4\x27\x9f\x59\x30\x3d\x92\xc7\x51\x8d\x02\x16\x04\xaa\x91\x52\x01\x6a\xac\x94\xd9\x48\x35\x64\x21\x36\xe0\x31\x8d\x94\xf2\x30\x43\xcf\x45\x49\xa9\xa7\x5b\xd6\x60\x2d\x85\x43\xba\x93\x46\xc2\xad\xcd\x9d\x7e\x8c\x07\x91\x53\xf6\xc8\xd5\x48\x2b\x88\xee\x97\x44\x39\x36\x36\xb4\x1a\x8f\x47\x4a\x25\x64\xca\xc9\x60\xbb\x86\x2b\x0c\xc2\x8d\x18\x83\x8d\xe4\x36\x6f\x2b\x4c\xb3\x56\xc3\x24\x04\xc1\xe6\x6f\x04\x31\xcb\xf3\x70\x23\x59\x9e\xe2\xca\x99\x12\xac\x83\x80\xe7\xc3\xb0\x80\xe4\x57\x5e\xe5\x68\xcf\x06\xe9\x1f\xac\x13\xdd\x43\xdd\x89\x0f\x44\x70\x60\x6a\x81\xf2\x6e\x93\x81\x2c\xbe\xf2\xf8\xb9\x09\x5e\x01\xf2\x8e\xe5\x72\xe4\xe2\x78\x04\xd3\xc0\x6f\xe2\xdc\xfc\x61\x4c\x2b\xd7\xad\x26\x8c\xde\x99\x86\xa8\x86\x82\x24\xf2\x1e\x53\xbb\xa1\x70\x9a\x05\x83\xac\xc8\xe7\x1a\x8d\x07\x57\x1f\x16\x9d\x77\x80\x3d\xaf\xf1\x45\x30\x94\x7b\xd3\x8f\x37\x57\x7d\xc7\x18\x5b\xce\x59\xc0\x9a\x42\xfb\x7a\x81\xad\x48\xde\x99\xf5\xbe\x9d\x48\xd6\x3a\x4e\x39\x96\x10\xcc\xb2\x5d\xbc\x65\x61\x0f\x19\x62\x3c\xe0\x7f\xa1\x22\xce\xb3\xdf\x1e\xe0\x7b\x1f\x88\x85\x12\x2c\x70\x77\xd5\xf7\x1c\x68\xe4\xc6\x03\x73\x9b\x6c\xc3\xae\xa3\x9b\x56\x86\x9d\x4d\x6e\xd5\x65\xdb\x94\x5c\x6f\x76\x40\x04\xe3\xc4\x5d\x9a\xd5\xd9\x56\xef\x54\x7e\xbf\xd2\x8c\x0f\xdf\x9e\x2d\xfa\xce\xe0\x91\x72\x62\x88\x92\x75\xa1\x5f\x66\xf7\x0d\x79\x04\x46\x3e\xaf\xb0\x9c\x06\x7c\xab\x12\x9c\x61\xf8\xf5\x3d\x7e\x7f\xf7\x78\x4f\xdb\xd8\x56\x80\x4a\x3d\xe5\x19\x6a\x5e\xb3\x60\xfd\x83\xf4\x13\x43\x61\xee\x16\x35\xc4\xc3\xb9\x39\xc0\x84\xf7\xc3\x9e\x95\x39\xbd\xed\x1a\x07\xa5\x31\x39\x4a\x4e\xd6\x1a\x5f\x22\x04\xfb\xba\xe6\x5b\xf4\x28\x78\x28\xb9\x3d\xc7\xbf\x69\x48\xf8\xc9\x05\xeb\xc2\xe2\x3f\x9f\x15\xc8\xe3\x23\xce\x0b\x70\x97\x86\x13\x27\x19\x29\xb5\x3f\xed\x9c\xf0\x9b\xf3\xec\x2b\x1a\x69\x86\x91\xcd\xc6\x2f\x9b\x36\xf5\xd1\x18\xca\x41\x2e\x22\xfa\x85\xd7\xeb\x9d\x01\xff\xf7\x6f\xd9\x65\x99\xf8\x21\x52\x50\x9a\xe5\x2e\xda\x3f\x63\xf4\xb4\xae\x31\xc8\x77\x0d\xf6\xb1\xc0\x71\x44\x53\xa2\xd6\xb5\xe2\x4a\x5d\x8f\x94\x62\xf4\x68\x84\x52\x91\x28\x55\x97\x52\xf3\x5b\x0f\xec\x38\x9c\x52\x82\x75\xf4\x20\xd8\x6e\xed\x1d\x41\xa9\xa1\x4b\xa7\x71\xca\x03\x21\x90\x34\xc5\xa0\xb7\x25\x26\xaa\x51\x96\x98\x0b\x31\xa6\x6c\x12\x44\xac\xd4\x58\x52\xc6\xf1\x11\xa5\x48\x49\x2a\x35\xbe\xbd\xba\xbd\xd9\xa9\xec\x5b\x9d\x94\x8b\x92\x02\x0a\x6e\xa0\x61\x8e\x5a\x48\x37\x83\x78\xa5\xc6\x73\xf0\xdc\x9a\xe8\xa2\x56\x9e\xae\x18\x7e\x2a\x53\x45\xa9\xaa\x95\xda\x64\x40\x77\x16\x4c\x72\xe2\x0c\xf8\x76\x03\xa3\xc9\xcd\x06\x0a\x82\x2f\xb2\x3b\x59\xca\xe1\x23\xdf\x53\x78\x24\x92\x4a\x95\x03\x0d\x45\x7f\x30\xa6\x4a\x7d\xb8\xb9\xf9\xdf\xff\xb7\x82\x39\xb7\x64\xef\x2f\xf3\x80\xa4\xf7\xc7\xa9\x53\x94\xcb\x8c\x0b\x2e\x60\xda\xc6\x58\x9f\x62\x5b\xa3\xa1\x5c\x0d\x8b\xc2\xb7\x5b\x9e\x2c\x4c\x2a\xd1\xea\x94\x5c\x58\x4c\xdf\xda\xf8\x90\xbd\x7f\x68\x86\xcf\x4a\xfd\x3a\xbf\x27\x79\x48\xc8\x85\xf1\x9d\xd6\xa0\x81\x75\x8f\x77\xb5\x93\xc1\x8a\x52\x26\xe6\x4a\x5d\x5f\x5d\xd5\x83\xd5\x1a\x6b\x4a\xeb\x4a\x7d\xb8\xba\xfa\xec\x7a\x92\x84\x7f\x65\xe4\x6f\xc1\x30\x54\xd7\x10\xec\x0e\x40\xab\xc9\xa9\xe3\x6a\xa5\xf5\xea\x6e\x97\xae\xf2\xce\x62\x31\x25\x59\x26\xe4\x25\x79\x7b\xe7\xc2\x9c\x06\x0a\xc6\x53\xb6\x3a\x26\x5a\x39\x8b\xe9\x0e\x9e\x79\xb8\xff\xc9\x45\xdd\x0c\xad\xfa\xd9\xc9\x52\x7b\x32\xe0\x75\x3b\xa9\xde\x35\x2c\x1d\xe8\x77\x4d\xfa\xae\x8c\x7d\xa2\x9f\x81\x65\x47\xac\x02\x38\x03\x0f\xc1\xa0\x66\x57\x3b\x0f\xa9\xc1\xd6\x8b\x42\xab\xd3\x86\x5b\x9a\x97\xaf\xa8\x57\x66\x37\x9f\x40\x9f\x0b\xfb\x7a\xc1\xee\x78\xc5\xec\xb5\xc1\x24\x3b\x74\xa5\xea\xa2\xfb\x00\xb2\xac\xd4\x14\xc5\x4c\x99\xfd\xb4\xd1\x99\x1a\x68\x94\xdd\xbc\x7c\x93\x21\x4f\x4c\xda\xf1\xa4\x30\x05\xec\xef\xc1\xaf\x07\xf7\xa5\xfd\x02\xab\x46\x6f\xd9\x5d\x12\x6f\x8c\x6e\x57\x94\x8a\x47\x9c\x98\xe5\x60\x3d\xf6\xcc\x97\x30\x7d\x19\x14\xca\xf2\x1b\xd6\x10\xe2\x4a\x79\x17\xf2\xcb\xe8\x9f\x01\x00\x10\x48\x3d\x70\x16\x11\x00\x00")
+
+func clusterAutoscalerYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_clusterAutoscalerYaml,
+		"cluster-autoscaler.yaml",
+	)
+}
+
+func clusterAutoscalerYaml() (*asset, error) {
+	bytes, err := clusterAutoscalerYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cluster-autoscaler.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf6, 0xb9, 0xaa, 0x62, 0x35, 0x27, 0xda, 0x48, 0xf, 0xaf, 0x50, 0xf8, 0xc6, 0x70, 0x2c, 0x9c, 0xfb, 0xe9, 0x9d, 0xd0, 0xbb, 0xf7, 0x1b, 0x72, 0xc2, 0xdf, 0x45, 0x94, 0xda, 0x7, 0x31, 0xed}}
+	return a, nil
+}
+
 var _efaDevicePluginYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x8f\xdb\x36\x10\xbd\xfb\x57\x0c\x1c\xec\xad\x92\xba\xd9\x5d\x20\x50\x4f\xc6\xc6\x2d\x82\x6e\xb6\x41\x9d\xb4\x87\xa2\x87\x31\x39\xb2\x08\x53\x24\xcb\x19\xd9\xeb\xfe\xfa\x82\xb2\xac\x58\xce\x3a\xeb\x5e\x72\x8a\x2f\x16\xc8\xf7\xe6\xcd\x0c\x1f\x3f\xb2\x2c\x9b\x60\x30\x7f\x50\x64\xe3\x5d\x09\x18\x02\x17\x9b\xeb\xc9\xda\x38\x5d\xc2\x5b\xa4\xc6\xbb\x05\xc9\xa4\x21\x41\x8d\x82\xe5\x04\xc0\x61\x43\x25\xe0\x96\x33\xaa\x30\x5b\xbf\xe1\x4c\xd3\xc6\x28\xca\x82\x6d\x57\xc6\x65\xba\x63\x31\x49\x8f\xe5\x80\x8a\x4a\x58\xb7\x4b\xca\x78\xc7\x42\xcd\x84\x03\xa9\x14\x8a\xc9\x92\x12\x1f\xd3\x37\x40\x83\xa2\xea\x07\x5c\x92\xe5\xfd\xc0\x41\xeb\xbc\xd8\x04\xa0\x0d\x1a\x85\x16\x12\x51\x68\xb5\xdb\x13\x65\x17\xa8\x84\xdf\xbd\xb5\xc6\xad\x3e\x75\x80\x09\x80\x50\x13\x2c\x0a\xf5\x6a\x47\x25\xa5\xdf\x2b\xf8\x58\x1b\x06\x74\xce\x0b\x8a\xf1\x0e\x0c\x83\xa6\x10\x49\xa1\x90\xce\xe1\x57\x0a\x02\x35\x45\x82\xca\x47\x58\xa2\x5a\x6f\x31\x6a\x50\xbe\x09\x28\x66\x69\xac\x91\xdd\x10\x6b\x41\x04\xb5\x48\xe0\xb2\x28\x52\xe5\xd1\x91\x10\xe7\xc6\x17\xda\x2b\x2e\x04\x79\xcd\x05\xea\xc6\x38\xc3\x42\x31\x53\xb6\x4d\xff\xc5\xaa\xc5\x88\x4e\x88\x74\xc6\xaa\x26\xdd\xa6\x0a\x32\x15\x8d\x18\x85\x36\x43\xad\xbd\xcb\x82\xd7\x5c\xf4\x52\x9f\xf3\x1d\x9a\x06\xd0\x53\x29\xe6\x68\x43\x8d\xf9\x38\x83\x21\x5a\xf0\xba\x84\xe9\xb4\xa7\xd9\x51\xe7\x5f\x5c\xe7\x0e\x77\x58\xc8\xee\x9b\x62\x9a\x9e\x29\xe5\x5b\x27\x25\x68\xaa\xb0\xb5\xd2\xcf\x8a\xb7\x14\x4f\xf3\xcc\x60\x4d\xbb\x12\xee\xfb\x84\x66\xa9\x3a\xfe\xcd\xd9\xdd\x80\x00\xf0\x21\xf1\x7c\x2c\x61\xfe\x64\x58\xf8\x94\x8c\x5b\xce\xb1\xc1\x7f\xbd\xcb\x95\x6f\x0a\xaa\xf0\x12\x32\x00\x55\x15\x29\x29\xe1\xd1\x2f\xfa\x6e\x0d\x8b\xf7\x1e\xe3\x1a\x24\xb9\x21\x78\x0d\xc8\x80\x70\xe8\x19\xa0\xd6\x99\x77\x3f\xc1\xb6\x26\x07\xe4\x70\x69\x49\xff\x00\x52\xd3\x29\x64\x88\x36\x2c\x06\x44\x4a\x3d\x22\x4e\x1f\xbe\x8d\x8a\xb8\xb3\xd2\x09\x31\x89\x32\xb0\x07\xa9\x51\x52\xe4\x1d\x28\xfc\x1c\x6e\x49\x89\xde\xc7\xd4\x80\x95\x50\x04\x84\x0a\x8d\x6d\x23\xe5\xdf\xde\x81\x21\x1a\x1f\x8d\xec\xee\x2d\x32\x3f\x76\xa6\x99\xee\xf7\x79\xe6\xbc\xa6\x81\x7a\xf0\x19\x56\x95\x71\x46\x76\x47\x4e\xf3\x9a\x66\x5f\x8c\xa6\x22\xe6\x3f\xcf\x80\xdb\x10\x7c\x14\xd2\x60\x1c\x0b\x3a\x45\x5c\x0e\x95\xa5\x5a\xf2\x13\x0b\xcc\xfe\x5c\xcc\xef\x5f\x17\x69\xab\xb3\x14\x9f\x98\xe2\x2f\xad\xd1\x94\xac\x91\xd7\xd2\xd8\x57\xc9\xcf\x87\x58\x59\x3a\x2b\x8e\x7d\x11\xe9\x9f\xd6\x44\xd2\x6f\xdb\x68\xdc\x6a\x31\xb4\xe0\xdd\xca\xf9\x61\x78\xfe\x44\xaa\x4d\x66\x3e\x4e\x77\x5f\xc8\xa2\x3f\xd3\x3e\x52\x6c\x78\x3c\x9d\x3c\xdb\x1d\x72\xf3\xa7\x10\x89\x79\xbc\x19\x8e\x51\x9d\xb3\xa7\x4b\x92\xd3\xbd\x3b\x4a\x7b\xfa\x0c\xf7\xd8\xf3\xef\xdc\xb3\x80\x0d\xda\x96\x9e\x15\xde\x8b\xab\x3b\x97\x5f\xbf\x79\xb2\x18\x57\xf4\x55\x50\x3a\x42\xed\x59\xc4\xea\x56\xbf\x04\x31\x37\xe4\xf2\xd7\xb7\x2f\x48\x75\xa8\x17\x02\xb9\xea\xfa\xe5\x40\xcd\x9d\xbe\x40\xae\xb9\xbb\x00\x14\x6e\x2e\x09\x15\x2f\x12\x8c\x17\x09\xde\xea\x73\xa0\xff\x67\xaa\xe4\xd1\xef\xa6\xfa\x6e\xaa\x33\xa6\xaa\x3d\xcb\x23\xc9\xd6\xc7\x75\x09\x12\xdb\xc3\xb8\xf2\x4e\xd0\x38\x8a\xa3\xeb\xdb\x34\xb8\x4a\xe7\xfd\x15\xe7\x7a\x1d\x73\x52\x31\xbf\xe2\xfc\x8a\x0b\x4a\x97\xcb\xb9\xa7\x43\xb9\xf9\x31\xbf\xc9\x6f\x8e\x9d\x76\xc9\x63\x63\xff\x63\x52\x6d\x77\xdd\x78\x27\xf4\x24\x63\xc7\xa1\xb5\x7e\xfb\x21\x9a\x8d\xb1\xb4\xa2\x39\x2b\xb4\xdd\x9b\xa3\x84\x0a\x2d\x8f\x1b\xa1\x30\x60\xf7\x6a\x33\x5f\xfa\x56\x47\x1f\x4a\xf8\x6b\x3a\x7b\x78\x98\xfe\x7d\x34\xb7\xf1\xb6\x6d\xe8\x7d\x7a\xe0\x9c\x70\xb2\xbe\x84\x73\x69\xa7\x5f\x93\x78\x1f\x50\xea\x12\x8a\x0d\xc6\xc2\x9a\x65\x77\x35\x5b\x92\x62\xc4\x3b\xdc\x47\x7b\xb9\x51\xc7\xbf\xae\x92\x56\xaf\x13\x18\x29\x87\x8b\x24\xff\x0b\x00\x00\xff\xff\xf5\xa9\x92\x60\x0c\x0c\x00\x00")
 
 func efaDevicePluginYamlBytes() ([]byte, error) {
@@ -137,6 +159,26 @@ func nvidiaDevicePluginYaml() (*asset, error) {
 	return a, nil
 }
 
+var _spotInterruptionHandlerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x5b\x73\xe2\x36\x14\x7e\xf7\xaf\x38\x93\x77\x3b\x61\xb7\xed\xec\xf8\xcd\x01\x85\x7a\x36\x31\x14\xb3\xfb\xea\x11\xf2\x81\xa8\x91\x25\x55\x17\x02\xfd\xf5\x1d\x99\xab\x63\x36\xcb\xb6\xdd\x11\x33\x98\x73\x74\xbe\xef\x3b\x17\x4b\xc4\x71\x1c\x51\xcd\xbf\xa2\xb1\x5c\xc9\x14\xcc\x82\xb2\x84\x7a\xf7\xac\x0c\xff\x9b\x3a\xae\x64\xf2\xf2\xc9\x26\x5c\xdd\xae\x07\xd1\x0b\x97\x75\x0a\x43\xe1\xad\x43\x33\x53\x02\xa3\x06\x1d\xad\xa9\xa3\x69\x04\x20\x69\x83\x29\xe0\x8b\x65\x4e\xc4\x56\x2b\x17\x73\xe9\xd0\x18\xaf\x03\x4c\xfc\x4c\x65\x2d\xd0\x44\xc6\x0b\xb4\x69\x14\x03\xd5\x7c\x6c\x94\xd7\x36\x04\xc7\x70\x73\x13\x01\x18\xb4\xca\x1b\x86\x7b\x9b\x54\x35\xda\x08\x60\x8d\x66\xb1\x37\xad\xd0\xb5\xdf\x82\xdb\xdd\x83\xa6\x8e\x3d\xb7\x4f\x5e\xd7\xd4\xe1\x75\xc8\x5a\xd5\xef\x01\x5f\x8d\x71\x8b\x6b\xce\x42\x7e\x5d\x30\x66\xf0\xa2\x14\xdc\x38\x94\xa1\xd2\x81\x3c\x78\xb5\xed\xe3\xd6\x14\x1b\x25\x2d\xba\x0b\x0a\xaf\x12\x86\x6b\x94\xce\x5e\x56\xf4\x9f\xfa\x7d\xcf\x65\xcd\xe5\xea\x42\xdb\xdf\xe9\xb7\x12\x38\xc3\x65\x50\x71\x90\xfe\x0e\x6d\x04\xd0\x9f\xb2\xab\x67\xcb\xfa\xc5\x9f\xc8\x5c\x3b\x5e\x3b\x98\x12\xcd\x9a\x33\xcc\x18\x53\x5e\xba\x2b\xe4\xee\x76\x58\x4d\x19\xa6\xf0\xe2\x17\x18\xdb\xad\x75\xd8\xf4\x2a\x17\x7a\x77\x2a\xd2\x08\xb5\x50\xdb\x06\xa5\xfb\xa1\xe2\x7c\x93\xcd\x6a\x64\xa1\x64\x06\xb5\xe0\x8c\xda\x14\x06\x11\x80\x45\x81\xcc\x29\x13\x3c\x00\x4d\x98\xfc\x47\xba\x40\xd1\xf6\x3d\x98\xa8\xd6\x49\x10\x6d\x24\x3a\x6c\xdb\xf8\x7d\x05\x0e\x1b\x2d\xa8\xc3\x3d\xe8\x99\xfa\xf0\x5b\x74\xf0\xff\x1d\x03\xc0\x21\x9d\xb0\x6c\xa7\x25\xc5\x15\xd1\x00\xda\x70\x65\xb8\xdb\x0e\x05\xb5\x76\x1f\xd2\xb6\x25\x66\xbb\xd3\x28\x66\x86\x3b\xce\xa8\x38\x92\x30\xdf\x06\x28\xe9\x70\xe3\x4e\xfa\x97\x76\x3f\x82\x83\xbb\xbb\xbb\xbd\x95\x29\xe9\x28\x97\x68\x8e\x79\xc6\xfb\xce\xd1\x57\x1b\x87\x43\x28\x76\x68\x1a\x2e\xe9\x05\x69\x00\xbc\xa1\x2b\x4c\x41\xfb\x85\xe0\x2c\x41\x66\x12\xfa\x6a\x6f\x43\x28\xb2\x0f\xb7\xef\x41\xa4\xeb\x41\x32\xf8\x98\x7c\xec\x42\x4d\xbd\x10\x53\x25\x38\xdb\xa6\x90\x2f\x0b\xe5\xa6\x06\x6d\x98\x2c\x80\xef\x24\x17\xe6\x85\xd6\x13\x29\xb6\x33\xa5\xdc\x03\x17\xb8\x1b\xa7\x14\x9c\xf1\x78\xbe\xcd\xcb\xcc\x16\x4a\x86\x6d\x97\x9d\x5f\x2c\x9a\x4e\x91\x8e\x9e\x7e\xfd\xc2\xa2\x42\xa8\xd7\xa9\xe1\x6b\x2e\x70\x85\xc4\x32\x2a\xda\x54\x53\x58\x52\x61\x4f\xf0\x28\xd7\x27\xbd\x87\x32\x17\x93\x11\xa9\x8a\xec\x89\x1c\x3d\x00\x6b\x2a\x3c\x3e\x18\xd5\x9c\xa7\x07\xb0\xe4\x28\xea\xfd\x79\x72\xbe\x5a\xfb\x94\xba\xe7\x30\x8a\xc8\x92\xd0\xb5\x30\x28\x3d\xae\xe9\x64\xf4\xff\x51\x1d\xde\xf5\x44\x5e\xa2\x0a\x34\xe5\x34\x1b\xfe\x04\xae\xf6\xc8\xe8\x11\x92\x22\xbb\x7f\x24\x55\xf9\x47\x59\xcd\xc9\xec\x29\x2f\xb2\x79\x3e\x29\xaa\xd1\x2c\xcb\x8b\xbc\x18\x9f\x61\xb7\x32\x52\xb8\x09\xad\xbf\xe9\xe1\x0c\x7f\x27\xc3\xcf\x55\x56\x8e\xab\x79\x36\xae\xee\xc9\xc3\x64\x46\x7e\x1c\x65\x44\x1e\xc9\x9c\x54\x8f\x93\x61\xf6\x58\x8d\xb2\x79\x76\x75\x64\x3e\x2e\x5a\xc6\x8c\x3c\x4d\x8a\xaa\x24\xf3\xf2\xea\xd0\xd0\xde\xf3\xdc\xc7\xb3\x6c\x48\xaa\x29\x99\xe5\x93\xd1\x05\x90\x78\xd0\x87\x20\x4f\xf9\xbc\xfa\xfc\xe5\x9e\xcc\x0a\x32\x27\x65\x45\xbe\x92\xe2\x0a\x05\x9d\x4b\xf8\xb0\x0c\xfe\xe5\xd1\xba\x8e\x0d\x80\x69\x9f\xc2\xaf\x77\x4d\xc7\xd8\x60\xa3\xcc\x36\x85\xdf\x7e\x79\xe2\x67\x0e\xc1\x1b\x7e\x31\x7e\x70\xf7\x0d\x80\xc1\x87\x4f\x47\x04\xba\x5c\x72\xc9\xdd\xf6\x14\x1f\x5e\x8c\xac\x67\xdd\x29\xe5\x06\xeb\x91\x37\x5c\xae\x4a\xf6\x8c\xb5\x17\x5c\xae\xf2\x95\x54\x47\x33\xd9\x20\xf3\xed\x6b\x7d\x16\xb9\xc3\x2c\xf7\x77\xd3\x1c\x4d\xf3\x46\x6e\xbc\xbb\xaa\xc8\x46\x1b\xb4\xed\xbf\x9f\xae\x3f\x94\xfe\x05\xb7\x29\x74\x2f\x16\x65\xdf\xec\x02\x50\x1a\x0d\x0d\x17\x20\xe4\xb2\xe7\x6c\xa7\xf1\x0d\x75\xf8\xc4\x20\xb8\xf4\x9b\xe8\x9f\x01\x00\xc9\x08\x09\x24\xec\x0a\x00\x00")
+
+func spotInterruptionHandlerYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_spotInterruptionHandlerYaml,
+		"spot-interruption-handler.yaml",
+	)
+}
+
+func spotInterruptionHandlerYaml() (*asset, error) {
+	bytes, err := spotInterruptionHandlerYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "spot-interruption-handler.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6d, 0xd5, 0xea, 0x0, 0xf1, 0x56, 0x90, 0x5f, 0x12, 0x7, 0xa7, 0x4, 0xe5, 0xa8, 0x92, 0xa0, 0xc4, 0x83, 0x1f, 0x98, 0xc1, 0xa4, 0xae, 0x28, 0x27, 0xd, 0x45, 0xfa, 0x59, 0x83, 0xce, 0xad}}
+	return a, nil
+}
+
 var _vpcAdmissionWebhookConfigYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x4f\x6b\xf3\x30\x0c\xc6\xef\xf9\x14\x22\xf7\xa4\xf4\xf6\xe2\xdb\x4b\x29\x63\x87\xc1\x18\x63\x3b\x8c\x1d\x14\x47\x4d\x45\x62\xcb\x58\x76\x4a\xf7\xe9\x47\xfe\xb4\xac\xb0\xd5\x17\xdb\x7a\xa4\xdf\x63\xc9\x18\xf8\x8d\xa2\xb2\x78\x03\xd8\x3a\xd6\xe9\x18\xa9\x63\x4d\x11\x13\x8b\xaf\xfb\x7f\x5a\xb3\x6c\xc6\x6d\x43\x09\xb7\x45\xcf\xbe\x35\xf0\x94\x13\x26\xf6\xdd\x3b\x35\x47\x91\x7e\x27\xfe\xc0\x5d\x5e\x2a\x0a\x47\x09\x5b\x4c\x68\x0a\x00\x8f\x8e\x0c\x8c\xc1\x56\x57\x7a\x75\x5a\x8a\x2a\x7b\xe8\xd6\x0c\x0d\x68\xc9\x40\x9f\x1b\xaa\xf4\xac\x89\x5c\x01\x30\x60\x43\x83\x4e\x10\x00\x0c\xe1\x0f\x4a\xb1\xee\x73\x62\x75\xcf\xaf\x46\x87\x5f\xe2\xf1\xa4\xb5\x15\x37\x63\xed\xc0\xe4\xd3\xf2\xfa\xc5\x08\x40\x29\x8e\x6c\xe9\x72\xbd\xdb\xc2\x4d\xce\xaf\x4d\x2c\x2b\x60\x3a\x1a\x28\x37\x6e\x1a\x1b\x95\x73\x3c\xe6\x81\xf4\xe2\x52\x81\x04\x5a\xc6\xa7\x06\x3e\xa0\xdc\xbd\xec\xff\xbf\xee\x4b\xf8\xbc\x32\x30\xf0\x43\x94\x1c\x26\xbd\x2c\x6f\xe2\xeb\x0f\xce\xca\xb8\xfd\xa1\x45\x52\xc9\xd1\xd2\xac\x04\x69\x75\xd5\x0e\xc8\x43\x8e\xf4\x2c\x03\xdb\xb3\x81\xc7\xce\x4b\xa4\xe2\x3b\x00\x00\xff\xff\x49\xee\x9e\x02\x0c\x02\x00\x00")
 
 func vpcAdmissionWebhookConfigYamlBytes() ([]byte, error) {
@@ -348,9 +390,11 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"cluster-autoscaler.yaml":           clusterAutoscalerYaml,
 	"efa-device-plugin.yaml":            efaDevicePluginYaml,
 	"neuron-device-plugin.yaml":         neuronDevicePluginYaml,
 	"nvidia-device-plugin.yaml":         nvidiaDevicePluginYaml,
+	"spot-interruption-handler.yaml":    spotInterruptionHandlerYaml,
 	"vpc-admission-webhook-config.yaml": vpcAdmissionWebhookConfigYaml,
 	"vpc-admission-webhook-csr.yaml":    vpcAdmissionWebhookCsrYaml,
 	"vpc-admission-webhook-dep.yaml":    vpcAdmissionWebhookDepYaml,
@@ -403,9 +447,11 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
+	"cluster-autoscaler.yaml": {clusterAutoscalerYaml, map[string]*bintree{}},
 	"efa-device-plugin.yaml": {efaDevicePluginYaml, map[string]*bintree{}},
 	"neuron-device-plugin.yaml": {neuronDevicePluginYaml, map[string]*bintree{}},
 	"nvidia-device-plugin.yaml": {nvidiaDevicePluginYaml, map[string]*bintree{}},
+	"spot-interruption-handler.yaml": {spotInterruptionHandlerYaml, map[string]*bintree{}},
 	"vpc-admission-webhook-config.yaml": {vpcAdmissionWebhookConfigYaml, map[string]*bintree{}},
 	"vpc-admission-webhook-csr.yaml": {vpcAdmissionWebhookCsrYaml, map[string]*bintree{}},
 	"vpc-admission-webhook-dep.yaml": {vpcAdmissionWebhookDepYaml, map[string]*bintree{}},
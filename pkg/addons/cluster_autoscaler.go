@@ -0,0 +1,224 @@
+package addons
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/assetutil"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	clusterAutoscalerNamespace = metav1.NamespaceSystem
+	clusterAutoscalerImage     = "k8s.gcr.io/autoscaling/cluster-autoscaler"
+)
+
+// clusterAutoscalerVersions are the releases of cluster-autoscaler matching each Kubernetes version
+var clusterAutoscalerVersions = map[string]string{
+	api.Version1_16: "v1.16.7",
+	api.Version1_17: "v1.17.4",
+	api.Version1_18: "v1.18.3",
+	api.Version1_19: "v1.19.1",
+	api.Version1_20: "v1.20.0",
+	api.Version1_21: "v1.21.0",
+}
+
+// NewClusterAutoscaler creates a new ClusterAutoscaler. If version is empty, the release of cluster-autoscaler
+// matching the Kubernetes version of the cluster is deployed
+func NewClusterAutoscaler(rawClient kubernetes.RawClientInterface, irsa IRSAHelper, clusterMeta *api.ClusterMeta, version string, planMode bool) *ClusterAutoscaler {
+	return &ClusterAutoscaler{
+		rawClient:   rawClient,
+		irsa:        irsa,
+		clusterMeta: clusterMeta,
+		version:     version,
+		planMode:    planMode,
+	}
+}
+
+// A ClusterAutoscaler deploys cluster-autoscaler to a cluster, with an IAM role restricted to the ASGs of the cluster
+type ClusterAutoscaler struct {
+	rawClient   kubernetes.RawClientInterface
+	irsa        IRSAHelper
+	clusterMeta *api.ClusterMeta
+	version     string
+	planMode    bool
+}
+
+// Deploy deploys cluster-autoscaler and its IAM service account
+func (c *ClusterAutoscaler) Deploy() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if ae, ok := r.(*assetutil.Error); ok {
+				err = ae
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	image, err := c.image()
+	if err != nil {
+		return err
+	}
+
+	irsaEnabled, err := c.irsa.IsSupported()
+	if err != nil {
+		return err
+	}
+	if !irsaEnabled {
+		return errors.Errorf("%s requires an IAM OIDC provider associated with the cluster; "+
+			"set iam.withOIDC or run `eksctl utils associate-iam-oidc-provider`", api.ClusterAutoscalerAddon)
+	}
+
+	sa := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      api.ClusterAutoscalerAddon,
+			Namespace: clusterAutoscalerNamespace,
+		},
+		AttachPolicy: c.makePolicyDocument(),
+	}
+	if err := c.irsa.CreateOrUpdate(sa); err != nil {
+		return errors.Wrap(err, "error enabling IRSA")
+	}
+
+	list, err := kubernetes.NewList(assetutil.MustLoad(clusterAutoscalerYamlBytes))
+	if err != nil {
+		return err
+	}
+	for _, item := range list.Items {
+		rawResource, err := c.rawClient.NewRawResource(item.Object)
+		if err != nil {
+			return err
+		}
+		if deployment, ok := rawResource.Info.Object.(*appsv1.Deployment); ok {
+			c.customiseDeployment(deployment, image)
+		}
+		msg, err := rawResource.CreateOrReplace(c.planMode)
+		if err != nil {
+			return err
+		}
+		logger.Info(msg)
+	}
+	return nil
+}
+
+// Delete deletes cluster-autoscaler and its IAM service account
+func (c *ClusterAutoscaler) Delete() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if ae, ok := r.(*assetutil.Error); ok {
+				err = ae
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	list, err := kubernetes.NewList(assetutil.MustLoad(clusterAutoscalerYamlBytes))
+	if err != nil {
+		return err
+	}
+	for _, item := range list.Items {
+		rawResource, err := c.rawClient.NewRawResource(item.Object)
+		if err != nil {
+			return err
+		}
+		msg, err := rawResource.DeleteSync()
+		if err != nil {
+			return errors.Wrapf(err, "deleting %q", rawResource)
+		}
+		if msg != "" {
+			logger.Info(msg)
+		}
+	}
+
+	sa := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      api.ClusterAutoscalerAddon,
+			Namespace: clusterAutoscalerNamespace,
+		},
+	}
+	return errors.Wrap(c.irsa.Delete(sa), "error deleting IRSA")
+}
+
+// InstalledVersion returns the version of cluster-autoscaler deployed to the cluster, read from the tag of its
+// image, and false if it isn't deployed
+func (c *ClusterAutoscaler) InstalledVersion() (string, bool, error) {
+	deployment, err := c.rawClient.ClientSet().AppsV1().Deployments(clusterAutoscalerNamespace).Get(context.TODO(), api.ClusterAutoscalerAddon, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, errors.Wrapf(err, "getting %s deployment", api.ClusterAutoscalerAddon)
+	}
+	var version string
+	if containers := deployment.Spec.Template.Spec.Containers; len(containers) > 0 {
+		if i := strings.LastIndex(containers[0].Image, ":"); i >= 0 {
+			version = containers[0].Image[i+1:]
+		}
+	}
+	return version, true, nil
+}
+
+func (c *ClusterAutoscaler) image() (string, error) {
+	version := c.version
+	if version == "" {
+		var ok bool
+		version, ok = clusterAutoscalerVersions[c.clusterMeta.Version]
+		if !ok {
+			return "", fmt.Errorf("no release of %s is known for Kubernetes version %q, specify the version to deploy", api.ClusterAutoscalerAddon, c.clusterMeta.Version)
+		}
+	} else if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	return fmt.Sprintf("%s:%s", clusterAutoscalerImage, version), nil
+}
+
+// customiseDeployment sets the image of cluster-autoscaler, and the ASG tags it discovers the nodegroups of the cluster by
+func (c *ClusterAutoscaler) customiseDeployment(deployment *appsv1.Deployment, image string) {
+	container := &deployment.Spec.Template.Spec.Containers[0]
+	container.Image = image
+	container.Command = append(container.Command,
+		fmt.Sprintf("--node-group-auto-discovery=asg:tag=%s,%s", api.ClusterAutoscalerEnabledTag, api.ClusterAutoscalerClusterTag(c.clusterMeta.Name)),
+	)
+}
+
+// makePolicyDocument allows scaling only the ASGs tagged as owned by the cluster
+func (c *ClusterAutoscaler) makePolicyDocument() map[string]interface{} {
+	return cft.MakePolicyDocument(
+		cft.MapOfInterfaces{
+			"Effect": "Allow",
+			"Action": []string{
+				"autoscaling:SetDesiredCapacity",
+				"autoscaling:TerminateInstanceInAutoScalingGroup",
+			},
+			"Resource": "*",
+			"Condition": map[string]interface{}{
+				"StringEquals": map[string]string{
+					"aws:ResourceTag/" + api.ClusterAutoscalerClusterTag(c.clusterMeta.Name): "owned",
+				},
+			},
+		},
+		cft.MapOfInterfaces{
+			"Effect": "Allow",
+			"Action": []string{
+				"autoscaling:DescribeAutoScalingGroups",
+				"autoscaling:DescribeAutoScalingInstances",
+				"autoscaling:DescribeLaunchConfigurations",
+				"autoscaling:DescribeTags",
+				"ec2:DescribeInstanceTypes",
+				"ec2:DescribeLaunchTemplateVersions",
+			},
+			"Resource": "*",
+		},
+	)
+}
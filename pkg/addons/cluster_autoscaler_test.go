@@ -0,0 +1,52 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	"github.com/weaveworks/eksctl/pkg/addons/fakes"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("ClusterAutoscaler", func() {
+	var (
+		rawClient         *testutils.FakeRawClient
+		fakeIRSAHelper    *fakes.FakeIRSAHelper
+		clusterAutoscaler *addons.ClusterAutoscaler
+	)
+
+	BeforeEach(func() {
+		rawClient = testutils.NewFakeRawClient()
+		rawClient.UseUnionTracker = true
+		fakeIRSAHelper = new(fakes.FakeIRSAHelper)
+		fakeIRSAHelper.IsSupportedReturns(true, nil)
+		clusterMeta := &api.ClusterMeta{Name: "my-cluster", Version: api.Version1_20}
+		clusterAutoscaler = addons.NewClusterAutoscaler(rawClient, fakeIRSAHelper, clusterMeta, "", false)
+	})
+
+	It("returns the version of the deployed cluster-autoscaler", func() {
+		_, ok, err := clusterAutoscaler.InstalledVersion()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+
+		Expect(clusterAutoscaler.Deploy()).To(Succeed())
+
+		version, ok, err := clusterAutoscaler.InstalledVersion()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(version).To(Equal("v1.20.0"))
+	})
+
+	It("deletes the resources and the IAM service account of cluster-autoscaler", func() {
+		Expect(clusterAutoscaler.Deploy()).To(Succeed())
+
+		Expect(clusterAutoscaler.Delete()).To(Succeed())
+
+		Expect(rawClient.Collection.Deleted()).To(HaveLen(len(rawClient.Collection.Created())))
+		Expect(rawClient.Collection.Deleted()).To(HaveKey("DELETE [/namespaces/kube-system/deployments/cluster-autoscaler] (cluster-autoscaler)"))
+		Expect(fakeIRSAHelper.DeleteCallCount()).To(Equal(1))
+		Expect(fakeIRSAHelper.DeleteArgsForCall(0).NameString()).To(Equal("kube-system/cluster-autoscaler"))
+	})
+})
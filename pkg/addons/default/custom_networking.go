@@ -0,0 +1,122 @@
+package defaultaddons
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	// customNetworkEnv enables VPC CNI custom networking in aws-node
+	customNetworkEnv = "AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG"
+	// eniConfigLabelDefEnv is the node label aws-node takes the name of the ENIConfig of a node from
+	eniConfigLabelDefEnv = "ENI_CONFIG_LABEL_DEF"
+
+	eniConfigAPIVersion = "crd.k8s.amazonaws.com/v1alpha1"
+	eniConfigKind       = "ENIConfig"
+)
+
+// MakeENIConfigs returns an ENIConfig for each pod subnet, named after the availability zone of the subnet
+// so that aws-node selects the ENIConfig of a node by its zone label
+func MakeENIConfigs(podSubnets api.AZSubnetMapping, securityGroups []string) []*unstructured.Unstructured {
+	var eniConfigs []*unstructured.Unstructured
+	for _, name := range podSubnets.Names() {
+		subnet := podSubnets[name]
+		groups := make([]interface{}, 0, len(securityGroups))
+		for _, sg := range securityGroups {
+			groups = append(groups, sg)
+		}
+		eniConfigs = append(eniConfigs, &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": eniConfigAPIVersion,
+				"kind":       eniConfigKind,
+				"metadata": map[string]interface{}{
+					"name": subnet.AZ,
+				},
+				"spec": map[string]interface{}{
+					"subnet":         subnet.ID,
+					"securityGroups": groups,
+				},
+			},
+		})
+	}
+	return eniConfigs
+}
+
+// EnableCustomNetworking creates the ENIConfigs of the pod subnets and enables custom networking in aws-node,
+// which then assigns pods the IP addresses of the pod subnet in the zone of their node
+func EnableCustomNetworking(rawClient kubernetes.RawClientInterface, podSubnets api.AZSubnetMapping, securityGroups []string, plan bool) error {
+	for _, eniConfig := range MakeENIConfigs(podSubnets, securityGroups) {
+		rawResource, err := rawClient.NewRawResource(eniConfig)
+		if err != nil {
+			return err
+		}
+		msg, err := rawResource.CreateOrReplace(plan)
+		if err != nil {
+			return err
+		}
+		logger.Info(msg)
+	}
+
+	daemonSets := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem)
+	awsNode, err := daemonSets.Get(context.TODO(), AWSNode, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "getting %q", AWSNode)
+	}
+	if !setCustomNetworkingEnv(awsNode) {
+		logger.Info("custom networking is already enabled in %q", AWSNode)
+		return nil
+	}
+	if plan {
+		logger.Info("(plan) would have enabled custom networking in %q", AWSNode)
+		return nil
+	}
+	if _, err := daemonSets.Update(context.TODO(), awsNode, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "enabling custom networking in %q", AWSNode)
+	}
+	logger.Info("enabled custom networking in %q", AWSNode)
+	return nil
+}
+
+// setCustomNetworkingEnv sets the environment variables of the aws-node container for custom networking, and
+// reports whether any was changed
+func setCustomNetworkingEnv(awsNode *appsv1.DaemonSet) bool {
+	changed := false
+	containers := awsNode.Spec.Template.Spec.Containers
+	for i := range containers {
+		if containers[i].Name != AWSNode {
+			continue
+		}
+		for _, env := range []corev1.EnvVar{
+			{Name: customNetworkEnv, Value: "true"},
+			{Name: eniConfigLabelDefEnv, Value: corev1.LabelTopologyZone},
+		} {
+			if setEnv(&containers[i], env) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+func setEnv(container *corev1.Container, env corev1.EnvVar) bool {
+	for i, e := range container.Env {
+		if e.Name == env.Name {
+			if e.Value == env.Value && e.ValueFrom == nil {
+				return false
+			}
+			container.Env[i] = env
+			return true
+		}
+	}
+	container.Env = append(container.Env, env)
+	return true
+}
@@ -0,0 +1,31 @@
+package defaultaddons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("default addons - custom networking", func() {
+	It("makes an ENIConfig for each pod subnet, named after its availability zone", func() {
+		podSubnets := api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+			"us-west-2b": {ID: "subnet-b"},
+			"us-west-2a": {ID: "subnet-a"},
+		})
+
+		eniConfigs := MakeENIConfigs(podSubnets, []string{"sg-1"})
+		Expect(eniConfigs).To(HaveLen(2))
+
+		eniConfig := eniConfigs[0]
+		Expect(eniConfig.GetAPIVersion()).To(Equal("crd.k8s.amazonaws.com/v1alpha1"))
+		Expect(eniConfig.GetKind()).To(Equal("ENIConfig"))
+		Expect(eniConfig.GetName()).To(Equal("us-west-2a"))
+		Expect(eniConfig.Object["spec"]).To(Equal(map[string]interface{}{
+			"subnet":         "subnet-a",
+			"securityGroups": []interface{}{"sg-1"},
+		}))
+		Expect(eniConfigs[1].GetName()).To(Equal("us-west-2b"))
+	})
+})
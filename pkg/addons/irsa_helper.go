@@ -12,10 +12,13 @@ import (
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 )
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate -o fakes/fake_irsa_helper.go . IRSAHelper
 // IRSAHelper provides methods for enabling IRSA
 type IRSAHelper interface {
 	IsSupported() (bool, error)
 	CreateOrUpdate(serviceAccounts *api.ClusterIAMServiceAccount) error
+	Delete(serviceAccount *api.ClusterIAMServiceAccount) error
 }
 
 // irsaHelper applies the annotations required for a ServiceAccount to work with IRSA
@@ -60,6 +63,11 @@ func (h *irsaHelper) CreateOrUpdate(sa *api.ClusterIAMServiceAccount) error {
 	return err
 }
 
+// Delete deletes the IAM role stack and the service account of an IAM service account, if they exist
+func (h *irsaHelper) Delete(sa *api.ClusterIAMServiceAccount) error {
+	return h.irsaManager.Delete([]string{sa.NameString()}, false, true)
+}
+
 func makeIAMServiceAccountStackName(clusterName, namespace, name string) string {
 	return fmt.Sprintf("eksctl-%s-addon-iamserviceaccount-%s-%s", clusterName, namespace, name)
 }
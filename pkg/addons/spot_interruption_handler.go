@@ -0,0 +1,222 @@
+package addons
+
+import (
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/assetutil"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	spotInterruptionHandlerNamespace = metav1.NamespaceSystem
+	spotInterruptionHandlerName      = "spot-interruption-handler"
+)
+
+// NewSpotInterruptionHandler creates a new SpotInterruptionHandler for the nodegroup
+func NewSpotInterruptionHandler(rawClient kubernetes.RawClientInterface, irsa IRSAHelper, region, nodeGroupName, queueURL, queueARN string, planMode bool) *SpotInterruptionHandler {
+	return &SpotInterruptionHandler{
+		rawClient:     rawClient,
+		irsa:          irsa,
+		region:        region,
+		nodeGroupName: nodeGroupName,
+		queueURL:      queueURL,
+		queueARN:      queueARN,
+		planMode:      planMode,
+	}
+}
+
+// A SpotInterruptionHandler deploys the AWS Node Termination Handler, processing the queue of spot
+// interruption, rebalance and termination events of a nodegroup
+type SpotInterruptionHandler struct {
+	rawClient     kubernetes.RawClientInterface
+	irsa          IRSAHelper
+	region        string
+	nodeGroupName string
+	queueURL      string
+	queueARN      string
+	planMode      bool
+}
+
+// SpotInterruptionHandlerName returns the name of the spot interruption handler of the nodegroup, used
+// for its deployment and service account
+func SpotInterruptionHandlerName(nodeGroupName string) string {
+	return fmt.Sprintf("%s-%s", spotInterruptionHandlerName, nodeGroupName)
+}
+
+// Deploy deploys the spot interruption handler of the nodegroup, and its IAM service account
+func (h *SpotInterruptionHandler) Deploy() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if ae, ok := r.(*assetutil.Error); ok {
+				err = ae
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	irsaEnabled, err := h.irsa.IsSupported()
+	if err != nil {
+		return err
+	}
+	if !irsaEnabled {
+		return errors.New("the spot interruption handler requires an IAM OIDC provider associated with the cluster; " +
+			"set iam.withOIDC or run `eksctl utils associate-iam-oidc-provider`")
+	}
+
+	name := SpotInterruptionHandlerName(h.nodeGroupName)
+	sa := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      name,
+			Namespace: spotInterruptionHandlerNamespace,
+		},
+		AttachPolicy: h.makePolicyDocument(),
+	}
+	if err := h.irsa.CreateOrUpdate(sa); err != nil {
+		return errors.Wrap(err, "error enabling IRSA")
+	}
+
+	resources, err := h.rawResources(name)
+	if err != nil {
+		return err
+	}
+	for _, rawResource := range resources {
+		msg, err := rawResource.CreateOrReplace(h.planMode)
+		if err != nil {
+			return err
+		}
+		logger.Info(msg)
+	}
+	return nil
+}
+
+// Delete deletes the spot interruption handler of the nodegroup and its IAM service account; the cluster role is
+// shared by the handlers of all nodegroups and is kept
+func (h *SpotInterruptionHandler) Delete() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if ae, ok := r.(*assetutil.Error); ok {
+				err = ae
+			} else {
+				panic(r)
+			}
+		}
+	}()
+
+	name := SpotInterruptionHandlerName(h.nodeGroupName)
+	resources, err := h.rawResources(name)
+	if err != nil {
+		return err
+	}
+	for _, rawResource := range resources {
+		if _, ok := rawResource.Info.Object.(*rbacv1.ClusterRole); ok {
+			continue
+		}
+		msg, err := rawResource.DeleteSync()
+		if err != nil {
+			return errors.Wrapf(err, "deleting %q", rawResource)
+		}
+		if msg != "" {
+			logger.Info(msg)
+		}
+	}
+
+	sa := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      name,
+			Namespace: spotInterruptionHandlerNamespace,
+		},
+	}
+	return errors.Wrap(h.irsa.Delete(sa), "error deleting IRSA")
+}
+
+// rawResources returns the resources of the spot interruption handler of the nodegroup
+func (h *SpotInterruptionHandler) rawResources(name string) ([]*kubernetes.RawResource, error) {
+	list, err := kubernetes.NewList(assetutil.MustLoad(spotInterruptionHandlerYamlBytes))
+	if err != nil {
+		return nil, err
+	}
+	var resources []*kubernetes.RawResource
+	for _, item := range list.Items {
+		// objects are named after the nodegroup before creating their resources, which are looked up by name
+		switch object := item.Object.(type) {
+		case *rbacv1.ClusterRoleBinding:
+			object.Name = name
+			object.Subjects[0].Name = name
+		case *appsv1.Deployment:
+			h.customiseDeployment(object, name)
+		}
+		rawResource, err := h.rawClient.NewRawResource(item.Object)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, rawResource)
+	}
+	return resources, nil
+}
+
+// customiseDeployment names the deployment after the nodegroup and points it at the queue of the nodegroup
+func (h *SpotInterruptionHandler) customiseDeployment(deployment *appsv1.Deployment, name string) {
+	deployment.Name = name
+	labels := map[string]string{
+		"app.kubernetes.io/name": name,
+	}
+	deployment.Spec.Selector.MatchLabels = labels
+	deployment.Spec.Template.Labels = labels
+	deployment.Spec.Template.Spec.ServiceAccountName = name
+
+	container := &deployment.Spec.Template.Spec.Containers[0]
+	container.Env = append(container.Env,
+		corev1.EnvVar{Name: "AWS_REGION", Value: h.region},
+		corev1.EnvVar{Name: "QUEUE_URL", Value: h.queueURL},
+		corev1.EnvVar{Name: "MANAGED_ASG_TAG", Value: api.SpotInterruptionHandlerTagPrefix + h.nodeGroupName},
+	)
+
+	// avoid running the handler on the spot nodes it drains
+	deployment.Spec.Template.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = []corev1.PreferredSchedulingTerm{
+		{
+			Weight: 100,
+			Preference: corev1.NodeSelectorTerm{
+				MatchExpressions: []corev1.NodeSelectorRequirement{
+					{
+						Key:      api.NodeGroupNameLabel,
+						Operator: corev1.NodeSelectorOpNotIn,
+						Values:   []string{h.nodeGroupName},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (h *SpotInterruptionHandler) makePolicyDocument() map[string]interface{} {
+	return cft.MakePolicyDocument(
+		cft.MapOfInterfaces{
+			"Effect": "Allow",
+			"Action": []string{
+				"sqs:DeleteMessage",
+				"sqs:ReceiveMessage",
+			},
+			"Resource": h.queueARN,
+		},
+		cft.MapOfInterfaces{
+			"Effect": "Allow",
+			"Action": []string{
+				"autoscaling:CompleteLifecycleAction",
+				"autoscaling:DescribeAutoScalingInstances",
+				"autoscaling:DescribeTags",
+				"ec2:DescribeInstances",
+			},
+			"Resource": "*",
+		},
+	)
+}
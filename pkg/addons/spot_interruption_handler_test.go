@@ -0,0 +1,121 @@
+package addons_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	"github.com/weaveworks/eksctl/pkg/addons/fakes"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("SpotInterruptionHandler", func() {
+	const (
+		queueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/ng-1-queue"
+		queueARN = "arn:aws:sqs:us-west-2:123456789012:ng-1-queue"
+	)
+
+	var (
+		rawClient      *testutils.FakeRawClient
+		fakeIRSAHelper *fakes.FakeIRSAHelper
+		handler        *addons.SpotInterruptionHandler
+	)
+
+	BeforeEach(func() {
+		rawClient = testutils.NewFakeRawClient()
+		rawClient.UseUnionTracker = true
+		fakeIRSAHelper = new(fakes.FakeIRSAHelper)
+		fakeIRSAHelper.IsSupportedReturns(true, nil)
+		handler = addons.NewSpotInterruptionHandler(rawClient, fakeIRSAHelper, "us-west-2", "ng-1", queueURL, queueARN, false)
+	})
+
+	Describe("Deploy", func() {
+		It("creates the IAM service account and the resources of the handler, named after the nodegroup", func() {
+			Expect(handler.Deploy()).To(Succeed())
+
+			Expect(fakeIRSAHelper.CreateOrUpdateCallCount()).To(Equal(1))
+			sa := fakeIRSAHelper.CreateOrUpdateArgsForCall(0)
+			Expect(sa.NameString()).To(Equal("kube-system/spot-interruption-handler-ng-1"))
+			Expect(sa.AttachPolicy["Statement"]).To(ContainElement(HaveKeyWithValue("Resource", queueARN)))
+
+			Expect(rawClient.Collection.Created()).To(HaveLen(3))
+			Expect(rawClient.Collection.Created()).To(HaveKey("POST [/clusterroles] (eksctl-spot-interruption-handler)"))
+			Expect(rawClient.Collection.Created()).To(HaveKey("POST [/clusterrolebindings] (spot-interruption-handler-ng-1)"))
+			Expect(rawClient.Collection.Created()).To(HaveKey("POST [/namespaces/kube-system/deployments] (spot-interruption-handler-ng-1)"))
+
+			binding := rawClient.Collection.Created()["POST [/clusterrolebindings] (spot-interruption-handler-ng-1)"].(*rbacv1.ClusterRoleBinding)
+			Expect(binding.Subjects[0].Name).To(Equal("spot-interruption-handler-ng-1"))
+
+			deployment := rawClient.Collection.Created()["POST [/namespaces/kube-system/deployments] (spot-interruption-handler-ng-1)"].(*appsv1.Deployment)
+			Expect(deployment.Spec.Template.Spec.ServiceAccountName).To(Equal("spot-interruption-handler-ng-1"))
+			Expect(deployment.Spec.Selector.MatchLabels).To(Equal(deployment.Spec.Template.Labels))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+				corev1.EnvVar{Name: "AWS_REGION", Value: "us-west-2"},
+				corev1.EnvVar{Name: "QUEUE_URL", Value: queueURL},
+				corev1.EnvVar{Name: "MANAGED_ASG_TAG", Value: api.SpotInterruptionHandlerTagPrefix + "ng-1"},
+			))
+		})
+
+		It("deploys a separate handler for each nodegroup", func() {
+			Expect(handler.Deploy()).To(Succeed())
+			other := addons.NewSpotInterruptionHandler(rawClient, fakeIRSAHelper, "us-west-2", "ng-2", queueURL, queueARN, false)
+			Expect(other.Deploy()).To(Succeed())
+
+			Expect(rawClient.Collection.Created()).To(HaveKey("POST [/namespaces/kube-system/deployments] (spot-interruption-handler-ng-1)"))
+			Expect(rawClient.Collection.Created()).To(HaveKey("POST [/namespaces/kube-system/deployments] (spot-interruption-handler-ng-2)"))
+			Expect(rawClient.Collection.Updated()).To(ConsistOf(BeAssignableToTypeOf(&rbacv1.ClusterRole{})))
+		})
+
+		It("replaces the resources of the handler when deployed again", func() {
+			Expect(handler.Deploy()).To(Succeed())
+			Expect(handler.Deploy()).To(Succeed())
+
+			Expect(rawClient.Collection.Created()).To(HaveLen(3))
+			Expect(rawClient.Collection.Updated()).To(HaveKey("PUT [/clusterrolebindings/spot-interruption-handler-ng-1] (spot-interruption-handler-ng-1)"))
+			Expect(rawClient.Collection.Updated()).To(HaveKey("PUT [/namespaces/kube-system/deployments/spot-interruption-handler-ng-1] (spot-interruption-handler-ng-1)"))
+		})
+
+		It("fails without an IAM OIDC provider", func() {
+			fakeIRSAHelper.IsSupportedReturns(false, nil)
+
+			Expect(handler.Deploy()).To(MatchError(ContainSubstring("requires an IAM OIDC provider")))
+			Expect(fakeIRSAHelper.CreateOrUpdateCallCount()).To(Equal(0))
+			Expect(rawClient.Collection.Created()).To(BeEmpty())
+		})
+
+		It("does not create the resources if the IAM service account can't be created", func() {
+			fakeIRSAHelper.CreateOrUpdateReturns(errors.New("stack failed"))
+
+			Expect(handler.Deploy()).To(MatchError(ContainSubstring("stack failed")))
+			Expect(rawClient.Collection.Created()).To(BeEmpty())
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the resources and the IAM service account of the handler, keeping the shared cluster role", func() {
+			Expect(handler.Deploy()).To(Succeed())
+
+			Expect(handler.Delete()).To(Succeed())
+
+			Expect(rawClient.Collection.Deleted()).To(HaveLen(2))
+			Expect(rawClient.Collection.Deleted()).To(HaveKey("DELETE [/clusterrolebindings/spot-interruption-handler-ng-1] (spot-interruption-handler-ng-1)"))
+			Expect(rawClient.Collection.Deleted()).To(HaveKey("DELETE [/namespaces/kube-system/deployments/spot-interruption-handler-ng-1] (spot-interruption-handler-ng-1)"))
+
+			Expect(fakeIRSAHelper.DeleteCallCount()).To(Equal(1))
+			Expect(fakeIRSAHelper.DeleteArgsForCall(0).NameString()).To(Equal("kube-system/spot-interruption-handler-ng-1"))
+		})
+
+		It("deletes the IAM service account if the resources do not exist", func() {
+			Expect(handler.Delete()).To(Succeed())
+
+			Expect(rawClient.Collection.Deleted()).To(BeEmpty())
+			Expect(fakeIRSAHelper.DeleteCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -98,6 +98,10 @@ func findRootDeviceMapping(image *ec2.Image) (*ec2.BlockDeviceMapping, error) {
 // It will only look for images with a status of available and it will pick the
 // image with the newest creation date.
 func FindImage(ec2api ec2iface.EC2API, ownerAccount, namePattern string) (string, error) {
+	return findImage(ec2api, ownerAccount, namePattern, true)
+}
+
+func findImage(ec2api ec2iface.EC2API, ownerAccount, namePattern string, publicOnly bool) (string, error) {
 	input := &ec2.DescribeImagesInput{
 		Owners: []*string{&ownerAccount},
 		Filters: []*ec2.Filter{
@@ -113,38 +117,45 @@ func FindImage(ec2api ec2iface.EC2API, ownerAccount, namePattern string) (string
 				Name:   aws.String("root-device-type"),
 				Values: []*string{aws.String("ebs")},
 			},
-			{
-				Name:   aws.String("is-public"),
-				Values: []*string{aws.String("true")},
-			},
 			{
 				Name:   aws.String("state"),
 				Values: []*string{aws.String("available")},
 			},
 		},
 	}
+	if publicOnly {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("is-public"),
+			Values: []*string{aws.String("true")},
+		})
+	}
 
 	output, err := ec2api.DescribeImages(input)
 	if err != nil {
 		return "", errors.Wrapf(err, "error querying AWS for images")
 	}
 
-	if len(output.Images) < 1 {
-		return "", nil
+	return newestImageID(output.Images), nil
+}
+
+// newestImageID returns the ID of the image with the newest creation date, or an empty string if there are no images
+func newestImageID(images []*ec2.Image) string {
+	if len(images) < 1 {
+		return ""
 	}
 
-	if len(output.Images) == 1 {
-		return *output.Images[0].ImageId, nil
+	if len(images) == 1 {
+		return *images[0].ImageId
 	}
 
 	// Sort images so newest is first
-	sort.Slice(output.Images, func(i, j int) bool {
+	sort.Slice(images, func(i, j int) bool {
 		//nolint:gosec
-		creationLeft, _ := time.Parse(time.RFC3339, *output.Images[i].CreationDate)
+		creationLeft, _ := time.Parse(time.RFC3339, *images[i].CreationDate)
 		//nolint:gosec
-		creationRight, _ := time.Parse(time.RFC3339, *output.Images[j].CreationDate)
+		creationRight, _ := time.Parse(time.RFC3339, *images[j].CreationDate)
 		return creationLeft.After(creationRight)
 	})
 
-	return *output.Images[0].ImageId, nil
+	return *images[0].ImageId
 }
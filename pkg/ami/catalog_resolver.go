@@ -0,0 +1,113 @@
+package ami
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// CatalogResolver resolves AMIs from the private AMI catalog declared in the ClusterConfig
+type CatalogResolver struct {
+	ssmAPI  ssmiface.SSMAPI
+	ec2API  ec2iface.EC2API
+	catalog *api.AMICatalog
+}
+
+// NewCatalogResolver creates a new CatalogResolver
+func NewCatalogResolver(ssmAPI ssmiface.SSMAPI, ec2API ec2iface.EC2API, catalog *api.AMICatalog) Resolver {
+	return &CatalogResolver{ssmAPI: ssmAPI, ec2API: ec2API, catalog: catalog}
+}
+
+// Resolve returns the AMI of the first rule of the catalog that has one for the given
+// Kubernetes version, AMI family and architecture of the instance type
+func (r *CatalogResolver) Resolve(region, version, instanceType, imageFamily string) (string, error) {
+	logger.Debug("resolving AMI using CatalogResolver for region %s, instanceType %s and imageFamily %s", region, instanceType, imageFamily)
+
+	architecture := instanceEC2ArchName(instanceType)
+	placeholders := strings.NewReplacer(
+		api.AMICatalogKubernetesVersionPlaceholder, version,
+		api.AMICatalogAMIFamilyPlaceholder, imageFamily,
+		api.AMICatalogArchitecturePlaceholder, architecture,
+	)
+
+	if r.catalog.SSMParameter != "" {
+		id, err := r.resolveFromSSMParameter(placeholders.Replace(r.catalog.SSMParameter))
+		if err != nil || id != "" {
+			return id, err
+		}
+	}
+
+	if images := r.catalog.Images; images != nil {
+		id, err := r.resolveFromImages(images, placeholders.Replace(images.NamePattern), architecture)
+		if err != nil || id != "" {
+			return id, err
+		}
+	}
+
+	if id, ok := r.catalog.Regions[region]; ok {
+		return id, nil
+	}
+
+	return "", NewErrFailedResolution(region, version, instanceType, imageFamily)
+}
+
+// resolveFromSSMParameter returns the AMI held by the SSM parameter, or an empty string if it doesn't exist
+func (r *CatalogResolver) resolveFromSSMParameter(parameterName string) (string, error) {
+	output, err := r.ssmAPI.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(parameterName),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == ssm.ErrCodeParameterNotFound {
+			logger.Debug("SSM parameter %q of the AMI catalog does not exist", parameterName)
+			return "", nil
+		}
+		return "", fmt.Errorf("error getting AMI from SSM parameter %q: %w", parameterName, err)
+	}
+	if output.Parameter == nil {
+		return "", nil
+	}
+	return aws.StringValue(output.Parameter.Value), nil
+}
+
+// resolveFromImages returns the newest image matching the owners, name pattern, tags and architecture,
+// or an empty string if there are none
+func (r *CatalogResolver) resolveFromImages(images *api.AMICatalogImages, namePattern, architecture string) (string, error) {
+	input := &ec2.DescribeImagesInput{
+		Owners: aws.StringSlice(images.Owners),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("name"),
+				Values: aws.StringSlice([]string{namePattern}),
+			},
+			{
+				Name:   aws.String("architecture"),
+				Values: aws.StringSlice([]string{architecture}),
+			},
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{"available"}),
+			},
+		},
+	}
+	for key, value := range images.Tags {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("tag:" + key),
+			Values: aws.StringSlice([]string{value}),
+		})
+	}
+
+	output, err := r.ec2API.DescribeImages(input)
+	if err != nil {
+		return "", fmt.Errorf("error querying AWS for images matching %q: %w", namePattern, err)
+	}
+	return newestImageID(output.Images), nil
+}
@@ -0,0 +1,101 @@
+package ami_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/weaveworks/eksctl/pkg/ami"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("AMI catalog resolution", func() {
+	var (
+		p       *mockprovider.MockProvider
+		catalog *api.AMICatalog
+	)
+
+	filterValue := func(input *ec2.DescribeImagesInput, name string) string {
+		for _, filter := range input.Filters {
+			if *filter.Name == name {
+				return *filter.Values[0]
+			}
+		}
+		return ""
+	}
+
+	resolve := func(instanceType string) (string, error) {
+		return NewCatalogResolver(p.MockSSM(), p.MockEC2(), catalog).Resolve("eu-west-1", "1.20", instanceType, api.NodeImageFamilyAmazonLinux2)
+	}
+
+	BeforeEach(func() {
+		_, p = createProviders()
+		catalog = &api.AMICatalog{
+			SSMParameter: "/my-org/eks/{kubernetesVersion}/{amiFamily}/{architecture}/image_id",
+			Images: &api.AMICatalogImages{
+				Owners:      []string{"self"},
+				NamePattern: "my-org-eks-{amiFamily}-{kubernetesVersion}-*",
+				Tags:        map[string]string{"approved": "true"},
+			},
+			Regions: map[string]string{"eu-west-1": "ami-static"},
+		}
+	})
+
+	It("resolves the AMI from the SSM parameter for the nodegroup's version, family and architecture", func() {
+		addMockGetParameter(p, "/my-org/eks/1.20/AmazonLinux2/arm64/image_id", "ami-ssm")
+
+		resolvedAmi, err := resolve("m6g.large")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolvedAmi).To(Equal("ami-ssm"))
+	})
+
+	It("falls back to the newest matching image when the SSM parameter does not exist", func() {
+		p.MockSSM().On("GetParameter", mock.Anything).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+		p.MockEC2().On("DescribeImages", mock.MatchedBy(func(input *ec2.DescribeImagesInput) bool {
+			return filterValue(input, "name") == "my-org-eks-AmazonLinux2-1.20-*" &&
+				filterValue(input, "architecture") == "x86_64" &&
+				filterValue(input, "tag:approved") == "true"
+		})).Return(&ec2.DescribeImagesOutput{
+			Images: []*ec2.Image{
+				{ImageId: aws.String("ami-old"), CreationDate: aws.String("2021-05-01T10:00:00.000Z")},
+				{ImageId: aws.String("ami-new"), CreationDate: aws.String("2021-06-01T10:00:00.000Z")},
+			},
+		}, nil)
+
+		resolvedAmi, err := resolve("m5.large")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolvedAmi).To(Equal("ami-new"))
+	})
+
+	It("falls back to the AMI of the region when no other rule matches", func() {
+		catalog.SSMParameter = ""
+		p.MockEC2().On("DescribeImages", mock.Anything).Return(&ec2.DescribeImagesOutput{}, nil)
+
+		resolvedAmi, err := resolve("m5.large")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolvedAmi).To(Equal("ami-static"))
+	})
+
+	It("fails when no rule matches", func() {
+		catalog = &api.AMICatalog{
+			Regions: map[string]string{"us-west-2": "ami-static"},
+		}
+
+		_, err := resolve("m5.large")
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(BeAssignableToTypeOf(&ErrFailedResolution{}))
+	})
+
+	It("returns errors other than a missing SSM parameter", func() {
+		p.MockSSM().On("GetParameter", mock.Anything).Return(nil, awserr.New("AccessDeniedException", "denied", nil))
+
+		_, err := resolve("m5.large")
+		Expect(err).To(MatchError(ContainSubstring("AccessDeniedException")))
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeImages", mock.Anything)
+	})
+})
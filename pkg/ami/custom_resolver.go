@@ -0,0 +1,70 @@
+package ami
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/kris-nova/logger"
+)
+
+// CustomResolver resolves the newest version of a custom AMI, e.g. one that is built from the EKS-optimized AMI
+type CustomResolver interface {
+	ResolveCustom() (string, error)
+}
+
+// CustomSSMResolver resolves a custom AMI from an SSM parameter holding its ID,
+// which is updated whenever a new version of the AMI is built
+type CustomSSMResolver struct {
+	ssmAPI        ssmiface.SSMAPI
+	parameterName string
+}
+
+// NewCustomSSMResolver creates a new CustomSSMResolver
+func NewCustomSSMResolver(api ssmiface.SSMAPI, parameterName string) CustomResolver {
+	return &CustomSSMResolver{ssmAPI: api, parameterName: parameterName}
+}
+
+// ResolveCustom returns the AMI ID held by the SSM parameter
+func (r *CustomSSMResolver) ResolveCustom() (string, error) {
+	logger.Debug("resolving custom AMI from SSM parameter %q", r.parameterName)
+
+	output, err := r.ssmAPI.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(r.parameterName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error getting AMI from SSM parameter %q: %w", r.parameterName, err)
+	}
+	if output.Parameter == nil || aws.StringValue(output.Parameter.Value) == "" {
+		return "", fmt.Errorf("SSM parameter %q does not hold an AMI ID", r.parameterName)
+	}
+	return *output.Parameter.Value, nil
+}
+
+// CustomImageResolver resolves a custom AMI to the newest image of an account whose name matches a pattern
+type CustomImageResolver struct {
+	ec2API       ec2iface.EC2API
+	ownerAccount string
+	namePattern  string
+}
+
+// NewCustomImageResolver creates a new CustomImageResolver, ownerAccount can be an account ID or `self`
+func NewCustomImageResolver(api ec2iface.EC2API, ownerAccount, namePattern string) CustomResolver {
+	return &CustomImageResolver{ec2API: api, ownerAccount: ownerAccount, namePattern: namePattern}
+}
+
+// ResolveCustom returns the ID of the newest matching image, which does not need to be public
+func (r *CustomImageResolver) ResolveCustom() (string, error) {
+	logger.Debug("resolving custom AMI from images of %s matching %q", r.ownerAccount, r.namePattern)
+
+	id, err := findImage(r.ec2API, r.ownerAccount, r.namePattern, false)
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		return "", fmt.Errorf("no images of %s match %q", r.ownerAccount, r.namePattern)
+	}
+	return id, nil
+}
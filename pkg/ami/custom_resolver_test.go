@@ -0,0 +1,62 @@
+package ami_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/weaveworks/eksctl/pkg/ami"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Custom AMI resolution", func() {
+	var p *mockprovider.MockProvider
+
+	BeforeEach(func() {
+		_, p = createProviders()
+	})
+
+	It("resolves the AMI held by an SSM parameter", func() {
+		addMockGetParameter(p, "/my-org/eks-node/1.20/image_id", "ami-custom1")
+
+		resolvedAmi, err := NewCustomSSMResolver(p.MockSSM(), "/my-org/eks-node/1.20/image_id").ResolveCustom()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolvedAmi).To(Equal("ami-custom1"))
+	})
+
+	It("fails when the SSM parameter does not hold an AMI", func() {
+		addMockFailedGetParameter(p, "/my-org/eks-node/1.20/image_id")
+
+		_, err := NewCustomSSMResolver(p.MockSSM(), "/my-org/eks-node/1.20/image_id").ResolveCustom()
+		Expect(err).To(MatchError(ContainSubstring("does not hold an AMI ID")))
+	})
+
+	It("resolves the newest private image of the owner matching the name pattern", func() {
+		p.MockEC2().On("DescribeImages", mock.MatchedBy(func(input *ec2.DescribeImagesInput) bool {
+			for _, filter := range input.Filters {
+				if *filter.Name == "is-public" {
+					return false
+				}
+			}
+			return aws.StringValueSlice(input.Owners)[0] == "self"
+		})).Return(&ec2.DescribeImagesOutput{
+			Images: []*ec2.Image{
+				{ImageId: aws.String("ami-old"), CreationDate: aws.String("2021-05-01T10:00:00.000Z")},
+				{ImageId: aws.String("ami-new"), CreationDate: aws.String("2021-06-01T10:00:00.000Z")},
+			},
+		}, nil)
+
+		resolvedAmi, err := NewCustomImageResolver(p.MockEC2(), "self", "my-eks-node-1.20-*").ResolveCustom()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolvedAmi).To(Equal("ami-new"))
+	})
+
+	It("fails when no images match the name pattern", func() {
+		p.MockEC2().On("DescribeImages", mock.Anything).Return(&ec2.DescribeImagesOutput{}, nil)
+
+		_, err := NewCustomImageResolver(p.MockEC2(), "self", "my-eks-node-1.20-*").ResolveCustom()
+		Expect(err).To(MatchError(ContainSubstring(`no images of self match "my-eks-node-1.20-*"`)))
+	})
+})
@@ -0,0 +1,37 @@
+package ami
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// FindImageFamily returns the image family and image class of an EKS-optimized image, by matching its name
+// against the image search patterns of the given Kubernetes version. It returns false when the name doesn't
+// match any of them, e.g. for custom images
+func FindImageFamily(version, imageName string) (string, int, bool) {
+	patterns := MakeImageSearchPatterns(version)
+
+	// iterate in a stable order, so that the result doesn't depend on map ordering
+	families := make([]string, 0, len(patterns))
+	for family := range patterns {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+
+	for _, family := range families {
+		for class, pattern := range patterns[family] {
+			if matchesImageSearchPattern(pattern, imageName) {
+				return family, class, true
+			}
+		}
+	}
+	return "", 0, false
+}
+
+// matchesImageSearchPattern returns true if the name matches the pattern, where `*` matches any
+// sequence of characters as it does in EC2 filters
+func matchesImageSearchPattern(pattern, name string) bool {
+	expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$"
+	return regexp.MustCompile(expr).MatchString(name)
+}
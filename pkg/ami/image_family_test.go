@@ -0,0 +1,32 @@
+package ami_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/ami"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("Image family", func() {
+	DescribeTable("finds the family and class of EKS-optimized images",
+		func(imageName, expectedFamily string, expectedClass int) {
+			family, class, ok := FindImageFamily("1.20", imageName)
+			Expect(ok).To(BeTrue())
+			Expect(family).To(Equal(expectedFamily))
+			Expect(class).To(Equal(expectedClass))
+		},
+		Entry("AmazonLinux2", "amazon-eks-node-1.20-v20210621", api.NodeImageFamilyAmazonLinux2, ImageClassGeneral),
+		Entry("AmazonLinux2 GPU", "amazon-eks-gpu-node-1.20-v20210621", api.NodeImageFamilyAmazonLinux2, ImageClassGPU),
+		Entry("AmazonLinux2 ARM", "amazon-eks-arm64-node-1.20-v20210621", api.NodeImageFamilyAmazonLinux2, ImageClassARM),
+		Entry("Ubuntu2004", "ubuntu-eks/k8s_1.20/images/hvm-ssd/ubuntu-focal-20.04-amd64-server-20210621", api.NodeImageFamilyUbuntu2004, ImageClassGeneral),
+	)
+
+	It("does not match images of other Kubernetes versions or custom images", func() {
+		_, _, ok := FindImageFamily("1.20", "amazon-eks-node-1.19-v20210621")
+		Expect(ok).To(BeFalse())
+		_, _, ok = FindImageFamily("1.20", "my-org-eks-node-1.20")
+		Expect(ok).To(BeFalse())
+	})
+})
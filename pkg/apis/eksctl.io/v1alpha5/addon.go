@@ -5,6 +5,13 @@ import (
 	"strings"
 )
 
+// Names of the EKS addons that replace the default addons of a cluster
+const (
+	VPCCNIAddon    = "vpc-cni"
+	CoreDNSAddon   = "coredns"
+	KubeProxyAddon = "kube-proxy"
+)
+
 // Addon holds the EKS addon configuration
 type Addon struct {
 	// +required
@@ -43,6 +50,10 @@ func (a Addon) Validate() error {
 		return err
 	}
 
+	if a.IsClusterAutoscaler() && (a.ServiceAccountRoleARN != "" || len(a.AttachPolicyARNs) > 0 || a.AttachPolicy != nil) {
+		return fmt.Errorf("the IAM role of %s is scoped to the cluster by eksctl, serviceAccountRoleARN, attachPolicyARNs and attachPolicy cannot be specified", ClusterAutoscalerAddon)
+	}
+
 	return nil
 }
 
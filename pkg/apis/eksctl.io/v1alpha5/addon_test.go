@@ -46,5 +46,15 @@ var _ = Describe("Addon", func() {
 				Expect(err).To(MatchError("at most one of serviceAccountRoleARN, attachPolicyARNs and attachPolicy can be specified"))
 			})
 		})
+
+		When("specifying IAM settings for cluster-autoscaler", func() {
+			It("errors", func() {
+				err := v1alpha5.Addon{
+					Name:             "cluster-autoscaler",
+					AttachPolicyARNs: []string{"arn"},
+				}.Validate()
+				Expect(err).To(MatchError("the IAM role of cluster-autoscaler is scoped to the cluster by eksctl, serviceAccountRoleARN, attachPolicyARNs and attachPolicy cannot be specified"))
+			})
+		})
 	})
 })
@@ -0,0 +1,52 @@
+package v1alpha5
+
+// Placeholders that can be used in the SSM parameter and image name pattern of an AMICatalog,
+// they are replaced with the values of the nodegroup whose AMI is resolved
+const (
+	// AMICatalogKubernetesVersionPlaceholder is replaced with the Kubernetes version, e.g. `1.20`
+	AMICatalogKubernetesVersionPlaceholder = "{kubernetesVersion}"
+	// AMICatalogAMIFamilyPlaceholder is replaced with the AMI family, e.g. `AmazonLinux2`
+	AMICatalogAMIFamilyPlaceholder = "{amiFamily}"
+	// AMICatalogArchitecturePlaceholder is replaced with the architecture of the instance type, i.e. `x86_64` or `arm64`
+	AMICatalogArchitecturePlaceholder = "{architecture}"
+)
+
+// AMICatalog holds the rules used to resolve the AMIs of nodegroups with `ami: custom-catalog` from
+// a private catalog of AMIs. The rules that are set are tried in order: `ssmParameter`, `images`, then `regions`
+type AMICatalog struct {
+	// SSMParameter is the name of an SSM parameter holding the ID of an AMI, it can contain the
+	// `{kubernetesVersion}`, `{amiFamily}` and `{architecture}` placeholders,
+	// e.g. `/my-org/eks/{kubernetesVersion}/{amiFamily}/{architecture}/image_id`
+	// +optional
+	SSMParameter string `json:"ssmParameter,omitempty"`
+
+	// +optional
+	Images *AMICatalogImages `json:"images,omitempty"`
+
+	// Regions maps regions to the ID of the AMI to use in them
+	// +optional
+	Regions map[string]string `json:"regions,omitempty"`
+}
+
+// AMICatalogImages selects the newest of the images matching an owner, name pattern and tags.
+// Only images with the architecture of the nodegroup's instance type are selected
+type AMICatalogImages struct {
+	// Owners are the IDs of the accounts that own the images, or `self`
+	// +required
+	Owners []string `json:"owners,omitempty"`
+
+	// NamePattern is the pattern image names must match, it can contain `*` wildcards along with the
+	// `{kubernetesVersion}`, `{amiFamily}` and `{architecture}` placeholders,
+	// e.g. `my-org-eks-{amiFamily}-{kubernetesVersion}-*`
+	// +required
+	NamePattern string `json:"namePattern,omitempty"`
+
+	// Tags are the tags images must have
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// IsEmpty returns true if the catalog doesn't declare any rule
+func (c *AMICatalog) IsEmpty() bool {
+	return c.SSMParameter == "" && c.Images == nil && len(c.Regions) == 0
+}
@@ -0,0 +1,129 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// ClusterAutoscalerAddon is the name of the cluster-autoscaler addon, which is deployed by eksctl rather than EKS
+	ClusterAutoscalerAddon = "cluster-autoscaler"
+
+	// ClusterAutoscalerTagPrefix is the prefix of the ASG tags read by cluster-autoscaler
+	ClusterAutoscalerTagPrefix = "k8s.io/cluster-autoscaler/"
+
+	// ClusterAutoscalerEnabledTag is the ASG tag cluster-autoscaler uses to auto-discover ASGs
+	ClusterAutoscalerEnabledTag = ClusterAutoscalerTagPrefix + "enabled"
+
+	clusterAutoscalerNodeTemplatePrefix         = ClusterAutoscalerTagPrefix + "node-template/"
+	clusterAutoscalerNodeTemplateLabelPrefix    = clusterAutoscalerNodeTemplatePrefix + "label/"
+	clusterAutoscalerNodeTemplateTaintPrefix    = clusterAutoscalerNodeTemplatePrefix + "taint/"
+	clusterAutoscalerNodeTemplateResourcePrefix = clusterAutoscalerNodeTemplatePrefix + "resources/"
+)
+
+// IsClusterAutoscaler returns true if the addon is cluster-autoscaler
+func (a Addon) IsClusterAutoscaler() bool {
+	return a.CanonicalName() == ClusterAutoscalerAddon
+}
+
+// HasClusterAutoscalerAddon returns true if cluster-autoscaler is among the addons of the cluster
+func (c *ClusterConfig) HasClusterAutoscalerAddon() bool {
+	for _, addon := range c.Addons {
+		if addon.IsClusterAutoscaler() {
+			return true
+		}
+	}
+	return false
+}
+
+// ClusterAutoscalerClusterTag returns the ASG tag cluster-autoscaler uses to auto-discover the ASGs of the cluster
+func ClusterAutoscalerClusterTag(clusterName string) string {
+	return ClusterAutoscalerTagPrefix + clusterName
+}
+
+// ClusterAutoscalerTag is an ASG tag read by cluster-autoscaler
+type ClusterAutoscalerTag struct {
+	Key   string
+	Value string
+	// PropagateAtLaunch is true for the tags that are also applied to the instances of the ASG
+	PropagateAtLaunch bool
+}
+
+// ClusterAutoscalerDiscoveryTags returns the tags cluster-autoscaler uses to auto-discover the ASGs of the cluster
+func ClusterAutoscalerDiscoveryTags(clusterName string) []ClusterAutoscalerTag {
+	return []ClusterAutoscalerTag{
+		{Key: ClusterAutoscalerEnabledTag, Value: "true", PropagateAtLaunch: true},
+		{Key: ClusterAutoscalerClusterTag(clusterName), Value: "owned", PropagateAtLaunch: true},
+	}
+}
+
+// ClusterAutoscalerNodeTemplateTags returns the tags describing the labels, taints and resources of the nodes of an
+// ASG, which cluster-autoscaler needs to scale up an ASG that has no nodes. The tags are sorted by key
+func ClusterAutoscalerNodeTemplateTags(labels map[string]string, taints []NodeGroupTaint, resources map[string]string) []ClusterAutoscalerTag {
+	var tags []ClusterAutoscalerTag
+	for key, value := range labels {
+		tags = append(tags, ClusterAutoscalerTag{Key: clusterAutoscalerNodeTemplateLabelPrefix + key, Value: value})
+	}
+	for _, taint := range taints {
+		tags = append(tags, ClusterAutoscalerTag{
+			Key:   clusterAutoscalerNodeTemplateTaintPrefix + taint.Key,
+			Value: fmt.Sprintf("%s:%s", taint.Value, taint.Effect),
+		})
+	}
+	for name, quantity := range resources {
+		tags = append(tags, ClusterAutoscalerTag{Key: clusterAutoscalerNodeTemplateResourcePrefix + name, Value: quantity})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Key < tags[j].Key
+	})
+	return tags
+}
+
+// ClusterAutoscalerNodePoolTemplateTags returns the node-template tags of the ASGs of a nodegroup, derived from its
+// labels, taints and volume size. Node-template tags set in the tags of the nodegroup take precedence
+func ClusterAutoscalerNodePoolTemplateTags(np NodePool) []ClusterAutoscalerTag {
+	ng := np.BaseNodeGroup()
+	tags := ClusterAutoscalerNodeTemplateTags(ng.Labels, np.NGTaints(), NodeTemplateResources(ng.VolumeSize))
+	for key, value := range ng.Tags {
+		if !IsClusterAutoscalerNodeTemplateTag(key) {
+			continue
+		}
+		found := false
+		for i := range tags {
+			if tags[i].Key == key {
+				tags[i].Value = value
+				found = true
+			}
+		}
+		if !found {
+			tags = append(tags, ClusterAutoscalerTag{Key: key, Value: value})
+		}
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Key < tags[j].Key
+	})
+	return tags
+}
+
+// NodeTemplateResources returns the resources of the nodes of a nodegroup that cannot be derived from their
+// instance type, in the format of the node-template resources tags
+func NodeTemplateResources(volumeSize *int) map[string]string {
+	if volumeSize == nil || *volumeSize == 0 {
+		return nil
+	}
+	return map[string]string{
+		"ephemeral-storage": fmt.Sprintf("%dGi", *volumeSize),
+	}
+}
+
+// IsClusterAutoscalerNodeTemplateTag returns true if the tag describes the nodes of an ASG to cluster-autoscaler
+func IsClusterAutoscalerNodeTemplateTag(key string) bool {
+	return strings.HasPrefix(key, clusterAutoscalerNodeTemplatePrefix)
+}
+
+// IsClusterAutoscalerNodeTemplateResourceTag returns true if the tag describes a resource of the nodes of an ASG to
+// cluster-autoscaler. Unlike labels and taints, resources can be set on ASGs independently of the nodegroup spec
+func IsClusterAutoscalerNodeTemplateResourceTag(key string) bool {
+	return strings.HasPrefix(key, clusterAutoscalerNodeTemplateResourcePrefix)
+}
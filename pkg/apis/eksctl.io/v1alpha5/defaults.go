@@ -59,7 +59,8 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 // IAM SAs that need to be explicitly deleted.
 func IAMServiceAccountsWithImplicitServiceAccounts(cfg *ClusterConfig) []*ClusterIAMServiceAccount {
 	serviceAccounts := cfg.IAM.ServiceAccounts
-	if IsEnabled(cfg.IAM.WithOIDC) && !vpccniAddonSpecified(cfg) {
+	// the VPC CNI of IPv6 clusters uses the IPv6 permissions of the node roles
+	if IsEnabled(cfg.IAM.WithOIDC) && !vpccniAddonSpecified(cfg) && !cfg.IPv6Enabled() {
 		var found bool
 		for _, sa := range cfg.IAM.ServiceAccounts {
 			found = found || (sa.Name == AWSNodeMeta.Name && sa.Namespace == AWSNodeMeta.Namespace)
@@ -111,6 +112,10 @@ func SetNodeGroupDefaults(ng *NodeGroup, meta *ClusterMeta) {
 	}
 
 	setContainerRuntimeDefault(ng)
+
+	if ng.HasSpotInterruptionHandler() && ng.SpotInterruptionHandler.HeartbeatTimeout == nil {
+		ng.SpotInterruptionHandler.HeartbeatTimeout = aws.Int(DefaultSpotInterruptionHandlerHeartbeatTimeout)
+	}
 }
 
 // SetManagedNodeGroupDefaults sets default values for a ManagedNodeGroup
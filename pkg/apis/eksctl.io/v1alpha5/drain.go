@@ -0,0 +1,56 @@
+package v1alpha5
+
+// Values for the `DRAIN_PHASE` environment variable and the `phase` field of the requests sent to drain hooks
+const (
+	// PreDrainPhase is the phase of drain hooks that are run before a node is drained
+	PreDrainPhase = "pre-drain"
+	// PostDrainPhase is the phase of drain hooks that are run after a node is drained
+	PostDrainPhase = "post-drain"
+)
+
+// DefaultDrainHookTimeoutSeconds is how long a drain hook may run for when `timeoutSeconds` is not set
+const DefaultDrainHookTimeoutSeconds = 60
+
+// DrainConfig holds the configuration used when draining the nodes of nodegroups, e.g. before they are
+// deleted, replaced or upgraded
+type DrainConfig struct {
+	// SkipPodSelector is a label selector, pods matching it are not evicted
+	// +optional
+	SkipPodSelector string `json:"skipPodSelector,omitempty"`
+
+	// SkipNamespaces are the namespaces whose pods are not evicted
+	// +optional
+	SkipNamespaces []string `json:"skipNamespaces,omitempty"`
+
+	// ForceDeletePodsOlderThanMinutes makes pods that have been running for longer than
+	// the given number of minutes be deleted rather than evicted, bypassing PodDisruptionBudgets
+	// +optional
+	ForceDeletePodsOlderThanMinutes *int `json:"forceDeletePodsOlderThanMinutes,omitempty"`
+
+	// PreDrainHooks are run before the pods of each node are evicted, the node is not
+	// drained until all of them succeed, so they can be used to gate the drain
+	// +optional
+	PreDrainHooks []DrainHook `json:"preDrainHooks,omitempty"`
+
+	// PostDrainHooks are run once each node is drained
+	// +optional
+	PostDrainHooks []DrainHook `json:"postDrainHooks,omitempty"`
+}
+
+// DrainHook is either an HTTP endpoint or a local command that is called for each node that is drained.
+// The endpoint receives a POST request with a JSON body containing the `phase`, `nodegroup` and `node`,
+// and must respond with a 2xx status code. The command is run with the `DRAIN_PHASE`, `NODEGROUP_NAME`
+// and `NODE_NAME` environment variables, and must exit with status 0
+type DrainHook struct {
+	// URL is the HTTP endpoint to call
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Command is the command to run, along with its arguments
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// TimeoutSeconds is how long the hook may run for, defaults to `60`
+	// +optional
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+}
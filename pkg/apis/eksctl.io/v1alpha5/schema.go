@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// assets/schema.json (86.961kB)
+// assets/schema.json (100.342kB)
 
 package v1alpha5
 
@@ -69,7 +69,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _schemaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x6d\x73\x1b\x37\xf2\x20\xfe\x7e\x3f\x05\x8a\xd9\xfa\xad\xbd\xc5\x11\x2d\x67\x37\x9b\xcd\xe6\xaf\xfa\xd3\x92\xe2\xf0\x1c\x49\x3c\x51\x4e\xee\x22\xb9\xd6\xe0\x0c\x48\x62\x35\x04\x66\x01\x8c\x24\xe6\xe7\xdc\x67\xbf\xc2\xd3\x3c\x62\x86\xf3\x24\x5b\x5b\xa7\x57\x96\x87\x33\x8d\x46\x77\xa3\xbb\xd1\xe8\x6e\xfc\xf7\x1f\x00\x18\xfd\x91\xa1\xd5\xe8\x3b\x30\xfa\x6a\x12\xa0\x15\x26\x58\x60\x4a\xf8\xe4\x38\x8c\xb9\x40\xec\x98\x92\x15\x5e\x8f\xc6\xf2\x45\xb1\x8b\x90\x7c\x91\x2e\xff\x85\x7c\xa1\x9f\xfd\x91\xfb\x1b\xb4\x85\xf2\xf1\x46\x88\xe8\xbb\xc9\xe4\x5f\x9c\x12\x4f\x3f\x3d\xa0\x6c\x3d\x09\x18\x5c\x09\xef\xd5\xdf\x26\xfa\xd9\x57\xfa\xbb\xcc\x50\xa3\xef\x80\xc4\x03\x80\xd1\xf4\xd7\x45\xbc\x24\x48\x9c\xc1\x28\xc2\x64\x9d\xfc\x00\xc0\x08\x06\x81\x7a\x1b\x86\x73\x46\x23\xc4\x04\x46\x3c\xf3\x7b\xe5\x34\x2c\xc8\x45\x84\xfc\x91\x79\xf9\xf7\xb1\x85\xea\x98\x91\x7a\x1e\x20\xee\x33\x1c\x49\x10\x6a\x66\x34\x0c\x38\xe0\x0a\x10\x10\x14\x4c\x7f\x05\x5b\x8d\x22\x3f\x00\xb3\x15\x10\x1b\x04\x6e\xd1\x0e\x60\x0e\x20\x01\xd3\x5f\xc7\x40\x6c\xa0\x00\x30\xe4\x14\x2c\x91\x4f\xb7\x88\xab\x77\x08\xdc\x22\x40\xf5\xfb\x06\x1a\x15\x1b\xc4\xee\x31\x47\x20\xe6\x28\x01\x24\x28\x60\x68\x85\x98\xfc\x43\x6c\xb0\x1d\xfb\x20\xc5\xf0\xc1\xc3\x44\xa0\x30\xc4\xff\xf2\x36\x62\x1b\x7a\x4f\x1f\xe3\x00\xad\x60\x1c\x0a\x89\xdd\x7f\xff\xae\x59\x61\x18\x31\xca\x31\x29\xc3\xf4\xa8\x82\xd5\xf0\xb7\xdc\xff\x33\x8c\xe4\x82\x49\xc1\x19\x67\x7f\x2b\x90\xc6\x87\x04\x2c\x11\xa0\x5b\x2c\x04\x0a\x00\x2e\x13\x23\xff\xf9\x1e\x4a\x37\x00\x97\x40\xfb\x3d\x05\x3c\xf2\x71\xc0\x8a\xb3\x70\x8b\xf0\x1a\x8b\x4d\xbc\x3c\xf0\xe9\xf6\xd3\x3d\x82\x77\xe8\x9e\xb2\x5b\xfe\x09\xdd\x72\x5f\x84\x9f\xa2\xdb\xf5\xa7\x58\xe0\x90\x7f\xc2\x91\xa4\xf7\x6c\x7e\x8e\x84\x7b\x44\x1c\xec\xa1\x5a\xfa\x55\x69\xa1\x44\x8a\xb9\x0c\x05\x17\x2c\x40\x12\xef\xeb\x1c\xdc\x71\x8e\x37\xc5\x59\x9a\xff\x7e\x18\xef\x59\xcc\x2b\x18\x72\x94\x17\x8c\x20\x50\x54\x4e\x24\x82\xa1\x7f\xc7\x98\xa1\x20\x8f\x81\x94\xd2\xf2\x28\x95\xd2\x23\x04\xf4\x37\x73\x1a\x62\x7f\xd7\x8c\x03\x33\x12\x62\x82\x4e\xa8\x1f\x6f\x11\x11\xb5\xd2\xa5\x17\x1e\x04\x91\x02\x0f\x02\xf3\x8d\x5c\x16\x7a\xdc\x56\xc2\xb5\x1f\x9a\x93\xd3\xd9\x19\x4e\x2f\xcf\x79\x71\x96\x58\xa0\x6d\xf1\x61\x8d\x38\xe4\x80\x67\xde\x83\x8c\xc1\x5d\x2d\x35\x42\xcc\x85\x54\x1f\x12\x09\xab\x46\x66\xd3\x33\x3d\x1f\x2c\x75\x4c\x27\xb2\xb4\x00\xeb\xa4\x8f\x92\x97\xa6\x6b\x21\xf3\x5d\x84\xd8\x16\x73\x2e\x65\xe2\x0d\x8d\x49\x00\x59\x49\x80\x5a\x28\xa2\xe9\xe5\xb9\x45\x3e\x03\x18\x2c\x0d\x64\x35\x09\xce\xa9\x8f\xa1\x40\xad\xc8\xd3\x0a\xb0\x73\xa2\x1c\xb1\x3b\xec\xa3\xa9\xef\xd3\x98\x88\x4b\x1a\xa2\xe9\xe5\x79\x17\x8a\x09\xb8\x2e\x49\xdf\x5e\x53\xde\x49\x18\x0b\x26\xdc\x45\xf0\xab\x0d\x02\x5b\x24\x60\x00\x05\x54\x44\x88\xa2\x70\xa7\x0d\x16\x02\xbe\xf6\x77\x0c\x71\xa4\x80\xdd\x63\xb1\x01\x3e\x14\x68\x4d\x19\xfe\x0d\x4a\x28\x00\x92\x00\x50\xb6\x86\xc4\x3c\x38\x00\xa7\xd0\xdf\x00\x01\xd7\xc0\xa7\x44\x7e\xa6\x04\x12\x2a\x03\x20\x5f\x86\x04\xd0\x48\xcf\x16\xdc\xc1\x30\x46\x63\xb0\xa4\x62\x23\x5f\xba\xdf\x60\x7f\x03\x76\x34\x06\x4a\xd7\xa0\x83\x56\x4c\xfe\xcf\x9a\x8c\xc3\xf8\x17\x45\xe5\x0e\x31\x8e\x73\xda\xbe\x5e\xca\x5a\xd9\x28\xb5\xe2\x1d\x83\xed\x95\xf9\x3a\xad\x5a\xf1\xdb\x68\x8f\xc6\x28\x2e\x8f\x56\xe6\xb1\xde\x45\x95\xbc\x3f\x7d\xb7\x00\x50\xda\x4d\xc9\xc4\x15\x5e\xc7\x4c\x31\xb7\xa5\xeb\x58\x07\x29\x67\xa2\xed\x3e\x21\xa4\x71\xf0\x0b\x14\xfe\xa6\x89\x03\x67\xe4\xf3\x27\xba\x5e\xe7\xfd\x7c\x50\x6d\x84\x4b\x03\xd9\xaf\x3b\x8a\x44\x01\x87\x41\xb8\xe0\x53\x22\x20\x26\xdc\x10\x0c\x44\x90\xc1\x2d\x12\x88\x71\xc0\x50\x08\xa5\x83\x28\x28\xc8\xd0\xaa\x29\x53\x5a\x03\xae\xe7\x51\x99\xf0\x95\xac\x42\x04\x2e\x43\x74\xb5\x8b\x4a\x4a\xba\xa1\x1b\x31\xce\xff\x8a\x48\xbc\xcd\x31\xc2\x92\x3b\xc2\x85\x57\xe5\xc3\x38\xc0\xc2\xf5\x58\x6c\x10\x11\xd8\x87\x82\xb2\xf2\xcf\x92\x58\x8c\x86\x21\x62\x67\x90\xc0\x35\x72\xbc\x22\xf7\xa2\x41\x1c\xba\x7e\x82\x61\x58\x7e\xf8\xe7\x51\xee\xc9\x87\x01\x7c\x23\x45\x52\xa9\x3a\x43\xcd\x0c\xc9\x40\x4d\x6c\xf0\x82\x23\x04\xae\x53\x76\x49\xc7\x8f\x7f\x78\x31\x89\x39\x5c\xa3\x89\x2f\x9f\xdf\xcb\xe7\x9e\x91\x61\xcf\x80\x98\x7c\x65\x1e\x68\x29\xf1\xd0\x03\xdc\x46\x21\xe2\x2f\x5f\x1e\x80\x9f\x61\x88\x03\x80\x88\x60\xd2\x41\x82\x0c\x7d\x07\x3e\xde\x48\xaa\xdf\x8c\x3e\x8e\xd5\x9f\x92\xd6\xe9\x7f\x32\x14\xb6\x0f\x4b\x74\xb5\x3f\x24\xd4\x4c\x3e\x0f\x43\xfb\xe7\x9f\x6f\x46\x1f\x5b\x5a\xb6\x3d\x84\xf9\x1e\x82\x0d\x43\xab\xff\xef\x66\xd4\x99\x20\x37\xa3\xa3\x02\x75\xbf\x9f\xc0\x23\x37\x95\xbe\xf7\x69\x80\x8e\xfe\xeb\xdf\x31\x15\xff\x80\x11\xd6\x7f\x7c\x3f\x51\x4f\xc7\xf9\x5f\x25\x05\x6b\x7f\xcf\x10\xb5\xe6\xbd\x12\x9d\x6b\xde\x4d\x48\x5f\x37\x6e\x18\xd6\xfc\xfa\xe7\xdc\x6f\x07\x5d\xd5\x69\x56\x4f\x0c\xa9\x4b\x11\xab\xd7\x79\xd6\xd5\x31\x1c\x6f\xab\x51\xdb\x82\x77\xea\x55\x1d\x23\xdb\xbb\x4d\xb5\xee\x5a\xd6\x03\xb8\xc5\x24\xbf\x7d\x8e\xf0\xcf\xc6\x37\x69\xbe\xa1\x95\x36\xba\xa9\x76\xae\x08\x93\xa9\x8d\x76\x43\xad\xe6\xde\x73\xa6\x88\xb7\xd8\x12\x39\xad\xc1\x48\xc7\x36\x0e\x30\x9d\xdc\x1d\xc2\x30\xda\xc0\xbf\x66\x51\xfb\xe0\x1e\xff\x0e\xe2\x10\x2e\x71\x88\xc5\xee\x57\x4a\xba\x5a\xab\x3e\x24\xf0\x5d\x3e\x50\x0d\xd1\xcb\xae\x53\x9d\xbd\x58\x14\x6c\x02\x8f\xa3\x88\x32\xd1\xc4\x2c\xbc\x6c\xa5\x7f\x17\x2d\x75\x6c\x5e\x99\x1a\xb4\xa4\x3e\x75\x53\x69\x05\xd9\x1a\x0a\x34\x67\x74\x85\xc3\xc6\x6c\x72\x53\xf0\x87\x1c\xac\x5e\xcc\x5b\x63\xd1\x8c\x6b\x6f\x71\xfd\x2e\xf3\x87\x9f\xde\xff\x2f\xf0\xf3\x21\x38\x39\x9d\x5f\x9e\x1e\x4f\xaf\x66\x17\xe7\xe0\xfc\xe2\x6a\x76\x7c\x7a\x00\x36\x42\x44\xfc\xbb\x49\x26\xa4\x37\x49\x43\x7a\x13\x2d\xf6\x13\xcc\x79\x8c\xf8\xe4\xf5\xdf\xbf\xf9\x1a\xbc\xc5\x02\xa0\x87\x88\x72\xc4\xf3\x4e\x38\x58\x51\x06\x7e\x08\xe3\x07\x70\x77\x68\xf7\x66\x08\xb2\x10\x23\x06\xb0\x40\xe6\x25\xba\x02\x6b\x2c\x68\xc4\x5b\x09\xc0\xd3\x9c\x41\x15\xd7\xe4\x6f\x4d\x19\x77\x51\xa4\x44\x61\xe6\xfb\x10\x7d\xad\x10\xbd\xc7\x61\x28\x5f\x11\x98\xc4\x48\x1a\x89\xa5\x8a\x85\x07\x00\x13\xb0\x8a\x45\xcc\x90\xc1\x19\x44\x21\x24\x7c\x0c\x18\x8a\x42\xe8\x2b\x57\x66\x83\x14\x45\xf2\x03\xc0\x25\xbd\x6b\x17\xe2\xf9\xa2\x88\x3a\x39\x81\xe1\xb6\x95\xd6\x9b\x4d\xcf\xaa\xc2\xd4\xd2\x47\x12\xbb\x39\xa3\x77\x38\x40\xac\x9f\x86\x98\x15\xa0\xf5\xd2\x11\xca\x58\xf7\xb6\x6e\x79\xaf\x61\xaf\x61\xbb\x8d\x97\x88\x11\x24\x10\x3f\x47\x42\x2e\xb3\x92\xbb\x51\x33\xfd\x77\x15\x1f\x3b\x47\xda\x2a\x6f\x33\x38\xa7\x01\x7a\xcb\x68\x5c\x5e\x57\xad\x28\x7f\x56\x80\x36\x44\x3c\x59\xd9\x40\x09\x71\xad\xf0\x03\xca\x36\x25\x16\x50\xe1\x8f\xc9\xda\x23\xc9\x1b\x2f\xd5\x3a\xb8\x36\x33\x03\xe9\x0f\xc9\x47\xe8\x96\x7b\xe6\x67\xf5\x1d\x1f\xc2\x5a\x3a\x30\xb9\x19\x1d\x15\x11\x97\x36\x52\xe1\x57\xfa\xbe\x8c\xd4\xcd\xe8\xa8\x3c\x89\x6a\x23\x9b\xb8\x9a\x6d\x96\xe4\x19\x12\xb0\x22\x60\x3e\x8c\x48\x0c\x2a\x0b\x3f\x50\x06\x30\x59\x51\xb6\x4d\xa3\x99\x76\x7f\x07\xd4\x06\xda\xc1\x6d\x97\x88\xb4\xb3\x8d\xfb\x46\x6d\x28\x0b\x4d\x98\x18\x31\x7c\x07\x05\x32\xdc\x69\xc6\xca\x79\xfe\x9b\x3a\x02\xc2\x30\xa4\xf7\xa9\x09\x91\x5a\x1f\x82\x55\x1c\x86\x3b\xcf\x8c\x9c\xec\x7e\x30\x31\x01\x5e\x42\x95\xf8\x81\x0d\xe4\x80\xc6\x42\x1d\x29\x00\x49\x30\xa9\x64\x00\xf4\x7d\xc4\xf9\x58\x11\xc5\x82\xd0\xcf\xd4\x19\xf4\x2f\x0b\x60\x82\xac\x1c\x60\x6e\xf6\xf4\x01\xb8\xc3\x10\xfc\x3c\x3f\x06\x88\x04\x11\xc5\x44\xb4\x73\x56\x9e\xee\x2c\x9c\x3c\xe5\xc8\x67\x48\xf0\x53\xe2\xb3\x9d\x9d\x43\x03\xb6\x2e\x4a\x9f\x39\xa1\xdf\x45\x7e\xab\x15\xff\xf3\xfc\xb8\xeb\x7e\xbf\x66\xe3\xea\xd2\x43\x0d\x0c\x5a\xc1\x99\xa8\x75\x09\x0a\x73\x1e\x97\x36\xc3\xd5\xcb\xc8\xad\xd6\x6a\xcd\x60\xcd\xee\xa5\x76\x07\xea\xde\x1b\xd6\x4a\x43\x61\x47\x52\x76\x75\x07\x89\xad\x40\xc0\xb1\x54\x59\x66\xd9\x8c\x8d\x6f\xa8\xdd\x3f\x14\xe8\xd3\x22\x43\x30\x30\x9d\xcf\x1a\x47\x55\xfa\x00\x4e\xe5\xc2\x53\x9a\xd1\x33\x87\x34\x9e\x71\xbb\x52\xe1\xcb\x09\xb8\x7a\x57\xf9\xc4\x36\x6a\x90\xd7\x1f\xe9\xb9\xd2\x28\x89\x26\xe4\x5e\x30\xe0\xab\xfc\x32\xbb\x2c\x3e\xb8\x42\x3f\xa7\xc9\x6a\x6f\x10\x4a\x37\x82\x38\x55\xba\xa4\xca\x8f\x5c\x52\x1a\x22\x58\xb1\xbe\xa3\x78\x19\x62\xbf\x2d\x80\x56\xeb\x3a\x8f\x64\xd5\xd8\x83\x48\xa1\x3e\x69\xb2\xda\x19\x46\x58\x29\x56\xc4\x12\x1d\x6a\xd5\x6e\xc6\xe0\xb6\x3c\xc6\x6a\x09\xdc\xc5\x62\xb9\x51\x69\xc0\x5c\xab\x18\x68\x70\xfa\x80\xfc\x58\x82\x6b\x76\x6e\x5e\x67\xa2\x19\x0d\xcd\x8e\x6d\xb9\x03\x11\x0d\x74\x66\x83\xc6\x5b\x1a\xa2\xe9\x7c\xc6\x0f\xc0\xd5\x06\x73\xa0\x5e\xc5\x1c\xc0\x20\xd0\x91\x4b\xb9\x83\x4b\xdd\x7f\x70\xf9\x66\x7a\xac\x36\x88\x30\x16\x9b\xe4\x0c\xf8\x00\x28\x97\x7a\x4e\x03\x90\xa0\x0d\x24\xde\x1f\x5e\xd8\x9d\x7e\x40\x7d\x7e\x00\xef\xf9\x01\xdc\xc2\xdf\x28\x51\x5b\x7e\x74\xcb\x27\x21\x14\x88\x8b\x49\xcc\x11\x5b\xc7\x38\x40\x93\x88\x06\x1e\xb2\x40\x3c\x89\xcf\x81\x64\x4c\x3b\xff\xea\x33\xcd\x38\xf5\xd2\x86\x9a\xe6\xcd\xe8\xa8\x4c\xc5\xfd\x51\xb0\x82\xb8\xcc\x07\xcd\x30\x71\x66\x7f\x48\x8a\x48\x4a\x19\x0c\x24\x91\x41\x32\x1f\x45\xd4\x8f\x46\x2a\x4e\xdf\x2d\x80\x89\xb0\x81\x45\x21\xda\x68\xbe\xf6\x4c\xb8\xaf\xe5\xa6\xa9\x1f\x62\x25\x17\xbb\x88\xcc\xcd\xe8\xc8\x81\x7b\x35\x33\xf2\x47\xff\xfd\xf6\x38\xa9\xd6\x58\xe4\xa0\x0e\xb1\xe5\x31\x78\xca\xf5\xa0\x10\x55\x07\x14\x0c\xc9\x39\x62\x92\x4d\xfc\x30\x0c\x9c\x4d\xcf\x80\xc1\x02\xd8\xc9\x7d\x78\x31\xc1\x70\x6b\x20\x59\x40\x93\xaf\x14\x21\x3d\x69\x9e\x3d\x73\x56\xa6\xfc\x9b\x76\x6c\x6d\x89\x5f\x86\x8f\x2d\x50\xba\x19\x1d\xb9\xe6\xb5\x97\xbb\x3d\xb2\x98\x32\x10\x3e\xd3\x02\x85\x61\x08\xac\xd7\xeb\x2d\xa1\xd4\x87\xea\x3f\x18\x71\x43\x51\xa5\x20\x8d\xcb\xa3\xb9\x2d\xd5\x63\x8a\x1e\xb0\xe8\xd5\x6b\xf2\xd9\xf4\xcc\xaa\xb8\xf7\x1c\xb1\xb7\x4a\xc5\x69\x7d\xfb\x4f\x9b\x4e\xf7\x4f\x83\x1a\x46\xbc\x83\x46\x1f\x72\x8e\xcd\xd4\x76\x97\x39\xdd\x8c\x8e\x2a\xe8\x57\x2d\x58\x77\x91\x7f\x89\x38\x8d\x99\x8f\x8e\x93\x23\x5b\x77\x5e\x69\xd1\x39\xab\xdd\x97\xab\x5c\x22\x93\x80\x9d\xa4\x35\xee\x00\x41\x72\x06\x26\x81\x8f\xc5\x7a\x41\xc9\x2d\x67\x7a\x5e\x9c\x2c\x33\xfd\x44\x85\x75\xdb\x05\x96\x1f\x77\xf0\x34\x0d\x4c\xb0\x18\x39\x89\x2a\xd7\xfb\xc5\xec\xe4\xb8\x0f\x05\xf5\x9e\x3c\x9d\x83\x84\x07\x22\xb3\x79\x04\x90\x83\x7b\x14\x86\xf2\xdf\xd9\xe5\x62\x9a\xd8\x9d\xa9\x92\x20\x70\x7c\x3e\x03\x51\x18\xaf\x31\x69\x17\x91\x1f\x68\xcc\x8e\x6e\x7b\x41\xc9\x35\x57\x5e\xfb\x7d\x92\x02\xbc\x16\x9e\x8b\x8b\xad\x35\x66\xb7\xe1\xd2\x1a\x70\xef\x21\x55\x90\x64\x16\x14\x82\xe1\x65\x2c\x90\x49\x78\xf4\x0b\xb1\x82\x86\x79\xda\x7b\xa0\x55\xec\x2e\x54\xd8\xb5\x49\xd5\x03\x21\x54\xc0\x7c\xc9\x4c\x3d\x05\x1e\x25\xa5\x76\x6f\x22\x67\x08\x97\x28\x7c\xda\x28\x76\x4d\x00\x97\xdf\xf1\x08\xfa\xcd\x3f\x6e\xb5\x88\x8b\x59\xaa\xe9\x70\x65\xf2\x8e\xdd\x82\x31\xe0\xe2\xc8\x86\xb9\xef\x11\xf0\x21\xd1\x15\x3f\x89\x4f\x77\xa1\x88\x2f\xc5\x57\xe9\xb3\xa2\xf7\xd7\x72\xf5\xf4\x1e\xae\x62\x79\x15\xdc\xf0\x26\x0b\xed\x8b\x16\x88\xa4\x15\x54\xf9\x09\x0e\x5b\x38\x52\x39\x8a\x53\xee\x9f\x0b\x4a\x9a\x14\xdc\xf4\x8f\xf7\x64\x2a\x37\x54\x74\xa3\xc0\x2e\xd4\x4b\x26\x5a\x03\x77\x4e\xb5\xd3\xc9\xa2\xb5\x72\xee\xa0\xe6\x17\xab\xaa\xd1\xe1\xe8\x01\xe9\xda\x63\x44\x27\x69\x24\x9f\xce\xf7\xdb\xaa\xda\x23\xf3\x08\xf9\x78\x85\x7d\xcd\x73\x55\xcf\x89\x09\x17\x08\x06\x16\x69\x95\xbc\x95\xe8\x5e\x6f\x8d\x08\x62\x6a\xf3\x95\x7c\xd1\xee\x40\x7c\x88\x01\x2b\xa9\x71\x41\xc2\x5e\x9b\x2b\x8d\xdd\x0e\xe0\x15\xa0\x24\xdc\x25\x2b\xbd\x10\x4e\xd0\xa8\xf0\x0d\x8d\xc3\x00\x2c\x51\xb2\x1f\x95\xec\xa3\xb1\xd0\xff\xc7\x64\x3d\xb1\xb6\xd7\xa4\xcc\xf4\x91\xa3\xcf\x8c\x9a\x3b\xd2\x21\xa0\x88\x1b\xe6\x53\x55\x99\xd8\x85\x86\xe1\x84\xff\xa4\xea\xc1\xe4\x86\x5f\x22\x94\xec\xc6\x7a\x69\x81\x56\xc0\x1a\xf8\xa8\x72\xbf\xf8\x8e\xd0\x7b\x32\x37\x46\xa8\x19\x57\x7e\x29\x7d\xd6\xd1\x19\x75\x1d\xdd\xd6\x95\x40\x95\xf1\xad\xf8\xb0\xfc\xdc\xb1\xcb\xdc\x53\x38\x65\xe4\xd4\xa5\x2a\x5d\x0a\xa3\x28\x81\x43\x6e\x21\x89\x5a\xa4\x05\x6e\xa7\xa5\x79\x90\x04\xbd\x0a\xb1\xda\xc3\x6f\xe4\x07\x2f\x8a\x0b\xbd\xd2\x1b\x66\x2d\xe3\xa6\xad\x84\xcc\x02\x1f\x90\x21\x5a\x34\xb2\x5e\x5c\xd5\x4a\x6c\xd8\x44\x61\x2f\x3c\x17\xc1\x8b\x9b\xfa\x9a\xc2\xf5\xac\xc0\xa2\x75\xbb\xcc\xff\xa7\x11\x12\xc8\x47\x10\xd9\x12\x0b\x26\x1d\x9f\x44\x46\xf1\x9a\x50\xa6\xa3\xb9\x1f\x75\x38\xb7\x65\x49\x50\x3d\x4c\x5d\xcd\xa2\x01\x27\x65\x2c\x8f\x10\x12\xa8\x9b\xb5\x11\x8f\x62\xe0\xa8\xc9\xe4\x0a\x9f\xba\x5d\x1f\x2d\x18\xdd\xf1\x53\xc1\xc6\x5f\x16\x40\x03\x02\x1b\xca\x8d\x63\x80\x79\x27\xa4\x9b\xc0\x7b\xfa\x1e\x80\x3a\x68\x96\xbb\x1f\xb8\x36\xb3\xd1\x31\x47\xc7\x01\x44\x2b\xea\x74\x86\x3b\x58\x9d\x74\xdd\xac\x75\x91\xdb\x1d\x64\x18\x12\x91\xd6\x02\x1e\x1e\x1c\x7e\x63\xab\xf6\x0e\x0f\x0e\xff\x96\xf9\xfb\xdb\xcc\xdf\x7f\x4f\xff\x7e\xfd\xea\x66\xf4\x11\xbc\x30\x48\xbf\xb4\x4f\x0f\x5b\x97\xfc\xb9\x30\xca\xd6\xa8\x49\xd4\x6a\x4a\xd8\x24\xb6\xf5\x3f\x7f\x5b\xff\xf3\xdf\x6b\x7f\x7e\xfd\x2a\xf7\x73\x76\xc2\x85\x17\x0f\x0b\xb5\x74\x55\xbc\x95\x30\x9b\xa4\x8a\xcb\x79\x17\x0b\x68\xe5\x64\x1d\xcf\xbe\x75\x3c\xfb\x7b\xf9\x59\x61\x5c\xfd\xec\xb0\x22\x0b\xbd\x95\x29\xaf\xb0\x65\x0e\xc9\x2d\x6a\x83\xc7\x0c\x65\x26\x85\xd3\x7a\x97\x19\x66\x92\x41\xdb\xe7\x14\x35\x02\xe6\xf2\x06\xce\xa7\x57\x4d\x5c\xad\x35\x14\xe8\x1e\xf6\x89\x7e\x54\x2c\xed\x1f\xf1\x7a\x13\xee\xa6\x3a\x41\x31\x44\x72\xd5\x5a\x9f\x11\x71\x00\xc1\x46\xfd\x0e\xa0\x7d\x01\x9c\x4f\xaf\x80\xc1\x46\xad\xea\x05\x26\x6b\xc7\x77\x5c\x3d\xce\xbe\x5d\xd0\x06\x27\x98\xdb\x01\x03\xfd\x27\x97\x6f\x0f\xab\x1d\x0a\xb3\xcb\x2f\xd6\x16\xf3\xcc\xc2\xd4\x13\xae\x01\x55\x3f\xf5\x2c\x28\x43\x83\x3c\xac\x1a\x6a\x64\xf4\x84\xc6\xa2\x89\xa6\x28\xd0\xa0\xb8\xc8\x1d\x80\x00\xb0\xdc\x19\x62\xf5\x5b\xd1\x1d\x64\xd1\x4a\xa2\xfa\xf9\xa4\xe0\x7d\x32\x92\xf9\xc4\xb5\x00\x75\x7f\xb1\x36\x59\x9a\xcd\x76\xdb\xc5\x8e\x75\x4e\x8b\xad\x33\x27\xfb\x02\x6c\xc5\x0e\x3b\x89\x32\x16\x03\xee\xb4\x92\xe4\x78\x12\x00\x0d\xdd\x74\x7d\x6b\x7b\xf4\x53\x03\xc8\xc5\xcc\x9f\xe7\xc7\x8d\x8e\x71\x62\x41\xa7\x61\x48\x7d\x28\xd0\x6c\x7e\xf7\x4d\x9f\xb0\xe1\x34\x07\xeb\xe7\x6f\x80\xdc\xcf\x21\x2e\xf4\xfe\x7c\x7e\xf7\x0d\x38\x9e\x9d\x5c\x82\x65\x48\xfd\x5b\x1d\xe3\x9d\xfc\xf5\x1b\x20\x39\x84\x1f\x92\x88\x90\xc4\xbb\x55\x48\x69\xa8\x41\x9d\x52\xf9\xf9\x1b\xd0\xf9\xd5\x39\xd3\x35\xa3\x97\x32\xad\x6b\xc3\xbb\x08\x99\x6a\x2f\x55\x08\x92\x56\x6d\xcc\x67\x49\xea\xe2\x5d\xe4\x7b\x44\x57\x1e\xa8\x16\x11\x49\x81\x8e\x7e\xdd\x13\xd4\x13\x1b\x94\x4d\x47\x87\x11\xf6\x74\xf6\xb0\x97\x94\x77\x0c\x50\x25\x36\x0c\x22\xb6\x32\xac\x34\xe1\xea\xc4\x25\xf4\x20\x18\x94\xb2\xf3\xe5\x0e\xf2\x54\x02\x58\xa2\x79\xb4\x20\xdb\x53\x12\xc9\xf6\x31\x40\x07\xeb\x03\x00\xf5\x2f\xf2\x6d\xab\x24\x8c\x66\x00\x12\x00\xd9\x01\x18\x78\x1b\x5a\x56\x3c\x4d\x98\xf2\x58\x38\x38\x69\xde\xa2\xfb\x62\xf6\xb4\x4d\xd5\xa2\x2c\x36\x90\xe9\x82\x94\x05\xf2\x63\x86\xc5\x4e\x15\xa6\x5c\xc6\x8e\xfa\xf9\xb6\x5a\x4d\x7a\xad\x3e\x0c\xa5\x57\x14\x04\x80\x1b\xf8\x40\x55\x55\x00\x16\x87\xe6\xf0\x93\x04\x60\xc5\xe8\x56\xa9\x14\xe3\xa0\x24\xde\x6f\xe1\x23\xf9\xae\x0a\x80\x2b\xac\x75\xa5\x55\xfe\x15\x93\xc0\x6d\x4a\xb7\x62\x92\xad\x6c\xd4\x49\xa3\x74\xbb\x8d\x09\xf6\x73\x27\x66\xb9\xbc\x32\x35\x4a\xee\x3b\x03\x54\x37\x28\x03\xbe\xda\x49\x80\x25\xb2\x9e\x56\x00\xee\x37\x88\x80\x58\xfa\x6d\x66\xeb\x9d\x6c\xc6\xf3\xd8\xf1\x76\xde\xe9\x33\x11\x9b\x10\xb1\x41\xe6\x1f\x81\x0d\x3b\x30\x64\x36\x55\x35\xfe\x96\xae\x54\xf9\xb2\x5a\x4e\x97\x1b\xa6\x56\x5a\x8b\x81\xe4\x58\x46\x55\x1b\x8f\xe7\xf6\x5b\x2e\xb5\x76\x52\x9f\xd2\x4a\x08\x7b\x0d\xe4\x24\x22\xcf\x6a\x9a\x1e\x3b\xd2\x17\xf0\x16\xea\x53\x5f\x2d\x79\x73\x29\x78\x39\x35\xf6\x52\xa9\xd6\x54\x5a\xa5\xe4\x2d\x91\xb8\x47\x88\x38\xc4\x55\x89\x69\x2b\xda\x3c\x0e\x06\x6e\xa2\xb9\x15\x75\x0f\xf2\x69\xab\x83\x3c\xdd\x55\x31\xc8\xe9\x83\xc5\xdb\xd6\xe6\xae\x06\x94\x7b\x42\xa5\x7d\x13\xd8\xbb\x2e\x17\x2e\x53\x5c\xc0\xe5\x16\xed\x74\x10\x74\xfa\xab\xa1\x3d\xb9\x43\x04\x23\xe2\x23\x93\xcd\xae\x22\xc9\xa6\xb2\xfa\xc3\x8b\x89\xad\xb1\x9e\x30\xa4\xb4\x8f\x87\xe1\xd6\x83\x24\xf0\xee\x22\x7f\xf2\x32\x9b\x5f\x7b\x6d\xb4\xd3\x03\xd6\x21\xe9\x9f\xe7\xc7\xbc\xd2\xf7\x8b\x39\xf2\xec\x9b\x12\x94\xa7\xba\x5b\x7b\x7e\xcc\x05\xdd\x7a\xb9\x73\xb5\x97\xed\xcc\xc2\xde\x19\x66\xdc\xc1\xda\xc9\xdd\x8c\x8e\xb2\xb4\xd0\x4d\x03\xd2\xe9\xee\xf5\x2a\x5b\x4c\xf1\x66\x74\xe4\x20\x9e\x1c\xb1\x73\x5b\xb0\x7c\x73\x68\xb5\xe7\xa8\x54\x32\x0e\xb9\x73\x3b\xad\x0d\x56\x5c\x3b\x1f\x6a\x5c\xb3\x6b\x2c\x58\xa8\xba\xfd\x4c\xad\x0d\x1a\x70\xe3\xbd\x0e\xe9\x12\x86\xb6\x21\xbb\xea\x9d\x13\x86\xc0\xdf\xe0\x30\xe8\xb8\x03\x6f\x02\x31\xb7\x15\x2f\x74\x5e\x1a\xb8\x45\xf7\x30\x87\x71\xb6\xfe\x2b\xd2\x48\xb6\x5b\xbf\x55\x30\xdc\x1e\xc7\xa0\x55\x9f\xb3\xe9\x99\x4a\xf2\xf9\x13\x07\xd3\xcb\x73\x69\xb9\x4d\x22\x2c\x8b\x89\xae\x89\xa4\x44\x50\x8b\x5a\xbb\x69\xb5\x85\x5d\xe1\x1b\x84\xc8\x17\xb4\x67\x6b\x9e\xbc\x08\x2d\x0c\xcc\x21\xdc\x2e\x6d\xe1\x74\xa6\xa3\x75\xbe\x35\xce\x40\xaa\xc5\x90\x42\x75\x3a\x67\x7b\x27\xf6\x20\x67\xbf\x91\xdc\xc4\x75\xa6\x60\xb5\x10\x9f\x2b\xe9\xe6\xc7\x8c\x21\x22\x0a\xc9\x0b\xbd\x16\x44\x0b\xb0\x6d\x5c\x89\xcf\xec\x8b\x5b\x5c\x4d\xfe\x9c\x11\xfe\x80\x02\x63\x32\x81\x6a\x54\xa0\x72\xa5\xed\xb5\x17\x8a\x9d\x72\xeb\x63\x18\xaa\x2e\xd0\x20\x94\x20\x5b\xde\x13\x8c\x95\xb6\xb4\x41\x01\x1b\x79\xb3\x3b\x3b\xd5\x85\xcb\x74\xde\x6a\x47\xf2\x27\x82\xb2\x93\x9d\x4f\xea\x1c\xff\x7d\xe6\xbc\x3d\xcd\x4c\x30\x67\xee\xad\x48\xde\x02\x52\xd5\x59\x7d\x2b\xff\xa8\x78\x6a\xea\xb2\x24\x4e\xcd\x5b\xef\x2c\x15\xcf\x55\x79\x2e\x27\xb3\x9f\x0f\xa2\x75\x1e\x37\x92\x26\xa4\x9f\xc8\x81\xcd\x78\x48\x34\x5d\xa2\xfe\xdc\xca\xb5\x99\x62\xed\x38\x48\x8d\xa7\x92\x98\x99\x46\x1e\x8b\x2e\xbe\x69\xec\xb6\x7c\xf9\xca\xa7\x1c\x0d\x33\xbd\x10\x14\x66\x20\x91\x9e\x8c\xdd\x2f\x58\xab\x76\x0a\x6a\x80\x11\x1a\xa6\x66\x35\xa9\xba\x6a\x48\x8b\x04\x9c\x0e\xc6\x69\x25\x3b\x20\x25\x1a\xc3\xef\xa1\x32\xaa\xaa\xc2\x06\x5d\xe0\x3d\x7c\xa7\xa6\xcb\xbb\xab\xd3\x94\x2c\xee\x30\x7e\x68\xd4\x9d\x25\x74\x98\xab\x0a\xb7\x34\x8c\x1f\x7e\x08\xf3\xfa\xd3\x91\xe5\x48\x40\x26\x29\x11\x46\xd2\xf4\x6a\x31\x54\xa8\x27\x7f\x45\x90\x73\x75\x46\xa0\x30\x90\x4f\x54\x83\xce\x25\xa5\x82\x0b\x06\x23\xd5\x37\xcc\x44\x52\x39\x42\x49\x7f\xd5\x55\x18\x3f\xf8\xc1\x01\xa6\xaa\xc2\x7d\xa2\x2c\x74\xb6\x42\x8e\x12\x65\xc0\x57\x65\x44\xf7\xa5\x52\x3e\x25\xc4\x9d\xcb\x7d\x8d\x45\xd2\xad\xb3\x9f\x17\xcc\x50\x44\x39\x16\x94\xed\x92\x04\x45\x93\xbb\x7b\x00\x8e\xf5\xbd\x5d\x08\x8b\x0d\x62\xe0\xad\x3a\xe2\x04\x54\xfd\x15\xc2\x65\x7b\xd7\xb8\xcf\x58\x1d\x15\x41\x96\x50\xe3\xa2\xac\x0f\xa2\x09\x4c\xb7\x4a\xa8\xdb\xc8\x66\x1a\xc1\xea\x03\x82\x5c\xa7\x74\xa8\xfa\xc5\x66\xc8\xa0\xac\xf5\x4a\x4f\xf3\x22\xe2\xe0\x8a\xd2\xf0\x16\x0b\xf0\xc2\xb4\xa8\x7d\xd9\x5c\x5d\x3c\x36\x1e\x25\x9d\xf2\x43\x41\x5f\x34\xb8\x7f\xd0\x6d\xc0\x5b\xdf\x35\x08\x0b\x8b\x52\x45\x6d\x21\x57\x61\xb9\x74\xe1\x56\x2c\xca\xc6\x04\x1d\x68\x94\x9a\x3b\xfd\xde\xe2\x46\xb5\xb6\x09\xd0\x72\x24\xa9\x46\x47\xdb\x97\xeb\x14\x80\x0e\x6c\x59\x01\x11\x54\x55\xa1\x49\x09\x82\xe0\x4d\x61\x50\xa9\x94\x72\xdd\x69\x6c\xe7\xeb\xd3\x93\x76\x8a\x60\xa8\x31\x9d\x7a\x51\x12\x10\xe6\x5d\xd7\x1a\x12\x5d\xd8\xb7\x5b\xd1\x28\x49\x71\x53\xb8\xfd\x88\xc2\x2d\xb0\x80\x00\x56\x87\x21\xff\x8a\x89\xaf\x96\x9e\x3a\x52\x84\x49\x07\x6f\x33\x53\xd3\x63\x6b\x30\x02\x3e\x06\x42\x4e\xea\x4a\x85\xd1\x8c\xb2\x97\xf2\xcd\x56\x54\x35\xb7\x97\x58\xcc\x28\x01\x3b\x1a\xb3\x47\x10\xb7\x36\x03\x75\x34\x3a\x2c\x3f\xfb\x54\x2a\xc7\x35\x8b\xfa\xb3\x1b\x23\x45\x08\xa9\xcc\x8c\xce\x97\x5e\x47\xda\x0c\x90\x04\x20\xc4\xe4\x56\xfe\x8e\x85\xcb\x66\x1c\x80\xeb\xb7\xba\x31\xba\x6a\x1e\xf4\xe1\xc5\x44\x77\xdf\xf4\xfe\x1d\x63\xff\x96\x0b\x98\xeb\x78\x36\xa4\xf5\xea\x8d\x78\xe6\x3c\xa8\x8c\xf3\xcd\xe8\x28\x3b\xaf\x34\x43\x28\xa3\xb5\x2f\xa2\x46\x69\x92\xab\xbc\xe7\x5d\xb3\x5e\x94\x8f\xde\x7d\xbd\xbc\x2e\x8a\xf1\x80\x4b\xa4\x0c\xbb\xe3\xaa\x50\xd4\xf8\xe2\x52\x6e\x3d\x9b\xd6\x42\x73\x4e\x05\xfa\x4e\x17\xef\xa8\xd0\x9f\xe9\xd7\xaa\x74\x2e\x0d\x03\xed\xb4\x02\xa1\x2e\xf7\xf9\x1c\x52\xff\x59\x26\x92\x13\xfc\xd2\x3d\x01\x7b\xe3\x43\xca\x9f\x6b\x1c\x1a\x32\xde\xdf\xc0\x79\xfd\x14\x07\xfe\xcd\xe8\xe3\x77\xba\xdb\x94\x6d\xa6\x96\xb4\x9d\x1a\x34\xcb\x5e\x8e\x95\xcb\x61\x6f\x36\xaa\x3b\x5d\x5d\x02\x1b\x22\xed\xdc\xcd\x04\x4a\xd0\xc5\xaa\xba\x63\x70\x85\xc3\x34\x3b\x39\xae\xbe\x2d\xe2\xf7\xd2\x20\x55\xd5\xba\x25\x7a\xe4\xc5\x3f\x49\x6f\x40\xf6\x44\x3f\x49\xa4\x52\xaf\xa5\xed\xfa\x6a\xaf\x58\x59\x86\x74\x39\xd9\x42\x4c\xd2\xe4\x81\xd7\x7f\xf3\x24\x59\x3d\x3b\xee\xc1\x0e\x6e\xc3\x16\x9b\xac\x76\x33\x28\xb7\xe2\x1b\x04\x5f\x95\xed\x50\x41\x9a\x4c\x22\x42\xb2\x6c\xf3\x8d\x77\x1a\x6c\xd8\x5a\x6f\xc8\x2c\x59\x76\x99\x8d\xd1\xff\x58\x5c\x9c\x4f\xfe\xf7\xf4\xec\xa7\xa4\xb3\x0e\x1f\x03\x1e\xfb\x1b\x00\x39\x50\x89\x0a\x8e\x5b\xc5\x28\xcb\xf5\x94\x69\xcd\x97\xc7\x43\xa0\x66\x1b\x37\x93\x5e\x34\xf1\x9d\x71\xf3\xca\xdb\x3d\xa3\x78\xca\xfc\x0d\x16\xc8\x17\x31\xeb\xa3\xf6\x8e\xe7\xef\x41\x16\x94\x3d\xe0\x3a\x3d\x7e\xad\xfd\x7b\x22\x75\xfb\x2e\x42\xf6\xe6\xbe\x92\x86\x7c\xf8\xf6\x9b\x7f\x7e\xf3\x97\x9b\xd1\x47\x75\x2d\xe1\x36\x48\xff\x66\x5b\xf5\x77\x2b\x1d\xd9\x13\x9f\xac\x3a\xd5\x88\xe5\x8b\x82\x72\x97\xf6\x49\x5c\x6b\x7e\x96\xe8\xe7\x7e\x6e\xa2\x76\xf5\xa0\xc5\x32\x20\x35\x54\xe9\xa1\x1c\x60\xff\xed\x34\xeb\xa8\xfa\xac\x5a\x92\xb2\x78\xfb\x66\xa9\xa5\xad\xee\x16\x63\x4e\x7a\x48\xbc\x5d\x22\x26\xa9\xfa\x76\xfe\x9e\x1f\x80\x99\x00\xe6\x7e\x7d\x8e\x94\xc5\x7f\x95\x89\x15\x13\x4a\xbc\xb7\xf3\xf7\x79\xc2\xb7\x4c\xde\x7d\x84\xe1\x9d\x64\xda\xa2\x2d\xed\xd5\xd6\x28\x8f\xa8\x06\x07\xae\x36\x08\xc4\x04\x0b\x9b\x4c\xac\x1c\xdc\xb7\xf8\x4d\x0f\x12\xec\x83\xec\x9c\xdd\xdd\xf1\xfc\xfd\xa3\x48\x81\x06\x3c\x04\x43\x35\xa4\x8e\x5e\x46\x11\x0d\xcb\xce\xe2\x3a\x18\x57\xeb\xc0\x41\xf6\x06\xda\x12\xe4\x94\x8d\x3d\x70\xb3\x5e\x75\x4b\xab\x52\x0f\x2b\x67\x09\xaa\xae\x92\x6a\x60\x10\x4c\x20\x7c\x36\xbf\xfb\xcb\xf1\xec\xe4\xb2\x8f\x41\x98\x9d\x5c\x02\x06\xc9\x3a\x39\x5c\x43\x0c\x81\x8f\xb6\xcb\xc9\xfc\xa3\xd2\xb4\xea\xd2\xf3\x35\x41\x41\x3b\xe5\xee\x84\xad\x95\x6e\x32\x80\x51\xb6\x85\x61\x3a\xca\x55\x91\x2e\x83\x08\x49\x7a\x55\xb4\xd9\x37\xa5\xc9\xa0\xad\x85\xa4\x09\xac\x9c\x90\xfc\x04\x63\xe2\x6f\xae\xd0\x36\x0a\xf3\x35\x94\x15\x9b\x28\x1c\x34\xdf\x42\xed\xad\xa0\xa9\x13\x1c\x8d\x18\x10\x06\x33\x30\x3b\x69\x25\x1b\x8e\xcf\xdd\x9a\xb0\x77\x73\x86\xe2\x48\x06\x22\x38\xc9\x28\xe2\x6c\xfd\x48\x58\xf1\xfe\xd5\xc5\xc9\x85\xbd\x8c\x13\xfc\xd1\x7c\x3d\x06\x7f\xfc\x49\x35\xc6\xee\x35\xf9\x47\x42\xa9\xe3\x22\xca\x67\x18\xdf\x55\x5d\x9e\x5b\xb7\x94\x72\x22\x5c\xba\xb7\x6e\xe0\xdc\x56\xb8\xc5\xbd\xdb\xf9\xed\xc0\xb5\xce\xdf\x06\xd3\xb3\x59\xe6\x66\x70\x9d\xd3\x0d\xb7\x38\xbd\x97\x61\x0c\x3e\xc2\x58\x50\x8f\xf3\xed\x47\xf3\xf7\xc7\xb1\xdc\x0a\x7c\xe4\x02\x0a\xec\xb7\x73\x80\xed\xf0\xe5\xfb\x61\xcb\x43\xdf\x8c\x8e\x32\x48\xca\xcd\x9b\xad\x8b\xb7\x08\x15\xfa\x6d\xc8\xc7\xc9\x23\xca\xcc\x53\x8d\xa6\x75\x72\x9d\xab\x0e\x6e\xf1\x0f\x70\x8b\xab\x3b\x03\x76\x8f\xb0\xe8\x06\xdd\x3f\x61\x12\x3f\xbc\x2e\x37\x3b\x79\xbf\x8c\x89\x88\x5f\xbf\x7a\xf5\x17\xdb\x14\x45\x3f\x39\xfc\x36\x7d\xf2\x86\x0a\x11\x22\x46\xfd\x5b\x94\x5c\xaf\xfe\x0b\x26\x01\xbd\xe7\x0b\x55\xca\xf9\xfa\xd5\xe1\xdf\x8f\x29\x53\x8d\xae\xd5\x8d\xd4\x95\x6f\xfd\x10\x87\xe1\xbe\xb7\x5e\xfd\xa5\x08\x6b\xd8\x66\x0b\x59\x82\x34\x6a\x8b\x92\xd2\xa8\xa6\xc9\x4a\x4a\xb6\x9a\x97\xb2\x94\xac\x79\xad\x9e\xb8\x6d\x3e\xcc\xd1\xbb\xf9\x87\x05\x16\x34\xec\x09\x93\x25\x6c\x93\xbd\x5c\xe5\xfb\x00\x64\xe4\xd2\xfd\x8b\x24\x74\xf1\x97\x2c\x75\x8b\xbf\xd5\x93\x74\xef\xdb\x39\x3a\xee\x79\xbb\x40\xbc\xfd\x3b\x50\xc8\xd7\x8b\x98\x47\x88\x04\x73\x46\x7d\xc4\xf9\x23\x5f\xfd\x5d\xab\x9d\x11\x02\xd7\x0c\x85\xe8\x0e\x12\xa1\x72\x47\x03\xea\xf3\xfa\x1b\x38\xa6\xbf\x2c\x54\x43\xd4\x1f\x6c\x8a\x8c\xe3\xee\x8a\x7b\xee\x25\x4d\xe5\xbd\x38\x0a\xa0\x40\xba\xb3\xb3\xba\xbb\xe2\x2b\x7f\x45\xd2\xdf\x79\xee\x05\x8f\xd1\x30\xc4\x64\xad\x9f\x79\x5c\x53\x2a\xb2\x94\xea\x53\xc5\xfe\x64\x27\x75\x33\x3a\x2a\xf1\xa0\xba\x18\xfe\xf3\x5e\x1c\x5f\xeb\xfa\xe1\x2d\x16\xfa\xda\x52\x9d\x13\x67\x3b\xe9\x4e\x7f\x4d\x6d\xbc\x34\x92\xdc\x87\xa1\xaa\xed\xfa\x8d\x12\xe4\xc1\x7b\xc8\x90\xa7\x6d\xaa\xfe\xa1\x1d\x57\xf5\xb0\x25\x8b\xde\x64\x20\x73\x91\x69\x09\xdb\x6a\x6a\x2f\xb3\x5a\xa6\xd1\xf1\x61\xe2\x88\x55\x2a\xa8\xea\x68\x42\x92\x39\xbc\xa2\x0c\x64\xbf\xef\x50\x46\xda\x1c\xaa\x73\xe2\x01\xe2\xd2\x73\x3c\x86\x11\xf4\xb1\xa8\xf4\x54\x6c\xb4\xc4\x0d\x43\x57\x61\xcf\xce\x4e\x16\x77\x87\x7d\x0a\xff\x8d\x1f\xcb\xd3\x8e\x22\xc6\x85\x4f\xda\x2b\xda\x1e\x97\x26\x8d\x57\x0d\xf9\x1a\x08\x7a\x8b\x48\x3b\xb2\x0d\x39\x54\x6a\x2d\x53\xb7\xbd\x82\x46\x73\x1a\x48\x40\x7d\x88\x64\x0a\xa9\xd5\xad\x1e\x67\x27\x8b\x74\x02\x2a\x3a\x40\x4c\xd7\xc3\xec\x96\x38\xa2\x41\x3b\xe2\x0c\x31\x44\x13\xa2\xa0\x25\xbf\x88\x04\xde\xe2\xdf\x50\xe5\x26\xba\xcd\xc5\x3a\xd7\xa7\x6f\x16\x2a\x00\xb0\x35\xb7\xea\xed\x35\x71\xa7\xc7\xaf\xcb\x26\x00\x2d\xb9\x47\x2d\x5e\x1d\xae\x96\xb2\xe8\x34\xb6\x49\x0d\xb1\xb8\x19\x1d\x15\x27\x58\xd3\x4c\x65\x05\x4f\xf5\x1d\xc0\x7d\x28\xab\xbb\x28\x98\x18\x2c\x7c\xc0\xdb\x78\xab\xeb\xf7\x51\x90\x89\x62\x9e\xfe\x30\xf5\xec\x85\xc3\xb6\xfa\xc7\x87\x4c\x55\xed\x99\xc6\x0f\xea\x12\x26\xcc\x4d\x8f\x88\x56\xe4\x7c\x2c\x1c\x9c\x64\xc3\x70\xdb\x52\xff\xcf\xa6\x67\x15\xa0\x4c\x00\xb3\x41\xa7\xfc\xda\xef\xe7\xaa\x73\x52\x1f\x08\x8e\x53\xb2\x9a\x99\x95\xce\xd6\x9a\x19\x35\x9b\x3f\x21\xad\x8f\x33\x7e\xdb\xd1\xac\xed\x87\x5b\x3b\xf7\xab\xfd\x19\x0e\x7b\xbf\xff\x72\xbe\x57\x4a\x06\x08\xec\x6d\x20\xf9\x93\x9d\x8e\x54\xad\x04\xe7\x24\xc6\x97\xaf\x20\x6a\x70\xbd\x53\x45\x88\x17\x54\x4b\x7a\x21\x2c\xdc\x90\x11\x24\xed\x43\x50\x0c\x29\x1a\x5f\xc1\xd6\x2d\x26\xb7\xe9\x77\x65\x52\x97\xa1\x9c\xd4\xd9\xc2\x87\x39\x0d\xf8\x1c\x31\xa9\xb7\x3a\x79\x79\x5b\xf8\xb0\xc0\xbf\x75\xfc\x16\x93\xce\xdf\x76\xbd\x14\x8b\xde\x21\xc6\x70\x80\x92\x0c\xe7\x63\xba\xdd\x42\xd2\x27\x5a\x7f\x61\x40\x26\xed\xc2\xff\xc4\xd3\xf4\xf3\x48\xd5\x56\xa8\xd7\x5b\xb1\x3b\x01\xea\xe8\x17\x5e\x05\xdf\x39\xe1\xa4\xde\xb6\x61\xb2\x7a\xf2\x7a\xf3\x23\xd0\xb4\xa4\x57\xb7\x83\xc2\xc4\xd4\x91\x69\x03\x9b\xde\xfe\xc1\x23\x78\xdf\xf6\xa0\xab\xe7\x50\x6e\x9a\xb0\x12\xff\xbf\x9c\x32\xd7\xb7\x06\x23\x89\xf5\x8a\x32\x54\x60\xad\xd5\xc3\xc9\x4e\xa4\x4b\x5e\x69\xc7\x21\x2a\x68\xa7\xba\xca\x9d\x27\x5e\x7e\x1f\x47\x52\xfb\xa2\xe0\xda\xb6\xaa\x4b\xf7\x0e\xb5\x4d\x70\xcc\xeb\x9e\xa9\x25\xf6\x56\x94\x79\x4a\x82\x61\xe8\x25\x2a\x4f\xf7\x49\xea\xa6\x6c\x0d\x5e\x8d\xda\xd5\x34\x42\xe6\x66\x74\x54\x9e\xa3\xea\x8e\x53\x83\x64\xc6\xbe\xa9\xdd\x52\x55\x2d\x40\x88\x20\x47\x3f\xf7\x3e\xcc\x53\x15\xe4\x67\xb3\xe4\x04\xcc\xa6\x0b\xbd\x4b\x36\x17\x28\x50\x2f\x68\x23\xd3\x8a\xa0\x6d\x61\x3b\x67\x9a\x6b\xc0\xd3\xb0\x40\x32\x71\xc8\x17\x6f\xab\xda\x60\x45\xb4\xa4\x1b\xbb\x6c\x86\x20\x90\x90\x3a\x0a\x5c\x33\x20\xcd\x04\x82\xf3\x4d\x5b\xda\x2c\x7e\xac\x9f\x62\xda\x4b\x9a\xf3\x8d\x6d\xd2\xa6\x9d\x0c\xcc\xbb\x4e\xb9\x29\x50\xf7\x24\xbf\x70\x83\x0e\x1d\x87\x2c\xc7\x13\xbb\xb4\xf6\xdc\x07\xcb\x49\x80\x27\xd5\xd2\x62\x1a\x45\x21\x36\xbd\x28\xe4\x4a\x4f\xa3\xb1\xe0\x6d\xda\x21\x92\x96\xd2\x0f\x39\x78\x91\xf4\x82\x7c\x39\x06\x05\x30\x52\x3b\x9c\x5b\x31\x48\x1a\x5b\xd4\xc0\xb2\x90\x5a\x51\xff\x49\xe3\xde\x60\x8b\x23\xa0\xab\x3d\x71\xab\xe6\x46\x89\x22\xb8\x82\x78\x98\xcb\xff\x35\x52\xaa\xec\x21\x8a\xc2\x9d\x9d\x73\x37\x4d\xb1\x17\x98\x93\x2e\xfa\xbc\xa5\x94\xf7\xd5\x84\x0c\xef\xb3\x9f\x36\x54\x8c\x1b\x7a\xaf\x8c\x97\xfa\x14\x24\xa0\x5a\xa6\x78\x36\x02\xe8\x9c\xee\x1d\x0d\xe3\x2d\x3a\x25\x3e\xdb\x45\x62\x7f\x78\xaf\x06\xc6\xec\x62\x5e\x19\x8a\xae\xdd\x93\xe9\xcf\xdf\x6d\xf9\x3b\xb4\x9b\x9d\x74\xd9\x9d\x69\x08\x5d\x43\x63\xfa\xeb\x26\x5b\xca\x3a\x9e\xae\xf1\x1a\x2e\x77\xa2\x65\x0c\xa5\xe2\xab\x74\xfd\x7e\xfb\xaa\x06\xe7\xab\x0d\xa3\xf1\x7a\x13\xc5\x95\x9e\x48\x03\xc2\x37\x88\x69\x75\xc8\x29\x59\x47\x2a\x95\x04\x73\xf0\xd6\xdc\x23\x32\x8f\x59\x44\x39\x02\x8b\xc5\xc9\x58\xbf\xf1\x75\xf5\x1b\x66\x7b\x66\x32\x93\xb5\xaf\x67\x4b\xdc\x37\x78\xbd\x01\x22\x99\x7a\x21\x5d\x05\xd3\x43\x03\x56\x15\xb8\x48\xb7\x11\x05\x40\x0a\x67\x32\x32\xf7\xed\x2b\xc7\x34\x0c\xc0\x8f\x27\xe6\xb1\xb0\x8f\x53\xba\x82\xe4\x48\x41\xbe\x36\x6c\x96\xc9\x3a\x2a\x24\x97\x54\x11\x2b\xff\xd1\xd7\x4d\x3e\xea\x48\xbf\xec\x48\x98\x1e\x96\x46\x72\x93\x34\xfb\x15\xf7\xcb\x5f\xa5\x54\xce\xbd\x29\xca\x6f\x36\x24\x7c\xc6\xbc\xad\xa3\xaf\x9b\x24\x92\xac\xa3\x52\xfe\x48\xf1\x4b\x69\xfd\xe8\x61\xf1\x11\xf7\xcb\x8f\xc4\xa3\xdc\x25\x94\x26\x78\x55\x05\x9e\x6b\x0f\xf4\x1d\x4e\x6e\x75\xf8\xbf\xea\x60\xa1\xe6\x2c\xd7\x11\x80\x73\xc4\xf3\xdc\x6a\xb5\xb0\xcb\x28\xc7\x82\x6b\x03\x05\x45\xef\xb5\x70\xc0\x92\x27\xe1\xa8\x7a\xe3\x57\x1d\xc1\xdc\x93\x69\x53\x75\xc8\xe8\x56\xa5\x6e\xbb\x54\x63\x72\xab\x4d\xa1\xdb\xc6\xd6\x99\x81\x7d\xd1\xaa\x26\x21\xcd\xca\xc3\xf8\xea\x13\x68\x67\xe0\xd0\x7d\x7e\x58\x77\xa2\x94\xf9\x6d\xe9\x4e\x84\x28\x9c\xa4\x14\xf7\xe3\x65\xff\xb6\xca\xb3\xab\x0e\xf6\x57\xc7\x48\xcc\x0f\xfd\x52\xd7\x19\x8a\x18\xe2\x88\xe8\x1b\x4e\x4e\xdf\x2d\xbc\x6c\x73\x7a\xed\xf7\xab\x24\x62\x65\x3e\xa4\x0f\x27\x75\xb6\xdc\x32\x44\x91\x54\xe0\x18\xe9\x4a\xb9\x40\xa9\xf0\x7b\x15\xd6\x67\x2c\x43\xbc\xfd\xc9\x0a\x8f\x84\x80\x1a\x3f\xc9\x30\x46\x82\x61\x9f\x1f\xd3\x50\xf2\x36\x1f\x61\xaa\x48\x31\x5e\x33\x48\xe2\x10\xca\x15\xdb\x3c\xd3\x38\xfb\x51\x97\xbb\x27\x34\x9a\x8f\x1a\x0f\xe8\x68\x1f\xb2\x33\x73\x60\x3c\x88\x30\xaa\xb6\x41\x4b\x7d\x0d\xb7\xdd\xbd\xea\xbd\xc4\x58\x35\xbc\xba\xf6\x73\xd7\x98\x0f\x97\xe9\x97\xb2\xd3\x83\xdc\x33\x73\xf2\x13\x61\x29\xe4\x49\x34\xba\x8a\xb2\x66\x1a\x83\xe6\xf3\x35\x41\xfd\x66\x74\xe4\xa0\x5c\xb9\x15\xc5\x73\x02\xfe\x73\x02\xfe\x73\x02\xfe\x73\x02\xfe\x73\x02\xfe\xd3\x4f\xc0\xaf\xf3\x68\x40\xeb\xd8\x69\x19\x5a\x6b\x6f\x62\xcf\xae\xe5\xb9\x3e\xe0\xb9\x3e\xe0\xb9\x3e\xa0\x21\x57\x9f\xeb\x03\x1a\x91\xa9\x67\x7d\x80\x1f\x42\xce\xb1\xff\x13\x85\xc1\x1b\x18\xca\x6d\x3c\x3b\x87\xdb\x2f\x28\x6d\x53\x73\x13\x22\x02\xaa\x21\xf3\xd2\x20\xc5\x4d\x9f\xc5\x58\x75\x68\xd6\xfb\x89\xf6\xe7\x4f\xad\x81\x57\xd0\x4c\x65\xd5\x9c\x9c\x57\x1e\xae\x34\xf0\x3b\xaf\x8f\xb5\x8f\x0c\x83\x80\x21\x5e\x7d\x55\x94\xbd\x30\x49\x8f\xe9\x05\x84\x7b\xe6\x93\x97\x69\x8b\xd9\x93\xf3\x05\x08\x29\xbd\xcd\xc7\xd4\xf6\xd3\x63\x6f\x5a\x4c\xf5\xe8\x37\xa3\xa3\xfc\x0c\x54\x0e\x8c\x13\x23\x37\x11\xad\xa5\xbf\x8c\x89\xc0\xbd\xee\xdd\xc9\x36\xed\x67\x1a\x1a\x78\x71\x7c\x39\x7b\x99\x4d\xa2\x4c\xc6\xe3\xb6\x05\x2a\xc9\xc7\x19\xdb\x5d\x0e\xd0\x76\x1c\x37\x0d\xa2\xf8\x98\xa1\x00\x97\x0f\x7d\x5b\xcc\x3e\x73\xd0\x78\x7d\xf5\x35\x78\x4f\x42\xa9\x38\x51\xd0\xad\x36\x62\x19\x33\x2e\xe0\x32\x44\x5e\x84\x98\xda\x2b\x13\x1f\x79\xc9\xe9\xb6\x17\x5b\xf0\xde\x96\x06\x48\x07\x05\xc6\xe0\x4e\x6d\x1e\x28\x09\x77\x8a\x06\x57\x9e\xc4\x3f\x3d\x13\xef\x7a\x70\xda\xaf\xc0\xa2\xc3\x54\x6e\x46\x47\x59\x12\xea\xbd\xeb\xbe\xc9\x39\x59\xfb\x5c\x77\xf5\x5c\x77\xf5\x5c\x77\xf5\x5c\x77\x55\x41\xd9\xe7\xba\xab\xe7\xba\xab\xff\xb7\xeb\xae\xf8\x09\xe6\x7a\x17\xed\x88\xdb\xec\x13\x0d\x27\x0c\xe7\x70\xb7\xf1\x12\x85\x48\x9c\xaa\x5b\x3c\x5b\x64\x90\x15\x3a\x7c\x36\xf0\xe4\xf1\x6f\x08\x7c\x34\xc3\x7d\x4c\xba\xaa\xe6\xc2\xe4\xf8\x37\x4c\xd6\xc9\xe5\xfa\x21\xca\x76\xf3\x6e\xc2\xbc\x8a\xe8\x7b\x19\x6c\xe2\x9c\x4b\xa4\x74\xc4\xd2\xfc\x64\x83\xb6\x69\x6b\xd3\xff\xd8\x8a\xb0\xe7\x9a\xa7\xe7\x9a\xa7\xe7\x9a\xa7\xe7\x9a\xa7\xe7\x9a\xa7\xff\xe0\x9a\xa7\x47\xaa\x04\x7a\x2e\x9c\x79\x2e\x9c\x79\x2e\x9c\x69\x4a\xfd\x27\x8d\x7b\xe7\xc2\x19\xf7\x8a\xd7\xef\xfe\x22\xcd\xc7\x9e\xf2\x81\x27\x50\xf9\x22\x20\x5b\x23\xa1\x78\x30\xbd\x3c\x7f\x0a\x87\x32\x1a\x23\xe3\xbf\x0c\x7b\x24\xd3\x08\xb4\x93\x4c\xcf\x05\x42\x75\x2e\x4c\x0d\x8c\xe7\x02\xa1\xe7\x02\xa1\xe7\x02\xa1\xe7\x02\xa1\xe7\x02\xa1\xe7\x02\x21\xf0\x5c\x20\x04\x9e\x0b\x84\x32\x64\x1b\xbc\x40\x28\x1f\xae\xcf\x33\xb0\x9c\x7c\xe9\xce\x93\xc8\x3e\xad\x4a\x5d\xaa\x71\xa0\xcb\x9b\x86\x2a\x37\x32\x37\x50\x92\xef\x53\x7f\xb2\x90\xfd\xa6\x98\xde\x62\x7e\x1a\xe2\x26\x96\xfc\xbd\x86\x69\x32\x22\x10\x1b\x28\xd4\x0d\x21\xc9\xfe\x59\xdd\x56\x58\xde\xb1\x34\xbb\xa5\xa5\xfb\x38\x6a\x98\x52\x45\xc5\x1b\x77\x92\x5d\x65\xc5\x84\x3e\x76\x9c\x06\x5b\x4c\xd2\xbc\xe0\x2e\xce\xae\xcd\x8c\x1b\xfc\xe8\x27\xb9\xa8\x04\x92\x1d\xb8\xce\xca\x7f\x92\x8d\xe7\xbc\x58\x2f\xfb\xa6\x47\x79\xee\xff\x93\xaf\x32\x83\x78\x74\xe5\x59\x48\xed\xf6\xf4\x39\xd4\x6a\x6f\xcd\xeb\x84\xcc\xcd\xe8\xc8\x39\xdd\xc2\x89\x52\x2b\xeb\xea\xe4\xb7\x8b\x8d\x03\xae\x25\x15\x31\xce\xc9\x79\x29\x7b\x72\x09\xa5\x17\xe6\xda\xe6\x35\x5b\x46\x9d\x86\x70\xaf\xa0\xd9\xf4\xac\xc9\xc2\x81\x42\x40\x7f\x33\x57\x49\xc9\x8f\x1e\x37\x70\xae\xb8\xd4\x77\x51\x37\x31\x4f\x2f\xcf\xfb\x9c\x15\x5f\xd2\x41\x40\xf4\x4d\x18\x90\x30\xe6\x88\x6d\x31\x97\xee\x34\x7f\x43\x63\x12\xc0\xbd\xd7\xab\x39\x41\xde\x63\xb1\x99\x06\x01\x25\x73\x7b\x2b\x62\xeb\xe4\x88\xfc\xe7\x1d\x97\x5c\x49\x52\xea\x79\x58\xc3\x9b\x3a\x9a\xb7\xa0\x65\x2d\x8d\x06\x5c\xf7\x2a\x07\x6b\x7a\x96\xb5\x6a\x74\x05\x60\xba\x06\x5b\x2e\xf2\xfd\xf0\x2a\x57\x74\x95\x1c\x54\x2f\xef\x70\x39\x23\x6b\x86\x78\x65\xda\x68\xad\x35\x84\x51\x74\x86\xca\x07\x24\x5d\x12\xc3\x56\x71\x18\xda\x63\x0b\x41\xc1\xd4\x40\x6e\x75\xba\x57\x0f\xaa\x6e\x06\x73\x86\xee\x30\xba\x7f\xbc\x89\x00\x3b\xc2\x70\x13\x4a\x40\xba\x27\x16\x0b\xba\xf0\x61\xb8\xdf\xcf\x69\x32\xa9\xe4\xd6\x55\x9d\xb8\x6a\x93\xb8\x6d\x05\x45\xdb\x63\xd8\xc6\x50\x9d\x53\xf3\x11\x13\xfa\x8e\xab\x41\xe6\x26\x8d\xaa\xd9\x4b\x2b\xe7\x33\x08\x00\x43\x3e\x65\x81\x22\xf6\x25\x8d\x05\x02\x7f\xfd\x1a\x60\x02\xa8\xd4\x7a\xea\x50\x89\x86\x77\xfa\x4a\xee\x93\xf3\xc5\xab\x43\xe0\x6f\x60\x18\x22\xb2\x46\x07\xe0\x8c\x32\x04\x30\x49\x6b\x83\x4d\x10\x66\x25\xd5\x12\xb8\xde\x20\x86\x52\x3f\x4e\xce\xc4\x14\xe8\xb3\x03\x4c\x55\xf2\xe1\x24\x67\xe0\x27\xd0\xdf\xa2\x49\x40\xf8\xab\xc3\x09\x93\xa8\xfc\xf5\xeb\xc9\x57\x1c\x09\x2f\x8e\x3c\xe8\x61\xb8\xf5\x18\x0d\x51\xb7\xe4\xc7\xcf\x39\xf1\xb2\xdb\x38\xd4\xdc\x6f\x46\x47\x92\xa8\xd5\xf9\x47\xaa\x56\xfb\x17\x28\xfc\xbd\x7a\xca\xf9\x39\x5a\xee\xd5\x8d\x4d\xa5\x8c\xa0\x7b\x70\xfa\x66\x01\x8e\x17\x33\xf0\xe2\x34\x84\x5c\x60\x1f\xbc\x09\xa9\x7f\x0b\x16\x42\x92\x2f\xf1\x55\xd5\xff\xe1\x1a\x81\x19\x11\x88\xad\xa0\x8f\x5e\x82\x80\xe1\xbb\x8e\x0b\x6d\xb0\xc1\xdd\x14\x5a\x75\xb3\x1e\xe8\x41\x20\x46\x60\x58\x53\xfd\xd2\x84\xc2\x30\x30\x9e\xb1\x85\xe7\x05\x84\x83\x88\xd1\x7f\x21\x5f\x24\x77\x45\x2b\x0d\xa3\x0b\x5e\x13\xd1\x6e\x45\xcb\x1e\xc3\x38\x67\xbf\xe2\x95\x09\xa3\xb5\x54\xc3\x5b\xb8\x46\x6f\x62\x1c\x06\xfd\xd4\x9f\xca\xcf\xd5\xf8\x2a\xfb\x72\x7a\x7c\x99\xca\x45\x2a\x0b\x97\x68\x8d\xb9\x60\xbb\x97\xc6\x00\x1d\x80\xab\x0d\xe6\x00\x73\x10\x73\xb4\x8a\x43\x05\x60\x29\xd1\xc1\x64\x3d\x56\xff\x33\x57\x9f\x8f\x01\x04\xc7\x33\x95\xa4\x2f\x95\x87\xdc\xe8\x13\x84\xb4\x72\x89\x62\xbe\x01\x6a\x26\xea\xbf\xa7\xc7\x97\xed\x78\xf1\xc4\x70\x77\x32\xea\xe1\x12\x56\x3a\xf4\x65\x0e\xb7\xf2\xb5\x73\x32\xe0\x36\xfa\x15\xeb\xac\xc2\x8c\x3a\x7c\xba\x6a\x27\xa9\xa0\x1c\x0b\x32\x5d\x50\x0c\x63\xa7\xb3\x59\x24\x93\x5b\x5d\x3f\x86\x93\x2e\x3d\xe4\xce\x37\xd8\xbb\x81\x54\xb8\xe3\x7b\xb2\x93\xab\xee\x93\xcd\xb5\x40\x2a\x51\xa0\xf2\xc6\x7a\x13\xa8\xbf\x44\xa6\x12\x71\x88\x6c\x34\x0b\x14\x30\x03\x55\xe5\xa3\xd5\x55\x4a\x64\x4b\x6a\x91\xff\x7a\x12\x73\xc4\xd6\xaa\x56\xc2\xc2\xf2\x2c\x2c\x53\xf1\xa5\x56\x9d\xea\x6f\xdc\xad\xc6\xab\x94\xa1\x36\x28\x7a\x37\xa3\x23\x17\x11\x92\x84\xb5\x3a\xc4\x9b\x65\xad\x7d\xbe\xcb\x43\x9c\xc3\x6f\xe1\xc3\x9c\xe1\x6a\x71\xd1\xd5\x1b\x95\x13\xa3\x04\x04\x68\x0b\x49\x00\x22\x05\xc5\x39\x06\x25\x27\xea\x9d\x37\x90\xa3\xa6\x55\x6c\x15\x03\xba\x8f\xc3\xed\x00\x73\xc4\x7c\x44\x04\x5c\xa3\xe9\x92\xde\xa1\x1e\xe3\xe5\x44\xec\x52\xdd\xa8\x7d\xfd\xca\x3b\x7c\xf5\xea\x43\x2b\xe1\xac\xf9\x32\x9d\xd3\xe1\x2b\xf7\xac\xa4\x6c\x4d\xc3\x90\xfa\xca\x19\x5e\x08\x06\x05\x5a\x77\x0a\x11\x49\x48\x56\x1d\xcd\x29\x2d\x27\xe3\x77\xa0\xc6\xa1\xf7\xba\x1b\x31\x1c\x1f\xa6\xb4\x78\xdd\xd5\x20\x56\x35\x8e\x4b\xe4\x7b\x9f\x3c\xb6\x14\xa7\x5a\xea\xee\x67\xe2\xb8\x4e\x73\x0f\x68\xf6\xdc\x01\xe3\xeb\xbc\xda\x4a\x52\x8c\xe5\xe3\xb4\xaa\x75\xd2\xbc\x45\x57\xdd\x60\xa5\xdc\xe1\xc2\x28\x37\xa3\xa3\x3c\x3a\x35\x8d\xb4\x16\x6f\x9b\x45\xb5\x54\x28\x72\x76\xf2\xe5\xb2\xdc\x34\x06\x88\x83\x94\x75\xc0\x9e\x47\xeb\xf4\x33\xde\x2f\x09\xb0\xd3\x00\x4e\xe5\x70\x8f\xc5\xe6\x27\xea\xc3\xb0\xd7\x66\x42\xa1\x03\x60\x01\x07\x20\x05\x3f\xd4\x88\x64\xb3\x90\xc1\x39\x15\xf6\x1e\x75\x93\x96\x52\x6a\x53\xd8\xce\x0d\x78\x7c\x04\x52\x25\x25\x58\xec\x2e\x96\x95\xa4\x5c\x6c\x20\xeb\x57\xd0\x69\xa6\xa2\xda\xdd\xe5\x27\xc3\x15\x6c\x00\xb7\x94\xac\x95\x33\x9a\xe2\xaa\x2b\x27\xbb\x55\x45\x0c\x3f\x60\x15\xad\x5a\xe9\xf4\x74\x15\xbb\x49\xec\x94\xe1\x41\x74\xa7\x4f\x89\x60\x34\xe4\xa5\x15\x55\x93\xa4\xdf\xe4\x10\xb5\x29\xcc\x0a\xe5\xb7\xf8\xb1\x59\x48\x3f\xa4\xbd\x02\xd9\xb3\x15\x90\x16\xeb\x5e\xee\x93\x25\xfb\x14\x9b\x17\x8b\x1f\x0b\xba\x3d\x62\xf4\x0e\x07\x28\x30\xdb\xe9\x60\x0c\xa8\xd8\x20\x76\x8f\x39\x02\x58\xc8\xa7\x78\x4d\x28\x43\xc1\x01\xb8\x20\xe1\x0e\x50\x82\x00\x5d\x81\x79\xbc\x0c\xb1\xff\x0e\xed\xe6\x50\x6c\xc6\xe9\x7f\x55\x32\x77\xf2\xbf\x73\xb8\x45\x36\x80\x98\x64\xb7\xb6\xab\x97\x7a\xc2\xd3\x70\x47\xc3\xd4\x46\x66\xc1\x4b\xc5\xcd\xed\x77\x6e\xa5\xd0\xee\xb5\x9c\x37\x25\x82\x9a\xba\x88\x98\x63\xb2\x06\x8b\xc5\xd9\x87\x17\x13\x2c\xe5\x32\x88\x55\xa6\xcc\x57\x9c\x6f\x3c\x1d\x2b\x69\x17\x52\xae\x18\x37\x63\xfb\x2b\x86\xb9\x19\x1d\x55\xe1\x56\x1d\xd1\x8d\x2c\x7d\x7b\x24\xae\x6a\x1e\x81\x5b\xa4\x10\x5d\x22\x69\x48\xd3\x82\x03\x8d\x8a\xc4\xec\x16\xed\xfc\x0d\xc4\xe4\x00\x64\x05\x4a\xa9\x0f\xbd\x6c\xef\x60\x18\xa3\xac\x9c\xb4\x22\xdc\x23\xa2\x51\x4f\xba\x06\x27\xd8\x0d\xc9\x47\xa4\x90\x63\xa2\x4a\x30\x9e\x08\x29\x1f\x13\xa5\x7a\xb2\x4a\x75\xd0\x83\xac\x57\x1b\x04\x22\x28\x36\x16\x53\x89\x63\xd4\x4b\x44\x8c\xea\x4b\xa6\x62\x4c\xb3\xf2\x0e\x6f\x46\xff\x67\x72\xc0\xf9\x66\x82\x83\x7f\x32\x0e\x0f\xa2\x78\x79\x33\xca\x2a\x40\x39\x40\x3f\xa6\x7c\xde\x09\xe9\x8c\xe2\xd2\xa4\xf4\xe3\xfd\x13\x73\x6f\x9e\x55\xad\xd1\x22\x9b\x4c\x3a\x7b\xe4\x2a\xd9\xae\x0e\x93\xb2\xfc\x95\x52\xe9\x54\xa0\x95\xaa\x61\x3f\x05\x9c\xb6\x6b\xc0\xbd\xab\xf4\x37\x95\x4f\x9a\xd6\x33\xe6\x4d\xb7\xca\x14\xec\x93\x65\xd1\x06\xba\xdb\x27\xd3\x77\x23\x35\x49\x40\x5c\xad\x90\xdf\xb0\xf4\xfc\xf6\x5b\x7e\x80\xe9\x27\x18\xe1\x4f\x3e\x65\xe8\xd3\xdd\xe1\x81\x1a\xe7\x54\xc3\x70\x4a\xe9\xed\x5e\x63\xe8\xfc\x4c\xad\x81\xc6\x1f\xb6\x92\xc6\xdb\xbc\x74\xe9\x91\xc6\x25\x8a\x0c\x22\x30\xd9\xae\xf7\xe0\x5d\xbc\x44\x8c\x20\x95\x5d\xaa\xd8\xd3\x54\x30\xea\xa1\xb8\x05\xe0\xbd\xbb\x5e\xac\x52\x0e\xb6\xf0\xe1\x3d\x31\xe9\xfb\x61\xaf\x8a\x23\x8e\x44\xd2\x70\x27\xd3\x64\xc7\x14\xbe\x6e\xa0\x30\x8e\xa7\x4f\xb7\x08\xc4\xe9\x98\xe0\x7e\x83\x88\x2e\x10\x93\x8e\x56\x26\xd7\x16\xbc\xb0\x3d\x06\x02\x00\xb9\x81\xd9\xce\x0f\xfc\x6c\x48\x39\xa5\x39\x4f\xdc\x34\x7c\xf7\xa4\xc9\x1c\x25\x68\x3e\x31\x52\x67\x11\xeb\xa8\x03\x0a\xd2\xde\x84\x55\x83\x6d\xe0\x55\xc6\xb2\x3b\x24\x99\x4c\xbe\x4b\x26\x6e\x17\xd8\x39\xdd\x71\x31\x3b\x39\x9e\x05\x88\x08\x2c\x76\x73\xbd\xfb\x64\x0d\x6f\x08\xc8\x9e\x1b\x71\x1e\x23\xf6\xfe\xf2\xa7\xfc\xd9\x29\x46\x44\xcc\x4e\x5a\x9c\x1b\xda\x2f\x3a\x18\x0f\x1d\xc0\x38\x0e\x21\xae\xdc\xb3\xee\xff\xbc\x47\xeb\xac\x84\x02\x1d\x3e\xee\xda\x36\xc7\x32\x47\xcd\xfa\x69\x37\x35\xfa\xd2\x3d\x0d\xf6\x22\x18\x73\xc4\x24\x1f\x3a\x4b\x90\x05\xd0\x52\x86\x5a\xa9\xb0\x76\xeb\xae\x72\x76\xd5\x58\x57\x2c\xa8\xaa\x85\x52\x2d\x8b\x45\xd6\x0f\xe8\x8a\x2b\x47\x39\x42\xbe\x4a\x44\x26\x40\x6a\x30\x1b\x38\x63\xd9\x8e\xb5\xa7\xef\x16\x00\xc6\x62\xf3\x1b\xe9\x70\x74\xd4\x72\x80\xbc\x4e\x8d\x10\x83\xf9\xf6\x79\xd5\x01\xd2\x84\x0c\x3f\x84\xf1\xc3\x94\x95\x57\xc9\xe7\x6b\x86\x90\x1e\xde\xf8\xba\xfc\x0e\x84\x98\x20\x00\xd9\x5a\x55\x0c\xd9\xe8\x30\x02\x12\x55\x10\x40\xb4\xa5\x04\x9c\x9c\xce\x2f\x4f\x8f\xa7\x57\xa7\x27\xad\x1c\x86\xde\x83\x39\xd7\x60\x4a\xcd\x1f\x51\xb8\xb5\x7c\xf8\x0f\xa1\xaa\x44\x19\x58\x9c\x1f\x9f\xae\x95\xc3\x39\x29\x2b\x81\x61\x61\x5f\x3f\x83\x04\xaf\x10\xaf\xee\xef\xdc\x24\x3c\x7c\xac\x40\xaa\x18\xb5\xca\x62\x53\x8c\xde\x5a\xc8\x36\x02\xf3\x16\x0b\x70\x89\x22\x0a\x28\xd1\xa7\xb2\x61\xd8\x95\x36\x83\x0c\xe8\xa4\x8e\x2a\x1d\xee\x11\x69\x93\x63\x2a\x18\x12\x89\x5b\x84\x22\x20\x18\xf4\x6f\xa5\x02\x92\x48\xfe\x89\x03\xbe\x23\xbe\x54\x42\x2a\x63\xed\x1f\x3a\xe4\x84\x39\x90\x4a\xf7\x0e\x86\x88\x08\xf9\xa5\x29\xa3\x95\x0e\x9f\xe7\xad\xb1\xf0\xe4\x57\x9e\x80\x6b\x35\x67\xfd\x88\x50\x81\xb8\xc7\xd0\x0a\x60\xa2\x29\xd0\x91\x9a\x4f\x05\xe7\x4a\x8f\x8a\x47\xb0\x3a\x95\xa8\x01\x53\x8e\xf5\x61\x22\x48\x60\xc9\xcd\x0a\x53\x0d\x9e\xad\x5c\x28\x5c\xf4\xfd\x74\xc5\x05\x85\x0e\xd6\x07\x60\xd5\x83\xbe\x8f\x30\x7c\x85\x13\x09\x83\x0b\x52\x7d\xd7\x54\xa3\x53\x3a\xc2\x05\x8b\x7d\xa1\x31\x12\x14\x48\xa0\x9e\x6a\x2d\xbe\xa5\x81\xee\x8d\xa4\xdb\xf2\x2a\x54\x03\x14\x85\x74\xa7\x62\xae\x90\x67\xde\xed\x48\xa9\x47\x1e\xbd\x59\xea\xdc\x3d\x16\x1b\xc9\x82\xbe\x64\xb4\xa1\xc0\x41\xac\x41\x23\x80\x1d\x7d\xd1\x2a\x8b\x50\x52\x8b\xce\x65\xe9\xa2\x9c\x4b\x28\x9d\xc6\x3d\x71\x95\x9a\x99\xfe\x41\x7c\x4f\x73\x40\x2e\xa9\x99\xdf\x67\xdb\x7e\xc3\x0c\x85\x50\xa4\x27\x07\xd4\x52\x5a\x5d\x1d\x91\xa8\xc8\x34\x49\x21\x59\xb8\x52\x91\x32\x14\x51\x8e\x05\x65\x3b\xa9\xe2\xa4\x0a\x6c\x1e\x03\xf8\xfc\x98\xe5\xbc\xdd\xb9\xa3\x95\x69\xcd\x1d\x9a\x34\x8e\x5a\xd5\xab\xb6\x92\xc9\x14\xfc\x20\x3c\x4f\xbb\x9c\x96\x3b\x9c\x26\xa5\x45\x8d\xf9\xd4\x0c\x5a\x9e\xb6\xba\x01\x89\x31\x05\xed\xf6\x13\xa7\x24\x88\x28\x26\x62\xa1\xef\x25\xe8\xe8\x01\x8f\xf3\xbf\x3a\x3b\xda\xd8\x3c\xf9\x32\x49\x52\xb4\xd2\x5c\xe7\xf2\x8f\x21\x5d\xf3\x51\xee\xe1\x87\x01\x1c\xef\x94\xdc\x99\xac\x34\x64\x88\x62\x6f\x6b\x30\xc1\xc9\x6d\xcc\x05\x58\x22\x60\x1b\xc2\x4b\x1f\xd9\x76\x06\xb5\xd5\x1a\xba\x85\x12\x22\x82\x29\x98\xa6\xb7\x54\x7e\xe2\xf6\x0e\xc3\xcc\x74\xed\x23\x39\xc9\xd6\x97\x17\x7e\x86\x39\x64\xdb\x20\xe5\x27\x53\x73\x41\x60\x66\x7e\x35\x6f\xc9\x29\x17\x2e\x0b\x74\x9a\x4e\xd4\xff\xde\x01\x5b\x5b\xa6\xcc\xbc\xd2\x7d\x2b\x00\x55\x39\xce\xce\xb6\x82\xed\xd6\xa3\xb7\x2b\xdc\x1a\xa7\xa1\x95\x46\x43\xe5\x56\x35\x35\x4b\x7c\x10\xad\x97\xbd\x0e\xa8\x1c\x52\xde\x37\xfb\x36\x97\x0d\x35\x87\x5e\xd0\x8a\xaa\x70\xbf\x89\x3a\xa4\xb1\x88\x62\xd1\x33\x0f\xe2\x42\x01\x01\x01\x66\xaa\x35\xd0\x2e\xd9\x42\xdb\x2b\xf0\x02\xf9\x97\x44\x09\x08\x73\xb5\x37\x07\x2f\xd6\xaa\x13\x9a\x40\xc9\x6f\x66\x3f\xde\xee\x60\xe5\x51\xc7\xce\x08\xe9\xc1\xe4\xfb\x7f\xc7\xd8\xbf\xe5\x02\x32\xe1\x49\xa3\xef\x49\x67\xad\x22\xe7\x89\x21\xdd\x7a\xad\x07\x51\xe9\x4a\x4d\xe3\x7f\xca\x41\xc1\x42\x8e\x6a\x91\x3d\x00\xc7\x3a\x49\x0d\x82\x25\x83\xc4\xdf\x8c\x81\xdc\x0e\xaa\x3b\xa8\xd4\x06\x7e\x03\xf9\x26\xe3\xc0\xb6\x53\xa9\x43\x8e\xeb\xa4\x8d\x4e\x50\xe8\x41\x19\x95\xa7\x47\x19\x78\x7f\xf9\x13\xa8\xc6\xb6\xd5\xa4\xbb\x80\x34\xc5\x87\xbc\xdc\xc0\x0e\x46\x91\x17\xa0\xbb\x21\x1a\xcf\x19\x62\xb9\x44\x6b\xec\x5c\xc5\x43\xfb\xf2\x01\x12\x10\x87\xea\xd2\x17\x08\xd2\x15\x90\x2e\x2a\x6a\x0c\xaa\xbd\xf5\x2c\xeb\x23\xc3\x20\xc8\x46\x8d\x52\x57\xb9\x93\xf3\xfe\x58\xa8\xe4\x74\xe7\x25\x8a\x68\x13\xc5\xa9\x57\x40\xcf\xe4\xb1\x35\x16\x66\x29\x81\x98\x04\x88\xd9\x4e\x90\x06\xef\x82\xfa\xc7\xd2\xd0\xde\xe3\x30\xd4\x09\xaa\x72\xc9\xc9\x5d\xcb\x7f\xa9\x60\x1d\x0a\xc6\x3a\xa6\xb1\x85\xea\xdb\x8e\x1b\xe2\xe1\xb0\x82\xdb\xe8\x1f\xfb\x30\x73\x7b\x3b\x5b\x88\xfb\xc6\x0a\x15\x0c\x83\xb7\xc5\xcd\xee\xe6\x8c\xb2\xf2\x37\x90\xac\x11\xef\x13\xdd\xeb\x36\x8a\x73\xd2\xab\x30\x7e\x18\x20\x1b\x31\x35\x83\x59\xce\xa9\xb0\x4f\x1d\xdb\xee\x99\x64\x1a\x19\xa7\x51\xb1\x49\x1f\x01\x7a\x1c\x2c\x9c\x74\x8b\xa0\xd8\x7c\xb9\xb3\x8b\x4b\x14\x42\x81\xef\x74\xd2\xa4\xbe\x4c\xd4\x14\x5a\x14\x22\x03\x9a\x02\xe6\x87\x8b\x88\xa7\x31\x06\x35\xf9\x2d\x25\xf2\x3d\x29\x37\x2b\x4c\x82\x6c\x3a\x53\x2e\xfc\xae\x5a\x88\x1b\xfa\x5c\xdf\xa8\x4e\x84\x1e\xdf\x71\x81\xb6\x37\xa3\x31\xb8\x19\x2d\x21\x47\x37\xa3\x0f\x5d\x79\xf7\x45\xa7\xa3\x37\x42\x99\x29\xd9\x3c\x50\xfd\xaf\x9c\x9a\xfe\xeb\xc3\x7e\xa1\xd0\xce\xf1\x62\xf1\x63\xff\x1c\xdf\x79\x26\x1d\xd6\x3a\xdd\x26\xdd\xd5\x1e\x75\xca\x99\xc4\x62\x83\x88\xc0\xbe\xfc\xb9\x23\xf5\xfb\x8d\xe4\x24\x44\xcc\xfa\x28\xd2\x2b\xc3\x78\x89\x84\x74\x8c\x0c\x6e\x25\x39\x50\x3c\x37\x89\x36\x39\xbb\x9b\x5b\xec\xad\xb5\xc8\x63\x0d\x5d\xed\xb7\xad\xb1\xf8\xff\xd3\xfe\x88\xdf\x51\xb6\x9e\xc8\x11\x2b\xfc\xb8\x42\x92\x40\x4f\x8b\x25\x41\x3c\xba\xc1\xea\x34\x48\x47\xcf\x55\xca\xde\xb8\xe4\x2f\x95\x54\xb7\xcb\x06\x16\x29\x5b\x72\x0e\x6a\xd7\xfa\xd0\x1e\xf0\xde\x98\x31\x2c\xaa\xc7\xe4\x32\x64\xad\x1d\x1f\x27\x52\xbd\x7f\xd4\x9c\x5f\xbb\x40\x3e\x43\x82\x9b\x4e\xc6\x8d\x9a\x5b\xdc\xa2\xdd\xf4\xf2\xbc\x79\x76\x9a\x79\xff\xb1\xf2\x93\x9d\xb8\x0c\x1f\xbf\x79\x77\xb6\x00\x28\xa1\x52\x92\xd7\x32\x50\xfc\xa6\x0a\x7a\x8e\x57\xbf\xa0\x30\x7c\x47\xe8\x7d\xbb\xe6\x80\x83\xb4\x90\x53\x7d\x93\x72\xed\x91\xca\x7d\xde\x0e\xc0\x02\x21\x70\x9d\x3e\x00\xd3\x5f\x16\x20\xa0\x3e\xaf\x6f\x37\x82\x6e\xb9\xbd\x95\x35\xd3\xca\xa3\x0c\x5e\x75\x1a\x69\x17\xb4\x68\x8e\x76\xb3\xd6\x23\x6d\x50\xbd\x19\x1d\x39\x48\xf1\xfd\x04\x1e\x55\x77\xc2\xaf\x39\x27\x85\xf7\x3c\xdb\xd9\xfa\x58\x57\xa2\x0e\xce\x56\x5d\xb8\xa7\x2a\x00\xef\xb9\x17\x52\x18\x78\xf6\x56\x7c\xcf\x4f\xc6\x34\xac\x96\x08\x01\x8b\x51\x57\x4e\xd7\x8e\x33\x08\xcf\xdb\xcc\xa9\x87\x1c\xec\x9d\xc8\xcd\xe8\xa8\x4c\xb1\xce\x02\x31\x50\x03\x45\x45\xae\x6c\x1b\xbf\x84\x76\x86\xc9\xb9\xdf\xf2\x3c\xee\xd4\xfd\xaf\x0b\x3b\x6b\xf0\x1b\xa8\x27\xe1\xcd\xe8\xa8\x34\xd1\xce\xac\x41\x4b\x7e\xbc\x98\x3d\xfe\x12\x45\x4b\xee\xf9\x1c\x97\x17\xa6\x14\x45\xfb\xa3\x6e\xfa\x57\xe0\x5c\xa6\xdd\xf7\x6d\xb2\x0b\xf3\x38\x5e\xf3\x49\xf9\x5b\xdb\xae\x51\xff\xcf\x8b\x92\x36\xbd\x03\xae\xcc\xaa\xa9\xd4\x76\x2a\xef\x81\xba\xd4\xce\x6e\x22\x75\xe7\xfa\xea\x33\x71\x7d\x55\xc7\xf5\x55\x0d\xd7\x0b\x5a\x6c\x19\xd2\xb5\x5a\x19\xba\xd3\x1e\x4f\xca\xbb\x31\x59\xa7\x80\x76\x04\x6e\xb1\xef\x45\xf6\xda\x18\x4c\xd6\x43\xf2\xbd\x62\x32\x65\xbe\x0f\x85\xbc\xe5\x7c\x99\x50\xdd\x39\x3f\x60\x0f\xcc\x7c\x63\xca\x9a\x86\x94\x86\xe9\xb9\xf7\x1b\x2f\xf2\xec\x57\x92\x94\xcb\x89\x8e\xc2\x6a\x65\x29\x62\x41\x19\x86\xa1\x5a\x51\x07\xdb\xa0\x0b\xbf\x5b\xce\xa3\xd5\x3a\x6f\x87\xfd\xcd\xe8\xa8\x44\xa4\xce\xac\xfe\xd2\x8d\x3b\xdb\x31\x62\x90\x41\x86\x3a\x92\x6f\xd7\xef\xb2\xda\xdf\xad\x58\x78\x15\xae\x51\x9d\x59\xae\x53\xde\x83\x6c\x29\xd5\xd1\xbc\xea\x80\x23\xf5\x9d\x4f\xb7\x5b\x4a\xd2\x86\xd9\x6d\x7a\x57\xee\x87\x94\xdb\x2a\xa6\x8b\xe7\xd3\x3d\x82\x77\xe8\x9e\xb2\x5b\xfe\x49\xdf\xbb\xfd\x29\xba\x5d\x7f\x8a\x05\x0e\xf9\x27\x1c\x11\x24\x0e\x66\xf3\xf3\xfc\x05\x2c\x15\x31\xaa\x92\x0c\x13\x30\x9b\x03\x18\x04\x0c\x71\xd5\x4a\xe8\x78\x76\x72\x09\x08\x15\xed\xf2\xbb\xf6\x80\xc9\xcd\x6b\x4f\x55\x75\xf5\x1c\x72\x50\xf2\x77\x7e\x66\x3e\x2a\x9f\x10\xec\xbb\x78\xe1\x2a\x2d\x2b\xce\xae\x03\xf7\x59\x41\xa9\x2e\x07\x92\x20\x44\x1c\xc4\x64\x0b\x19\xdf\xc0\x50\x5d\x6c\xb9\xa4\x62\x03\xb6\x30\xba\xd6\xa8\x7f\xd0\xff\xa8\xd3\xca\xeb\x0f\x85\x81\x9b\xd2\xb8\xff\x48\x7f\xb0\x0b\xfe\xf7\x3f\xfc\xfe\x87\xff\x1b\x00\x00\xff\xff\x74\xcf\x7c\x90\xb1\x53\x01\x00")
+var _schemaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7b\x73\x1b\x37\xf2\xe0\xff\xfa\x14\x28\x7a\xeb\xd6\x4e\x71\x44\xdb\xd9\xf5\x3a\xbe\x3d\x55\x31\x92\xec\xf0\x12\x49\x2c\x4b\x4e\xee\x62\xb9\x42\x70\x06\x22\x11\x0d\x07\xb3\x00\x46\x12\x93\xe8\xbb\xff\xaa\xf1\x98\x27\xe6\x45\xd2\xb6\xb6\x4a\xc9\x1f\xa6\x66\x30\x8d\xee\x46\xa3\xbb\xd1\x68\x34\xfe\xdc\x43\x68\xf0\x37\x4e\xae\x06\x6f\xd0\xe0\xc9\x28\x20\x57\x34\xa2\x92\xb2\x48\x8c\x0e\xc3\x44\x48\xc2\x0f\x59\x74\x45\x17\x83\x21\x34\x94\xeb\x98\x40\x43\x36\xff\x9d\xf8\x52\x3f\xfb\x9b\xf0\x97\x64\x85\xe1\xf1\x52\xca\xf8\xcd\x68\xf4\xbb\x60\x91\xa7\x9f\xee\x33\xbe\x18\x05\x1c\x5f\x49\xef\xf9\xbf\x46\xfa\xd9\x13\xfd\x5d\xae\xab\xc1\x1b\x04\x78\x20\x34\x18\x9f\x4c\x0e\xb1\xc4\x21\x5b\xa4\xcf\x10\x1a\xc4\x9c\xc5\x84\x4b\x4a\x44\xee\x29\x42\x03\xba\xc2\x8b\xd2\xb3\x5a\x6a\x32\xc8\x13\xfd\x55\xfa\xc9\xfd\x30\xfd\x39\xe0\x64\x51\x40\xc8\x3c\xc6\x41\xa0\xc0\xe0\x70\xea\x46\x05\xa1\x1c\x77\x84\xe4\x34\x5a\x64\x1d\x14\xba\x70\x73\xd1\xfe\x37\x08\x88\xf0\x39\x8d\xa1\x33\xe0\xe8\x0a\xc7\x02\x19\xa4\x90\x64\x48\x2e\x09\x9a\x1c\x21\x76\xa5\x7e\x8d\x4f\x26\xf0\x30\x11\x04\xd1\x08\x9e\xac\x8a\xc0\xee\x3c\x1a\x49\x12\x86\xf4\x77\x6f\x29\x57\xa1\xb7\x53\xe0\x01\xb9\xc2\x49\x28\x07\x6f\xd0\xe0\xcf\x7b\x37\x37\x85\x58\x4d\x31\xc7\x2b\x22\x09\x2f\xb3\xb4\xc4\xac\x26\x26\x44\x78\x45\x80\x64\x1c\xa1\xf3\xf3\x13\x14\x5b\x90\x68\xc9\xc2\x80\x46\x8b\x1c\xe2\x38\x02\xbc\x87\x88\x4a\xe4\xe3\x08\xf9\x2c\x92\x58\x63\x8f\x66\x7f\x5e\x27\x73\xc2\x23\x22\x89\xf8\x99\x70\x41\x59\x74\x3f\x1b\xa2\xd9\x9f\x78\x45\xdf\xe2\x15\x0d\xd7\xf7\x33\x84\xa3\x00\x9e\x70\x7f\x49\x25\xf1\x65\xc2\xc9\xfd\x0c\xc5\x21\xf6\x09\xf4\x45\xb8\x18\x22\xb2\xbf\xd8\x47\xb3\xd1\x6a\xed\x81\x6c\x93\x6b\x31\x72\x00\x1e\xe5\xa0\x8e\x8a\xf0\x46\x4a\x6a\x7f\xa3\xc1\xac\xd7\x60\xed\x86\x09\xff\xf6\x59\x40\x0e\x1c\x08\xff\x7b\xa4\xde\x0c\x6d\x8b\x0c\x7d\xf3\x46\xb1\xc6\xbe\xcc\xd3\x63\xdf\x3b\xb8\xa4\x9b\xef\x80\x55\xa6\x8f\x9c\x94\xed\x95\xa4\x6d\x10\x73\x72\x45\x38\x27\xc1\x19\x0f\x94\xb4\x7d\xac\x91\xc3\x61\x45\x7f\xe4\x9e\x98\xd9\x60\x3b\xfa\x64\x5f\xd5\x69\x80\x2b\x1c\x0a\x32\xdc\x73\xcb\x2d\x88\x8c\x50\xe3\xc2\x93\x90\x08\x94\x08\x12\xc0\x94\xe2\x44\xb0\xf0\x86\xd8\x59\x26\x60\x58\x23\x16\x90\x05\x67\x49\x2c\xd0\x2d\x95\x4b\x34\xc3\x2b\xfa\x06\xf9\x89\x90\x6c\xe5\xf9\x5a\x71\xcd\xd0\x15\x67\x2b\x84\x51\xcc\xe9\x0d\x96\x04\x99\xe7\xf0\xfd\xf8\x64\x22\xf6\xd1\x45\xda\x97\x5c\x62\x89\x30\x27\x48\x10\xfd\xaf\xe4\x94\x04\xa0\x26\x18\xf0\xe7\x0d\x9a\xe5\x99\x32\x1b\xa2\x99\x12\x4b\x31\x1b\x02\x5a\x11\x9a\x19\x4e\x64\x42\xda\x26\xa0\xdb\x50\xab\x05\xc5\x41\xb2\x15\xae\x1d\x12\xae\xfb\xca\x53\x5f\x92\x7d\xcd\x88\xf4\xa1\x62\x87\xfe\xc8\xf0\xc4\xbc\x1a\xec\xe5\x24\x70\x50\xb1\x2f\x99\xbe\x1b\x70\xf2\x9f\x84\x72\x12\x14\xa5\x92\xdd\x46\x84\x17\xa4\x0f\xe6\xf8\x14\x4b\x49\x78\x54\x95\xc0\x3a\x23\x98\xff\x68\x73\x1d\x1b\xeb\x6e\x91\x22\x1e\x01\x4c\x81\x56\x89\x90\x68\x85\xa5\xbf\xac\xe8\x92\xd9\x37\x33\x74\x4b\xc3\xc0\xc7\x3c\x10\x08\x87\x2c\x5a\xe8\x91\xdc\xbd\xa6\xd5\xda\xc3\x23\xd7\xc2\xcb\x01\xf0\x1c\x7d\x78\xdf\xf4\x53\xa9\x3d\x69\xd6\x32\xf0\x8d\x19\xfd\x7a\xf2\x8d\x92\xac\xe2\x57\x92\xb3\x1c\x35\x5b\xeb\xd8\x3e\x4c\x32\xc0\xac\x84\xa5\x22\x9c\x17\xca\x92\x20\x51\x49\x56\xe5\x87\x55\xf9\xca\xbd\xbc\x1f\xba\xe4\x10\x73\x8e\xd7\x8d\x62\x38\x39\x12\xd6\xff\xc0\xbe\xcf\x92\x48\x9a\x19\xcd\x6e\xb5\x01\x53\x63\x25\x86\x88\x71\x34\x13\x24\xbc\xea\x37\xe2\x7d\xc0\x1b\x3d\x41\xc2\xab\x46\x86\x49\xbc\x78\x38\xee\x22\x20\x63\x68\xd0\xb3\x77\x89\x6f\x48\x2f\x0e\x75\x80\x50\xe7\xf8\xed\x95\x90\x6f\x34\xc9\xcd\xca\x2f\xf7\x18\x10\xaa\x2a\xc3\x4d\xcc\xb1\x20\x21\xf1\x95\x3c\x11\x14\x91\x5b\x22\xa4\x95\x05\x4b\x2f\x4c\x7b\xf0\x27\x71\x04\x02\x41\xf8\x50\x29\x42\x64\xf5\x04\xcc\x4e\x40\x67\x1f\x9d\x45\xe1\xda\x7e\x95\xce\xfb\xfc\x8c\xb5\x90\x53\x43\xf7\x77\x81\x68\x24\x24\x8e\x7c\x82\x60\x3a\x18\x13\x05\x18\x91\x20\xa5\xb7\x6d\x74\x1e\x30\x09\x45\x63\xf8\xeb\x79\x32\x8f\x88\x3c\xc1\x71\x0c\xb2\x9d\xc9\x7e\xfb\xdc\xa8\x5b\xbf\x19\x90\xe7\x31\xf1\x07\x15\x51\xab\x99\x23\x65\x11\xd0\x3e\x8a\x50\x80\xc0\x37\x19\xff\x8a\x56\x1a\x45\xb1\x8f\x26\x9a\xde\x6b\xb2\x46\x54\x28\x27\xfa\xd7\xa1\x71\x27\x42\xc1\xd0\x9c\xf8\x0c\x2c\x04\xb4\xb1\xce\x38\xfc\x36\xd0\x98\x5c\x12\x7e\x4b\x05\x01\x37\x2f\x05\xa4\xbc\xbd\x2b\xc2\xa1\x33\xb9\xa4\xb6\xef\xfd\xce\x23\xfe\x00\x31\x76\xcc\xff\xf2\xb8\xab\x41\xea\xb0\x80\xc7\x7f\x14\xfe\xae\x2a\x45\xdb\xa9\x6b\x30\x61\x6d\x33\x27\x88\xad\xa8\x94\xe0\xe1\x55\x99\x51\xfc\xbc\x85\xd3\x1d\xc0\xa5\xd0\x52\xc1\x43\x68\xe0\xd3\x80\x77\x0b\x41\x2c\xa8\x5c\x26\xf3\x7d\x9f\xad\xfe\xba\x25\xf8\x86\xdc\x32\x7e\x2d\xfe\x22\xd7\xc2\x97\xe1\x5f\xf1\xf5\xe2\xaf\x44\xd2\x50\xfc\x45\xe3\x88\xc8\xfd\xc9\xf4\x94\x48\x77\x8f\x34\x68\xe1\x9a\xf3\xab\x07\x65\xa8\x70\x10\xe8\xd5\x41\x4e\x1e\x6f\xc1\xd1\xd6\xdc\x40\x3e\x27\x58\x12\x81\xa8\x1c\x82\xeb\x6e\xf1\xb4\x5f\x00\x2d\xb6\x29\x0e\x02\xd1\x6b\xa0\x3f\x6b\xdf\x3b\x31\x8e\x34\xb3\x08\x7a\x92\xe4\xfe\x52\xe2\xb6\x95\x7d\x2c\xce\xd8\x20\x60\x51\xfb\x5a\x05\xd4\x47\xf7\x25\x09\x96\x12\xfb\xcb\x29\x0b\xa9\xbf\xee\x36\x35\x26\x51\x48\x23\x72\xc4\xfc\x64\x45\xa2\x66\xd1\xd1\x1a\x11\xa3\x58\x81\x47\x81\xf9\x06\x44\x43\xf7\xdb\x4b\x18\xda\xa1\xa5\xc0\xee\x87\x6e\x0a\xc7\xef\x4f\xcb\x93\xe6\xcb\x39\xcc\x21\xd5\x3e\x00\x20\x61\xf5\xfb\x64\x7c\xa2\xb9\x43\x89\xc8\x11\xd2\x87\x2d\x3d\xc0\xee\x39\x48\xd0\xf2\x52\xe2\x49\x1d\xf1\xf9\xef\x62\xc2\x57\x54\xc0\x6a\x4e\x7c\xcf\x92\x28\xc0\x7c\xdd\x02\xa6\x89\x39\xe3\xf7\xa7\x16\xf9\x1c\x60\x34\x37\x90\x15\x11\x42\x30\x9f\x62\xd9\xcf\x4b\xee\x05\xd8\x49\xa8\x20\xfc\x86\xfa\x64\xac\x57\x21\xef\x59\x48\xc6\xef\x4f\x5b\x48\x75\x02\x7a\x50\x6a\x1d\xc2\x30\x2b\x22\x71\x80\x25\x56\xdc\x8d\xe3\x70\x6d\x15\xa7\xaf\xf7\x13\x0c\x73\x40\xc0\x94\xeb\xec\x63\x49\x16\x8c\xd3\x3f\x30\xa0\xac\x3c\x54\xc6\x17\x38\x32\x0f\xf6\xd1\x31\xf6\x97\xa0\x75\x61\x21\x2e\xa8\x90\x6a\x09\x87\x95\xb3\x02\x8d\xc1\xd3\x55\x08\xe0\x10\xdd\xe0\x30\x21\x43\x34\x67\x72\x09\x8d\x6e\x97\xd4\x5f\xa2\x35\x4b\x90\xd2\x35\x64\xbf\xd7\x20\xff\x77\x11\x53\x67\x78\xb2\x46\x83\x1b\x1d\x27\x29\x4b\x4b\x9d\x1c\xf4\xb3\x59\x6a\xc6\x3b\x3a\x6b\x95\xf9\x26\xad\x5a\xf3\x2e\xff\xdc\xa5\x31\xb6\x32\x8f\xc3\x3d\xb7\x6c\x67\xf1\xcd\xe3\x1f\xcf\xc1\xf2\x33\x15\x5a\xbf\xa2\x8b\x84\xab\xc1\x4d\xbb\xed\x1e\x29\xad\x83\x54\x30\xd1\x76\x1f\x2e\x64\x49\xf0\x0b\xac\xef\xba\x78\xd6\x46\x3e\x7f\x62\x8b\x45\x71\x01\x86\x50\xeb\x86\x5f\xda\x91\xfd\x7a\x43\x91\x28\xe1\xb0\x93\x51\x30\xc1\x38\x61\x18\x96\xed\x83\xc0\x4e\x56\x88\x61\x21\x20\x19\xca\xf1\xaa\xeb\xa0\xf4\x06\xdc\x3c\x46\x55\xc6\xd7\x0e\x15\x89\xf0\x3c\x24\x17\xeb\x98\x6c\xe8\x46\x0c\x8b\x6f\x49\x94\xac\x0a\x03\x61\x9e\xe3\x98\x96\x9a\xc2\xc3\x24\xa0\xd2\xf5\x18\xc2\xde\x92\xfa\x58\x32\x5e\x7d\x0d\xcc\xe2\x2c\x0c\x09\x3f\xc1\x11\x5e\x10\x47\x13\xd8\xeb\x0d\x92\xd0\xf5\x0a\x87\x61\xf5\xe1\x37\x99\x94\xc1\xff\x9f\x72\x7f\xdd\x0f\x5d\xea\xaa\xdd\x37\x52\x2c\x05\xfd\x1a\xea\xc1\x80\x01\xd4\xcc\x46\x4f\x05\x21\xe8\x63\x36\x5c\xe0\xf8\x89\x4f\x4f\x47\x89\xc0\x0b\x32\xf2\xe1\xf9\x2d\x3c\xf7\x8c\x0c\x7b\x06\xc4\xe8\x89\x79\xa0\xc5\xcf\x23\x77\x78\x15\x87\x44\x3c\x7b\xb6\x8f\x7e\xc6\x21\x0d\x10\x89\x60\xa7\x45\x40\x7c\xe7\x0d\x9a\x5d\x0e\x70\x4c\x2f\x07\x10\xfd\xbe\xd4\xbc\xce\xfe\xc8\x71\xd8\x3e\xac\xf0\xd5\xbe\x48\xb9\x69\x1f\xe0\x30\xb4\x3f\xbf\xb9\x1c\xcc\x7a\x5a\xb6\x16\xc6\xfc\x1b\xa3\x25\x27\x57\xff\xe7\x72\xb0\x31\x43\x2e\x07\x07\x25\xee\xfe\x7b\x84\x0f\xdc\x5c\xd2\x91\xd6\xff\xf5\x9f\x84\xc9\xff\x8d\x63\xaa\x7f\x94\x82\xe5\xe6\x2d\x70\xb0\xf1\x7d\x8e\xa9\x0d\xed\x2a\x7c\x6e\x68\x9b\xb2\xbe\xa1\x0d\x0e\xc3\x86\xb7\xdf\x14\xde\xed\x6f\xaa\x4e\xf3\x7a\x62\x97\xba\x94\xf0\x66\x9d\x67\x06\xd8\x0a\x4b\x5f\x8d\xda\x17\xbc\x53\xaf\x2a\x00\xed\xcb\x54\xeb\xae\xe5\x66\xc3\xe0\x9a\x46\xc5\xe5\x74\x4c\xcd\x5e\x48\x95\x8b\x75\x2a\x5a\xd9\xe8\xae\xda\xd9\x6d\x5c\xc7\x41\x90\xf5\xd8\xa6\xd5\xf6\x1c\x8d\x06\x78\x45\xcd\x0e\x63\x37\x73\x9e\xed\x48\x36\x6a\xc9\x80\xf8\x21\xe6\x44\xa0\x25\xbb\xdd\x60\x4b\x1a\x82\xc0\x66\x8b\x37\x18\x22\xad\x56\x55\x13\x80\x63\x37\x6a\x73\xaa\x55\xbd\xf3\xf0\x8a\x7a\x22\x89\x63\xc6\xe5\xe8\x89\x01\x09\xcf\x6c\xfb\x67\xbd\xf4\x59\x67\x12\x5a\xf7\x99\xab\xd4\x54\x75\x61\x37\x0a\x40\x01\x56\xf9\x00\x4a\xb0\x66\x74\x33\xb1\x2c\x8d\x6e\x83\xb5\x77\xdb\xfa\x81\x8e\x4e\xed\x53\x36\xba\x79\x81\xc3\x78\x89\xff\x39\xd8\x73\x99\xd6\x42\xff\x37\x98\x86\x78\x4e\x43\x2a\xd7\xbf\xb2\x68\x53\x5f\x24\xf7\xf2\x7e\xe8\xa2\xa2\x41\xc0\xfd\xd4\x60\x74\x13\xf0\x8a\xd3\xd5\x28\xe7\xe7\x25\x8b\x6f\x06\xaf\x8b\xd1\xef\x27\x8d\xe7\x3d\x2d\x68\xd1\x54\x1a\xb4\xea\x05\x25\xe0\x98\x46\xdd\x18\x74\x04\x4d\x73\xf9\x7b\x75\xac\xb1\x8b\x8f\xdc\x0c\x82\x5d\x9f\xf2\x14\x82\xc9\xa1\x7a\xb7\x73\xe3\xe3\x11\x09\x89\x04\x07\x02\xd6\xae\xea\x15\x8d\x16\x29\x47\x57\xe0\x1b\xd2\x68\xe1\x65\x30\x46\x4f\x02\xf3\x89\x87\xa3\xc0\xb3\x9f\xf4\x63\xf0\xa6\xe8\x56\x06\xa5\x3b\x82\x97\x83\x03\x27\xad\xf5\xc3\x74\x85\xf9\x02\x4b\x32\xe5\xec\x8a\x86\x9d\x67\x93\x7b\x1c\xdf\x16\x60\x65\xfd\x6d\x30\xc7\x16\x54\x76\x93\x9d\x77\x54\x36\xca\xcc\xdb\x9f\x3e\xfc\x3f\xf4\xf3\x0b\x74\x74\x3c\x7d\x7f\x7c\x38\xbe\x98\x9c\x9d\xa2\xd3\xb3\x8b\xc9\xe1\xf1\x3e\x82\x3c\x50\xf1\x66\x94\xdb\xf0\x18\x65\x1b\x1e\x23\xad\x9d\x46\x54\x88\x84\x88\xd1\xcb\xef\x5e\x7d\x8b\xde\x51\x89\xc8\x5d\xcc\x04\x11\xc5\x95\x30\xba\x62\x1c\xbd\x0d\x93\x3b\x74\xf3\xc2\x06\x48\x08\xe6\x21\x25\x1c\x51\x49\x4c\x23\x76\x85\x16\x54\xb2\x58\xf4\x12\xa3\x87\x49\x41\xdd\xa8\xb1\xb8\x2c\x2e\xf5\x03\x77\x16\x8b\xc6\xb1\x6b\x43\xf4\xa5\x42\xf4\x96\x86\x21\xd0\x22\x69\x94\x10\xf0\xd4\xe6\x6a\x6f\x53\x25\x91\x5d\x25\x6a\x8b\x58\x73\x1d\xc5\x21\x8e\xc4\x10\x71\x02\xf9\x29\x36\x17\x11\xc6\xb4\xd8\x01\x9e\xb3\x9e\xd9\x08\x5f\x15\x51\xe7\x48\x50\xbc\xea\x65\x9c\x26\xe3\x13\xf7\x90\xd2\x00\x16\x2a\x72\x3d\xe5\xec\x86\x06\xdd\x73\x6e\xdc\xbd\x4d\x4a\xd0\xb2\x3e\x37\xd0\x11\xca\x63\x2e\x61\x53\x32\xf3\x1d\x9c\x10\x6b\x9d\x15\x67\xdb\xfd\x8f\x2c\x53\xe9\x94\x48\x98\x66\xe6\xc3\x4e\xcc\xfe\xb1\xe6\x63\x67\x4f\x4a\xeb\x93\xe0\x94\x05\xe4\x9d\xd2\xf9\x5b\x71\xfe\xa4\x04\x2d\x4f\xe9\xfd\xd0\xc5\xc2\xf6\xc0\x05\x78\x10\x1f\x4f\x33\x4b\xa6\x5c\xe7\x26\xb3\xfa\x4c\x4d\xd8\x8f\x86\xb2\x9c\x11\x4c\x3f\x82\xf4\x39\xf3\x5a\x59\x3b\xb1\x0b\xa7\xc6\x81\xc9\xe5\xe0\xa0\x8c\x38\xd8\x48\x85\x5f\xe5\xfb\x2a\x52\x97\x83\x83\x2a\x11\xf5\x46\x36\x5d\xef\x75\x92\x12\x23\x91\x27\x44\x62\x37\xb8\x68\x37\x22\xb1\x53\x59\x78\xcb\x38\xa2\xd1\x15\xe3\x2b\xa3\x9b\xa2\x00\xd9\x20\x8b\x5e\x6e\x39\x46\xdb\x25\x22\xbd\x86\xbb\xb5\xd7\x8e\xb2\xd0\x65\x10\x4d\x52\xaf\x19\x9d\x6e\x43\x39\x2d\x7e\xd3\xc4\x40\x1c\x86\xec\x36\x33\x21\x60\x9e\x30\xba\x4a\xc2\x70\xed\xa5\xe9\xc4\x26\x04\x41\x23\xb3\xcb\x12\x31\x35\x87\xd0\x12\x0b\xc4\x12\xa9\x36\x0c\x11\x30\x0c\x34\x14\xa4\x0e\x12\x21\x86\x4a\xa6\x2d\x08\xfd\x0c\xac\xe4\xf8\x97\x73\x64\x76\x3a\x04\x24\x12\xe9\xb0\x4d\x80\x6e\x28\x46\x3f\x4f\x0f\x11\x89\x82\x98\xd1\x48\x8a\x5e\x03\xf2\x70\xa9\x70\x8e\xa9\x20\x3e\x27\x52\x1c\x47\x3e\x5f\x5b\x1a\x3a\x0c\xeb\x79\xe5\x33\x27\xf4\x9b\xd8\xef\x06\xcf\xc8\xc7\xcf\xd3\xc3\x1c\x9a\x7b\x25\x80\x8d\x41\xb7\x86\xe8\x91\x4b\x0f\x75\x30\x68\xb9\x26\xe0\x4c\x34\xba\x04\xb9\x97\x40\xf3\xb0\x12\x91\xca\x3d\x89\xeb\xa6\x44\x5e\xad\xe5\x9e\xae\x4a\x86\x4b\x0c\x1a\x56\x2f\xb9\x57\xd5\x40\x81\x7b\x09\xdf\x28\x0d\xb9\x97\x6a\x89\x35\x70\xc7\xb9\x72\x4f\x17\x85\xf5\x88\xf5\x88\x2b\x11\xbc\x4d\xe2\xa0\x18\x09\x0a\x41\x6b\x33\xbb\x86\xc6\x85\xd4\xee\x2c\x01\xff\x52\x2e\x91\xe1\x2b\x1a\x4f\x27\x29\x1e\xad\x93\x76\x0b\xc0\x99\xf8\x78\x4a\x81\x7a\x66\x43\xd5\x33\xde\x59\x26\xa3\x85\x79\xa0\xda\x0e\xde\xe4\x62\x40\x29\xd0\xd2\x1e\xf0\x20\x8d\x0d\x15\x1a\x18\xf0\xa5\xc8\x6b\x25\x64\xfd\xc9\x15\xa6\x3d\x4e\x95\x42\x87\x6d\x2f\x23\xaf\x63\xa5\x38\xcb\xd3\xd9\xda\xc7\x39\x63\x21\xc1\x35\x6a\x20\x4e\xe6\x21\xf5\xfb\x02\xd8\x2b\x01\x6a\x9c\xfe\x45\x24\xeb\xfa\xde\x89\x14\xea\x5d\x61\xab\xc4\x71\x4c\x95\x15\x21\x3c\x55\xb5\x56\x3b\xe7\xec\x72\x67\x49\xdc\x08\xb8\x6b\x88\x61\x3d\xd3\x61\x70\xad\xfe\x60\xc1\xf1\x1d\xf1\x13\x00\xd7\x2d\xc7\xc5\x12\xe4\xe2\x10\x67\xa1\x59\xd8\xcd\xd7\x28\x66\x81\x4e\x6e\xd2\x4c\x01\x7b\x35\x9e\xea\xa3\x40\x54\x20\xd5\x14\xf2\x36\xf3\xb9\x7e\xd9\x2a\x01\xbd\xff\x7e\x7c\xa8\xd6\x91\xb0\x0d\x97\xe6\x6b\xec\x23\xe5\x79\x4f\x59\x80\x52\xb4\x11\xe0\xfd\xe9\xa9\x0d\x08\x04\xcc\x17\xfb\xf8\x56\xec\xe3\x15\xfe\x83\x45\x2a\x32\x40\xae\xc5\x08\xb6\x9e\x85\x1c\x25\x82\xf0\x45\x42\x03\x32\x8a\x59\xe0\x11\x0b\xc4\x03\x7c\xf6\x41\x45\xf4\x73\xc3\xbe\x10\xc5\x99\x33\xb7\x2b\x32\x2f\x07\x07\x55\x2e\xd6\xbb\x80\x35\xe2\x32\x75\xe4\x76\x6c\x2e\x3e\xce\x4c\x2d\xe0\x08\x70\xca\x60\x00\x4c\x46\x29\x3d\x8a\xa9\x33\x23\x15\x90\xab\x61\x02\x71\xe8\xbc\x14\x3b\x36\x5f\xa7\x1b\x02\xfd\x46\x79\x3b\xc4\x2a\x9e\x78\x19\x99\xcb\xc1\x81\x03\xf7\xfa\xc1\x28\xa6\xe9\x6c\xb7\x14\xca\xb4\xc6\x79\x01\x6a\xd6\x73\xa1\xef\x5e\x2b\x23\x83\x67\xee\x24\x0f\x33\xb9\xbb\xe6\xc0\xb2\xd5\x77\x66\x00\x27\xe3\x13\x64\xb0\x40\x96\xb8\x4f\x4f\x47\x14\xaf\x0c\x24\x0b\x68\xf4\x44\x2d\x6f\x3d\xb0\xfb\x9e\xd9\xd7\x56\x41\xdc\x7e\xc3\xda\x13\xbf\xdc\x38\xf6\x40\xe9\x72\x70\xe0\xa2\xab\x75\x74\xbb\x69\xe3\x36\x08\x5f\x68\x82\xe2\x30\x44\xd6\x39\xf6\xe6\x18\xf4\xa1\xfa\x03\xf2\x2c\x34\x47\x95\x82\x34\x2e\x8f\xe2\xe6\x47\x50\x8f\x19\x7a\xc8\xa2\xd7\xac\xc9\x27\xe3\x13\xab\xe2\x3e\x08\xc2\xdf\x29\x15\xa7\x2d\xe3\x6f\x36\xf5\xf5\x37\x83\x1a\x25\x62\x03\x8d\xbe\x4b\x1a\xbb\xa9\xed\x4d\x68\xba\x1c\x1c\xd4\xf0\xaf\x5e\xb0\x6e\x62\xff\x3d\x11\x2c\xe1\x3e\x39\x4c\xd3\x2b\xdc\x39\xe0\x65\xe7\xac\x49\x28\x74\x96\x31\x11\xc5\x14\xe4\x35\x8a\x08\x8c\x8a\x49\xb6\xe5\x89\x9e\x50\xb0\x32\xcd\x72\x3b\xd2\x69\xa6\x9f\xa8\x30\x75\xbf\xf8\xf3\xe7\xed\x3c\x4b\xd9\x94\x3c\x21\x4e\xa6\xc2\x7c\x3f\x9b\x1c\x1d\x6e\xc3\x41\xbd\x74\xcf\x68\x00\x78\x28\x36\x6b\x4c\x84\x05\xba\x25\x61\x08\xff\x4e\xde\x9f\x8f\x53\xbb\x33\x56\x12\x84\x0e\x4f\x27\x28\x0e\x93\x05\x8d\x7a\x31\x6e\x57\x7d\x6e\xe8\xb6\x97\x94\x5c\x77\xe5\x95\x6b\x59\xe3\x93\x94\xe0\xd5\xb4\x6a\x81\x9d\x0e\x6b\x15\x33\xab\xc1\x07\x1d\xa7\xd6\x0e\xd7\x1e\xa0\x66\x61\xb0\xb0\x94\x9c\xce\x13\x38\x03\xa3\x32\xad\x8d\x99\x4a\x31\xea\x78\xa6\xa2\x05\x5a\xcd\xea\x42\x45\x67\x3b\xac\x30\x70\x14\x31\x89\x8b\xe5\x63\x9a\x39\x90\x6f\x53\x35\x4c\xb9\x97\xf7\x43\xd7\x54\x73\xa7\xbf\xb7\x26\x5d\x87\x78\x4e\xc2\x87\x8d\xe2\xa6\x87\x35\xe0\x3b\x11\x63\xbf\xfb\xc7\x7b\x25\x20\xbd\x32\xca\xb3\xee\xaa\xec\x1d\xba\x05\x63\x87\x93\x23\xb7\x30\x46\xb7\x50\x7a\x23\x82\x85\x59\xce\xa7\x3b\x53\xcc\x07\xf1\x55\x3a\xb4\xec\xfd\xf5\x9c\x3d\x5b\x77\x57\x33\xbd\xce\x0b\x5a\xa6\xd3\x44\xcb\x27\xde\x77\x8a\xba\xee\xf2\x30\x57\x76\x0c\xb5\x48\x60\x11\x6a\x37\x85\xb4\x41\x2f\x69\x27\xf7\x43\x37\x47\x1e\x0f\x7f\x55\x0f\x7f\xe9\x77\xd6\x58\x96\x98\x53\xe2\x42\x13\x79\xb9\x53\x56\xb0\x10\xcf\xba\xb5\xe1\x8d\x6d\x64\xa2\x37\x70\x27\xa9\x1b\x6d\x40\x5a\x2b\xe7\x84\x18\x3b\x3c\x87\x9d\xb0\xb0\xf5\xa0\x5a\x56\x06\x60\x47\x7c\xdd\xa2\x47\x27\x6b\x40\x08\x4e\xdb\x6d\x55\x13\x3f\xe0\x60\x3a\xbd\xa2\xbe\x1e\x73\xb0\x28\xaa\x2c\x03\xc1\x81\x45\xfa\x10\x76\x30\x52\xdd\xeb\x2d\x48\x04\x39\x3a\x24\xc8\xbe\xe8\xc5\x8e\x9d\x74\x58\xcb\x0d\xa8\x43\xb1\xcd\xd2\x40\x63\xb7\x86\xc3\xee\x0c\xea\x41\xd8\x99\x5e\x0a\x27\x68\x54\xc4\x92\x25\x61\x00\x1b\x18\x76\x3d\x0a\xc3\xc7\x12\xa9\xd7\xa7\x90\xca\x68\x6d\x6f\xb4\x70\x8e\x6a\x7f\xc6\x7d\x31\xd4\x9c\x2c\x16\x12\xcb\x44\xf4\x9d\xdb\x06\x43\x83\xe0\xb9\x86\xe1\x84\xff\xa0\xce\x6e\xc2\x82\x1f\x10\x4a\x57\x63\xdb\x8c\x5e\x3f\x60\x1d\x7c\x54\x58\xa3\xfe\x18\xb1\xdb\x68\x6a\x8c\x50\xb7\x51\xf9\xa5\xf2\xd9\x86\xce\x68\xaa\xe8\x9b\xfc\x80\x46\x7c\x6b\x3e\x1c\xd4\x1a\xce\xdc\x0b\x97\x51\xa8\xca\xa9\x4b\x55\x96\x9e\x29\x85\xf1\x19\x8f\x47\xe2\x48\x39\x20\xa5\xd1\x46\x96\x7b\x2a\x65\x62\x9b\x43\x93\xfd\xe1\x77\xf2\x83\xcd\x24\xed\xe0\x0d\x73\x33\x38\xf9\x87\x3b\x5b\xf1\x58\xe0\x3b\x1c\x10\xad\xc2\xac\xad\x71\xf0\xae\xe7\x00\xb4\xc3\x73\x31\xbc\xbc\xa8\x6f\x28\x32\x61\xd1\x49\x8b\x31\x56\xb9\x51\xbb\x52\x79\x18\x21\x81\x02\xd7\x30\x9f\x53\xc9\x21\x52\x98\xca\x28\x5d\x44\x8c\xeb\x68\xee\x4c\x87\x73\x7b\x1e\xdf\x6b\x86\xa9\xcf\xb8\x68\xc0\xe9\x91\xb3\xbe\xea\xb6\x43\x48\xa0\x89\x6a\x23\x1e\xe5\xc0\x51\x17\xe2\x4a\x9f\x3a\xb1\x33\x82\xb1\x39\x7e\x20\xbb\x60\xa2\x34\x20\xb4\x64\xc2\x38\x06\x54\x6c\x84\x74\x17\x78\x4e\x4a\x1e\x94\x07\x60\x2b\x76\x42\x79\x02\x4d\x8d\x0e\xe7\x3b\x36\x20\x7a\x71\x67\x63\xb8\x1d\x04\x35\xcb\x67\xf9\xd3\x45\x75\x07\x59\xd0\xc7\x76\x6f\x30\xa7\x38\x92\xd9\xb9\xdd\x17\xfb\x2f\x5e\xd9\x13\xb6\x2f\xf6\x5f\xfc\x2b\xf7\xfb\x75\xee\xf7\x77\xd9\xef\x97\xcf\x2f\x07\x33\xf4\xd4\x20\xfd\xcc\x3e\x7d\xd1\xfb\x78\xae\x0b\xa3\xfc\x79\x52\x40\xad\xe1\xb8\x29\x60\xdb\xfc\xfa\x75\xf3\xeb\xef\x1a\x5f\xbf\x7c\x5e\x78\x9d\x27\xb8\xd4\xf0\x45\xa1\x61\xbd\x12\x02\xd6\x75\xc9\x28\x07\xba\x0b\xed\xf4\xb3\x7f\x39\x9e\xbd\x76\x3c\xfb\xae\xfa\xac\xd4\xaf\x82\xf7\xf2\x45\x4d\xb2\xfa\x5e\x49\xfa\x1a\x4d\x79\x8d\x2d\x73\x48\x6e\xee\x91\xd2\x06\xb9\xbf\x77\x1e\xca\x34\x47\x72\x05\xd2\xcb\xda\xd0\x2a\xa7\x8d\x72\x8a\x3a\x01\x73\x79\x03\xa7\xe3\x8b\x2e\xae\x16\xa4\x3d\xdc\xe2\xf5\xee\xa7\xf6\x0f\x74\xb1\x0c\xd7\x63\x9d\xc7\x18\x12\x98\xb5\xd6\x67\x84\x23\xe9\x68\xa9\xde\x23\x6c\x1b\xa0\xd3\xf1\x05\x32\xd8\xa8\x59\x7d\x4e\xa3\x85\xe3\x3b\xa1\x1e\xe7\x5b\x97\xb4\xc1\x11\x15\xb6\xc3\x40\xff\x14\xd0\x7a\xb7\xda\xa1\x44\x5d\x71\xb2\xf6\xa0\x33\x0f\x53\x13\xdc\x00\xaa\x99\xf4\x3c\x28\xc3\x83\x22\xac\x06\x6e\x18\x28\x40\xb9\xc6\xa2\x8b\xa6\x28\xf1\xa0\xf0\x09\x72\x02\x42\x68\x60\x30\xdb\xc5\xec\x37\x3c\xd8\xcd\xa4\x85\x51\xf1\x8b\xb9\xc3\x6d\x32\x92\xfb\xc4\x35\x01\x75\x91\x46\xd1\x65\x12\x9a\x04\xc8\x6e\xab\xed\x72\xd9\xcf\xf4\x8b\xfb\x4a\xe6\xe4\xb6\x00\xf7\x4a\x80\xbb\x64\x71\x0e\xaa\x58\xec\x64\x80\xf4\xd2\xd4\x74\xa2\x96\xb8\x1a\xba\x29\x2e\x28\x3a\x0f\x5b\x2b\x20\xd7\x60\x42\x72\x7b\x87\x81\xc4\x89\x64\xe3\x30\x64\x50\xa1\x6a\x32\xbd\x79\x55\xa7\x56\xbb\x84\x0d\xc7\x05\x58\x3f\xbf\x42\xb0\x9e\x23\x50\x99\x0b\xd6\xe7\xd3\x9b\x57\xe8\x70\x72\xf4\x1e\xcd\x43\xe6\x5f\xab\x48\x1c\x1a\xfd\xf3\x15\x82\x11\xa2\x77\x69\x44\x08\xf0\x2e\x74\xd2\xc2\x9c\x9d\x75\x9a\xf6\x79\x5f\x2e\xab\xd8\x49\x26\x77\x55\xc5\xd3\xaf\xcf\x99\x6e\xe8\xfd\xb0\xfc\x55\xd3\x38\x41\x92\xd0\x47\x7b\x30\xc7\xe6\x8d\xc2\x11\x95\xe9\x24\x4d\x5d\xbc\x89\x7d\x2f\xd2\x07\x14\x20\x4c\xfa\xc4\x36\xf7\x74\x73\x4f\x32\x4f\x2e\x49\x3e\x1d\x1d\xc7\xd4\x83\x45\x3f\xe1\x9e\xcd\x1e\xee\x79\xba\xa8\x94\xee\xb6\x4b\x44\xec\x01\xb2\x0a\xc1\xf5\x89\x4b\xe4\x4e\x72\x0c\xb2\xf3\xf5\x36\xf2\x60\x4e\x64\x9a\x47\xcf\x1e\xbb\x4b\x02\xc3\x6e\x6a\xbd\x63\xfd\x06\x5a\x5b\x25\x61\x34\x03\x14\x2c\xc7\xd1\x1a\xe1\xc0\x5b\xb2\xaa\xe2\xe9\x32\x28\x9f\x0b\x87\x3d\x07\x73\x36\x2c\x61\xab\x44\x82\x9c\x2f\x31\xd7\xc7\x37\xcf\x89\x9f\x70\x2a\xd7\xea\xb0\xdd\xfb\xc4\x71\xcc\xbe\xaf\x56\x03\xaf\xd5\xc7\x61\x08\x9c\x0c\x90\x30\xf0\xd1\x02\x3a\xb0\x37\x6b\x30\xa5\xe2\xd5\x8d\x14\xb0\xee\x37\x0e\x4a\xea\xfd\x96\x3e\x82\xb6\xd0\x4c\x28\xac\xf5\x81\xac\x62\x13\x93\xc0\x6d\x4e\x78\x25\x51\xfe\x00\xa4\x9a\xae\x3e\x5b\xad\x92\x88\xfa\x85\x1d\xb3\x42\x5e\x99\xc2\xa8\xf0\x9d\x01\xca\xd4\x9c\x83\xf4\x81\x88\x49\xd8\xba\x31\x9e\x56\xa0\x2b\xde\x26\xe0\xb7\x99\xa5\x77\xba\x18\x2f\x62\x27\xfa\x79\xa7\x8f\x4c\xec\xc2\xc4\x0e\x99\x7f\x11\x96\xbd\x2c\x02\x2c\xaa\x9c\x80\x62\x16\x54\xfd\xbd\x06\x78\xd3\xac\x7d\xd3\x6c\xb1\x19\x7e\x90\x72\x09\x49\x82\xba\x88\x0f\xca\x34\xf8\x10\x52\xd6\x61\xa5\x29\x61\x84\x29\x47\x93\x29\x4c\x2a\x4e\x04\x9c\xe8\xb7\x23\x2f\x32\xf5\x51\xda\x17\xb5\x8f\xf5\x9f\x94\xdb\x21\x01\xe3\xf1\xb1\xd2\x5b\xad\x3d\x03\xb3\xe2\x47\xd4\x33\x25\x7a\xb2\x57\xcf\x36\x4a\x68\xfc\x2a\xe4\xb6\xda\xca\x5a\x22\x2f\x07\x07\x15\x4c\xeb\x2d\x61\xfe\x50\xd3\xd7\x35\x88\xfa\x00\x6b\xe6\xd0\xa9\x29\xac\x34\x64\xce\xaa\x1b\xe7\xf8\xfa\xb5\x00\x8f\x26\x3d\xca\xd4\x6b\x5c\xb7\xea\x68\xcf\x41\xe6\xc0\xce\xfc\x77\xe6\x24\xde\x9f\x2e\x0e\x18\x4e\x35\xb1\xe0\x29\xbe\xc6\x4a\x32\x4c\xca\xe7\x14\x12\x88\x0b\x16\xef\x99\x72\x6b\x33\xc5\x06\x9a\x7e\x4e\xe4\x2d\x21\x91\x43\xb3\x29\x79\xea\xc5\x9b\xcf\x83\x81\x9b\x69\x6e\x9b\xbe\x05\xfb\x00\xb1\x98\x13\x4f\x2d\x27\x49\x50\x30\x1d\xe7\xef\x7a\xf1\xa1\x05\x94\x9b\xa0\x3e\x2a\xd7\xa8\xf0\x2e\x6a\xf7\x9a\xac\xf5\x36\xcf\xf8\x57\xc3\xfb\xe8\x86\x44\x94\x44\x3e\x51\x97\xe2\xcc\xa1\x8e\x30\xca\x76\x88\x20\x0e\xa9\x6d\xfd\x8a\xc1\x65\x58\x4b\xa8\x30\x1c\x59\x75\x03\x27\xbb\x23\x42\xe0\x8c\x1c\x85\x43\xfe\x68\xfc\xeb\x3e\xca\xd5\x6f\x48\x6f\x82\xb0\xda\x69\xbe\x36\xda\x09\x0a\x16\xc3\x3d\x50\xe6\x85\x3d\x24\xa5\x92\xe8\x4c\xa1\x80\x4f\x4f\x47\xb6\x64\xc0\x88\x13\xe5\x6a\x78\x14\xaf\x54\xe1\xa7\x9b\xd8\x1f\x3d\xcb\xe7\x81\x7f\x34\x56\xf4\x8e\xea\xad\x98\x9f\xa7\x87\xa2\x56\xa7\x27\x82\x78\xb6\xa5\x07\x2f\xd5\xe5\x1b\x56\xfb\xd9\xc0\x94\x12\xc7\x67\xfd\xdc\x97\x07\xc6\x5e\x73\x07\x91\x7e\x6b\x82\x65\x15\x93\xd0\xc8\xe4\xcb\xc1\x41\x7e\x4c\x40\xf7\xe7\xd9\xde\x6a\x59\x7a\xb0\xfa\x72\x70\xe0\x18\x44\xe8\x71\x7f\x37\x17\x21\xa8\x35\x7a\xad\xa6\x75\x4c\x3e\xf7\x22\xcf\xed\x1b\x75\x50\x46\xfd\x56\x22\xc3\x86\xd8\x4b\xee\x1d\xf8\x79\xb9\x3f\xfd\xfa\xf5\xbd\xc3\x3c\xef\x30\x7c\xb5\x08\xd9\x1c\x87\x56\x70\x41\x5d\xc3\x71\x00\x7f\x49\xc3\xc0\x8a\x67\x8a\x4b\xdb\x2c\xea\x0e\xb1\x10\xd0\xca\x97\xab\xeb\x10\xd1\xba\x62\xdc\x27\xaa\x2a\x1b\x1c\xbf\x10\x67\x61\x40\xf8\xc5\x12\x47\x27\x34\x4a\x64\xa9\x71\xce\x76\x80\xaa\x2f\x57\x2c\x2e\xb3\x64\x85\xaf\x89\x30\x07\x6e\xe1\x22\x45\xb8\x46\x0b\xcd\xc1\xaa\xf1\x24\x82\x7a\x70\x4a\xf7\xc2\xb5\x6f\x50\x02\x1e\x26\x3d\x18\xea\x05\xbd\x21\x11\x8a\x92\xd5\x9c\x70\xf0\xe3\x56\x1a\x11\xb5\xec\x52\x68\x06\x88\x63\x98\x3e\xfa\x13\x72\x43\xe1\xe6\xa4\x21\x9a\xaf\x63\xa8\x21\x1f\x2d\xd0\x94\x05\x47\x54\xf0\x44\x8d\xcd\xf7\x49\xb0\x28\x4a\x66\x3b\xe7\x1f\x14\xe2\x29\xde\xe9\x64\x87\xd1\x64\x42\xaa\x81\xfe\x81\xb1\xeb\xae\x0e\xa6\xdb\x6c\xa6\x60\x32\xf5\x52\xe8\xab\x97\xc7\x09\x47\xc8\x58\xe4\x13\x44\x20\x07\x58\xb9\x09\x54\xd8\xfa\x81\xbd\xc6\xa0\x19\xd2\x9e\x03\x51\xd0\x81\x0f\x95\x27\x73\x72\xa5\x1d\x07\xa2\xe7\x03\xbb\xca\xd1\x05\x15\x21\x53\x69\xb0\x75\x18\x81\x5a\x58\x28\x1b\x8a\x51\x12\x49\x1a\x82\x4f\x6d\xd6\x36\x2b\x24\x12\xdf\x27\x20\x40\x42\xe5\x71\xaf\x91\xb9\x7b\xc9\x26\x0a\xc0\xf6\x09\xbc\x40\xe5\xda\x20\xdd\x98\xff\x00\x50\xde\x73\xb0\x7e\x20\xae\x69\x0c\xf9\xcb\xea\x10\x4b\xd7\x61\x2e\xb9\xbc\x3b\x18\xd5\xf4\x1c\x8d\x40\xb7\x4b\x26\x0c\x93\x60\x28\x81\x05\x86\x37\xbd\x98\xde\x0d\xe2\x9e\x03\x71\xc5\x13\x88\x38\xa8\x8b\xed\x58\x25\x04\x5f\xa2\xbe\x89\x2c\x8c\xd4\x51\x2b\x73\x23\x1d\xe3\x66\x41\x9e\xde\x88\x47\x65\x05\xa3\x3e\x34\x6e\x00\x7e\x43\xa7\xa7\xcc\x92\x7a\x09\x1a\x76\x37\x86\xb5\xca\xa6\x5e\x35\xef\xd0\xb9\x30\xb1\xb6\xcc\x57\x84\xc3\x4c\x26\x7c\x66\x4b\xa9\xd6\xd5\x71\x35\x71\xe7\x6c\x52\xaf\x15\xa3\x8d\x61\xb2\xc5\x1c\x49\x00\xee\x79\x12\x2f\x38\x0e\x72\x23\xdb\xfd\x2a\x8e\x2f\x8a\x5e\xd5\xed\x01\x96\x77\x71\x7a\x60\xad\x8d\xa3\xa0\xf0\xf0\x4b\x2a\x0f\xd3\xbf\x39\xf7\x3c\xcc\xdf\x7d\x4b\x61\xff\x8f\x2f\xd4\xfd\x59\xa2\xd7\xdc\xea\x0c\x74\xcf\x81\xfe\x40\xd2\x15\x61\x89\x3c\x27\x3e\x8b\x82\x32\x13\x7a\xb9\x7d\x50\x01\x5d\xd1\x03\x02\xb1\x64\xec\x1a\xad\xf0\x1a\x50\x02\x77\x6f\x68\x83\xd4\x2a\x24\x34\x7b\xf5\xbc\xdf\xd5\xb3\x3d\x60\xeb\x55\xdf\xab\xe7\x66\xc1\xe7\xa6\x3a\xe1\xe1\x16\xca\xf2\x87\x8b\x8b\x69\x1a\xc7\x02\x7a\xfc\xf2\xa5\x1c\x6d\xf4\xb8\x21\x6c\xa8\xf2\x12\x9e\xef\xdc\xca\xc3\xa0\x7e\x90\x77\xa2\x9b\x08\x55\xce\x38\x8e\x50\x91\x18\xd8\xc7\x42\xb0\xd1\x1c\xa2\x54\x32\xc1\x99\x86\xe4\x50\x1c\x42\xdc\x1f\x9c\xe8\xcc\xa5\xb0\x2f\x8d\xf7\x00\x5b\x11\x24\x83\xc6\x89\x4f\xe8\x8d\xca\x92\x99\x9e\x9d\x5f\xd8\x6d\x6b\x3d\x67\x30\xfa\xbf\xe7\x67\xa7\x68\xce\x82\x35\x32\xf9\x54\x56\xd7\xcc\xe2\x25\x16\x04\x92\x0b\x53\x65\x63\x6e\xcd\x86\xbf\x67\xba\x70\x9f\xba\xa2\x97\x13\x11\xb3\xc8\x94\x13\xc3\xe8\xe5\xdd\x9d\x4d\xd3\x06\xf9\xd1\xe8\x58\x42\xa0\x52\x50\x12\x65\x3b\x20\xb3\xa3\xf7\xe3\xc9\xe9\x6f\xd3\x1f\xc6\xe7\xc7\xd0\xd7\xe9\xd9\xd1\xf1\xbb\xf7\x67\x1f\xa6\xbf\x9d\x8e\x4f\x8e\x4d\x87\xf0\xd0\xfc\x4d\xa2\x1b\xca\x59\x04\x73\x5c\xe7\x20\x41\xda\x4e\x0e\x17\x72\x47\x0d\x69\x06\x85\x2c\xc3\xae\x4d\xa2\x1e\xf6\x70\xe8\x49\xa9\xc6\xc4\xcc\x4b\x9b\xd7\x94\x0e\x8f\x79\x9e\xbb\xc5\x1b\x5e\xa5\xad\x77\x32\x5e\xba\xcb\xdc\xa0\xa5\xe0\xf5\x9b\xe2\xf8\x55\x31\x4a\x87\xd2\xbe\xda\x60\x40\x0b\xd6\xcb\x94\x15\x32\x95\x02\x3b\x26\xf9\x57\xa6\x6f\x9d\xb9\xdb\x4d\x1e\xba\x2d\x7d\x14\x6b\x24\xfb\x85\x04\xeb\x60\xa4\x20\x52\x15\x07\x74\x38\xaa\x45\x6c\x8e\xfe\x64\x7c\xa2\x8e\xde\xfd\x5d\xc0\x11\x5f\x50\xd3\xe6\x0c\x38\x88\x84\x72\x3f\x59\x24\x99\x45\xad\x1f\x59\x7d\x61\x3b\xc9\xb5\xce\xb0\xe8\xe8\x8f\xb8\xd7\xac\x45\x11\x4a\xfd\xde\xdc\xa7\xf7\x43\x17\x07\xdb\xbd\x15\xd5\x0b\x68\x04\x92\xed\x3b\x6b\x9c\x11\x44\x38\x43\x86\x95\xd3\x61\xaf\xf8\x29\x91\xdc\x87\x9d\xdb\xf5\xb4\xe7\x20\xd4\x9e\xea\xda\x5c\x7c\x94\xde\x4f\x38\x87\xa9\x6d\x26\xef\x2e\x26\x44\x0f\xb0\x6e\xba\x4c\xec\xaf\x9b\xc8\x94\xe8\xcd\xbd\xbc\x1f\xba\xf8\xd2\x2e\x14\x7a\xcb\xcf\xe2\x6a\x8e\x8e\x1a\xe1\x0f\x98\xdd\x28\x45\xca\x6d\x57\x76\xce\x50\x67\xaf\x3a\x4f\x07\x54\x5d\x19\x1e\x41\xb8\xdf\x54\xb6\x09\xc0\x75\xb5\x11\xcf\xec\xec\xa3\x4d\x6a\x50\x75\xea\x4d\xcc\xa0\x1f\xcb\x1f\x08\xca\x7b\x0e\xd6\x3f\xac\x23\x2c\x1f\x4c\x38\x06\x8e\x9a\x64\x87\x72\xcc\x71\x93\x5e\x2c\xef\x01\xa9\xee\x98\xca\x5e\x89\x98\x5e\x07\x06\x5c\x96\xc4\xa9\x79\x1d\x33\xab\xe1\x48\x81\x51\x2a\x15\x03\xbc\x89\xff\xac\x75\x9e\x30\x92\x26\x61\xcb\x47\xa4\x01\xb1\x54\xd3\x59\xd1\xab\x51\xae\x6d\xe3\xb0\x55\x27\x0d\x9e\x4a\x6a\x66\x3a\x79\x2c\x2a\xba\x55\xe5\x5a\x9d\xdb\xf2\xf5\x8b\xfe\x14\x78\x98\x2b\x03\x5a\x0c\x62\x89\x9c\xdd\x2f\x59\xab\x7e\x0a\x6a\x07\x3d\xd4\xcd\xa2\xa1\x6b\x24\x4a\x9c\x2d\xf1\xac\x23\x2f\x52\x70\x3a\x3d\x47\x2b\xd9\x1d\x72\xa2\x33\xfc\x2d\x54\x46\x5d\x41\xa4\x8a\xa8\x6e\x33\xc1\xb7\xf0\x9d\xba\x4e\xef\x4d\x9d\x26\xc3\xa9\x01\xdc\x07\xd3\x25\x7e\x76\x15\x3a\xcc\x55\x8d\x5b\x1a\x26\x77\x6f\xc3\xa2\xfe\xac\xf2\xa8\x90\x0e\xb0\xc2\x31\x98\x5e\x2d\x86\x0a\xf5\xf4\x17\xec\x94\xa9\xf4\x58\x85\x01\xbc\x03\x94\xd1\x9c\x31\x29\x24\xc7\xb1\xba\x1f\xc0\x24\x11\xc2\xb5\x0e\xb6\xa4\xe3\x55\x98\xdc\xf9\x01\xdc\xe5\x06\xc5\x1d\x47\xca\x42\xe7\x0e\x58\x21\xb8\xae\x26\x0c\xd1\x55\x15\xd1\x16\xce\x3f\x28\xc4\x53\xbc\x53\xc9\x87\x5a\xe6\x54\xa6\xf7\xd9\x6c\x3e\xe1\xc1\x5d\xe5\x24\x66\x82\x4a\xc6\xd7\xe9\xd9\x5c\x73\x6c\x7d\x1f\x1d\xea\x8d\x1c\x13\x7d\x78\xa7\xb2\xfb\x21\x58\xfb\x8e\xca\x10\xcf\xfb\x4d\xfe\x6d\xfb\xda\x50\x11\xe4\x19\x35\x2c\xcb\xfa\x4e\x34\x81\x49\x58\x01\xef\xb6\x18\x28\x67\xaa\x49\xe1\x42\x4f\xac\x6e\x54\xca\xb1\x41\xb9\x04\x30\xfc\xef\xa8\x3c\x8b\x05\xba\x60\x2c\xbc\xa6\x12\x3d\x35\x97\x38\x3d\xeb\xae\x2e\x3e\x37\x1e\x15\x9d\xf2\xb6\xa4\x2f\xda\x8d\x78\x59\x36\x2b\x23\x59\x63\xb8\xcb\x2c\xc7\xa5\x49\x09\x88\xdb\xed\xf6\x6c\xe2\xd6\x4c\xca\xce\x0c\xdd\x51\x2f\x0e\xe3\x6d\xb9\x08\x17\xc9\x75\x50\xcc\x29\x50\xe3\x9f\x75\xd3\xd1\xb6\xb1\x45\xc4\xc5\x48\xbd\xc7\x63\x05\x44\x32\x55\x80\x09\x24\x19\xa3\xef\x4b\x9d\x82\x36\xcd\x2d\x7f\xf6\xd3\xbb\xe1\x8e\x8f\xfa\x29\x82\x5d\xf5\x99\x76\x99\x8a\x0f\x42\x03\xb0\x6c\xb8\xe8\xba\x36\xb0\xe8\xcc\xb6\xee\xc5\x23\x3b\xbb\x74\xf0\xe4\x07\x12\xae\x90\x05\x04\xb9\x6c\x3e\x8b\x7e\x4f\x22\x1f\x9a\xdb\x30\xa6\xbd\xe3\xce\x50\x6a\xca\xcb\xef\x8c\x81\x9f\x03\x21\x27\x77\x41\x61\x74\xe3\xec\x7b\x68\xd9\x8b\xab\xe6\x92\x6d\x8b\x19\x8b\xd0\x9a\x25\xfc\x33\x88\x5b\x9f\x8e\x36\x34\x3a\xbc\x48\x7d\x26\x95\xc3\x86\x49\xfd\xc5\x8d\x91\x62\x04\x28\x33\xa3\xf3\xc1\xeb\xb0\x6c\x50\x41\xee\x90\x46\x90\x54\x8a\xa8\x74\xd9\x8c\x7d\xf4\xf1\x9d\xba\x69\x06\xa9\x5a\xe0\x9f\x9e\x8e\xf4\xc5\x33\xde\x7f\x12\xea\x5f\x0b\x89\x0b\xc5\xfe\x77\x69\xbd\xb6\x46\x3c\x97\xda\x59\xc5\xf9\x72\x70\x90\xa7\x2b\x3b\x12\x60\xc6\x7e\x60\x6e\x91\xec\xa0\xb8\xaf\x8a\x9e\x77\xc3\x7c\x01\xb1\xdf\x62\xbe\xbc\x2c\x8b\xf1\x0e\xa7\x48\x15\xf6\x86\xb3\x42\x71\xe3\xab\x4b\xb9\xf5\x6c\x7a\x0b\xcd\x29\x93\xe4\x8d\xae\x5b\xa3\xa2\x95\x26\x7b\x41\x19\x01\x16\x42\xed\x6e\xf0\xa9\xc0\x83\x11\x5f\x44\xea\xbf\x08\x21\x05\xc1\xaf\xdc\xa4\xd9\x1a\x1f\x02\x6e\x54\x15\x5b\xdc\xec\x1d\x66\x4f\xaa\x1e\x63\xd3\x14\xa9\x29\x69\xc1\x68\xe0\x5f\x0e\x66\x6f\x10\x54\x15\x4f\xef\x11\xb0\x41\x5e\xbe\xd3\x02\x13\xd0\x57\xa1\x7c\x43\xb7\x5e\xdd\x95\x1a\x00\xd8\x2e\x2a\x2e\xb8\x07\x81\x45\xe4\xec\xaa\xd0\xb0\x83\x9a\x02\x62\xea\xef\x53\xbd\xaf\x74\x52\x62\x5f\x5a\xa8\xae\xc2\x8f\xa2\xf8\xa7\x27\x26\x88\x4d\xce\x4f\xcf\x10\xaa\x66\x9f\x9e\x76\xba\x84\x78\x1e\xb2\xf9\x68\x85\x69\x94\x1d\xb6\x78\xf9\x2f\x0f\xd8\xea\xd9\x7e\xf7\xd7\x78\x15\xf6\x58\x64\xf5\xa3\x20\xb3\x33\x3b\xc5\x57\x1d\x5c\xa8\x61\x4d\xee\x4c\x41\x3a\x6d\x8b\x35\xa7\xb3\x09\x56\xa7\x7b\xff\xcc\xe4\xaa\xe3\x82\xcc\xb2\x65\x9d\x5b\x18\x41\xf6\xc6\xe8\xff\x8f\x4f\x7e\x4a\x8b\x4a\x8b\x21\xa4\xb7\x2e\xe1\x70\x85\x3a\x58\x6e\x50\x46\x31\xe6\x78\x45\x24\xe1\xea\x9c\x74\xbe\x9c\x72\xef\x71\xf9\x7c\x08\x34\x2c\xe3\x26\xe0\xd6\x47\xbe\x33\x6e\x5e\xa7\xeb\xfc\x38\x19\x73\x7f\x49\x25\xf1\x65\xc2\xb7\x51\x7b\x87\xd3\x0f\x28\x0f\xca\x6e\x70\x1d\x1f\xbe\xd4\x4b\x2b\xc8\xdc\x86\x71\xdc\x47\x35\x1a\xf2\xee\xf5\xab\xdf\x5e\xfd\x03\x4a\xef\xcc\x2e\x07\x78\x15\x64\xbf\xf9\x4a\xfd\x2e\xf6\xdf\x32\x14\x5b\xe2\x93\x57\xa7\x1a\xb1\x62\x3d\x9c\xfc\x7b\x85\x6b\xc3\x6b\xbe\x2a\xbd\xee\xa2\x76\x75\xa7\x85\x96\x30\x55\x56\x81\xe3\x21\x74\x50\xa3\xa2\xb3\xa6\x83\x45\x9c\x6c\x95\x7b\x27\x54\x29\x62\x6a\x76\x7a\xb2\xb3\x08\xef\xa6\x1f\xc4\x3e\x9a\x48\x9b\x70\x2d\x88\xb2\xf8\xcf\x73\xb1\xe2\x88\x45\xde\xbb\xe9\x87\x22\xe3\x7b\x9e\x5b\xff\x0c\xdd\xa7\xbd\xa7\x9a\x06\x0e\x0e\x91\x15\xdb\xaa\xa2\x77\x11\x51\x0d\x0e\x41\xdc\x31\x89\xa8\x2c\xa4\x11\xbe\xa3\xdf\x6f\xc1\x82\x36\xc8\x4e\xea\x6e\x0e\xa7\x1f\x3e\x8b\x14\x68\xc0\x9b\x53\x53\x86\x54\x31\xe7\xdd\xbc\x8c\x32\x1a\x76\x38\x73\x4f\xd4\x3c\x18\xd6\xeb\xc0\x8a\xfb\xb0\xc9\xda\x40\x9b\xa2\x82\xb2\xb1\x1b\x6e\xd6\xab\x4e\x71\x6a\x63\x54\x17\x58\x05\x4b\xf0\x63\xcd\xc5\xb6\x1d\x0c\x02\x8d\xdf\xe2\x15\x0d\xb7\x91\xff\xc9\x14\x5d\x29\x18\x56\xe5\xaa\x3c\x28\x58\xc9\x9b\x28\x7b\x9a\x8d\x62\x96\x0b\x43\x74\x53\xd1\xc0\x50\xc4\x6d\x32\xbd\x01\xb5\xaf\x56\x13\xfa\x4f\xa8\xf7\xb8\x8f\xc6\xa6\x9a\x90\x5d\x6d\x18\xa7\x1f\xf2\x37\xe1\xf0\x7f\xa5\x64\xe5\xd0\x6c\xec\xc1\x69\xa1\x04\x87\x9e\x90\xd8\xbf\xee\xa7\x7c\x76\x43\x53\xde\x2a\x00\x71\x45\x9b\x91\xa5\x00\xa6\x2d\x8a\x45\x24\x3f\x0f\xe5\x46\x6d\x68\x2a\x6f\x72\x76\xe4\xbe\x7a\xf5\x11\x34\x80\x23\x9a\x5b\x48\x07\x7c\x8e\x38\x8e\x16\xe9\x9e\x2b\xe1\x04\xcd\xcc\x01\xeb\xc9\x74\xa6\x59\x05\x61\xf4\x45\xdf\x73\x5d\x6e\xd8\x9a\xa7\x69\x07\x86\x99\xa5\x6e\x36\x54\x37\x65\xbe\x64\xd8\x66\x33\x69\x27\xfa\xc4\xe4\xba\xa6\xc5\x6a\x6d\x46\x11\x84\x14\xfa\xea\x93\x2e\xb0\x0a\xfa\xe4\x27\x9c\x44\xfe\xf2\x82\xac\xe2\xb0\x58\x69\xae\x66\xbd\x4d\x83\x2a\xd1\xb5\x0a\x27\xd8\x42\x98\x34\x62\x48\x1a\xcc\xd0\xe4\xa8\x97\xbc\x38\x3e\x4f\xbf\xbe\x77\x14\x02\xdd\x1d\xa2\x06\x22\x3a\xca\xd9\xec\x7c\x95\x9d\xb0\xa6\xfd\xc5\xd9\xd1\x19\x32\xd7\x86\xa2\xbf\x99\xaf\x87\xe8\x6f\x3f\xa9\x2b\x11\xb7\x22\xfe\x33\xa1\xb4\xe1\xc4\x2a\x9e\x2b\x37\x7d\xf5\x9b\x4a\x05\x11\x3e\x29\xdd\xa5\xde\x2e\xc4\xfd\xd2\xa0\xf1\x8a\x6e\x21\x1e\xf6\x2a\x0d\x5b\x35\x67\x7c\x32\xc9\x6a\x2b\xe8\x67\x1e\x5e\xd1\xec\xf6\xda\x21\x9a\x41\xb9\x40\x4f\x88\xd5\xcc\xfc\x86\x7f\x21\x77\x8c\xfa\x33\x58\x3e\xce\xcc\x67\xbe\xbe\xa8\x7d\xb6\xd1\xd5\x1e\xb9\x08\x75\x2d\x2e\x59\x01\x1b\xc0\x1a\x16\xfe\x36\xd3\xdd\x62\x58\x4a\x80\x87\xc7\xa5\x47\x1a\x6f\xf3\x10\xb0\xd7\x00\x8a\x24\x98\xd7\x76\x4c\x72\x92\x04\x3a\x75\x45\xb7\x76\x5c\x6a\xd6\xa5\xfa\xce\xc3\x9f\x68\x94\xdc\xbd\xac\xd6\x8f\xfe\x30\x4f\x22\x99\xbc\x7c\xfe\x1c\x5c\x95\xdc\x93\x17\xaf\xb3\x27\xdf\x33\x29\x43\xc2\x99\x7f\x4d\xa4\x7d\xf6\x0b\x8d\x02\x76\x2b\xe0\x2a\x12\xc2\x5f\x3e\x7f\xf1\xdd\x21\xe3\xea\xee\x40\x38\x12\xc1\x6b\x5b\xbd\x4d\xc2\xb0\xad\xd5\xf3\x7f\x94\x61\xf5\x73\x76\xda\xd6\xc3\x79\x86\x14\x5d\x98\x9a\x8a\xb2\x19\x8f\x0a\xcd\x5d\x8d\x5e\xbc\x6e\x6c\x94\xe7\x64\x43\xb3\x66\xe6\xf6\xf9\xb0\xc0\xef\xee\x1f\x3e\xff\x47\x7d\x8f\xf5\xfe\x57\x9e\xb1\x5d\x62\x04\xb5\xed\x11\xca\xc9\xa5\xfb\xcd\x8b\xd7\xd5\x37\x79\xee\x96\xdf\x35\xb3\xb4\xb5\x75\x81\x8f\x2d\xad\x4b\xcc\x6b\x8f\x6c\x60\xb1\x38\x4f\x44\x4c\xa2\x60\xca\x19\x94\xc6\x22\x5f\x2f\x77\x1d\x02\xae\x1f\x39\x09\xc9\x0d\x8e\xa4\xca\x49\x86\xe4\xaa\xe6\x4b\x8d\xc7\xbf\x9c\xab\x3b\xa6\xde\xda\xd4\x2b\xc7\x75\xc0\xb7\xc2\x4b\xef\xe9\xf4\x92\x38\xc0\x92\xa8\xe8\xe0\x7a\x1f\xa6\xf0\x13\xff\x2a\xca\xde\x8b\x42\x03\xb8\xf3\x1d\x76\x6c\xf4\x33\x4f\x68\x4e\xc5\x96\x53\xfd\xaa\xac\x75\xbf\xd5\xf8\xab\x12\x75\x39\x38\xa8\x8c\x41\x7d\x55\x35\x53\x5f\x9b\x86\x54\xae\x7f\x65\xd1\x57\x94\x9e\x9f\xe8\x8a\x4a\xf4\x31\xad\x89\x68\x62\x24\x3e\x1a\xff\x9a\x39\x04\x60\x40\x85\x8f\x81\xfc\xd1\x93\x3f\x58\x44\x3c\x7c\x8b\x39\xf1\xe0\xb9\x67\x5e\xf4\x1b\x55\xdd\x6d\xc5\xda\x77\xe9\xe8\x72\x70\xe0\xc4\xb6\x9e\xdb\xf3\xbc\x96\x79\xd3\x65\xbf\x27\xf5\xda\x6a\x15\x54\x99\x8f\x06\x13\x22\xb2\x8c\x74\xc8\x9b\xca\x7f\xbf\x41\xb9\xb5\xee\x50\x9d\x84\x07\x44\x80\x9b\x79\x88\x63\xec\x53\xb9\x6e\x8b\xc2\xb9\x61\xe8\xea\xa0\x93\x93\xa3\xf3\x9b\x17\xdb\xd4\x52\x35\x4e\xaf\xb0\xc7\x2b\x53\x7f\x3f\xbd\xb1\xc6\xac\x6d\x6d\x7a\xb8\xea\xf2\x25\x92\xec\x9a\x44\xfd\xd8\xb6\xcb\xae\x32\x6b\x99\xf9\xf8\x35\x3c\x9a\xb2\x00\x70\xde\x86\x49\xa6\xe0\x20\xec\xf0\x03\xa8\x8c\x00\x15\xba\x88\xcc\x45\x32\xf9\xf5\x33\xc4\xba\x7a\x31\x67\x17\x5d\x74\x61\x0a\x99\x8b\xb3\x58\xd2\x15\xfd\x83\x04\xdb\xb0\xc4\x96\xd9\xfc\x78\xfc\xfd\xb9\x8a\x64\xae\xe8\x1f\x4a\xbd\xb7\x9a\xb8\xe3\xc3\x97\x55\x13\x40\xe6\xc2\x33\x50\x48\xb0\xc1\x6d\xfd\x16\x9d\xce\x36\xa9\x23\x16\x97\x83\x83\x32\x81\xf5\x1a\x8d\x5c\xe1\x63\x85\xc7\x56\x9c\xd5\x71\x4b\x13\xdb\xc7\x77\x74\x95\xac\x40\x2c\xd8\x2d\x09\x72\xd1\xf1\xe3\xb7\x63\x4f\x13\x1d\x58\xa1\x40\x3e\xe6\x90\x39\x13\x99\xea\x17\xea\x5e\x7b\x2a\x4c\xd9\xdd\x5e\xec\xfc\x5c\x38\x38\xd9\x46\xf1\xaa\xa7\xfe\x9f\x8c\x4f\x6a\x40\x99\xc0\x78\x87\xcb\x47\x1b\xbf\x9f\xaa\x0a\xf8\xdb\x40\x70\xec\xbe\x36\x50\x56\xd9\xb3\x6d\x12\x90\xcc\xfc\x98\x28\x9d\xb2\x3e\xce\x7d\x81\x0d\xcd\x5a\x3b\xdc\x46\xda\x2f\xda\x33\x67\x5a\xbf\xff\x7a\xbe\x57\xc6\x06\x8c\xec\x05\xcb\x16\xb3\x52\x42\x55\x3f\xae\xd6\x82\xdb\x73\xa0\xfc\x00\x4e\xa6\x55\x32\x0c\xaa\x28\xd6\xc4\x83\x1b\x24\xbd\x14\x43\xee\x38\x10\x51\x56\xaa\xb2\x1c\x7f\x34\xbe\x82\x3d\x0f\x9b\xd6\x78\xd8\x74\x90\x36\xe9\xca\xc9\x9d\x15\xbe\x83\x02\x4f\x53\xc2\x41\x6f\x95\xb9\xd3\xc9\xcb\x5b\xe1\xbb\x73\xfa\xc7\x86\xdf\xd2\x68\xe3\x6f\x3b\x14\x73\x70\x7e\xc7\x6e\x08\xe7\x34\x20\x69\xe6\xfc\xa1\xbb\x08\x52\x09\x56\x93\x10\x9c\x19\x90\xe9\x0d\x8c\x7f\x17\xd9\xb1\x86\x18\x04\x42\x0f\x64\xaf\xe1\x4e\x81\x3a\xae\x60\xac\x83\xef\x24\x38\x3d\xc7\xdd\x4d\xf8\xa7\x69\xf3\x26\x92\x33\x61\x04\x29\xcb\x8e\x8a\x2b\x59\x03\x8b\xaa\x37\xf3\x40\xfc\x84\x3d\x62\x3e\x27\x48\xc4\xf8\xb6\xef\x4e\xd9\x96\x5d\xb9\x79\xc2\x2b\xe3\xff\xf5\x94\x39\x51\x27\xb3\x61\x2f\x54\x97\xfd\x2a\x0e\xad\xd5\xc3\xe9\x4a\xc4\xec\x84\xf5\xe2\xe1\x86\x5d\xec\x39\x48\xb3\xf7\x1f\x99\xed\x7a\xd0\xdd\x25\xc6\xf5\x71\x24\xb5\x2f\x8a\x3e\xda\xdb\x3f\x3a\xd4\xe0\x87\x22\xc2\xa6\xb9\x67\xce\xa8\x7b\x57\x8c\x7b\x4a\x7d\xe3\xd0\x4b\x55\x9e\xae\x27\x9e\x69\xc0\x3e\x0c\x33\x78\x75\xaa\x68\xdc\x09\x99\xcb\xc1\x41\x95\x46\x70\xd3\x9b\x90\xcc\xd9\x37\xb5\x5a\x72\x4f\x70\x88\x1e\x61\x41\x7e\xde\x7a\xe7\x0f\xe6\xd7\xf8\x64\x92\x6e\x97\x99\x34\x81\xe3\x1f\xd3\xc5\x05\x09\x54\x03\x6d\xcf\x7a\x31\xb4\x2f\x6c\x27\xa5\x85\x6a\xf8\xa2\x9b\x3e\x4b\x1d\xf2\xf3\x77\x35\x5e\x8c\x88\x99\xac\xe3\x5a\x9f\xc5\x10\x46\x00\x69\x43\x81\xeb\x06\xa4\x9b\x40\x08\xb1\xec\xcb\x9b\xf3\x1f\x9a\x49\xb4\xe7\xa5\x04\x12\x62\x69\x2f\x33\x00\xc9\x55\x2b\xa7\x0d\x49\xee\x0a\xd4\x4d\xe4\x57\x2e\xfc\xa2\xe3\x90\xd5\x78\xa2\xc5\xab\x0f\x27\xda\x60\xed\x39\x90\x7d\x58\xa5\x52\xc6\x71\x1c\x52\x53\xe3\x04\x66\x7a\x16\x8d\x45\xef\xb2\x4b\x77\x58\x25\xad\x55\xa0\xa7\xe9\xf5\x3a\xcf\x86\xa8\x04\x06\x34\x4f\x5a\xe1\x3e\x2d\x98\xd2\x00\xcb\x42\xea\xc5\xfd\x07\x8d\x7b\x87\x25\x0e\x6c\x6b\x75\x9e\x08\x2d\x8a\xe0\x02\x60\xed\x62\x7a\x68\xa4\x80\x54\x1c\xc7\xe1\xda\xd2\xbc\x99\xa6\x68\x05\xb6\xe7\x40\x77\xa0\xf7\x5b\x2a\xf9\x84\x5d\xd8\xf0\x21\xff\x69\x13\x99\x39\xc5\x08\x35\x39\xc1\x30\xaa\x4f\x51\x0a\xaa\x67\xea\x70\x27\x80\x4e\x72\x6f\x58\x98\xac\xc8\x71\xe4\xf3\x75\x2c\xdb\x03\xa7\x0d\x30\x26\x67\xd3\xf3\x8d\xd6\x64\x1a\x85\x1f\x57\xe2\x47\xb2\x9e\x1c\xd5\x81\x28\xab\x9d\x2a\x84\x4d\x43\x63\xfa\xeb\x2e\x4b\xca\xa6\x31\x5d\xd0\x05\x9e\xaf\x8b\x75\x8e\xdb\x07\xae\xe6\xab\x6c\xfe\xbe\x7e\xde\x80\xf3\xc5\x92\xb3\x64\xb1\x8c\x13\xd9\x86\x79\x13\x90\xcf\x72\x1a\x6c\x11\xab\x54\x12\x2a\xd0\x3b\x73\x35\xf3\x34\xe1\x31\x94\xfa\x3e\x3f\x3f\x52\x39\x1d\x8b\xf8\xdb\xfa\x16\x66\x79\x66\x32\xde\xb5\x1f\x69\x4b\x27\xc0\xdd\xc8\x48\xa6\xa4\x97\xd2\x55\x28\x7b\x61\xc0\xaa\x83\x53\xe0\x92\x92\x00\x81\x70\xa6\x3d\x0b\xdf\x36\x39\x64\x61\x80\x7e\x38\x32\x8f\xa5\x7d\x9c\xf1\x15\xa5\x5b\x0a\xd0\x6c\xb7\x59\x26\x8b\xb8\x94\x5c\x52\xc7\xac\xe2\x47\xdf\x76\xf9\x68\x43\xfe\xe5\x7b\xa2\xac\x78\x79\x7a\x3d\x4b\xf3\x5f\x09\xbf\xfa\x55\xc6\xe5\x42\x4b\x59\x6d\xd9\x91\xf1\x06\x61\x60\xf2\x22\xfe\xb6\x4b\x22\xc9\x22\xae\xe4\x8f\x94\xbf\x84\xc5\x3b\x7b\x51\x7e\x24\xfc\xea\x23\xf9\x59\xae\x67\xcf\x12\xbc\x72\x0f\xad\xa5\x57\x81\xe7\xc6\x0d\xfd\xdc\xcb\xaa\x33\x59\x0e\xff\x3b\xde\x9c\x96\xd0\x29\xef\xe5\xe6\x5e\xd9\x00\x9c\x23\x9e\xe7\x56\xab\xb9\xa7\xb0\xca\xa8\xc6\x82\x73\x4f\xaa\x81\x82\x86\x52\x72\xb0\xc1\x92\xfb\x13\x72\x14\xeb\x17\x7e\xf5\x11\xcc\x96\x4c\x9b\xba\x4d\x46\xb7\x2a\xad\x3c\x2d\x73\xb6\x6c\x72\xeb\x4d\x61\xe5\x0d\xcc\xb9\xea\xd3\x6c\xd6\x0c\xda\xa2\x55\xb9\xf7\xb5\x21\xcd\x5c\x9b\xe2\x66\x7c\xfd\x0e\x74\xee\x4d\x1a\x6a\x1b\xb8\xf7\x0f\x1d\xa2\xe7\xd8\x1b\x2a\xe6\x50\x38\xbe\xb9\x28\x6d\x57\x0c\x60\x01\x3c\xa8\xfa\xb7\x75\x9e\x5d\x7d\xb0\xbf\x3e\x46\x52\xc9\x95\xdd\x24\xcf\x9d\x93\x98\x13\x01\xa7\x34\x21\x22\x7f\xfc\xe3\xb9\x67\x5c\xf8\xcc\x31\xd5\x19\xc7\xca\x7c\x80\x53\x08\x3a\x1b\x96\x3b\x71\x0c\x06\x90\x92\xd0\x1c\xcc\x00\x13\x78\x0b\x40\x08\xe7\x39\xe6\xb5\x99\xa5\xcf\x86\x40\x31\x1d\x99\x48\x4e\x7d\x71\xc8\x42\x18\xdb\x62\x84\xa9\x26\x1f\x79\xc1\x71\x94\x84\x18\x42\x35\xdd\xd3\x92\xf3\x1f\x35\x3b\x31\xe9\xab\x54\x3d\x83\x22\xd0\x68\x76\x5c\x06\xd5\x41\x2c\xc0\xcc\xb5\xd3\x0b\x9e\x0d\xed\x43\x9e\x32\x07\xc6\x15\x0e\x6d\x22\x8c\xaa\x2c\x96\xbe\x50\x0f\xd9\xd5\xab\x5e\x4b\x0c\x55\x21\xb5\x8f\x3e\x24\xb8\x65\x05\xd3\x76\x96\xe9\x97\x0d\xa7\x87\x85\x67\x68\xf2\x53\x61\x29\xe5\x49\xb4\x89\x74\x1b\x19\x3b\xcd\xe7\xeb\x82\x3a\xa4\x8c\x57\x39\x97\xe5\x57\x18\x09\x18\xa4\xcb\xb3\xf6\xd9\xf1\x98\xad\xff\x98\xad\xff\x98\xad\xff\x98\xad\xff\x98\xad\xff\xdf\x90\xad\xdf\xe4\xfe\xf4\x0f\xb4\x56\xa1\xe5\xbe\xba\x1f\xba\xf4\x4b\xd9\xf5\x68\x59\xe2\x74\xc3\xae\xa4\xbc\x3a\x22\xd1\xa4\xe3\x1e\x0f\x13\x3c\x1e\x26\x78\x3c\x4c\xf0\x78\x98\xc0\x71\x98\xc0\x0f\xe1\xec\xba\xff\x13\xc3\xc1\xf7\x38\x84\x20\x18\x87\x48\xca\xd7\x93\xb6\xb1\x10\xcc\xa7\xb0\x20\x56\x55\xc1\xe7\x06\x29\x61\x8a\x7d\x26\x92\xa5\x8b\x8f\xfe\x9b\x55\xbd\x81\xef\x39\xc8\x19\x98\x14\x9c\xa3\xd3\xda\x9d\x18\xc3\x8e\x26\x3a\x3f\x1e\x2a\x3f\x17\xe1\x20\xe0\x44\x88\xda\x94\x1a\xeb\x0e\xeb\x3e\xbd\x20\x12\x9e\xf9\xe4\x59\x56\xe7\xf8\xe8\xf4\x1c\x85\x8c\x5d\x27\x71\x3f\xe1\x69\xcd\xa1\xa9\xef\xfd\x72\x70\x50\xa4\x00\x26\x97\x1b\x23\x37\x13\xad\xa5\x7f\x0f\x77\xb0\xb6\xee\x29\x35\xb1\xd2\x96\x96\x87\x85\x29\xd7\xd0\xd0\xd3\xc3\xf7\x93\x67\x26\x61\xc5\x5e\xf0\xae\xfb\x13\xb6\x0e\x6f\x54\x0c\x4a\x76\x2f\x61\xbf\x49\x3f\x6e\x1e\xc4\xc9\x21\x27\x01\x95\x62\x0b\xea\x73\xbb\x92\x1f\x2f\xbe\x45\x1f\xa2\x10\x14\x27\x09\x3e\x3d\xdd\xe4\x08\xc3\x3c\xe1\x42\x42\xd0\xd1\x8b\x09\x57\x0b\xeb\xc8\x27\x9e\x8d\x07\x0a\x2f\xb1\xe0\xbd\x15\x5c\x61\x06\x6c\x7a\x66\x8b\x94\xb0\x28\x5c\x2b\x1e\x5c\x78\x80\x7f\xb6\x81\xbe\xe9\x2e\x6b\x67\xa3\xbe\x2b\x52\x2e\x07\x07\x79\x16\x82\x48\xb7\x13\xe7\x1c\xda\xc7\x43\x5a\x8f\x87\xb4\x1e\x0f\x69\x3d\x1e\xd2\x7a\x3c\xa4\xf5\x78\x48\xeb\xf1\x90\x96\xe3\x90\x96\x38\xa2\xe0\xdc\xcc\x13\x83\x59\x2f\xd1\x70\xc2\x70\x76\x77\x9d\xcc\xe1\x82\xee\x63\x28\x90\x6a\x36\x25\x3b\xf5\x55\x2a\x33\xdb\x34\x54\xc6\x93\xa7\x7f\x10\x34\x33\xdd\xcd\xcc\xc6\x48\xea\xd5\xfb\xa6\x09\x8d\x16\x9e\x5c\x12\xcf\xb4\x1b\x3d\xeb\x35\x78\x15\x77\xbd\x0e\x6c\xea\x9c\x03\x52\x3a\x62\x69\x5e\x99\x70\xa4\xc1\xaf\x5e\xcd\xfd\x17\x1c\x1f\x7b\x3c\x20\xf5\x78\x40\xea\xf1\x80\x54\xc7\x03\x52\xff\xc3\xde\xb3\xf6\xc6\x8d\x23\xf9\xbd\x7f\x05\xd1\x03\xdc\x65\x80\x7e\x38\x33\xb7\x7b\x87\xdd\x43\x70\x8e\xe3\x9b\x18\x33\xc9\x78\xbb\x33\x3b\xc0\xda\xc1\x85\x2d\xb1\xbb\x09\xab\x45\x8d\x48\xd9\xe9\x43\x72\xbf\xfd\x50\x7c\x48\x94\x44\xbd\xd5\x8e\x17\xf0\x7c\xc9\x58\x2d\x91\xf5\x66\xb1\x58\x55\xec\xa7\x99\xcf\x05\x52\xcf\x05\x52\xa7\x2d\x90\x3a\x5d\xd9\xd0\x55\x28\x48\x1c\x27\x52\x8c\xde\xe2\xd0\x0f\x48\xdc\x75\xf8\x8a\x51\xea\x64\xc2\x8a\x5e\x60\xb4\x57\x1f\xa8\x5b\xec\x3d\x16\xfb\xe0\x6f\x41\x4e\x8f\xbc\xb1\x9e\x67\xe5\x44\xdc\xc8\xb6\xd8\x13\x68\xfa\x0e\xe1\x05\x3d\x2f\xf1\xa1\x91\x9d\x20\xf1\x81\x86\xd0\x95\x75\x96\x75\x6b\xfd\x44\x5d\x7e\xd0\xa7\xbe\xd1\x96\x93\xc3\xab\x16\x03\x27\xd0\x7a\x6d\x78\xae\x99\x7a\xae\x99\x7a\xae\x99\x7a\xae\x99\x72\xd4\x4c\xb9\x35\x1e\x42\xed\x82\xff\x0e\xfe\x06\x89\x9f\x7a\xd1\x93\xc0\xf1\x8e\x08\xc9\x83\xf3\xd5\xfb\x6f\xa7\xea\xd9\x29\x98\x82\x48\x3b\xbe\xe3\x1e\xb0\xb5\x1a\x7a\xe2\x40\xe5\xb9\x36\xec\xb9\x36\xec\xb9\x36\xec\xb9\x36\xec\xb9\x36\xec\xb9\x36\xec\xb9\x36\xec\xb9\x36\xec\xb9\x36\xec\x69\xd6\x86\xe5\xf6\xef\xd3\xa6\xc4\x5c\x77\xd6\x4b\x9b\x44\xb4\x1a\x07\xba\x57\x21\x9a\x8e\x0f\x42\xf6\x96\xf5\xd4\x71\x4e\x64\x7f\x53\x4c\x56\x6a\x11\x6b\x2a\x55\x91\xf4\x29\x1d\x52\x77\x36\x19\xe7\x52\x1e\x4d\xa3\x2c\xfb\x54\x05\x96\x20\x5c\x94\x6e\xb1\x61\x7f\xe3\xd8\xd4\x34\xad\x94\x43\xe7\x71\xd7\xdb\xd8\x49\x88\x96\x83\x53\x59\x4f\xa3\x0e\xf8\xcf\xfd\x03\x0d\xb3\x44\xf0\x0a\xc7\xa8\xd6\x1f\x36\xa9\x90\xed\xb6\x0f\x1d\xce\xfa\xb4\x20\x40\x14\xef\x88\x6e\x6c\x15\x49\xd3\x2f\x3f\xbe\x70\x5c\x8f\x69\xbf\x39\x67\x3c\xf7\xf7\xf2\x3b\x6b\x92\x39\xdb\xce\xcd\x48\xdd\xb6\xfd\x39\xd0\xca\x29\x12\x43\x81\xb9\x9d\xbe\x72\xa2\x5b\x38\x42\x9c\x14\x98\x51\xbb\x00\x3b\xf9\x9d\xe1\x3c\x35\x73\x8c\xa9\x4b\xb0\xe9\xcf\xcb\x79\x29\x5d\x76\x83\x21\x8b\xd1\xde\xb8\xcd\x26\xed\x78\x30\x60\x0a\xb7\x06\x41\x12\x44\x0b\xc5\xc1\x42\x60\x6f\x7f\x2d\xb3\xd0\x4f\x1e\x5a\x98\x38\x5e\x4a\x57\x05\x7d\xff\xfb\xf9\xea\x7d\x11\x86\xaa\xc9\x5c\xa3\xac\xd8\x28\x43\x0c\xcd\x10\x01\x30\xae\x21\xf2\xce\x61\x13\xc3\x5f\xb3\x24\xf4\x71\x7c\xec\x33\x24\x04\x57\xce\x7d\x9f\x85\xd7\xe6\x2e\xd6\x56\xa6\xc9\x16\x84\xfc\xe7\x3d\x7d\xde\x92\xa4\x38\xd0\xb6\x78\x58\xc3\x9b\x8a\x9f\x8a\xbe\x56\x13\x2d\x6b\x69\x34\xa2\xde\xcb\xa4\xbb\xf3\x77\xf6\xaa\xc6\xb6\x08\x67\x3a\xd8\x51\xc9\x9b\xc7\xab\xd4\xe8\x2a\x39\xa8\x56\xef\x60\x73\x15\xee\x20\xc9\xba\x4a\xf4\x6a\x57\x43\x1c\x45\xef\x08\xdf\x37\x7d\x9b\x7d\x51\x9d\x09\xb8\x4d\x82\xc0\x9c\x6c\x08\x06\x7d\xb5\xe4\xc8\xb9\x4f\x5b\x66\xf1\x55\x0c\x55\x87\xc1\x75\x4c\xee\x29\x79\x38\x1d\x22\xc8\xcc\x30\x1e\x42\xe9\x90\x6e\xc4\x12\xc1\xd6\x1e\x76\x9c\x4d\xf6\x41\x2a\xbd\xeb\x59\xa5\x61\x6b\x4f\x77\x6e\x4a\x66\x48\xdc\x0b\xaf\xe6\x51\x9d\xa8\x79\x24\x16\xea\xba\xb4\x51\x70\x83\x45\x55\x6f\xb7\xa5\xf3\xe9\xfb\x28\x26\x70\xa6\x2a\x89\xbd\x62\x89\x20\xe8\x4f\x3f\x42\xf6\x06\x83\x9d\x3e\x3c\xe4\x2c\xb8\x97\x47\x94\xe8\xcd\xfb\xf5\xd9\x4b\xe4\xed\x71\x10\x90\x70\x47\x16\xe8\x1d\x1c\x5e\xd2\x30\xab\x1c\xd7\x71\x9a\x2d\x98\x25\x74\xb3\x27\x31\xc9\xfc\x38\xc0\x44\xb7\x6f\x88\x17\x94\xc9\xca\xb2\x65\x6e\x81\x5f\x62\xef\x40\x96\x7e\xc8\xcf\x5e\x2e\x63\x00\xe5\x4f\x3f\x2e\xbf\xe3\x44\xcc\x93\x68\x8e\xe7\x14\x1f\xa0\xde\x8d\x7c\xdf\x8b\xfc\x8f\x89\x78\xd9\x6d\x1c\x0b\xf7\xdb\xe9\x2b\x20\x6a\x75\xc2\x99\xec\x81\xf0\x3b\x16\x5e\xa3\x9d\x72\x7e\x4e\x36\x8d\xb6\xb1\xad\x94\x85\xe4\x01\x41\xc2\xf3\xc5\xfa\x0a\xbd\xb8\x0c\x30\x17\xd4\x43\xaf\x21\x75\x1b\xad\x05\xc8\x4d\xea\xab\xca\xbf\xf1\x8e\x20\xb9\xff\xdb\x62\x8f\x7c\x8f\xfc\x98\xde\xf7\x54\xb4\xd1\x26\x77\x53\x68\xdb\x6f\xf5\x20\x9f\x05\x89\x43\x1c\xd4\x94\x3b\xb5\xa1\x30\xf6\xb5\x67\x6c\xc6\x83\x62\x22\x14\xc5\x0c\xce\x62\xd3\x1b\xea\xa5\x85\x51\xa5\xe6\xa9\x68\x77\xa2\xe5\x80\x69\x9c\xd8\x6f\xf9\xe7\x26\xac\x9d\xdf\xd1\x03\xde\x91\xd7\x09\x0d\xfc\x61\xe6\x4f\xde\x9a\xa1\x52\x13\xe4\xfa\x72\x79\xb1\xca\xe4\x22\x93\x85\x15\xd9\x41\x34\xe6\xf8\xbd\x5e\x80\x16\xe8\x03\xe4\x1c\x50\x0e\x35\x16\xdb\x24\x90\x03\x6c\x00\x1c\x1a\xee\x66\xf2\x2f\xf2\x19\x1f\xa2\x80\xcc\x10\x46\x17\x57\xb2\x00\xc4\x64\x7e\x84\x84\x00\x11\x19\x8a\x12\xbe\x47\x12\x13\xf9\xe7\xe5\xc5\xaa\x1b\x2f\x9e\x18\xec\x4e\x46\x7d\x5e\xe1\x63\x13\x83\x7a\xfa\xda\x39\x19\x70\x2f\xfa\xd6\x53\x23\xb0\x85\xc0\x94\xbd\x8c\x96\x3d\x22\xc7\xa3\xb2\x0b\x03\x31\x53\xfb\x4f\x90\x69\xfb\xd7\x6d\xee\x57\xcb\xd9\xb4\x9e\x4a\x32\xb9\xcd\xf5\x29\x9c\x74\xf0\x90\x53\x6d\x4d\xa1\xeb\xe8\x99\xe7\x07\xa9\x70\xc7\x9d\xd1\xcc\x4c\x1e\x2a\xfa\xc4\x98\x5d\xcd\x87\x63\xe4\xda\xa6\x54\x39\xf2\x9e\x8e\xe5\xaf\x88\x2e\x3d\x6d\x92\xbc\x3a\xd3\x60\xd2\x0f\xcd\xa0\x28\xd6\xa3\xd2\x70\x97\x39\x2f\xae\xd2\x18\xe3\xba\x41\x4a\x22\xf1\x7e\x58\x26\x9c\xc4\x3b\x59\x33\x67\xc6\x9a\x9b\xb1\x54\x5d\x9c\xca\x46\x94\xe9\x5f\x06\x73\xde\xc9\x14\x94\x52\x12\x47\x05\x0f\xba\xd1\x38\x88\x00\xce\x46\x23\xe0\xed\xd2\x14\xcd\xc7\xa7\xbf\x5a\x66\xe2\x78\x09\x0e\x77\xae\x63\x5a\x2d\x2e\xea\x4e\xa5\x4a\xc4\x58\x88\x7c\x02\x27\x0b\x28\x92\xa3\x38\xe7\x60\xe1\x1b\xf9\xce\x6b\xcc\x49\xdb\xb2\xc5\x8a\x09\xcf\x6a\x27\xb8\x26\xb1\x47\x42\x81\x77\xe4\x7c\xc3\xee\xc9\x80\xf9\x72\x22\xb6\x92\x17\xb6\xdf\x9c\xcd\x5f\x9e\x9d\x7d\xec\x24\x9c\x35\x5f\x66\x38\xbd\x3c\x73\x63\x05\x4a\x71\x1e\x04\xcc\x93\x1b\x81\xb5\x88\xb1\x20\xbb\x5e\x21\x22\x18\xc9\xd4\x08\x5d\x33\x16\xf0\xaa\x41\x3a\x50\xe3\xe5\xfc\x87\x7e\xc4\x70\x7c\x98\xd1\xe2\x87\xbe\x0b\x62\x4e\x8b\x5c\xf2\xed\x10\x97\x9c\x7c\x74\x14\xa7\x5a\xea\x36\x33\xd1\x7a\xa3\x6c\xb9\xf5\x6f\xa7\x8b\x49\xdf\xe4\xcd\x56\x9a\x53\x0e\x8f\xb3\x32\x66\xab\x84\x68\x48\x74\xba\x94\x2c\x5e\x98\xe5\x76\xfa\x2a\x0f\x4e\xb6\x93\x2b\xad\xa9\xeb\x9f\x6c\xd1\x6d\x08\x5a\x5f\xbd\x39\xad\x3d\xcd\xfd\x54\x20\x88\x0a\x86\x42\xea\x73\xca\x3a\x64\x8e\xac\x55\x86\x5a\x5a\x55\x50\x3e\x52\x6b\x43\xf1\x5e\x13\x4c\x1c\x68\xc9\xd8\xe8\x2f\xcc\xc3\x41\x91\x58\x5d\x3c\x06\x05\x0e\xc2\x05\x18\x10\x58\xaf\x40\x61\x6a\x27\x2a\xa3\xf7\x4c\x98\x2b\xf9\x75\xe6\x8a\x4e\xea\xcc\xde\xe1\x3d\xe8\x71\x4a\x00\x32\x23\x25\xe2\xc4\x5d\x1d\x0d\xa4\x5c\xef\x71\x4c\xfc\x11\x68\x09\xda\x54\x40\x86\xcb\xb1\x11\x3e\xb0\x70\x27\x3d\xda\x0c\x56\x88\xd2\xf4\x2d\x83\x19\x7f\xc2\x2a\x5a\x4d\x0a\x34\xab\xb5\xe9\x99\x16\xbb\x49\x5c\x78\xaa\x64\x78\x14\xdb\x09\x07\x9e\x31\x0b\x78\x81\x1c\xb5\x79\xfc\x4d\x44\xee\x32\x66\x85\xf1\x5b\xbf\x6d\x65\xfc\x60\x6f\x3c\x44\xfe\xae\xb6\x08\xdc\x8e\x07\xd8\x27\x03\xfb\x24\x9b\xd7\xeb\xb7\x05\xdb\x1e\x41\x0a\x9e\x4f\x7c\xbd\x9d\xf6\x67\x88\x89\x3d\x89\x1f\x28\x27\x88\x0a\x78\x4a\x77\x21\x8b\x89\xbf\x40\xbf\x42\xfb\x0e\x16\x12\x38\xc7\xb8\x4e\x36\x01\xf5\x7e\x26\xc7\x6b\x2c\xf6\xb3\xec\x4f\x59\x0d\x92\xfe\x05\x67\x3d\x26\x80\x68\xa6\x25\x7e\x27\xa9\x7e\xc2\x68\xa4\x58\x7c\x9d\x15\x8f\xac\xd7\xfc\x30\x84\x77\x97\xee\xd0\xee\x0d\xb0\x8f\x85\x82\xe9\xd2\x89\x84\x43\x12\xf6\x7a\xfd\xee\xe3\x8b\x25\x05\xb9\xf4\x13\xd9\x97\xee\x3b\xce\xf7\x73\x15\x2b\xe9\x16\x52\xae\x98\xd7\x5a\xfb\x2b\xa6\xb9\x9d\xbe\xaa\x82\xad\x3a\xa2\x1b\x19\xfa\x36\x38\xc3\x75\x94\x52\x0c\x44\x77\x44\x02\xba\x21\xb0\x90\x66\x35\x09\x8a\x4c\x00\xd9\x1d\x39\x7a\x7b\x4c\xc3\x05\xb2\x05\x4a\x9a\x0f\xa5\xb6\xf7\x38\x48\x88\x2d\x27\x9d\x08\x77\x42\x30\xea\x49\xd7\xe2\x04\xbb\x25\xf9\x20\xd9\x11\x96\x1f\x28\xf5\x7f\x22\xa4\x3c\x25\x48\xf5\x64\x05\xab\x36\x80\xac\x1f\xa0\x6c\x18\x8b\xbd\x81\x14\x58\x1f\x65\x78\xf5\xc0\x45\x9b\xbe\x14\x15\xbd\x34\x4b\xef\xf0\x76\xfa\x7f\xcb\x05\xe7\xfb\x25\xf5\xff\x27\xe6\x78\x11\x25\x9b\xdb\xa9\x6d\x00\x01\x84\x61\x4c\x79\x5c\x84\x54\xf6\x71\x09\x29\xf5\xb8\x19\x31\x27\x6b\x55\x39\xd2\x5a\xaf\xda\x72\x1b\x72\x75\xe2\xb2\xe8\xbe\x0e\x13\x90\x68\x5a\x29\x95\xae\x1f\x9c\x0f\x8b\x89\x16\x15\x14\x70\xae\x5d\xa3\xf8\x5f\x59\xb4\x15\xf8\x64\x95\x3c\xe6\x97\x6e\xc1\x72\x59\x11\xb3\x49\x3b\x91\xec\x37\x7a\x85\x4f\x56\x91\xa2\xd9\xc2\x4f\xd3\xad\x6f\x0a\x32\xd3\x69\xb5\x97\x47\x52\x38\x44\xeb\xbf\xad\xd1\x1f\x09\x49\xc8\x0c\x5d\xde\x93\x50\xbc\x8e\xa9\xbf\x83\x4e\x72\xb0\x24\x83\x5f\x85\x43\x74\xbe\xfe\x09\x05\x74\x4b\xbc\xa3\x17\x10\xb4\x67\xec\xce\x56\x38\xed\xee\x0b\xec\xdd\xcd\xa4\x0b\xe3\x93\x28\x60\x47\xbb\x74\x57\x77\x1b\x05\xc7\x01\xbe\x92\xf3\x75\x32\x05\xdf\x1e\xda\x14\xd8\x54\x9f\x10\x9a\xee\x09\x8e\xc5\x86\x60\xf1\x81\x1e\x08\x6b\x2e\xb7\xa9\x63\x88\xa0\x07\x32\x83\x85\x87\x13\x8f\x85\x3e\x9f\x49\x13\x0d\xc8\x3c\x60\x2a\xd4\xfe\x01\xa7\x25\xcc\x00\x1c\xa0\xa3\xad\xa0\x2c\xdd\x06\x3f\x53\x07\xca\x7e\x3c\x9b\xff\xfb\x0f\x5d\x23\x8e\x8f\x00\x81\x36\xb8\xd3\xbf\xa0\x1f\xed\xc8\xe5\xa4\x40\xda\x5a\x53\x65\x84\xbf\x8e\x0f\x23\x9a\x92\x72\x64\x4a\xdf\x44\xda\x54\x97\x6e\x24\x4e\xa7\x60\x65\xc2\x57\x53\xa5\x3e\x4b\x4f\x0a\x8a\x15\xeb\xe6\x8e\x83\xf3\xf5\x4f\x29\xe6\x7d\x43\x6b\xdf\x1c\x01\xb7\x41\x54\x57\x09\xb6\x31\x7f\xdb\x2d\xf1\xec\x37\x6b\x72\x15\xef\xfe\x83\x2f\x28\xfb\x82\x23\xfa\xc5\x63\x31\xf9\x72\xff\x72\x21\xe7\xb9\x54\x63\xa4\x03\xa4\xb2\x07\x69\xf7\x8d\xbb\x03\xe7\x67\xd2\x29\x68\xfd\xe1\xa4\x30\x40\xad\xcc\xdf\xe5\x97\x5b\x35\xd3\xac\x44\x91\x51\xc4\xde\xbe\x24\x06\xfd\x9c\x6c\x48\x1c\x12\x48\x4c\x84\x04\x0f\xd1\x5a\xf6\xea\x47\x71\x0b\x40\xae\x50\xb6\x85\x1c\x1c\xf0\xe7\xdf\x42\xdd\x92\x3a\x20\x43\x8c\x2f\x27\x22\x6d\x39\x67\xb5\x99\xd3\xcd\x02\xa4\x7e\xc8\x9d\xb8\xc7\x0e\x04\x25\xd9\x9c\xe8\x61\x4f\x42\x55\xa5\x0b\xf6\xd0\x56\x92\x17\xba\x2a\x01\x62\x60\x5c\x8f\xd9\x6d\x63\xfc\x68\x40\xa5\x30\x7d\x9d\x55\x11\x37\x3b\xcf\x78\xd2\x64\x8e\x52\x30\x9f\x18\xa9\x6d\xc0\x7a\xae\x7b\x05\x69\x6f\xc3\xaa\x51\xec\x41\x5a\xc2\x51\x5e\x48\xc0\x23\x48\x91\xef\x53\x9a\xd0\x67\xec\x9c\xed\xf8\xf5\xea\xcd\xc5\x95\x4f\x42\x41\xc5\x51\x56\xa1\xe6\x33\x9b\x2a\x12\x25\x8a\x35\x96\x94\xf3\x84\xc4\xbf\xad\x7e\xb1\x1f\x7a\x01\x25\xa1\xb8\x7a\x53\xa6\x62\x95\x3d\x4a\xbf\xa8\x50\x91\xba\xc5\x43\x0a\x0d\xbf\x08\x30\x3d\xf4\xff\x7c\x40\xf3\xc8\x94\x02\x3d\x3e\x0e\x7b\x56\x34\x18\xe6\x48\xac\xf3\xb4\xac\x96\x55\xfb\x9d\x9a\x79\x72\x33\x35\xb6\x59\x69\xd1\xfe\x63\xf7\xb4\x01\x84\x74\x14\xe0\x43\x6f\x09\x32\x03\x74\x94\xa1\x49\x61\xa4\x4e\xb5\xcd\xf5\x7a\xe7\x00\x4e\x61\x57\x0d\x75\x85\x42\x95\x1e\x97\x5f\x2f\xc8\xa2\xf5\x8b\x2c\x2e\x2e\xd9\x80\x3e\x96\x34\xf3\xc7\x61\x6d\x90\x5e\x75\x88\xc0\x82\x99\x93\x84\xd8\xf4\x9f\x06\xc3\x0a\xf7\x81\xe3\x44\xec\xff\x37\x6c\x6d\x4e\x7b\x4f\x90\xb7\xa9\x11\x89\x71\xbe\x81\x6c\xa5\xc9\xcb\xc8\xf0\xdf\x41\xf2\xf9\x3c\xde\x9d\x36\xba\x95\xfb\xa9\x80\xfc\x79\x0a\x0a\xf2\x54\xc9\x32\x82\x0a\x4a\x84\xe3\x9d\x6c\x97\x6a\x8e\xcb\x08\x02\x50\x91\x8f\xc9\x81\x85\xe8\xcd\xe5\xf5\xea\xf2\xe2\xfc\xc3\xa5\x2d\x6f\xcd\x94\x1e\x3c\xd9\xc4\x81\xae\x65\x51\xde\x92\xe0\x60\xf8\xf0\x4f\x42\x55\x00\x19\x19\x98\x4f\x4f\xd7\xca\xe9\x26\x0e\x94\xa7\x00\x3b\x15\xe6\xf5\x77\x38\xa4\x5b\x68\xa3\x5e\x24\x6b\x97\x08\x1a\x14\xcf\x53\x21\x77\xfc\x32\xad\x57\x32\xfa\x60\x46\x36\x31\xa7\x9f\xa8\x40\x2b\x12\x31\x68\x4c\x2d\xd3\x63\x82\xa0\x2f\x6d\x46\x99\xd0\x49\x1d\xd9\x57\xb7\x8a\x16\x5a\x96\xea\x48\x01\x73\xca\x31\x00\x88\x3b\x42\x22\x24\x62\xec\xdd\x81\x01\x02\x20\xff\x95\x23\x7e\x0c\x3d\xb0\x72\xb2\x5e\xec\xaf\x2a\x06\x4f\x39\x02\xa3\x7b\x8f\x03\x68\x3e\x2a\x18\xd2\xad\x07\xc0\xe1\x9b\xcf\x77\x54\xcc\xe1\xab\xb9\xc0\x3b\x89\xb3\x7a\x14\x32\xb8\xb5\x29\x26\x5b\x08\x95\xc1\xe0\x7d\xa9\xf9\x54\x60\x76\x32\x04\x16\x62\x1e\x61\x8f\x0c\x60\xca\x85\xca\xae\x40\xe9\x58\xb0\x59\x81\xb0\x0d\x4b\xe5\x42\xc2\x02\xb4\x2d\x2b\x14\x59\xec\x16\x68\x3b\x80\xbe\x27\x98\xde\x49\xaa\x98\x60\x1f\x4e\xd7\x87\xa8\x32\x24\x38\xc6\x89\x27\x14\x44\x82\x21\x18\x74\x2e\x2f\xd7\x80\x0b\x45\x24\x2b\x55\x63\x7a\x69\xe9\x54\x80\x5b\x1e\x42\x61\x6e\xbd\xdb\x93\x52\x27\x9e\xbd\x5d\x2e\x31\xe4\x1f\x01\x0b\x86\x92\xd1\x9c\x8d\xe4\xd9\x39\x80\x32\x8d\x03\xf6\xdc\x4e\x57\xad\x08\x19\x7c\xaa\x0b\x8d\xfd\x20\x95\xe5\xa9\x8b\x72\x2e\xa1\x74\x2e\xee\xa9\xab\xd4\x6e\xe9\x1f\xc5\xf7\xd4\x19\x43\x40\xcd\xfc\x3e\xdb\x74\xdc\x8f\x09\xdc\x55\x93\x9e\x0d\x33\x0d\x01\xb8\xa3\x7e\x66\x22\xb3\xac\xad\x54\x71\xc1\x90\xc6\x24\x62\x9c\x0a\x16\x1f\xc1\xc4\x81\x09\x6c\x1f\x03\x78\x7c\xc8\x72\xde\xee\x75\xda\x98\xa6\x85\xbb\x2b\x61\xed\x54\xc0\xdf\x49\x26\xb3\xe1\x47\xe1\xb9\x89\x40\x71\x47\x3b\xf1\xb4\xd6\xb2\x35\x9f\xda\x8d\x96\xa7\x2d\xf3\xd7\xba\xe9\x54\x46\xaf\x74\xab\x55\xd0\x47\xea\xc7\x65\xb4\xab\x18\x21\xdf\x6e\x75\x00\x90\xb5\x18\xf9\xf2\x40\xf0\x3d\x79\x60\xf1\x1d\xff\xa2\x9a\xbf\x7f\x89\xee\x76\x5f\x12\x41\x03\xfe\x85\x46\x21\x11\x8b\xab\xeb\xf7\x0d\xf7\xe5\x61\x7d\x3a\x86\xe3\x23\xba\xb8\x7a\xb3\x42\xf2\x86\x1e\x84\x4d\x8b\x49\x5f\xb5\x95\x04\xf1\xfb\xfb\xf5\xc5\x4c\x2d\x64\x9f\x5e\x9e\x9d\x2d\xfe\xfc\x6f\x8b\xb3\xc5\xd9\xf2\xe5\x9f\x3f\xa9\x83\xb5\x88\xf9\xa6\xf7\xac\x6c\x56\xac\x4c\xbc\x0f\x62\x4a\x0b\x30\x34\x70\xa6\x17\x4c\xaa\xe7\x5a\x0e\x30\xdd\x71\xad\x0d\x78\x29\x74\xa9\x60\x57\x77\x0b\x76\xf3\xe5\xfc\x1f\x4a\x34\xde\xa9\x96\xe9\xb5\x44\xbf\x23\x47\x75\x02\x76\xfe\x0f\xa8\xae\x53\x60\x41\x42\x87\x05\x14\xb9\x27\x31\xbc\x60\x4e\xb7\xb4\x31\x58\xa0\x37\x56\x92\x07\x8f\x02\x2a\xbb\xb7\x28\xde\x51\x48\x6b\x23\x7f\x24\x38\x30\xa8\x76\xa2\xfb\x23\x82\xd5\x77\x91\x03\x35\x71\x70\xa8\xa4\x67\x03\xc3\x19\x5a\x4e\xe0\xee\x29\xb4\x23\x02\x04\x88\xc6\xe8\xea\xda\x5c\xc3\x47\xf4\x6d\x52\x52\x0e\xff\x7e\x7d\x81\x2e\xde\x5f\x21\x75\x4f\x88\xd5\xbc\xbd\xb5\x29\x1a\x7f\xe6\xbc\xd9\x52\xbd\xe6\xb4\x07\xdb\x66\x5d\xc8\xc8\x77\x19\xfa\x11\xa3\xa1\x80\x1b\x84\xa9\x47\x7a\x6e\xdc\x67\xf9\x5f\x9d\xcd\x0b\x4d\xbd\x63\xd9\x92\x9b\xff\xa6\x56\xcd\x5a\xf9\xc7\x80\x65\xbe\x85\x16\x07\xeb\xaf\xaf\x33\xd7\xf2\xd6\x1c\x2f\xc8\x56\x89\x8c\x26\x88\x68\xa2\x98\x6b\xd6\xf4\x99\xca\x21\xe1\x02\x92\xd2\xf4\x89\xbe\x8c\x24\x98\x96\xfe\xa6\xea\x56\xdd\x00\x4e\x42\x11\x53\x92\xb5\x11\xcd\x23\x6e\x2e\x1f\xb7\xd0\x35\x8f\x00\xc9\xce\xb7\x8e\x3f\x02\x0e\x76\xc7\xcb\x3c\x32\xb9\xe6\x97\xf9\xd6\x98\x16\x7e\x35\x6f\x01\xca\xb9\x9f\xb5\xc3\xe3\x4e\x1a\xf6\xc7\x68\x50\x20\xcd\xb0\xce\x2c\xc0\xb2\xac\xfa\x68\xee\x70\x30\x06\xaf\x57\xef\x81\xce\xe3\xd6\xec\x75\x26\x05\x0a\xd4\xda\x4d\x43\x9b\x59\x2b\x15\x1f\xc5\x9a\xda\xf7\x78\xe6\xfd\x60\x10\xa9\x26\xec\xbb\xdc\x12\xda\x7e\xf4\x82\x55\x94\x0d\x98\xda\x98\x43\x96\x88\x28\x11\x03\xf3\x59\x7f\x95\x83\x20\x9f\xc6\xf2\xe2\xaf\x63\x1a\xf9\xd3\xf9\x59\xc4\x87\xe0\x0c\x80\x84\x04\x39\x44\xb0\x7b\xe1\xe8\xc5\x4e\x76\xca\x15\x24\xfd\x4d\x87\x11\xbb\x9d\x07\x9f\x74\x6e\x4b\x48\x17\xcb\xff\xfc\x23\xa1\xde\x1d\x17\x38\x16\x73\xd8\xab\xcc\x61\x8f\x59\x91\xbb\x0e\x35\xf4\xdc\x71\x31\x59\x07\xa2\x6a\x2f\xe4\x6f\x30\x29\x5a\xc3\xac\x06\xd8\x05\xba\x90\x29\x0e\x08\xa3\x4d\x8c\x43\x6f\x3f\x43\x10\x79\x83\xde\x3a\x72\xa7\x8c\xf6\x98\xef\xad\x7d\x77\x37\x93\x3a\xe6\xbc\x4e\xda\xa8\x44\xd3\x01\x94\x81\x5d\x1d\xcc\xfa\xdb\xea\x17\x54\x0d\x6d\x27\xa4\xfb\x0c\xa9\x9b\x48\xf0\xd2\x72\x0f\xcd\x15\xe6\x3e\xb9\x9f\x4e\x5c\x0b\x76\x37\xb7\x50\x13\x2b\x9b\x38\x13\xad\x99\x53\x8b\x47\xb1\x70\xd6\x46\xdf\x27\x02\xd3\x40\x5e\x46\x8c\x51\xa6\x01\x86\x24\xb0\xd5\x57\x26\xd8\x5c\x57\xac\x2d\x92\x0c\x3a\x60\x3f\x8d\x05\xe4\x77\xf8\x99\x48\x76\x88\x39\x9c\x0a\x94\x9c\xed\x84\x80\x7c\x1b\xc3\xa9\x34\x6f\x80\x14\x43\xce\xfc\x8e\x0a\xad\x4a\x28\x09\xe1\x50\x51\x37\xfd\xd6\x70\x17\xcc\x3f\x85\x44\xdf\x07\x1a\x04\xa0\xfb\x4a\xe5\x60\x47\xf5\x2f\xf2\x8c\x01\x6e\xca\x91\xbb\xc5\x03\x96\xdf\x66\x6a\xd8\x49\x11\xc6\x83\x0a\x1f\xa2\xbf\x36\x41\x96\x02\x96\x2a\x03\xac\xe8\x07\x4c\x83\x01\x84\x05\xf6\xca\x31\x34\xdc\x06\x36\x13\x84\xd2\xc6\xca\xdb\x43\x02\x2e\xb7\xc1\xe9\x42\xa8\xfe\xb3\x38\x91\x86\xf8\xfd\x08\x55\x25\xd9\x32\x68\x73\x0e\xa2\x98\xb5\x6c\x7b\x88\x41\x94\x42\xcd\x27\x80\x65\xd9\x97\x2e\xa7\x83\xc2\x49\x37\xa8\x3a\xe9\xb9\x73\xb3\x7e\xfc\x3a\x73\xd1\xbc\x79\x0b\xb5\x82\x78\x27\xbd\x57\xc5\x2f\xa0\x9b\x62\x4f\x43\x87\x8d\xd1\x14\xd0\x3f\xfc\x1a\xf1\x2c\x34\x2a\xe5\xe6\xc0\x42\x78\x0f\xe4\x66\x4b\x43\xdf\xce\xc2\xcc\x9d\x1a\xca\xdb\x62\x34\x7d\x6e\x6e\x65\xd3\xe9\x39\x3f\x72\x41\x0e\x50\xd1\x73\x3b\x85\xce\xb3\xb7\xd3\x8f\x7d\x79\xf7\x4d\xd1\x51\x1b\x21\x0b\x25\x53\xcf\xa3\xfe\x05\xd4\xd4\xff\xe5\xd0\x9b\x38\x58\x68\x3a\xd0\xaf\xd7\x6f\x87\xd7\x6a\x5d\x5b\x65\x4d\xc6\xe9\xd6\x65\x4b\x26\x43\x03\x18\x93\x88\x3d\xa4\xb6\x79\xf0\x73\x4f\xea\x0f\x9b\xc9\x49\x88\x24\x1e\x62\x48\x3f\x68\xc6\x03\x10\xe0\x18\x69\xd8\x4a\x72\x20\x45\x58\xe7\x07\xe6\xd6\xdd\x9c\xb2\x77\xa2\xc5\x29\xa7\xae\xf6\xdb\x76\x54\xfc\x57\x16\x84\xfe\x0b\x8b\x77\x4b\x40\xb6\xc2\x8f\xcb\x06\x95\xb9\x4d\x03\x08\x0d\x98\xc2\x10\x9d\x97\x92\x2e\x24\xed\x3d\x49\x4f\xcf\x15\x64\x6f\x56\xf2\x97\xac\x27\xd2\x66\x4e\x5d\x6b\xa0\xf5\x0c\x20\xb6\xdf\x91\x4b\xae\xfd\xa0\xac\xeb\x63\x7b\xc0\x8d\x47\x5d\xb8\x68\x1e\x13\x73\x51\x8b\x32\xf6\xbd\x9c\xdd\x11\x66\xcd\xf9\xb5\x6b\xe2\xc5\x44\x70\x7d\x69\x45\xab\x26\x65\x77\xe4\x08\x4d\xb4\x4b\xf4\xac\x72\x89\xf5\xfb\xf5\x7a\xd0\x53\x9a\xaa\x60\x19\x3f\x7e\xf3\xf3\xbb\x35\x22\x29\x95\xd2\x74\xbc\x91\xe2\x37\x55\xa3\xe7\x78\xf5\x3b\x09\x82\x9f\x43\xf6\xd0\xad\xc9\xf3\x28\xad\x80\x65\xf9\x9e\xe9\x79\x57\xd1\xaf\x77\x81\xd6\x84\xa0\x9b\xec\x01\x3a\xff\x7d\x8d\x7c\xe6\xf1\xfa\xb6\x71\xe4\x8e\x2f\x41\x7c\xb9\xb0\x5b\xb2\x95\x87\x07\xcd\xf8\x3e\x53\x9a\x36\x44\x6f\x0f\x76\xbb\x16\x72\x5d\x40\xbd\x9d\xbe\x72\x90\x02\xfa\x1a\x2c\x2a\xa3\x49\xc5\x90\x67\xf6\xde\x14\x3f\x70\xfb\x12\x13\xe8\x73\x19\xb3\x60\x74\xb6\x26\x69\xd5\x24\x7e\xe0\xf3\x80\x61\x7f\xae\xab\xc1\xe2\xb9\xee\x62\x92\xb1\x1a\x00\x42\x06\xa2\xbe\x9c\xae\x9d\x67\x14\x9e\x77\xc1\x69\x80\x1c\x34\x22\x72\x3b\x7d\x55\xa6\x58\x6f\x81\x18\xa9\x11\xb6\x54\x11\xbb\x1d\x73\x4a\x3b\xcd\xe4\xdc\x6f\x79\x1e\xf7\xea\xe2\xdc\x87\x9d\x35\xf0\x95\x19\xd6\x0b\xaa\xdb\xe9\xab\xdc\x24\x83\x58\x43\x36\xfc\x62\x7d\x75\x7a\x15\x25\x1b\x3e\xf7\x38\x2d\x2b\x26\x88\xa2\xf9\x51\x35\x6f\x2e\x68\x67\xe6\xce\x2e\xef\xd2\x5d\xd8\x9c\xd3\x1d\x5f\x96\xbf\x35\x6d\xb7\xd5\x5f\xf3\x28\xbd\x6e\x61\x44\xcd\xac\x42\xa5\xcc\xde\x71\x40\x07\xeb\x5c\x7a\x7b\x98\x42\x92\xed\x23\x71\x7d\x5b\xc7\xf5\x6d\x09\xa1\x8c\xeb\x05\x2b\xb6\x81\x83\xc6\xa5\xde\x26\x91\x98\xa7\xdd\x80\x68\xb8\xcb\x06\x3a\x86\xf8\x40\xbd\x79\x64\x6e\x08\xa4\xe1\x6e\x4c\xbe\x57\x20\x53\xe6\xfb\x58\xc0\x1b\xce\x97\x09\xd5\x9f\xf3\x56\x8f\xe5\xa1\x4c\x37\x63\xa9\x3e\xe6\x35\x8d\xc5\x35\xd3\x73\xef\xb7\x56\x72\xfb\x2b\x20\xe5\x66\xa9\xa2\xb0\x72\xd9\x5e\x8a\x44\xb0\x98\xe2\x40\x1a\x83\xc5\xc1\xef\xc3\xef\x8e\x78\x74\xd2\xf3\x6e\xd0\xdf\x4e\x5f\xe5\x80\x19\xc4\xea\x6f\xdd\x80\xbd\x1b\x23\x46\x99\xa4\x86\x30\x93\x02\x81\x46\xec\x5b\x5e\xed\xef\x5a\x2f\x75\x6b\x6e\x5e\x5a\x96\xeb\x8c\xf7\x28\x5b\x4a\xa0\xbc\xea\x64\x08\xc6\x1b\x62\xff\x2c\xcc\x2e\x3e\xe9\xd2\x83\xbc\x79\xa4\xdc\x56\xb1\x47\xce\x64\x26\xcc\xc5\xbd\x79\x15\x76\x38\xb4\xd2\xb4\x20\x55\x4e\xa6\xe4\x85\x4c\xe4\xa3\x6b\x8d\x52\x5a\x3f\x4c\x0e\xaf\x86\x66\x10\xd5\x38\xe4\x46\xc9\x5f\xef\x6e\x7d\x54\x3e\x21\xa8\xc8\x7d\x4c\xcb\x99\x3f\x64\xdd\x10\xd2\xf1\x2b\xcf\x0a\x8a\x04\x54\x0d\x48\x38\x82\xfb\xf1\x63\x0e\xb7\xa9\x00\x73\x37\x4c\xec\xd1\x01\x47\x37\x2a\x44\xf8\x51\xfd\x23\x4f\x2b\x6f\x3e\x16\x26\x6e\x4b\xe3\xe1\x33\x4d\x8c\xc2\x7f\x9d\x7c\x9d\xfc\xff\x00\x38\x86\xca\x90\xf6\x87\x01\x00")
 
 func schemaJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -85,7 +85,7 @@ func schemaJson() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "schema.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd0, 0x4b, 0x6e, 0xc1, 0xa2, 0xfd, 0x79, 0xd3, 0x87, 0xfd, 0x3, 0xac, 0x58, 0x66, 0xee, 0xe, 0x90, 0xc5, 0x69, 0xe2, 0xcf, 0x7e, 0x42, 0x83, 0x42, 0xe9, 0x55, 0x72, 0x3, 0xe5, 0x20, 0x19}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x61, 0x10, 0x70, 0x35, 0x56, 0xa6, 0x7d, 0x40, 0x17, 0x40, 0x2b, 0xf5, 0x9f, 0xa, 0xe7, 0xa5, 0x10, 0x9d, 0xf8, 0x9d, 0x53, 0xd6, 0x5d, 0x4, 0xec, 0x24, 0xdc, 0xf4, 0x2f, 0x42, 0xa8, 0xc3}}
 	return a, nil
 }
 
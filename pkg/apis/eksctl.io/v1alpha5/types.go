@@ -185,6 +185,12 @@ const (
 	NodeImageFamilyWindowsServer2004CoreContainer = "WindowsServer2004CoreContainer"
 )
 
+// IP family values.
+const (
+	IPV4Family = "IPv4"
+	IPV6Family = "IPv6"
+)
+
 // Container runtime values.
 const (
 	ContainerRuntimeContainerD = "containerd"
@@ -198,11 +204,18 @@ const (
 	// DefaultNodeCount defines the default number of nodes to be created
 	DefaultNodeCount = 2
 
+	// DefaultSpotInterruptionHandlerHeartbeatTimeout defines the default time, in seconds, the ASG waits
+	// for the spot interruption handler to drain a terminating node
+	DefaultSpotInterruptionHandlerHeartbeatTimeout = 300
+
 	// NodeImageResolverAuto represents auto AMI resolver (see ami package)
 	NodeImageResolverAuto = "auto"
 	// NodeImageResolverAutoSSM is used to indicate that the latest EKS AMIs should be used for the nodes. The AMI is selected
 	// using an SSM GetParameter query
 	NodeImageResolverAutoSSM = "auto-ssm"
+	// NodeImageResolverCustomCatalog is used to indicate that the AMI should be resolved from the `amiCatalog`
+	// of the ClusterConfig
+	NodeImageResolverCustomCatalog = "custom-catalog"
 
 	// EksctlVersionTag defines the version of eksctl which is used to provision or update EKS cluster
 	EksctlVersionTag = "alpha.eksctl.io/eksctl-version"
@@ -219,6 +232,10 @@ const (
 	// NodeGroupTypeTag defines the nodegroup type as managed or unmanaged
 	NodeGroupTypeTag = "alpha.eksctl.io/nodegroup-type"
 
+	// SpotInterruptionHandlerTagPrefix prefixes the tag that identifies the ASG of a nodegroup to its
+	// spot interruption handler
+	SpotInterruptionHandlerTagPrefix = "alpha.eksctl.io/spot-interruption-handler/"
+
 	// OldNodeGroupNameTag defines the tag of the nodegroup name
 	OldNodeGroupNameTag = "eksctl.io/v1alpha2/nodegroup-name"
 
@@ -537,6 +554,17 @@ type ClusterMeta struct {
 type KubernetesNetworkConfig struct {
 	// ServiceIPv4CIDR is the CIDR range from where `ClusterIP`s are assigned
 	ServiceIPv4CIDR string `json:"serviceIPv4CIDR,omitempty"`
+	// IPFamily is the IP family of the pods and services of the cluster,
+	// valid variants are `"IPv4"` and `"IPv6"`. An IPv6 cluster requires
+	// a VPC created by eksctl, which is dual-stack.
+	// Defaults to `"IPv4"`
+	// +optional
+	IPFamily string `json:"ipFamily,omitempty"`
+}
+
+// IPv6Enabled returns true if the pods and services of the cluster use IPv6
+func (c *ClusterConfig) IPv6Enabled() bool {
+	return c.KubernetesNetworkConfig != nil && strings.EqualFold(c.KubernetesNetworkConfig.IPFamily, IPV6Family)
 }
 
 type EKSCTLCreated string
@@ -547,6 +575,8 @@ type ClusterStatus struct {
 	CertificateAuthorityData []byte                   `json:"certificateAuthorityData,omitempty"`
 	ARN                      string                   `json:"arn,omitempty"`
 	KubernetesNetworkConfig  *KubernetesNetworkConfig `json:"-"`
+	// ServiceIPv6CIDR is the CIDR EKS assigns the `ClusterIP`s of an IPv6 cluster from
+	ServiceIPv6CIDR string `json:"-"`
 
 	StackName     string        `json:"stackName,omitempty"`
 	EKSCTLCreated EKSCTLCreated `json:"eksctlCreated,omitempty"`
@@ -588,6 +618,7 @@ type ClusterProvider interface {
 	CloudFormation() cloudformationiface.CloudFormationAPI
 	CloudFormationRoleARN() string
 	CloudFormationDisableRollback() bool
+	CloudFormationPreviewChanges() bool
 	ASG() autoscalingiface.AutoScalingAPI
 	EKS() eksiface.EKSAPI
 	EC2() ec2iface.EC2API
@@ -600,6 +631,7 @@ type ClusterProvider interface {
 	Region() string
 	Profile() string
 	WaitTimeout() time.Duration
+	ResumeCreation() bool
 	ConfigProvider() client.ConfigProvider
 	Session() *session.Session
 }
@@ -608,10 +640,12 @@ type ClusterProvider interface {
 type ProviderConfig struct {
 	CloudFormationRoleARN         string
 	CloudFormationDisableRollback bool
+	CloudFormationPreviewChanges  bool
 
-	Region      string
-	Profile     string
-	WaitTimeout time.Duration
+	Region         string
+	Profile        string
+	WaitTimeout    time.Duration
+	ResumeCreation bool
 }
 
 // +genclient
@@ -667,6 +701,15 @@ type ClusterConfig struct {
 	// +optional
 	SecretsEncryption *SecretsEncryption `json:"secretsEncryption,omitempty"`
 
+	// Drain configures how the nodes of nodegroups are drained, see [Deleting and draining](/usage/managing-nodegroups/#deleting-and-draining)
+	// +optional
+	Drain *DrainConfig `json:"drain,omitempty"`
+
+	// AMICatalog declares how the AMIs of nodegroups with `ami: custom-catalog` are resolved,
+	// see [Custom AMI catalogs](/usage/custom-ami-support/#custom-ami-catalogs)
+	// +optional
+	AMICatalog *AMICatalog `json:"amiCatalog,omitempty"`
+
 	Status *ClusterStatus `json:"-"`
 
 	// FLUX V1 DEPRECATION NOTICE. https://github.com/weaveworks/eksctl/issues/2963
@@ -900,6 +943,17 @@ type NodeGroup struct {
 	// ContainerRuntime defines the runtime (CRI) to use for containers on the node
 	// +optional
 	ContainerRuntime *string `json:"containerRuntime,omitempty"`
+
+	// SpotInterruptionHandler configures a handler that cordons and drains
+	// spot nodes before they are interrupted or terminated, requires
+	// `instancesDistribution`
+	// +optional
+	SpotInterruptionHandler *NodeGroupSpotInterruptionHandler `json:"spotInterruptionHandler,omitempty"`
+}
+
+// HasSpotInterruptionHandler returns true if the spot interruption handler is enabled for the nodegroup
+func (n *NodeGroup) HasSpotInterruptionHandler() bool {
+	return n.SpotInterruptionHandler != nil && IsEnabled(n.SpotInterruptionHandler.Enabled)
 }
 
 // GetContainerRuntime returns the container runtime.
@@ -1210,6 +1264,22 @@ type (
 		CapacityRebalance bool `json:"capacityRebalance"`
 	}
 
+	// NodeGroupSpotInterruptionHandler holds the configuration of the handler
+	// that cordons and drains the nodes of a nodegroup before they are
+	// interrupted, rebalanced or terminated by the ASG
+	NodeGroupSpotInterruptionHandler struct {
+		// Enabled adds an SQS queue, EventBridge rules and an ASG lifecycle hook
+		// to the nodegroup stack, and deploys a handler processing the queue
+		// +optional
+		Enabled *bool `json:"enabled,omitempty"`
+		// HeartbeatTimeout is the time, in seconds, the ASG waits for a
+		// terminating node to be drained.
+		// Range [30-7200]
+		// Defaults to `300`
+		// +optional
+		HeartbeatTimeout *int `json:"heartbeatTimeout,omitempty"`
+	}
+
 	// NodeGroupBottlerocket holds the configuration for Bottlerocket based
 	// NodeGroups.
 	NodeGroupBottlerocket struct {
@@ -1313,7 +1383,7 @@ type NodeGroupBase struct {
 	// +optional
 	IAM *NodeGroupIAM `json:"iam,omitempty"`
 
-	// Specify [custom AMIs](/usage/custom-ami-support/), `auto-ssm`, `auto`, or `static`
+	// Specify [custom AMIs](/usage/custom-ami-support/), `auto-ssm`, `auto`, `static` or `custom-catalog`
 	// +optional
 	AMI string `json:"ami,omitempty"`
 
@@ -1441,6 +1511,20 @@ type NodeGroupTaint struct {
 	Effect corev1.TaintEffect `json:"effect,omitempty"`
 }
 
+// TaintEffectFromEKS converts a taint effect returned by the EKS API, e.g. NO_SCHEDULE, to its Kubernetes value
+func TaintEffectFromEKS(effect string) corev1.TaintEffect {
+	switch effect {
+	case eks.TaintEffectNoSchedule:
+		return corev1.TaintEffectNoSchedule
+	case eks.TaintEffectPreferNoSchedule:
+		return corev1.TaintEffectPreferNoSchedule
+	case eks.TaintEffectNoExecute:
+		return corev1.TaintEffectNoExecute
+	default:
+		return corev1.TaintEffect(effect)
+	}
+}
+
 // ManagedNodeGroup represents an EKS-managed nodegroup
 // TODO Validate for unmapped fields and throw an error
 type ManagedNodeGroup struct {
@@ -3,6 +3,7 @@ package v1alpha5
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -13,8 +14,10 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/weaveworks/eksctl/pkg/utils"
 	"github.com/weaveworks/eksctl/pkg/utils/taints"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation"
 	kubeletapis "k8s.io/kubelet/pkg/apis"
 )
@@ -29,6 +32,9 @@ const (
 	MaxGP3Iops    = 16000
 )
 
+// maxIPv6Subnets is the number of /64 subnets in the /56 IPv6 CIDR of a VPC
+const maxIPv6Subnets = 256
+
 var (
 	// ErrClusterEndpointNoAccess indicates the config prevents API access
 	ErrClusterEndpointNoAccess = errors.New("Kubernetes API access must have one of public or private clusterEndpoints enabled")
@@ -145,6 +151,22 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		cfg.VPC.PublicAccessCIDRs = cidrs
 	}
 
+	if err := validateDrainConfig(cfg.Drain); err != nil {
+		return err
+	}
+
+	if err := validateAMICatalog(cfg); err != nil {
+		return err
+	}
+
+	if err := validateSubnetsForNewVPC(cfg); err != nil {
+		return err
+	}
+
+	if err := cfg.validatePodSubnets(); err != nil {
+		return err
+	}
+
 	if cfg.SecretsEncryption != nil && cfg.SecretsEncryption.KeyARN == "" {
 		return errors.New("field secretsEncryption.keyARN is required for enabling secrets encryption")
 	}
@@ -192,15 +214,137 @@ func (c *ClusterConfig) ValidatePrivateCluster() error {
 
 // validateKubernetesNetworkConfig validates the network config
 func (c *ClusterConfig) validateKubernetesNetworkConfig() error {
-	if c.KubernetesNetworkConfig != nil {
-		serviceIP := c.KubernetesNetworkConfig.ServiceIPv4CIDR
-		if _, _, err := net.ParseCIDR(serviceIP); serviceIP != "" && err != nil {
-			return errors.Wrap(err, "invalid IPv4 CIDR for kubernetesNetworkConfig.serviceIPv4CIDR")
+	if c.KubernetesNetworkConfig == nil {
+		return nil
+	}
+	serviceIP := c.KubernetesNetworkConfig.ServiceIPv4CIDR
+	if _, _, err := net.ParseCIDR(serviceIP); serviceIP != "" && err != nil {
+		return errors.Wrap(err, "invalid IPv4 CIDR for kubernetesNetworkConfig.serviceIPv4CIDR")
+	}
+
+	switch ipFamily := c.KubernetesNetworkConfig.IPFamily; {
+	case ipFamily == "" || strings.EqualFold(ipFamily, IPV4Family):
+		return nil
+	case strings.EqualFold(ipFamily, IPV6Family):
+		return c.validateIPv6()
+	default:
+		return fmt.Errorf("invalid value %q for kubernetesNetworkConfig.ipFamily, supported values are %q and %q", ipFamily, IPV4Family, IPV6Family)
+	}
+}
+
+// validateIPv6 rejects the settings that are not supported by IPv6 clusters
+func (c *ClusterConfig) validateIPv6() error {
+	if c.KubernetesNetworkConfig.ServiceIPv4CIDR != "" {
+		return errors.New("kubernetesNetworkConfig.serviceIPv4CIDR cannot be set for IPv6 clusters, EKS assigns their service CIDR")
+	}
+	if err := c.ValidateIPv6Version(); err != nil {
+		return err
+	}
+	if c.VPC != nil {
+		if c.VPC.ID != "" {
+			return errors.New("vpc.id cannot be set for IPv6 clusters, they require a VPC created by eksctl")
+		}
+		if IsEnabled(c.VPC.AutoAllocateIPv6) {
+			return errors.New("vpc.autoAllocateIPv6 cannot be set for IPv6 clusters, their VPC always has an IPv6 CIDR")
+		}
+	}
+	if c.PrivateCluster != nil && c.PrivateCluster.Enabled {
+		return errors.New("fully-private clusters are not supported with IPv6")
+	}
+
+	for i, ng := range c.NodeGroups {
+		if IsWindowsImage(ng.AMIFamily) {
+			return fmt.Errorf("unmanaged Windows nodegroups are not supported with IPv6 (nodeGroups[%d].amiFamily)", i)
+		}
+		// the Ubuntu bootstrap scripts do not configure the kubelet for IPv6
+		if ng.AMIFamily == NodeImageFamilyUbuntu2004 || ng.AMIFamily == NodeImageFamilyUbuntu1804 {
+			return fmt.Errorf("unmanaged %s nodegroups are not supported with IPv6 (nodeGroups[%d].amiFamily)", ng.AMIFamily, i)
+		}
+		// custom Amazon Linux 2 AMIs are bootstrapped with a script that does not configure the kubelet for IPv6
+		if IsAMI(ng.AMI) && ng.AMIFamily != NodeImageFamilyBottlerocket && ng.OverrideBootstrapCommand == nil {
+			return fmt.Errorf("nodeGroups[%d].overrideBootstrapCommand must be set for a custom AMI with IPv6", i)
+		}
+	}
+
+	// EKS only configures its own addons for IPv6, the default addons deployed with the cluster are IPv4-only
+	var missingAddons []string
+	for _, name := range []string{VPCCNIAddon, CoreDNSAddon, KubeProxyAddon} {
+		if !c.hasAddon(name) {
+			missingAddons = append(missingAddons, name)
+		}
+	}
+	if len(missingAddons) > 0 {
+		return fmt.Errorf("the %s addons must be set in addons for IPv6 clusters", strings.Join(missingAddons, ", "))
+	}
+	return nil
+}
+
+// ValidateIPv6Version checks that the Kubernetes version of an IPv6 cluster supports IPv6. Unset and "auto" versions
+// are not checked
+func (c *ClusterConfig) ValidateIPv6Version() error {
+	version := c.Metadata.Version
+	if !c.IPv6Enabled() || version == "" || version == "auto" {
+		return nil
+	}
+	supported, err := utils.IsMinVersion(Version1_21, version)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return fmt.Errorf("metadata.version must be %s or greater for IPv6 clusters, got %s", Version1_21, version)
+	}
+	return nil
+}
+
+// validatePodSubnets validates the pod subnets of VPC CNI custom networking, which eksctl creates in a secondary CIDR
+// of the VPC it creates
+func (c *ClusterConfig) validatePodSubnets() error {
+	if !c.HasCustomNetworking() {
+		return nil
+	}
+	podSubnets := c.VPC.PodSubnets
+	if podSubnets.CIDR == nil {
+		return errors.New("vpc.podSubnets.cidr must be set")
+	}
+	if c.VPC.ID != "" {
+		return errors.New("vpc.podSubnets cannot be set with vpc.id, pod subnets are only supported in a VPC created by eksctl")
+	}
+	if c.IPv6Enabled() {
+		return errors.New("vpc.podSubnets is not supported with IPv6, pods get their IPv6 addresses from the subnets of their nodes")
+	}
+
+	vpcCIDR := DefaultCIDR()
+	if c.VPC.CIDR != nil {
+		vpcCIDR = *c.VPC.CIDR
+	}
+	if podSubnets.CIDR.Contains(vpcCIDR.IP) || vpcCIDR.Contains(podSubnets.CIDR.IP) {
+		return fmt.Errorf("vpc.podSubnets.cidr %s overlaps with the VPC CIDR %s", podSubnets.CIDR, vpcCIDR.String())
+	}
+
+	for _, az := range podSubnets.Subnets.Names() {
+		subnet := podSubnets.Subnets[az]
+		if subnet.AZ != az {
+			return fmt.Errorf("vpc.podSubnets.subnets must be keyed by availability zone, %q is in %s", az, subnet.AZ)
+		}
+		if subnet.CIDR == nil {
+			return fmt.Errorf("vpc.podSubnets.subnets[%q].cidr must be set", az)
+		}
+		if !podSubnets.CIDR.Contains(subnet.CIDR.IP) {
+			return fmt.Errorf("vpc.podSubnets.subnets[%q].cidr %s is not within vpc.podSubnets.cidr %s", az, subnet.CIDR, podSubnets.CIDR)
 		}
 	}
 	return nil
 }
 
+func (c *ClusterConfig) hasAddon(name string) bool {
+	for _, addon := range c.Addons {
+		if addon.CanonicalName() == name {
+			return true
+		}
+	}
+	return false
+}
+
 // NoAccess returns true if neither public are private cluster endpoint access is enabled and false otherwise
 func noAccess(ces *ClusterEndpoints) bool {
 	return !(*ces.PublicAccess || *ces.PrivateAccess)
@@ -338,6 +482,130 @@ func validateIdentityProviders(idPs []IdentityProvider) error {
 	return nil
 }
 
+func validateDrainConfig(drain *DrainConfig) error {
+	if drain == nil {
+		return nil
+	}
+	if _, err := labels.Parse(drain.SkipPodSelector); err != nil {
+		return errors.Wrap(err, "invalid drain.skipPodSelector")
+	}
+	if drain.ForceDeletePodsOlderThanMinutes != nil && *drain.ForceDeletePodsOlderThanMinutes < 1 {
+		return errors.New("drain.forceDeletePodsOlderThanMinutes must be at least 1")
+	}
+
+	validateHooks := func(hooks []DrainHook, path string) error {
+		for i, hook := range hooks {
+			hookPath := fmt.Sprintf("drain.%s[%d]", path, i)
+			if (hook.URL == "") == (len(hook.Command) == 0) {
+				return fmt.Errorf("exactly one of %[1]s.url and %[1]s.command must be set", hookPath)
+			}
+			if hook.URL != "" {
+				if u, err := url.Parse(hook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+					return fmt.Errorf("%s.url must be an HTTP or HTTPS URL", hookPath)
+				}
+			}
+			if hook.TimeoutSeconds != nil && *hook.TimeoutSeconds < 1 {
+				return fmt.Errorf("%s.timeoutSeconds must be at least 1", hookPath)
+			}
+		}
+		return nil
+	}
+	if err := validateHooks(drain.PreDrainHooks, "preDrainHooks"); err != nil {
+		return err
+	}
+	return validateHooks(drain.PostDrainHooks, "postDrainHooks")
+}
+
+// validateSubnetsForNewVPC validates the subnets of a VPC created by eksctl, whose names become part of the
+// logical IDs of the subnet resources, and which nodegroups must refer to by name
+func validateSubnetsForNewVPC(cfg *ClusterConfig) error {
+	if cfg.VPC == nil || !cfg.HasSubnetsForNewVPC() {
+		return nil
+	}
+
+	subnetsByTopology := map[SubnetTopology]AZSubnetMapping{
+		SubnetTopologyPrivate: cfg.VPC.Subnets.Private,
+		SubnetTopologyPublic:  cfg.VPC.Subnets.Public,
+	}
+	for _, topology := range SubnetTopologies() {
+		subnets := subnetsByTopology[topology]
+		for _, name := range subnets.Names() {
+			path := fmt.Sprintf("vpc.subnets.%s[%q]", strings.ToLower(string(topology)), name)
+			if IsInvalidNameArg(name) {
+				return fmt.Errorf("invalid subnet name %q, subnet names can only contain alphanumeric characters and hyphens when the VPC is created by eksctl (%s)", name, path)
+			}
+			if subnets[name].CIDR == nil {
+				return fmt.Errorf("%s.cidr must be set when the VPC is created by eksctl", path)
+			}
+		}
+	}
+	if cfg.IPv6Enabled() || IsEnabled(cfg.VPC.AutoAllocateIPv6) {
+		// each subnet gets a /64 block of the /56 IPv6 CIDR of the VPC
+		if count := len(cfg.VPC.Subnets.Private) + len(cfg.VPC.Subnets.Public); count > maxIPv6Subnets {
+			return fmt.Errorf("at most %d subnets can be set in vpc.subnets when the VPC has an IPv6 CIDR, got %d", maxIPv6Subnets, count)
+		}
+	}
+
+	validateNgSubnets := func(ng *NodeGroupBase, path string) error {
+		topology := SubnetTopologyPublic
+		if ng.PrivateNetworking {
+			topology = SubnetTopologyPrivate
+		}
+		for i, name := range ng.Subnets {
+			if _, ok := subnetsByTopology[topology][name]; !ok {
+				return fmt.Errorf("subnet %q is not defined in vpc.subnets.%s (%s.subnets[%d])", name, strings.ToLower(string(topology)), path, i)
+			}
+		}
+		return nil
+	}
+	for i, ng := range cfg.NodeGroups {
+		if err := validateNgSubnets(ng.NodeGroupBase, fmt.Sprintf("nodeGroups[%d]", i)); err != nil {
+			return err
+		}
+	}
+	for i, ng := range cfg.ManagedNodeGroups {
+		if err := validateNgSubnets(ng.NodeGroupBase, fmt.Sprintf("managedNodeGroups[%d]", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateAMICatalog(cfg *ClusterConfig) error {
+	catalog := cfg.AMICatalog
+	if catalog == nil {
+		for i, ng := range cfg.NodeGroups {
+			if ng.AMI == NodeImageResolverCustomCatalog {
+				return fmt.Errorf("amiCatalog must be set when using ami: %s (nodeGroups[%d].ami)", NodeImageResolverCustomCatalog, i)
+			}
+		}
+		for i, ng := range cfg.ManagedNodeGroups {
+			if ng.AMI == NodeImageResolverCustomCatalog {
+				return fmt.Errorf("amiCatalog must be set when using ami: %s (managedNodeGroups[%d].ami)", NodeImageResolverCustomCatalog, i)
+			}
+		}
+		return nil
+	}
+
+	if catalog.IsEmpty() {
+		return errors.New("amiCatalog must set at least one of ssmParameter, images and regions")
+	}
+	if catalog.Images != nil {
+		if len(catalog.Images.Owners) == 0 {
+			return errors.New("amiCatalog.images.owners must be set")
+		}
+		if catalog.Images.NamePattern == "" {
+			return errors.New("amiCatalog.images.namePattern must be set")
+		}
+	}
+	for region, ami := range catalog.Regions {
+		if !IsAMI(ami) {
+			return fmt.Errorf("invalid AMI %q (amiCatalog.regions.%s)", ami, region)
+		}
+	}
+	return nil
+}
+
 type unsupportedFieldError struct {
 	ng    *NodeGroupBase
 	path  string
@@ -448,6 +716,10 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		return err
 	}
 
+	if err := validateSpotInterruptionHandler(ng, path); err != nil {
+		return err
+	}
+
 	if err := validateCPUCredits(ng); err != nil {
 		return err
 	}
@@ -717,7 +989,7 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 		}
 
 	case ng.AMI != "":
-		if !IsAMI(ng.AMI) {
+		if !IsAMI(ng.AMI) && ng.AMI != NodeImageResolverCustomCatalog {
 			return errors.Errorf("invalid AMI %q (%s.%s)", ng.AMI, path, "ami")
 		}
 		if ng.AMIFamily != NodeImageFamilyAmazonLinux2 {
@@ -811,6 +1083,19 @@ func validateInstancesDistribution(ng *NodeGroup) error {
 	return nil
 }
 
+func validateSpotInterruptionHandler(ng *NodeGroup, path string) error {
+	if !ng.HasSpotInterruptionHandler() {
+		return nil
+	}
+	if ng.InstancesDistribution == nil {
+		return fmt.Errorf("%s.spotInterruptionHandler can only be enabled for nodegroups with instancesDistribution", path)
+	}
+	if timeout := ng.SpotInterruptionHandler.HeartbeatTimeout; timeout != nil && (*timeout < 30 || *timeout > 7200) {
+		return fmt.Errorf("%s.spotInterruptionHandler.heartbeatTimeout should be between 30 and 7200", path)
+	}
+	return nil
+}
+
 func validateCPUCredits(ng *NodeGroup) error {
 	isTInstance := false
 	instanceTypes := []string{ng.InstanceType}
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 	"github.com/weaveworks/eksctl/pkg/utils/strings"
 )
 
@@ -561,6 +562,39 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("spotInterruptionHandler", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = newNodeGroup()
+			ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+				InstanceTypes: []string{"m5.large", "m5a.large"},
+			}
+			ng.SpotInterruptionHandler = &api.NodeGroupSpotInterruptionHandler{
+				Enabled: api.Enabled(),
+			}
+		})
+
+		It("accepts nodegroups with an instances distribution", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects nodegroups without an instances distribution", func() {
+			ng.InstancesDistribution = nil
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].spotInterruptionHandler can only be enabled for nodegroups with instancesDistribution"))
+		})
+
+		It("rejects heartbeat timeouts out of range", func() {
+			ng.SpotInterruptionHandler.HeartbeatTimeout = aws.Int(10)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].spotInterruptionHandler.heartbeatTimeout should be between 30 and 7200"))
+		})
+
+		It("is not validated when disabled", func() {
+			ng.InstancesDistribution = nil
+			ng.SpotInterruptionHandler.Enabled = api.Disabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+	})
+
 	Describe("ssh flags", func() {
 		var (
 			testKeyPath = "some/path/to/file.pub"
@@ -757,6 +791,285 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("drain", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Drain = &api.DrainConfig{
+				SkipPodSelector: "app=web,tier!=db",
+				SkipNamespaces:  []string{"monitoring"},
+				PreDrainHooks: []api.DrainHook{
+					{URL: "https://approvals.example.com/drain"},
+				},
+				PostDrainHooks: []api.DrainHook{
+					{Command: []string{"notify-mesh", "--drained"}, TimeoutSeconds: aws.Int(10)},
+				},
+			}
+		})
+
+		It("should pass with filters and hooks", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should fail when skipPodSelector is invalid", func() {
+			cfg.Drain.SkipPodSelector = "app in web"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("invalid drain.skipPodSelector")))
+		})
+
+		It("should fail when forceDeletePodsOlderThanMinutes is not positive", func() {
+			cfg.Drain.ForceDeletePodsOlderThanMinutes = aws.Int(0)
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("drain.forceDeletePodsOlderThanMinutes must be at least 1"))
+		})
+
+		It("should fail when a hook has both a url and a command", func() {
+			cfg.Drain.PreDrainHooks[0].Command = []string{"true"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("exactly one of drain.preDrainHooks[0].url and drain.preDrainHooks[0].command must be set"))
+		})
+
+		It("should fail when a hook has neither a url nor a command", func() {
+			cfg.Drain.PostDrainHooks[0].Command = nil
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("exactly one of drain.postDrainHooks[0].url and drain.postDrainHooks[0].command must be set"))
+		})
+
+		It("should fail when the url of a hook is not an HTTP URL", func() {
+			cfg.Drain.PreDrainHooks[0].URL = "ftp://approvals.example.com"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("drain.preDrainHooks[0].url must be an HTTP or HTTPS URL"))
+		})
+	})
+
+	Describe("amiCatalog", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng"
+			ng.AMI = api.NodeImageResolverCustomCatalog
+			cfg.AMICatalog = &api.AMICatalog{
+				Images: &api.AMICatalogImages{
+					Owners:      []string{"self"},
+					NamePattern: "my-org-eks-{amiFamily}-{kubernetesVersion}-*",
+				},
+				Regions: map[string]string{"us-west-2": "ami-123"},
+			}
+		})
+
+		It("should pass with a valid catalog", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should fail when a nodegroup uses the catalog but it is not set", func() {
+			cfg.AMICatalog = nil
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("amiCatalog must be set when using ami: custom-catalog (nodeGroups[0].ami)"))
+		})
+
+		It("should fail when the catalog has no rules", func() {
+			cfg.AMICatalog = &api.AMICatalog{}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("amiCatalog must set at least one of ssmParameter, images and regions"))
+		})
+
+		It("should fail when images have no name pattern", func() {
+			cfg.AMICatalog.Images.NamePattern = ""
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("amiCatalog.images.namePattern must be set"))
+		})
+
+		It("should fail when a region maps to an invalid AMI", func() {
+			cfg.AMICatalog.Regions["us-west-2"] = "my-ami"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`invalid AMI "my-ami" (amiCatalog.regions.us-west-2)`))
+		})
+	})
+
+	Describe("kubernetesNetworkConfig.ipFamily", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Version = api.Version1_21
+			cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+				IPFamily: api.IPV6Family,
+			}
+			cfg.Addons = []*api.Addon{{Name: api.VPCCNIAddon}, {Name: api.CoreDNSAddon}, {Name: api.KubeProxyAddon}}
+			ng := api.NewManagedNodeGroup()
+			ng.Name = "mng"
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{ng}
+		})
+
+		It("should pass with an IPv6 cluster", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should pass with an IPv4 cluster", func() {
+			cfg.KubernetesNetworkConfig.IPFamily = "ipv4"
+			cfg.Addons = nil
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should fail when the IP family is unknown", func() {
+			cfg.KubernetesNetworkConfig.IPFamily = "dual-stack"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`invalid value "dual-stack" for kubernetesNetworkConfig.ipFamily, supported values are "IPv4" and "IPv6"`))
+		})
+
+		It("should fail when the Kubernetes version does not support IPv6", func() {
+			cfg.Metadata.Version = api.Version1_20
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("metadata.version must be 1.21 or greater for IPv6 clusters, got 1.20"))
+		})
+
+		It("should fail when the service IPv4 CIDR is set", func() {
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = "172.16.0.0/12"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("kubernetesNetworkConfig.serviceIPv4CIDR cannot be set for IPv6 clusters, EKS assigns their service CIDR"))
+		})
+
+		It("should fail with a pre-existing VPC", func() {
+			cfg.VPC.ID = "vpc-123"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpc.id cannot be set for IPv6 clusters, they require a VPC created by eksctl"))
+		})
+
+		It("should fail for a fully-private cluster", func() {
+			cfg.PrivateCluster.Enabled = true
+			cfg.ManagedNodeGroups[0].PrivateNetworking = true
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("fully-private clusters are not supported with IPv6"))
+		})
+
+		It("should fail with unmanaged Windows nodegroups", func() {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "windows"
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019CoreContainer
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("unmanaged Windows nodegroups are not supported with IPv6 (nodeGroups[0].amiFamily)"))
+		})
+
+		It("should pass with unmanaged Amazon Linux 2 nodegroups", func() {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "linux"
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should fail with unmanaged Ubuntu nodegroups", func() {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ubuntu"
+			ng.AMIFamily = api.NodeImageFamilyUbuntu2004
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("unmanaged Ubuntu2004 nodegroups are not supported with IPv6 (nodeGroups[0].amiFamily)"))
+		})
+
+		It("should fail with unmanaged nodegroups of a custom AMI without a bootstrap command", func() {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "custom"
+			ng.AMI = "ami-123"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("nodeGroups[0].overrideBootstrapCommand must be set for a custom AMI with IPv6"))
+			ng.OverrideBootstrapCommand = aws.String("/etc/eks/bootstrap.sh ipv6 --ip-family ipv6")
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should fail when there are more subnets than /64 blocks in the IPv6 CIDR of the VPC", func() {
+			cfg.VPC.Subnets = &api.ClusterSubnets{Private: api.AZSubnetMapping{}}
+			for i := 0; i < 257; i++ {
+				cfg.VPC.Subnets.Private[fmt.Sprintf("private-%d", i)] = api.AZSubnetSpec{
+					AZ:   "us-west-2a",
+					CIDR: ipnet.MustParseCIDR(fmt.Sprintf("10.%d.%d.0/24", i/256, i%256)),
+				}
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("at most 256 subnets can be set in vpc.subnets when the VPC has an IPv6 CIDR, got 257"))
+		})
+
+		It("should fail when the default addons are not replaced with EKS addons", func() {
+			cfg.Addons = []*api.Addon{{Name: api.VPCCNIAddon}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("the coredns, kube-proxy addons must be set in addons for IPv6 clusters"))
+		})
+	})
+
+	Describe("vpc.subnets of a VPC created by eksctl", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.VPC.Subnets = &api.ClusterSubnets{
+				Private: api.AZSubnetMapping{
+					"workload-a": {AZ: "us-west-2a", CIDR: ipnet.MustParseCIDR("192.168.64.0/19")},
+					"database-a": {AZ: "us-west-2a", CIDR: ipnet.MustParseCIDR("192.168.96.0/19")},
+				},
+				Public: api.AZSubnetMapping{
+					"public-a": {AZ: "us-west-2a", CIDR: ipnet.MustParseCIDR("192.168.0.0/19")},
+				},
+			}
+			ng := cfg.NewNodeGroup()
+			ng.Name = "database"
+			ng.PrivateNetworking = true
+			ng.Subnets = []string{"database-a"}
+		})
+
+		It("should pass with named subnets", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should fail when a subnet has no CIDR", func() {
+			cfg.VPC.Subnets.Private["workload-a"] = api.AZSubnetSpec{AZ: "us-west-2a"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`vpc.subnets.private["workload-a"].cidr must be set when the VPC is created by eksctl`))
+		})
+
+		It("should fail when a subnet name cannot be used in resource names", func() {
+			cfg.VPC.Subnets.Public["public_a"] = cfg.VPC.Subnets.Public["public-a"]
+			delete(cfg.VPC.Subnets.Public, "public-a")
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`invalid subnet name "public_a", subnet names can only contain alphanumeric characters and hyphens when the VPC is created by eksctl (vpc.subnets.public["public_a"])`))
+		})
+
+		It("should fail when a nodegroup refers to an unknown subnet", func() {
+			cfg.NodeGroups[0].PrivateNetworking = false
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`subnet "database-a" is not defined in vpc.subnets.public (nodeGroups[0].subnets[0])`))
+		})
+
+		It("should not validate the subnets of a pre-existing VPC", func() {
+			cfg.VPC.ID = "vpc-123"
+			cfg.VPC.Subnets.Private["workload-a"] = api.AZSubnetSpec{ID: "subnet-123"}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+	})
+
+	Describe("vpc.podSubnets", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.VPC.PodSubnets = &api.PodSubnets{
+				CIDR: ipnet.MustParseCIDR("100.64.0.0/16"),
+			}
+		})
+
+		It("should pass with a pod CIDR", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should pass with pod subnets keyed by AZ", func() {
+			cfg.VPC.PodSubnets.Subnets = api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {CIDR: ipnet.MustParseCIDR("100.64.0.0/18")},
+				"us-west-2b": {CIDR: ipnet.MustParseCIDR("100.64.64.0/18")},
+			})
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should fail without a pod CIDR", func() {
+			cfg.VPC.PodSubnets.CIDR = nil
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpc.podSubnets.cidr must be set"))
+		})
+
+		It("should fail when the pod CIDR overlaps with the VPC CIDR", func() {
+			cfg.VPC.PodSubnets.CIDR = ipnet.MustParseCIDR("192.168.128.0/17")
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpc.podSubnets.cidr 192.168.128.0/17 overlaps with the VPC CIDR 192.168.0.0/16"))
+		})
+
+		It("should fail when a pod subnet is not within the pod CIDR", func() {
+			cfg.VPC.PodSubnets.Subnets = api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {CIDR: ipnet.MustParseCIDR("100.65.0.0/18")},
+			})
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`vpc.podSubnets.subnets["us-west-2a"].cidr 100.65.0.0/18 is not within vpc.podSubnets.cidr 100.64.0.0/16`))
+		})
+
+		It("should fail with a pre-existing VPC", func() {
+			cfg.VPC.ID = "vpc-123"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("vpc.podSubnets cannot be set with vpc.id")))
+		})
+	})
+
 	Describe("ebs encryption", func() {
 		var (
 			nodegroup = "ng1"
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
@@ -70,14 +71,27 @@ func (m *AZSubnetMapping) WithIDs() []string {
 		return nil
 	}
 	subnets := []string{}
-	for _, s := range *m {
-		if s.ID != "" {
+	for _, name := range m.Names() {
+		if s := (*m)[name]; s.ID != "" {
 			subnets = append(subnets, s.ID)
 		}
 	}
 	return subnets
 }
 
+// Names returns the sorted list of subnet names
+func (m *AZSubnetMapping) Names() []string {
+	if m == nil {
+		return nil
+	}
+	names := make([]string, 0, len(*m))
+	for name := range *m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // WithCIDRs returns list of subnet CIDRs
 func (m *AZSubnetMapping) WithCIDRs() []string {
 	if m == nil {
@@ -127,7 +141,9 @@ type (
 		// SecurityGroup (aka the ControlPlaneSecurityGroup) for communication between control plane and nodes
 		// +optional
 		SecurityGroup string `json:"securityGroup,omitempty"`
-		// Subnets are keyed by AZ for convenience.
+		// Subnets are keyed by AZ for convenience, or by an arbitrary name
+		// when more than one subnet is needed in an AZ. Nodegroups refer
+		// to subnets by their key in `subnets`.
 		// See [this example](/examples/reusing-iam-and-vpc/)
 		// as well as [using existing
 		// VPCs](/usage/vpc-networking/#use-existing-vpc-other-custom-configuration).
@@ -137,6 +153,11 @@ type (
 		// private subnets or any ad-hoc subnets
 		// +optional
 		ExtraCIDRs []string `json:"extraCIDRs,omitempty"`
+		// PodSubnets enables VPC CNI custom networking, pods get their IP addresses
+		// from these subnets instead of the subnets of their nodes.
+		// See [custom networking](/usage/vpc-networking/#vpc-cni-custom-networking)
+		// +optional
+		PodSubnets *PodSubnets `json:"podSubnets,omitempty"`
 		// for pre-defined shared node SG
 		SharedNodeSecurityGroup string `json:"sharedNodeSecurityGroup,omitempty"`
 		// Automatically add security group rules to and from the default
@@ -165,6 +186,17 @@ type (
 		Private AZSubnetMapping `json:"private,omitempty"`
 		Public  AZSubnetMapping `json:"public,omitempty"`
 	}
+	// PodSubnets holds the subnets pods get their IP addresses from with VPC CNI custom networking
+	PodSubnets struct {
+		// CIDR is a secondary CIDR block associated with the VPC, e.g. `100.64.0.0/16`,
+		// the pod subnets are created in it
+		// +required
+		CIDR *ipnet.IPNet `json:"cidr"`
+		// Subnets are keyed by AZ, a subnet is created in every AZ of the cluster.
+		// Defaults to splitting CIDR into equal subnets
+		// +optional
+		Subnets AZSubnetMapping `json:"subnets,omitempty"`
+	}
 	// SubnetTopology can be SubnetTopologyPrivate or SubnetTopologyPublic
 	SubnetTopology string
 	AZSubnetSpec   struct {
@@ -175,6 +207,9 @@ type (
 		AZ string `json:"az,omitempty"`
 		// +optional
 		CIDR *ipnet.IPNet `json:"cidr,omitempty"`
+		// Tags are added to the subnet when eksctl creates it, in addition to the tags eksctl adds
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
 	}
 	// Network holds ID and CIDR
 	Network struct {
@@ -290,8 +325,10 @@ func doImportSubnet(subnets AZSubnetMapping, az, subnetID, cidr string) error {
 			}
 		}
 		if idKey != "" {
+			newS.Tags = subnets[idKey].Tags
 			subnets[idKey] = newS
 		} else if guessKey != "" {
+			newS.Tags = subnets[guessKey].Tags
 			subnets[guessKey] = newS
 		} else {
 			subnets[az] = newS
@@ -325,6 +362,27 @@ func (c *ClusterConfig) HasAnySubnets() bool {
 	return c.VPC.Subnets != nil && len(c.VPC.Subnets.Private)+len(c.VPC.Subnets.Public) != 0
 }
 
+// HasSubnetsForNewVPC checks if subnets were set for a VPC that eksctl creates, i.e.
+// subnets were set without vpc.id, and none of them has an ID
+func (c *ClusterConfig) HasSubnetsForNewVPC() bool {
+	if !c.HasAnySubnets() || c.VPC.ID != "" {
+		return false
+	}
+	for _, subnets := range []AZSubnetMapping{c.VPC.Subnets.Private, c.VPC.Subnets.Public} {
+		for _, s := range subnets {
+			if s.ID != "" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// HasCustomNetworking checks if VPC CNI custom networking is enabled, i.e. if pod subnets were set
+func (c *ClusterConfig) HasCustomNetworking() bool {
+	return c.VPC != nil && c.VPC.PodSubnets != nil
+}
+
 // HasSufficientPrivateSubnets validates if there is a sufficient
 // number of private subnets available to create a cluster
 func (c *ClusterConfig) HasSufficientPrivateSubnets() bool {
@@ -189,6 +189,26 @@ var _ = Describe("VPC Configuration", func() {
 				},
 			}),
 		}),
+		Entry("Named subnet with tags", subnetCase{
+			subnets: AZSubnetMappingFromMap(map[string]AZSubnetSpec{
+				"database": {
+					AZ:   "us-east-1a",
+					CIDR: ipnet.MustParseCIDR("192.168.1.0/24"),
+					Tags: map[string]string{"tier": "database"},
+				},
+			}),
+			az:       "us-east-1a",
+			subnetID: "subnet-1",
+			cidr:     "192.168.1.0/24",
+			expected: AZSubnetMappingFromMap(map[string]AZSubnetSpec{
+				"database": {
+					AZ:   "us-east-1a",
+					ID:   "subnet-1",
+					CIDR: ipnet.MustParseCIDR("192.168.1.0/24"),
+					Tags: map[string]string{"tier": "database"},
+				},
+			}),
+		}),
 	)
 	DescribeTable("Can determine if VPC config in config file has cluster endpoints",
 		func(e endpointAccessCase) {
@@ -24,6 +24,62 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMICatalog) DeepCopyInto(out *AMICatalog) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(AMICatalogImages)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMICatalog.
+func (in *AMICatalog) DeepCopy() *AMICatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(AMICatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMICatalogImages) DeepCopyInto(out *AMICatalogImages) {
+	*out = *in
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMICatalogImages.
+func (in *AMICatalogImages) DeepCopy() *AMICatalogImages {
+	if in == nil {
+		return nil
+	}
+	out := new(AMICatalogImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in AZSubnetMapping) DeepCopyInto(out *AZSubnetMapping) {
 	{
@@ -53,6 +109,13 @@ func (in *AZSubnetSpec) DeepCopyInto(out *AZSubnetSpec) {
 		in, out := &in.CIDR, &out.CIDR
 		*out = (*in).DeepCopy()
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -95,6 +158,22 @@ func (in *Addon) DeepCopy() *Addon {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscalerTag) DeepCopyInto(out *ClusterAutoscalerTag) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerTag.
+func (in *ClusterAutoscalerTag) DeepCopy() *ClusterAutoscalerTag {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscalerTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterCloudWatch) DeepCopyInto(out *ClusterCloudWatch) {
 	*out = *in
@@ -232,6 +311,16 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(SecretsEncryption)
 		**out = **in
 	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AMICatalog != nil {
+		in, out := &in.AMICatalog, &out.AMICatalog
+		*out = new(AMICatalog)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(ClusterStatus)
@@ -597,6 +686,11 @@ func (in *ClusterVPC) DeepCopyInto(out *ClusterVPC) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PodSubnets != nil {
+		in, out := &in.PodSubnets, &out.PodSubnets
+		*out = new(PodSubnets)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManageSharedNodeSecurityGroupRules != nil {
 		in, out := &in.ManageSharedNodeSecurityGroupRules, &out.ManageSharedNodeSecurityGroupRules
 		*out = new(bool)
@@ -635,6 +729,72 @@ func (in *ClusterVPC) DeepCopy() *ClusterVPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainConfig) DeepCopyInto(out *DrainConfig) {
+	*out = *in
+	if in.SkipNamespaces != nil {
+		in, out := &in.SkipNamespaces, &out.SkipNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForceDeletePodsOlderThanMinutes != nil {
+		in, out := &in.ForceDeletePodsOlderThanMinutes, &out.ForceDeletePodsOlderThanMinutes
+		*out = new(int)
+		**out = **in
+	}
+	if in.PreDrainHooks != nil {
+		in, out := &in.PreDrainHooks, &out.PreDrainHooks
+		*out = make([]DrainHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostDrainHooks != nil {
+		in, out := &in.PostDrainHooks, &out.PostDrainHooks
+		*out = make([]DrainHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainConfig.
+func (in *DrainConfig) DeepCopy() *DrainConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainHook) DeepCopyInto(out *DrainHook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainHook.
+func (in *DrainHook) DeepCopy() *DrainHook {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FargateProfile) DeepCopyInto(out *FargateProfile) {
 	*out = *in
@@ -1009,6 +1169,11 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SpotInterruptionHandler != nil {
+		in, out := &in.SpotInterruptionHandler, &out.SpotInterruptionHandler
+		*out = new(NodeGroupSpotInterruptionHandler)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1413,6 +1578,32 @@ func (in *NodeGroupSSH) DeepCopy() *NodeGroupSSH {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupSpotInterruptionHandler) DeepCopyInto(out *NodeGroupSpotInterruptionHandler) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HeartbeatTimeout != nil {
+		in, out := &in.HeartbeatTimeout, &out.HeartbeatTimeout
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupSpotInterruptionHandler.
+func (in *NodeGroupSpotInterruptionHandler) DeepCopy() *NodeGroupSpotInterruptionHandler {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupSpotInterruptionHandler)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeGroupTaint) DeepCopyInto(out *NodeGroupTaint) {
 	*out = *in
@@ -1537,6 +1728,33 @@ func (in *Placement) DeepCopy() *Placement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSubnets) DeepCopyInto(out *PodSubnets) {
+	*out = *in
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = (*in).DeepCopy()
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make(AZSubnetMapping, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSubnets.
+func (in *PodSubnets) DeepCopy() *PodSubnets {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSubnets)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateCluster) DeepCopyInto(out *PrivateCluster) {
 	*out = *in
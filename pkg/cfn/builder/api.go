@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 
@@ -28,6 +29,26 @@ func (r *awsCloudFormationResource) AWSCloudFormationType() string {
 	return r.Type
 }
 
+// withProperties converts a resource to an awsCloudFormationResource that has the given properties set, which allows
+// setting properties goformation does not support yet
+func withProperties(resource gfn.Resource, properties map[string]interface{}) (*awsCloudFormationResource, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var r awsCloudFormationResource
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	if r.Properties == nil {
+		r.Properties = map[string]interface{}{}
+	}
+	for name, value := range properties {
+		r.Properties[name] = value
+	}
+	return &r, nil
+}
+
 // ResourceSet is an interface which cluster and nodegroup builders
 // must implement
 type ResourceSet interface {
@@ -3,6 +3,7 @@ package builder
 import (
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 
@@ -67,7 +68,9 @@ func (c *ClusterResourceSet) AddAllResources() error {
 	}
 
 	c.addResourcesForIAM()
-	c.addResourcesForControlPlane(vpcResource.SubnetDetails)
+	if err := c.addResourcesForControlPlane(vpcResource.SubnetDetails); err != nil {
+		return errors.Wrap(err, "error adding resources for the control plane")
+	}
 
 	if len(c.spec.FargateProfiles) > 0 {
 		c.addResourcesForFargate()
@@ -132,7 +135,7 @@ func (c *ClusterResourceSet) newResource(name string, resource gfn.Resource) *gf
 	return c.rs.newResource(name, resource)
 }
 
-func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *subnetDetails) {
+func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *subnetDetails) error {
 	clusterVPC := &gfneks.Cluster_ResourcesVpcConfig{
 		SecurityGroupIds: gfnt.NewSlice(c.securityGroups...),
 	}
@@ -169,7 +172,20 @@ func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *subnetDe
 		}
 	}
 
-	c.newResource("ControlPlane", &cluster)
+	if c.spec.IPv6Enabled() {
+		// goformation does not support the IP family of a cluster yet
+		controlPlane, err := withProperties(&cluster, map[string]interface{}{
+			"KubernetesNetworkConfig": map[string]string{
+				"IpFamily": strings.ToLower(api.IPV6Family),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		c.newResource("ControlPlane", controlPlane)
+	} else {
+		c.newResource("ControlPlane", &cluster)
+	}
 
 	if c.spec.Status == nil {
 		c.spec.Status = &api.ClusterStatus{}
@@ -191,6 +207,14 @@ func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *subnetDe
 		c.spec.Status.ARN = v
 		return nil
 	})
+	if c.spec.IPv6Enabled() {
+		// the service CIDR of an IPv6 cluster is not returned by the EKS API of the AWS SDK in use,
+		// unmanaged nodegroups read it from this output to configure the kubelet
+		c.rs.defineOutputFromAtt(outputs.ClusterServiceIPv6CIDR, "ControlPlane", "KubernetesNetworkConfig.ServiceIpv6Cidr", false, func(v string) error {
+			c.spec.Status.ServiceIPv6CIDR = v
+			return nil
+		})
+	}
 
 	if c.supportsManagedNodes {
 		// This exports the cluster security group ID that EKS creates by default. To enable communication between both
@@ -202,6 +226,7 @@ func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *subnetDe
 				return nil
 			})
 	}
+	return nil
 }
 
 func (c *ClusterResourceSet) addResourcesForFargate() {
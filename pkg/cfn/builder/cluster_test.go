@@ -261,6 +261,27 @@ var _ = Describe("Cluster Template Builder", func() {
 			})
 		})
 
+		When("the IP family is IPv6", func() {
+			BeforeEach(func() {
+				cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+					IPFamily: api.IPV6Family,
+				}
+			})
+
+			It("should set the IP family of the control plane", func() {
+				controlPlane := clusterTemplate.Resources["ControlPlane"]
+				Expect(controlPlane.Type).To(Equal("AWS::EKS::Cluster"))
+				Expect(controlPlane.Properties.KubernetesNetworkConfig.IpFamily).To(Equal("ipv6"))
+				Expect(controlPlane.Properties.Name).To(Equal(cfg.Metadata.Name))
+				Expect(controlPlane.Properties.ResourcesVpcConfig.SubnetIds).To(HaveLen(4))
+				Expect(controlPlane.Properties.RoleArn).To(ContainElement([]interface{}{"ServiceRole", "Arn"}))
+			})
+
+			It("should output the service IPv6 CIDR of the control plane", func() {
+				Expect(clusterTemplate.Outputs).To(HaveKey("ServiceIPv6CIDR"))
+			})
+		})
+
 		It("should add cluster stack outputs", func() {
 			Expect(clusterTemplate.Outputs).To(HaveLen(11))
 			Expect(clusterTemplate.Outputs).To(HaveKey("ARN"))
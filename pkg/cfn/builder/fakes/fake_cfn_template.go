@@ -52,6 +52,9 @@ type Properties struct {
 
 	CapacityRebalance bool
 
+	LifecycleHookSpecificationList []map[string]interface{}
+	EventPattern                   map[string]interface{}
+
 	VPCZoneIdentifier interface{}
 
 	LoadBalancerNames                 []string
@@ -65,16 +68,22 @@ type Properties struct {
 	VpcID, SubnetID                            interface{}
 	RouteTableID, AllocationID                 interface{}
 	GatewayID, InternetGatewayID, NatGatewayID interface{}
+	EgressOnlyInternetGatewayID                interface{}
 	DestinationCidrBlock                       interface{}
+	DestinationIpv6CidrBlock                   interface{}
 	MapPublicIPOnLaunch                        bool
+	AssignIpv6AddressOnCreation                bool
 
 	Ipv6CidrBlock map[string][]interface{}
 
 	AmazonProvidedIpv6CidrBlock         bool
 	AvailabilityZone, Domain, CidrBlock string
 
-	Name, Version      string
-	RoleArn            interface{}
+	Name, Version           string
+	RoleArn                 interface{}
+	KubernetesNetworkConfig struct {
+		ServiceIpv4Cidr, IpFamily string
+	}
 	ResourcesVpcConfig struct {
 		SecurityGroupIds []interface{}
 		SubnetIds        []interface{}
@@ -157,7 +157,7 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() error {
 		n.rs.withNamedIAM = true
 	}
 
-	if err := createRole(n.rs, n.clusterSpec.IAM, n.spec.IAM, false, n.forceAddCNIPolicy); err != nil {
+	if err := createRole(n.rs, n.clusterSpec, n.spec.IAM, false, n.forceAddCNIPolicy); err != nil {
 		return err
 	}
 
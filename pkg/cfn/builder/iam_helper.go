@@ -76,8 +76,8 @@ func createWellKnownPolicies(wellKnownPolicies api.WellKnownPolicies) ([]managed
 }
 
 // createRole creates an IAM role with policies required for the worker nodes and addons
-func createRole(cfnTemplate cfnTemplate, clusterIAMConfig *api.ClusterIAM, iamConfig *api.NodeGroupIAM, managed, forceAddCNIPolicy bool) error {
-	managedPolicyARNs, err := makeManagedPolicies(clusterIAMConfig, iamConfig, managed, forceAddCNIPolicy)
+func createRole(cfnTemplate cfnTemplate, clusterConfig *api.ClusterConfig, iamConfig *api.NodeGroupIAM, managed, forceAddCNIPolicy bool) error {
+	managedPolicyARNs, err := makeManagedPolicies(clusterConfig.IAM, iamConfig, managed, forceAddCNIPolicy)
 	if err != nil {
 		return err
 	}
@@ -97,6 +97,11 @@ func createRole(cfnTemplate cfnTemplate, clusterIAMConfig *api.ClusterIAM, iamCo
 
 	refIR := cfnTemplate.newResource(cfnIAMInstanceRoleName, &role)
 
+	if clusterConfig.IPv6Enabled() {
+		// the VPC CNI of IPv6 clusters uses the node role, see getRecommendedPolicies in the addon package
+		cfnTemplate.attachAllowPolicy("PolicyVPCCNIIPv6", refIR, vpcCNIIPv6Statements())
+	}
+
 	if api.IsEnabled(iamConfig.WithAddonPolicies.AutoScaler) {
 		cfnTemplate.attachAllowPolicy("PolicyAutoScaling", refIR, autoScalerStatements())
 	}
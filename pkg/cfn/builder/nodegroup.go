@@ -2,6 +2,7 @@ package builder
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -160,24 +161,37 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 			"PropagateAtLaunch": "true",
 		},
 	}
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.AutoScaler) {
-		tags = append(tags,
-			map[string]interface{}{
-				"Key":               "k8s.io/cluster-autoscaler/enabled",
-				"Value":             "true",
-				"PropagateAtLaunch": "true",
-			},
-			map[string]interface{}{
-				"Key":               "k8s.io/cluster-autoscaler/" + n.clusterSpec.Metadata.Name,
-				"Value":             "owned",
-				"PropagateAtLaunch": "true",
-			},
-		)
+	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.AutoScaler) || n.clusterSpec.HasClusterAutoscalerAddon() {
+		autoscalerTags := append(api.ClusterAutoscalerDiscoveryTags(n.clusterSpec.Metadata.Name),
+			api.ClusterAutoscalerNodeTemplateTags(n.spec.Labels, n.spec.Taints, api.NodeTemplateResources(n.spec.VolumeSize))...)
+		for _, tag := range autoscalerTags {
+			if _, ok := n.spec.Tags[tag.Key]; ok {
+				// already applied to the ASG as a stack tag
+				continue
+			}
+			tags = append(tags, map[string]interface{}{
+				"Key":               tag.Key,
+				"Value":             tag.Value,
+				"PropagateAtLaunch": strconv.FormatBool(tag.PropagateAtLaunch),
+			})
+		}
+	}
+
+	if n.spec.HasSpotInterruptionHandler() {
+		tags = append(tags, map[string]interface{}{
+			"Key":               api.SpotInterruptionHandlerTagPrefix + n.spec.Name,
+			"Value":             "true",
+			"PropagateAtLaunch": "false",
+		})
 	}
 
 	asg := nodeGroupResource(launchTemplateName, vpcZoneIdentifier, tags, n.spec)
 	n.newResource("NodeGroup", asg)
 
+	if n.spec.HasSpotInterruptionHandler() {
+		n.addResourcesForSpotInterruptionHandler()
+	}
+
 	return nil
 }
 
@@ -309,6 +323,10 @@ func nodeGroupResource(launchTemplateName *gfnt.Value, vpcZoneIdentifier interfa
 		ngProps["CapacityRebalance"] = ng.InstancesDistribution.CapacityRebalance
 	}
 
+	if ng.HasSpotInterruptionHandler() {
+		ngProps["LifecycleHookSpecificationList"] = spotInterruptionLifecycleHooks(ng.SpotInterruptionHandler)
+	}
+
 	if ng.DesiredCapacity != nil {
 		ngProps["DesiredCapacity"] = fmt.Sprintf("%d", *ng.DesiredCapacity)
 	}
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	corev1 "k8s.io/api/core/v1"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
@@ -330,6 +331,21 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("the IP family of the cluster is IPv6", func() {
+				BeforeEach(func() {
+					cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+						IPFamily: api.IPV6Family,
+					}
+				})
+
+				It("adds the PolicyVPCCNIIPv6 policy to the role", func() {
+					Expect(ngTemplate.Resources).To(HaveKey("PolicyVPCCNIIPv6"))
+					Expect(ngTemplate.Resources["PolicyVPCCNIIPv6"].Properties.Roles).To(HaveLen(1))
+					Expect(isRefTo(ngTemplate.Resources["PolicyVPCCNIIPv6"].Properties.Roles[0], "NodeInstanceRole")).To(BeTrue())
+					Expect(ngTemplate.Resources["PolicyVPCCNIIPv6"].Properties.PolicyDocument.Statement[0].Action).To(ContainElement("ec2:AssignIpv6Addresses"))
+				})
+			})
+
 			Context("ng.WithAddonPolicies.CertManager is set", func() {
 				BeforeEach(func() {
 					ng.IAM.WithAddonPolicies.CertManager = aws.Bool(true)
@@ -751,6 +767,47 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("ng.SpotInterruptionHandler is enabled", func() {
+				BeforeEach(func() {
+					ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+						InstanceTypes: []string{"type-1", "type-2"},
+					}
+					ng.SpotInterruptionHandler = &api.NodeGroupSpotInterruptionHandler{
+						Enabled:          api.Enabled(),
+						HeartbeatTimeout: aws.Int(600),
+					}
+				})
+
+				It("adds a lifecycle hook and a tag identifying the ASG to the handler", func() {
+					properties := ngTemplate.Resources["NodeGroup"].Properties
+					Expect(properties.LifecycleHookSpecificationList).To(ConsistOf(map[string]interface{}{
+						"LifecycleHookName":   "SpotInterruptionHandler",
+						"LifecycleTransition": "autoscaling:EC2_INSTANCE_TERMINATING",
+						"DefaultResult":       "CONTINUE",
+						"HeartbeatTimeout":    float64(600),
+					}))
+					Expect(properties.Tags).To(ContainElement(fakes.Tag{
+						Key:               "alpha.eksctl.io/spot-interruption-handler/" + ng.Name,
+						Value:             "true",
+						PropagateAtLaunch: "false",
+					}))
+				})
+
+				It("adds the queue and the rules forwarding events to it", func() {
+					Expect(ngTemplate.Resources).To(HaveKey("SpotInterruptionQueue"))
+					Expect(ngTemplate.Resources).To(HaveKey("SpotInterruptionQueuePolicy"))
+					for _, rule := range []string{"SpotInterruptionWarningRule", "SpotRebalanceRecommendationRule", "SpotInstanceStateChangeRule", "SpotTerminationLifecycleRule"} {
+						Expect(ngTemplate.Resources).To(HaveKey(rule))
+						Expect(ngTemplate.Resources[rule].Type).To(Equal("AWS::Events::Rule"))
+					}
+					Expect(ngTemplate.Resources["SpotTerminationLifecycleRule"].Properties.EventPattern).To(HaveKeyWithValue("detail", map[string]interface{}{
+						"AutoScalingGroupName": []interface{}{makeRef("NodeGroup")},
+					}))
+					Expect(ngTemplate.Outputs).To(HaveKey("SpotInterruptionQueueURL"))
+					Expect(ngTemplate.Outputs).To(HaveKey("SpotInterruptionQueueARN"))
+				})
+			})
+
 			Context("ng.DesiredCapacity is set", func() {
 				BeforeEach(func() {
 					ng.DesiredCapacity = aws.Int(5)
@@ -916,13 +973,46 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 
 				It("appends autoscaling tags to the ASG", func() {
 					tags := ngTemplate.Resources["NodeGroup"].Properties.Tags
-					Expect(tags).To(HaveLen(4))
+					Expect(tags).To(HaveLen(5))
 					Expect(tags[2].Key).To(Equal("k8s.io/cluster-autoscaler/enabled"))
 					Expect(tags[2].Value).To(Equal("true"))
 					Expect(tags[2].PropagateAtLaunch).To(Equal("true"))
 					Expect(tags[3].Key).To(Equal("k8s.io/cluster-autoscaler/bonsai"))
 					Expect(tags[3].Value).To(Equal("owned"))
 					Expect(tags[3].PropagateAtLaunch).To(Equal("true"))
+					Expect(tags[4].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/resources/ephemeral-storage"))
+					Expect(tags[4].Value).To(Equal("80Gi"))
+					Expect(tags[4].PropagateAtLaunch).To(Equal("false"))
+				})
+
+				Context("ng.Labels and ng.Taints are set", func() {
+					BeforeEach(func() {
+						ng.Labels = map[string]string{"role": "worker"}
+						ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}}
+					})
+
+					It("appends node-template tags to the ASG", func() {
+						tags := ngTemplate.Resources["NodeGroup"].Properties.Tags
+						Expect(tags).To(HaveLen(7))
+						Expect(tags[4].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/label/role"))
+						Expect(tags[4].Value).To(Equal("worker"))
+						Expect(tags[4].PropagateAtLaunch).To(Equal("false"))
+						Expect(tags[6].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/taint/dedicated"))
+						Expect(tags[6].Value).To(Equal("gpu:NoSchedule"))
+						Expect(tags[6].PropagateAtLaunch).To(Equal("false"))
+					})
+				})
+			})
+
+			Context("the cluster-autoscaler addon is enabled", func() {
+				BeforeEach(func() {
+					cfg.Addons = []*api.Addon{{Name: "cluster-autoscaler"}}
+				})
+
+				It("appends autoscaling tags to the ASG", func() {
+					tags := ngTemplate.Resources["NodeGroup"].Properties.Tags
+					Expect(tags).To(HaveLen(5))
+					Expect(tags[2].Key).To(Equal("k8s.io/cluster-autoscaler/enabled"))
 				})
 			})
 
@@ -0,0 +1,87 @@
+package builder
+
+import (
+	gfnevents "github.com/weaveworks/goformation/v4/cloudformation/events"
+	gfnsqs "github.com/weaveworks/goformation/v4/cloudformation/sqs"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const (
+	spotInterruptionQueueName         = "SpotInterruptionQueue"
+	spotInterruptionLifecycleHookName = "SpotInterruptionHandler"
+)
+
+// spotInterruptionEvents are the EventBridge events forwarded to the queue of the spot interruption handler,
+// by rule name
+var spotInterruptionEvents = map[string]struct {
+	source     string
+	detailType string
+}{
+	"SpotInterruptionWarningRule":     {source: "aws.ec2", detailType: "EC2 Spot Instance Interruption Warning"},
+	"SpotRebalanceRecommendationRule": {source: "aws.ec2", detailType: "EC2 Instance Rebalance Recommendation"},
+	"SpotInstanceStateChangeRule":     {source: "aws.ec2", detailType: "EC2 Instance State-change Notification"},
+	"SpotTerminationLifecycleRule":    {source: "aws.autoscaling", detailType: "EC2 Instance-terminate Lifecycle Action"},
+}
+
+// addResourcesForSpotInterruptionHandler adds the queue processed by the spot interruption handler, and the
+// rules forwarding the interruption, rebalance and termination events of the nodegroup to it
+func (n *NodeGroupResourceSet) addResourcesForSpotInterruptionHandler() {
+	queue := n.newResource(spotInterruptionQueueName, &gfnsqs.Queue{
+		MessageRetentionPeriod: gfnt.NewInteger(300),
+	})
+	queueARN := gfnt.MakeFnGetAttString(spotInterruptionQueueName, "Arn")
+
+	n.newResource("SpotInterruptionQueuePolicy", &gfnsqs.QueuePolicy{
+		Queues: gfnt.NewSlice(queue),
+		PolicyDocument: cft.MakePolicyDocument(cft.MapOfInterfaces{
+			"Effect": effectAllow,
+			"Principal": map[string][]string{
+				"Service": {"events.amazonaws.com", "sqs.amazonaws.com"},
+			},
+			"Action":   []string{"sqs:SendMessage"},
+			"Resource": queueARN,
+		}),
+	})
+
+	for name, event := range spotInterruptionEvents {
+		pattern := map[string]interface{}{
+			"source":      []string{event.source},
+			"detail-type": []string{event.detailType},
+		}
+		if event.source == "aws.autoscaling" {
+			pattern["detail"] = map[string]interface{}{
+				"AutoScalingGroupName": []*gfnt.Value{gfnt.MakeRef("NodeGroup")},
+			}
+		}
+		n.newResource(name, &gfnevents.Rule{
+			EventPattern: pattern,
+			Targets: []gfnevents.Rule_Target{
+				{
+					Id:  gfnt.NewString(spotInterruptionQueueName),
+					Arn: queueARN,
+				},
+			},
+		})
+	}
+
+	n.rs.defineOutputWithoutCollector(outputs.NodeGroupSpotInterruptionQueueURL, queue, false)
+	n.rs.defineOutputWithoutCollector(outputs.NodeGroupSpotInterruptionQueueARN, queueARN, false)
+}
+
+// spotInterruptionLifecycleHooks returns the lifecycle hook that holds terminating instances until the
+// spot interruption handler has drained them
+func spotInterruptionLifecycleHooks(handler *api.NodeGroupSpotInterruptionHandler) []map[string]interface{} {
+	hook := map[string]interface{}{
+		"LifecycleHookName":   spotInterruptionLifecycleHookName,
+		"LifecycleTransition": "autoscaling:EC2_INSTANCE_TERMINATING",
+		"DefaultResult":       "CONTINUE",
+	}
+	if handler.HeartbeatTimeout != nil {
+		hook["HeartbeatTimeout"] = *handler.HeartbeatTimeout
+	}
+	return []map[string]interface{}{hook}
+}
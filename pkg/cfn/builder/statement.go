@@ -186,6 +186,31 @@ func elbStatements() []cft.MapOfInterfaces {
 	}
 }
 
+// vpcCNIIPv6Statements returns the permissions the VPC CNI needs to assign IPv6 addresses to pods, which are not
+// part of the AmazonEKS_CNI_Policy managed policy
+func vpcCNIIPv6Statements() []cft.MapOfInterfaces {
+	return []cft.MapOfInterfaces{
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				"ec2:AssignIpv6Addresses",
+				"ec2:DescribeInstances",
+				"ec2:DescribeTags",
+				"ec2:DescribeNetworkInterfaces",
+				"ec2:DescribeInstanceTypes",
+			},
+		},
+		{
+			"Effect":   effectAllow,
+			"Resource": addARNPartitionPrefix("ec2:*:*:network-interface/*"),
+			"Action": []string{
+				"ec2:CreateTags",
+			},
+		},
+	}
+}
+
 func cloudWatchMetricsStatements() []cft.MapOfInterfaces {
 	return []cft.MapOfInterfaces{
 		{
@@ -2,6 +2,7 @@ package builder
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,12 +18,17 @@ import (
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
-var internetCIDR = gfnt.NewString("0.0.0.0/0")
+var (
+	internetCIDR     = gfnt.NewString("0.0.0.0/0")
+	internetIPv6CIDR = gfnt.NewString("::/0")
+)
 
 const (
 	cfnControlPlaneSGResource         = "ControlPlaneSecurityGroup"
 	cfnSharedNodeSGResource           = "ClusterSharedNodeSecurityGroup"
 	cfnIngressClusterToNodeSGResource = "IngressDefaultClusterToNodeSG"
+	cfnIPv6CIDRBlockResource          = "AutoAllocatedCIDRv6"
+	cfnPodCIDRBlockResource           = "PodSubnetsCIDR"
 )
 
 // A VPCResourceSet builds the resources required for the specified VPC
@@ -31,7 +37,8 @@ type VPCResourceSet struct {
 	clusterConfig *api.ClusterConfig
 	ec2API        ec2iface.EC2API
 
-	vpcResource *VPCResource
+	vpcResource               *VPCResource
+	egressOnlyInternetGateway *gfnt.Value
 }
 
 // VPCResource represents a VPC resource
@@ -49,6 +56,7 @@ type SubnetResource struct {
 type subnetDetails struct {
 	Private []SubnetResource
 	Public  []SubnetResource
+	Pod     []SubnetResource
 }
 
 // NewVPCResourceSet creates and returns a new VPCResourceSet
@@ -86,8 +94,8 @@ func (v *VPCResourceSet) AddResources() (*VPCResource, error) {
 		return v.vpcResource, nil
 	}
 
-	if api.IsEnabled(vpc.AutoAllocateIPv6) {
-		v.rs.newResource("AutoAllocatedCIDRv6", &gfnec2.VPCCidrBlock{
+	if api.IsEnabled(vpc.AutoAllocateIPv6) || v.clusterConfig.IPv6Enabled() {
+		v.rs.newResource(cfnIPv6CIDRBlockResource, &gfnec2.VPCCidrBlock{
 			VpcId:                       v.vpcResource.VPC,
 			AmazonProvidedIpv6CidrBlock: gfnt.True(),
 		})
@@ -96,6 +104,7 @@ func (v *VPCResourceSet) AddResources() (*VPCResource, error) {
 	if v.isFullyPrivate() {
 		v.noNAT()
 		v.vpcResource.SubnetDetails.Private = v.addSubnets(nil, api.SubnetTopologyPrivate, vpc.Subnets.Private)
+		v.addPodSubnets()
 		return v.vpcResource, nil
 	}
 
@@ -117,6 +126,20 @@ func (v *VPCResourceSet) AddResources() (*VPCResource, error) {
 		AWSCloudFormationDependsOn: []string{vpcGA},
 	})
 
+	if v.clusterConfig.IPv6Enabled() {
+		v.rs.newResource("PublicSubnetIPv6Route", &gfnec2.Route{
+			RouteTableId:               refPublicRT,
+			DestinationIpv6CidrBlock:   internetIPv6CIDR,
+			GatewayId:                  refIG,
+			AWSCloudFormationDependsOn: []string{vpcGA},
+		})
+		// private subnets reach the internet over IPv6 through an egress-only internet gateway,
+		// and over IPv4 through the NAT gateways
+		v.egressOnlyInternetGateway = v.rs.newResource("EgressOnlyInternetGateway", &gfnec2.EgressOnlyInternetGateway{
+			VpcId: v.vpcResource.VPC,
+		})
+	}
+
 	v.vpcResource.SubnetDetails.Public = v.addSubnets(refPublicRT, api.SubnetTopologyPublic, vpc.Subnets.Public)
 
 	if err := v.addNATGateways(); err != nil {
@@ -124,6 +147,7 @@ func (v *VPCResourceSet) AddResources() (*VPCResource, error) {
 	}
 
 	v.vpcResource.SubnetDetails.Private = v.addSubnets(nil, api.SubnetTopologyPrivate, vpc.Subnets.Private)
+	v.addPodSubnets()
 	return v.vpcResource, nil
 }
 
@@ -167,6 +191,17 @@ func (v *VPCResourceSet) AddOutputs() {
 		addSubnetOutput(subnetAZs, api.SubnetTopologyPublic, outputs.ClusterSubnetsPublic)
 	}
 
+	if subnets := v.vpcResource.SubnetDetails.Pod; len(subnets) > 0 {
+		var subnetRefs []*gfnt.Value
+		for _, subnet := range subnets {
+			subnetRefs = append(subnetRefs, subnet.Subnet)
+		}
+		v.rs.defineJoinedOutput(outputs.ClusterSubnetsPod, subnetRefs, true, func(value string) error {
+			return vpc.ImportPodSubnetsFromIDList(v.ec2API, v.clusterConfig, strings.Split(value, ","))
+		})
+		v.rs.defineOutputWithoutCollector(outputs.ClusterPodSubnetsCIDR, v.clusterConfig.VPC.PodSubnets.CIDR.String(), false)
+	}
+
 	if v.isFullyPrivate() {
 		v.rs.defineOutputWithoutCollector(outputs.ClusterFullyPrivate, true, true)
 	}
@@ -177,13 +212,13 @@ func (v *VPCResourceSet) RenderJSON() ([]byte, error) {
 	return v.rs.renderJSON()
 }
 
-func (v *VPCResourceSet) addSubnets(refRT *gfnt.Value, topology api.SubnetTopology, subnets map[string]api.AZSubnetSpec) []SubnetResource {
+func (v *VPCResourceSet) addSubnets(refRT *gfnt.Value, topology api.SubnetTopology, subnets api.AZSubnetMapping) []SubnetResource {
 	var subnetIndexForIPv6 int
-	if api.IsEnabled(v.clusterConfig.VPC.AutoAllocateIPv6) {
+	if api.IsEnabled(v.clusterConfig.VPC.AutoAllocateIPv6) || v.clusterConfig.IPv6Enabled() {
 		// this is same kind of indexing we have in vpc.SetSubnets
 		switch topology {
 		case api.SubnetTopologyPrivate:
-			subnetIndexForIPv6 = len(v.clusterConfig.AvailabilityZones)
+			subnetIndexForIPv6 = len(v.clusterConfig.VPC.Subnets.Public)
 		case api.SubnetTopologyPublic:
 			subnetIndexForIPv6 = 0
 		}
@@ -191,19 +226,20 @@ func (v *VPCResourceSet) addSubnets(refRT *gfnt.Value, topology api.SubnetTopolo
 
 	var subnetResources []SubnetResource
 
-	for name, subnet := range subnets {
-		az := subnet.AZ
-		nameAlias := strings.ToUpper(strings.Join(strings.Split(name, "-"), ""))
+	for _, name := range subnets.Names() {
+		spec := subnets[name]
+		az := spec.AZ
+		nameAlias := makeAlias(name)
 		subnet := &gfnec2.Subnet{
 			AvailabilityZone: gfnt.NewString(az),
-			CidrBlock:        gfnt.NewString(subnet.CIDR.String()),
+			CidrBlock:        gfnt.NewString(spec.CIDR.String()),
 			VpcId:            v.vpcResource.VPC,
 		}
 
 		switch topology {
 		case api.SubnetTopologyPrivate:
-			// Choose the appropriate route table for private subnets
-			refRT = gfnt.MakeRef("PrivateRouteTable" + nameAlias)
+			// Choose the route table of the AZ for private subnets
+			refRT = gfnt.MakeRef("PrivateRouteTable" + makeAlias(az))
 			subnet.Tags = []gfncfn.Tag{{
 				Key:   gfnt.NewString("kubernetes.io/role/internal-elb"),
 				Value: gfnt.NewString("1"),
@@ -215,7 +251,28 @@ func (v *VPCResourceSet) addSubnets(refRT *gfnt.Value, topology api.SubnetTopolo
 			}}
 			subnet.MapPublicIpOnLaunch = gfnt.True()
 		}
+		tagKeys := make([]string, 0, len(spec.Tags))
+		for k := range spec.Tags {
+			tagKeys = append(tagKeys, k)
+		}
+		sort.Strings(tagKeys)
+		for _, k := range tagKeys {
+			subnet.Tags = append(subnet.Tags, gfncfn.Tag{
+				Key:   gfnt.NewString(k),
+				Value: gfnt.NewString(spec.Tags[k]),
+			})
+		}
 		subnetAlias := string(topology) + nameAlias
+
+		if v.clusterConfig.IPv6Enabled() {
+			// the subnets of an IPv6 cluster are dual-stack, the nodes and pods need an IPv6 address
+			// as soon as they are launched
+			subnet.Ipv6CidrBlock = v.subnetIPv6CIDRBlock(subnetIndexForIPv6)
+			subnet.AssignIpv6AddressOnCreation = gfnt.True()
+			subnet.AWSCloudFormationDependsOn = []string{cfnIPv6CIDRBlockResource}
+			subnetIndexForIPv6++
+		}
+
 		refSubnet := v.rs.newResource("Subnet"+subnetAlias, subnet)
 		v.rs.newResource("RouteTableAssociation"+subnetAlias, &gfnec2.SubnetRouteTableAssociation{
 			SubnetId:     refSubnet,
@@ -223,20 +280,9 @@ func (v *VPCResourceSet) addSubnets(refRT *gfnt.Value, topology api.SubnetTopolo
 		})
 
 		if api.IsEnabled(v.clusterConfig.VPC.AutoAllocateIPv6) {
-			// get 8 of /64 subnets from the auto-allocated IPv6 block,
-			// and pick one block based on subnetIndexForIPv6 counter;
-			// NOTE: this is done inside of CloudFormation using Fn::Cidr,
-			// we don't slice it here, just construct the JSON expression
-			// that does slicing at runtime.
-			refAutoAllocateCIDRv6 := gfnt.MakeFnSelect(
-				gfnt.NewInteger(0), gfnt.MakeFnGetAttString("VPC", "Ipv6CidrBlocks"),
-			)
-			refSubnetSlices := gfnt.MakeFnCIDR(
-				refAutoAllocateCIDRv6, gfnt.NewInteger(8), gfnt.NewInteger(64),
-			)
 			v.rs.newResource(subnetAlias+"CIDRv6", &gfnec2.SubnetCidrBlock{
 				SubnetId:      refSubnet,
-				Ipv6CidrBlock: gfnt.MakeFnSelect(gfnt.NewInteger(subnetIndexForIPv6), refSubnetSlices),
+				Ipv6CidrBlock: v.subnetIPv6CIDRBlock(subnetIndexForIPv6),
 			})
 			subnetIndexForIPv6++
 		}
@@ -250,12 +296,85 @@ func (v *VPCResourceSet) addSubnets(refRT *gfnt.Value, topology api.SubnetTopolo
 	return subnetResources
 }
 
+// addPodSubnets associates the pod CIDR with the VPC, and adds the pod subnets of VPC CNI custom networking,
+// which share the route table of the private subnets of their AZ
+func (v *VPCResourceSet) addPodSubnets() {
+	podSubnets := v.clusterConfig.VPC.PodSubnets
+	if podSubnets == nil {
+		return
+	}
+
+	v.rs.newResource(cfnPodCIDRBlockResource, &gfnec2.VPCCidrBlock{
+		VpcId:     v.vpcResource.VPC,
+		CidrBlock: gfnt.NewString(podSubnets.CIDR.String()),
+	})
+
+	for _, az := range podSubnets.Subnets.Names() {
+		alphanumericUpperAZ := makeAlias(az)
+		refRT := gfnt.MakeRef("PrivateRouteTable" + alphanumericUpperAZ)
+		refSubnet := v.rs.newResource("SubnetPod"+alphanumericUpperAZ, &gfnec2.Subnet{
+			AvailabilityZone:           gfnt.NewString(az),
+			CidrBlock:                  gfnt.NewString(podSubnets.Subnets[az].CIDR.String()),
+			VpcId:                      v.vpcResource.VPC,
+			AWSCloudFormationDependsOn: []string{cfnPodCIDRBlockResource},
+		})
+		v.rs.newResource("RouteTableAssociationPod"+alphanumericUpperAZ, &gfnec2.SubnetRouteTableAssociation{
+			SubnetId:     refSubnet,
+			RouteTableId: refRT,
+		})
+		v.vpcResource.SubnetDetails.Pod = append(v.vpcResource.SubnetDetails.Pod, SubnetResource{
+			AvailabilityZone: az,
+			RouteTable:       refRT,
+			Subnet:           refSubnet,
+		})
+	}
+}
+
+// subnetIPv6CIDRBlock returns the /64 block at index of the IPv6 CIDR of the VPC
+func (v *VPCResourceSet) subnetIPv6CIDRBlock(index int) *gfnt.Value {
+	// get enough /64 subnets from the auto-allocated IPv6 block for every subnet,
+	// and pick one block based on the index;
+	// NOTE: this is done inside of CloudFormation using Fn::Cidr,
+	// we don't slice it here, just construct the JSON expression
+	// that does slicing at runtime.
+	refAutoAllocateCIDRv6 := gfnt.MakeFnSelect(
+		gfnt.NewInteger(0), gfnt.MakeFnGetAttString("VPC", "Ipv6CidrBlocks"),
+	)
+	refSubnetSlices := gfnt.MakeFnCIDR(
+		refAutoAllocateCIDRv6, gfnt.NewInteger(ipv6SubnetBlockCount(v.clusterConfig.VPC.Subnets)), gfnt.NewInteger(64),
+	)
+	return gfnt.MakeFnSelect(gfnt.NewInteger(index), refSubnetSlices)
+}
+
+// ipv6SubnetBlockCount returns the number of /64 blocks to slice from the IPv6 CIDR of the VPC, one per public and
+// private subnet, but at least 8 so that the templates of existing VPCs are unchanged
+func ipv6SubnetBlockCount(subnets *api.ClusterSubnets) int {
+	count := len(subnets.Public) + len(subnets.Private)
+	if count < 8 {
+		return 8
+	}
+	return count
+}
+
+// addPrivateIPv6Route routes the IPv6 traffic of a private route table through the egress-only internet gateway,
+// if there is one
+func (v *VPCResourceSet) addPrivateIPv6Route(refRT *gfnt.Value, alphanumericUpperAZ string) {
+	if v.egressOnlyInternetGateway == nil {
+		return
+	}
+	v.rs.newResource("PrivateSubnetIPv6Route"+alphanumericUpperAZ, &gfnec2.Route{
+		RouteTableId:                refRT,
+		DestinationIpv6CidrBlock:    internetIPv6CIDR,
+		EgressOnlyInternetGatewayId: v.egressOnlyInternetGateway,
+	})
+}
+
 func (v *VPCResourceSet) addNATGateways() error {
 	switch *v.clusterConfig.VPC.NAT.Gateway {
 	case api.ClusterHighlyAvailableNAT:
-		v.haNAT()
+		return v.haNAT()
 	case api.ClusterSingleNAT:
-		v.singleNAT()
+		return v.singleNAT()
 	case api.ClusterDisableNAT:
 		v.noNAT()
 	default:
@@ -577,9 +696,30 @@ func makeNodeIngressRules(ng *api.NodeGroupBase, controlPlaneSG *gfnt.Value, vpc
 	return append(ingressRules, makeSSHIngressRules(ng, vpcCIDR, description)...)
 }
 
-func (v *VPCResourceSet) haNAT() {
+// makeAlias turns a subnet name or an AZ into a string that can be used in logical IDs
+func makeAlias(name string) string {
+	return strings.ToUpper(strings.Join(strings.Split(name, "-"), ""))
+}
+
+// publicSubnetRef returns a reference to the first public subnet in the AZ, NAT gateways are placed in it
+func (v *VPCResourceSet) publicSubnetRef(az string) (*gfnt.Value, bool) {
+	subnets := v.clusterConfig.VPC.Subnets.Public
+	for _, name := range subnets.Names() {
+		if subnets[name].AZ == az {
+			return gfnt.MakeRef("Subnet" + string(api.SubnetTopologyPublic) + makeAlias(name)), true
+		}
+	}
+	return nil, false
+}
+
+func (v *VPCResourceSet) haNAT() error {
 	for _, az := range v.clusterConfig.AvailabilityZones {
-		alphanumericUpperAZ := strings.ToUpper(strings.Join(strings.Split(az, "-"), ""))
+		alphanumericUpperAZ := makeAlias(az)
+
+		refPublicSubnet, ok := v.publicSubnetRef(az)
+		if !ok {
+			return fmt.Errorf("a public subnet is required in %s for its NAT gateway when vpc.nat.gateway is %s", az, api.ClusterHighlyAvailableNAT)
+		}
 
 		// Allocate an EIP
 		v.rs.newResource("NATIP"+alphanumericUpperAZ, &gfnec2.EIP{
@@ -588,7 +728,7 @@ func (v *VPCResourceSet) haNAT() {
 		// Allocate a NAT gateway in the public subnet
 		refNG := v.rs.newResource("NATGateway"+alphanumericUpperAZ, &gfnec2.NatGateway{
 			AllocationId: gfnt.MakeFnGetAttString("NATIP"+alphanumericUpperAZ, "AllocationId"),
-			SubnetId:     gfnt.MakeRef("SubnetPublic" + alphanumericUpperAZ),
+			SubnetId:     refPublicSubnet,
 		})
 
 		// Allocate a routing table for the private subnet
@@ -601,28 +741,33 @@ func (v *VPCResourceSet) haNAT() {
 			DestinationCidrBlock: internetCIDR,
 			NatGatewayId:         refNG,
 		})
-		// Associate the routing table with the subnet
-		v.rs.newResource("RouteTableAssociationPrivate"+alphanumericUpperAZ, &gfnec2.SubnetRouteTableAssociation{
-			SubnetId:     gfnt.MakeRef("SubnetPrivate" + alphanumericUpperAZ),
-			RouteTableId: refRT,
-		})
+		v.addPrivateIPv6Route(refRT, alphanumericUpperAZ)
 	}
+	return nil
 }
 
-func (v *VPCResourceSet) singleNAT() {
-	sortedAZs := v.clusterConfig.AvailabilityZones
-	firstUpperAZ := strings.ToUpper(strings.Join(strings.Split(sortedAZs[0], "-"), ""))
+func (v *VPCResourceSet) singleNAT() error {
+	var refPublicSubnet *gfnt.Value
+	for _, az := range v.clusterConfig.AvailabilityZones {
+		if ref, ok := v.publicSubnetRef(az); ok {
+			refPublicSubnet = ref
+			break
+		}
+	}
+	if refPublicSubnet == nil {
+		return fmt.Errorf("a public subnet is required for the NAT gateway when vpc.nat.gateway is %s", api.ClusterSingleNAT)
+	}
 
 	v.rs.newResource("NATIP", &gfnec2.EIP{
 		Domain: gfnt.NewString("vpc"),
 	})
 	refNG := v.rs.newResource("NATGateway", &gfnec2.NatGateway{
 		AllocationId: gfnt.MakeFnGetAttString("NATIP", "AllocationId"),
-		SubnetId:     gfnt.MakeRef("SubnetPublic" + firstUpperAZ),
+		SubnetId:     refPublicSubnet,
 	})
 
 	for _, az := range v.clusterConfig.AvailabilityZones {
-		alphanumericUpperAZ := strings.ToUpper(strings.Join(strings.Split(az, "-"), ""))
+		alphanumericUpperAZ := makeAlias(az)
 
 		refRT := v.rs.newResource("PrivateRouteTable"+alphanumericUpperAZ, &gfnec2.RouteTable{
 			VpcId: v.vpcResource.VPC,
@@ -633,23 +778,17 @@ func (v *VPCResourceSet) singleNAT() {
 			DestinationCidrBlock: internetCIDR,
 			NatGatewayId:         refNG,
 		})
-		v.rs.newResource("RouteTableAssociationPrivate"+alphanumericUpperAZ, &gfnec2.SubnetRouteTableAssociation{
-			SubnetId:     gfnt.MakeRef("SubnetPrivate" + alphanumericUpperAZ),
-			RouteTableId: refRT,
-		})
+		v.addPrivateIPv6Route(refRT, alphanumericUpperAZ)
 	}
+	return nil
 }
 
 func (v *VPCResourceSet) noNAT() {
 	for _, az := range v.clusterConfig.AvailabilityZones {
-		alphanumericUpperAZ := strings.ToUpper(strings.Join(strings.Split(az, "-"), ""))
-
+		alphanumericUpperAZ := makeAlias(az)
 		refRT := v.rs.newResource("PrivateRouteTable"+alphanumericUpperAZ, &gfnec2.RouteTable{
 			VpcId: v.vpcResource.VPC,
 		})
-		v.rs.newResource("RouteTableAssociationPrivate"+alphanumericUpperAZ, &gfnec2.SubnetRouteTableAssociation{
-			SubnetId:     gfnt.MakeRef("SubnetPrivate" + alphanumericUpperAZ),
-			RouteTableId: refRT,
-		})
+		v.addPrivateIPv6Route(refRT, alphanumericUpperAZ)
 	}
 }
@@ -2,6 +2,7 @@ package builder_test
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -12,6 +13,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder/fakes"
 	"github.com/weaveworks/eksctl/pkg/eks/mocks"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 )
 
@@ -414,6 +416,190 @@ var _ = Describe("VPC Template Builder", func() {
 			})
 		})
 
+		Context("when the IP family is IPv6", func() {
+			BeforeEach(func() {
+				cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+					IPFamily: api.IPV6Family,
+				}
+			})
+
+			It("adds an IPv6 CIDR block to the VPC", func() {
+				Expect(vpcTemplate.Resources).To(HaveKey("AutoAllocatedCIDRv6"))
+				Expect(vpcTemplate.Resources["AutoAllocatedCIDRv6"].Properties.AmazonProvidedIpv6CidrBlock).To(BeTrue())
+				Expect(vpcTemplate.Resources["AutoAllocatedCIDRv6"].Properties.VpcID).To(Equal(makeRef(vpcResourceKey)))
+			})
+
+			It("creates dual-stack subnets that assign IPv6 addresses on creation", func() {
+				for _, subnet := range []string{publicSubnetRef1, publicSubnetRef2, privateSubnetRef1, privateSubnetRef2} {
+					Expect(vpcTemplate.Resources).To(HaveKey(subnet))
+					Expect(vpcTemplate.Resources[subnet].Properties.AssignIpv6AddressOnCreation).To(BeTrue())
+					Expect(vpcTemplate.Resources[subnet].Properties.Ipv6CidrBlock["Fn::Select"]).To(HaveLen(2))
+					Expect(vpcTemplate.Resources[subnet].DependsOn).To(ConsistOf("AutoAllocatedCIDRv6"))
+				}
+				Expect(vpcTemplate.Resources).NotTo(HaveKey("PublicUSWEST2ACIDRv6"))
+				Expect(vpcTemplate.Resources).NotTo(HaveKey("PrivateUSWEST2ACIDRv6"))
+			})
+
+			It("routes the IPv6 traffic of public subnets through the internet gateway", func() {
+				Expect(vpcTemplate.Resources).To(HaveKey("PublicSubnetIPv6Route"))
+				route := vpcTemplate.Resources["PublicSubnetIPv6Route"].Properties
+				Expect(route.RouteTableID).To(Equal(makeRef(pubRouteTable)))
+				Expect(route.DestinationIpv6CidrBlock).To(Equal("::/0"))
+				Expect(route.GatewayID).To(Equal(makeRef(igwKey)))
+			})
+
+			It("routes the IPv6 traffic of private subnets through an egress-only internet gateway", func() {
+				Expect(vpcTemplate.Resources).To(HaveKey("EgressOnlyInternetGateway"))
+				Expect(vpcTemplate.Resources["EgressOnlyInternetGateway"].Properties.VpcID).To(Equal(makeRef(vpcResourceKey)))
+				for _, az := range []string{"USWEST2A", "USWEST2B"} {
+					Expect(vpcTemplate.Resources).To(HaveKey("PrivateSubnetIPv6Route" + az))
+					route := vpcTemplate.Resources["PrivateSubnetIPv6Route"+az].Properties
+					Expect(route.RouteTableID).To(Equal(makeRef("PrivateRouteTable" + az)))
+					Expect(route.DestinationIpv6CidrBlock).To(Equal("::/0"))
+					Expect(route.EgressOnlyInternetGatewayID).To(Equal(makeRef("EgressOnlyInternetGateway")))
+				}
+			})
+		})
+
+		Context("when subnets are named", func() {
+			BeforeEach(func() {
+				*cfg.VPC.NAT.Gateway = api.ClusterHighlyAvailableNAT
+				cfg.VPC.Subnets = &api.ClusterSubnets{
+					Public: api.AZSubnetMapping{
+						"public-a": {AZ: azA, CIDR: ipnet.MustParseCIDR("192.168.0.0/19")},
+						"public-b": {AZ: azB, CIDR: ipnet.MustParseCIDR("192.168.32.0/19")},
+					},
+					Private: api.AZSubnetMapping{
+						"workload-a": {AZ: azA, CIDR: ipnet.MustParseCIDR("192.168.64.0/19")},
+						"database-a": {
+							AZ:   azA,
+							CIDR: ipnet.MustParseCIDR("192.168.96.0/19"),
+							Tags: map[string]string{"tier": "database"},
+						},
+						"workload-b": {AZ: azB, CIDR: ipnet.MustParseCIDR("192.168.128.0/19")},
+					},
+				}
+			})
+
+			It("adds a subnet for each name, in the route table of its AZ", func() {
+				for name, routeTable := range map[string]string{
+					"WORKLOADA": privRouteTableA,
+					"DATABASEA": privRouteTableA,
+					"WORKLOADB": privRouteTableB,
+				} {
+					Expect(vpcTemplate.Resources).To(HaveKey("SubnetPrivate" + name))
+					Expect(vpcTemplate.Resources).To(HaveKey("RouteTableAssociationPrivate" + name))
+					association := vpcTemplate.Resources["RouteTableAssociationPrivate"+name].Properties
+					Expect(association.SubnetID).To(Equal(makeRef("SubnetPrivate" + name)))
+					Expect(association.RouteTableID).To(Equal(makeRef(routeTable)))
+				}
+				Expect(vpcTemplate.Resources["SubnetPrivateDATABASEA"].Properties.AvailabilityZone).To(Equal(azA))
+				Expect(vpcTemplate.Resources["SubnetPrivateDATABASEA"].Properties.CidrBlock).To(Equal("192.168.96.0/19"))
+				Expect(vpcTemplate.Resources).NotTo(HaveKey(privateSubnetRef1))
+				Expect(vpcTemplate.Resources).NotTo(HaveKey(rtaPrivateA))
+			})
+
+			It("adds the tags of the subnet", func() {
+				Expect(vpcTemplate.Resources["SubnetPrivateDATABASEA"].Properties.Tags).To(ContainElement(fakes.Tag{
+					Key:   "tier",
+					Value: "database",
+				}))
+				Expect(vpcTemplate.Resources["SubnetPrivateWORKLOADA"].Properties.Tags).NotTo(ContainElement(fakes.Tag{
+					Key:   "tier",
+					Value: "database",
+				}))
+			})
+
+			It("places the NAT gateways in the public subnets of their AZ", func() {
+				Expect(vpcTemplate.Resources["NATGatewayUSWEST2A"].Properties.SubnetID).To(Equal(makeRef("SubnetPublicPUBLICA")))
+				Expect(vpcTemplate.Resources["NATGatewayUSWEST2B"].Properties.SubnetID).To(Equal(makeRef("SubnetPublicPUBLICB")))
+			})
+
+			Context("the IP family is IPv6", func() {
+				BeforeEach(func() {
+					cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+						IPFamily: api.IPV6Family,
+					}
+				})
+
+				It("adds a single IPv6 route to each private route table", func() {
+					var routes []string
+					for name, resource := range vpcTemplate.Resources {
+						if resource.Properties.DestinationIpv6CidrBlock == "::/0" && resource.Properties.EgressOnlyInternetGatewayID != nil {
+							routes = append(routes, name)
+						}
+					}
+					Expect(routes).To(ConsistOf("PrivateSubnetIPv6RouteUSWEST2A", "PrivateSubnetIPv6RouteUSWEST2B"))
+				})
+
+				It("slices at least one /64 block for each subnet", func() {
+					fnCIDR, err := json.Marshal(vpcTemplate.Resources["SubnetPrivateWORKLOADB"].Properties.Ipv6CidrBlock["Fn::Select"][1])
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fnCIDR).To(MatchJSON(`{ "Fn::Cidr": [{ "Fn::Select": [ 0, { "Fn::GetAtt": ["VPC", "Ipv6CidrBlocks"] }]}, 8, 64 ]}`))
+				})
+
+				Context("there are more than 8 subnets", func() {
+					BeforeEach(func() {
+						for i := 0; i < 8; i++ {
+							cfg.VPC.Subnets.Private[fmt.Sprintf("extra-%d", i)] = api.AZSubnetSpec{
+								AZ:   azA,
+								CIDR: ipnet.MustParseCIDR(fmt.Sprintf("192.168.%d.0/24", 160+i)),
+							}
+						}
+					})
+
+					It("slices a /64 block for each subnet", func() {
+						fnCIDR, err := json.Marshal(vpcTemplate.Resources["SubnetPrivateWORKLOADB"].Properties.Ipv6CidrBlock["Fn::Select"][1])
+						Expect(err).NotTo(HaveOccurred())
+						Expect(fnCIDR).To(MatchJSON(`{ "Fn::Cidr": [{ "Fn::Select": [ 0, { "Fn::GetAtt": ["VPC", "Ipv6CidrBlocks"] }]}, 13, 64 ]}`))
+						Expect(vpcTemplate.Resources["SubnetPrivateWORKLOADB"].Properties.Ipv6CidrBlock["Fn::Select"][0]).To(BeNumerically("==", 12))
+					})
+				})
+			})
+
+			Context("an AZ has no public subnet", func() {
+				BeforeEach(func() {
+					delete(cfg.VPC.Subnets.Public, "public-b")
+				})
+
+				It("returns an error", func() {
+					Expect(addErr).To(MatchError(ContainSubstring("a public subnet is required in us-west-2b for its NAT gateway")))
+				})
+			})
+		})
+
+		Context("when pod subnets are set", func() {
+			BeforeEach(func() {
+				cfg.VPC.PodSubnets = &api.PodSubnets{
+					CIDR: ipnet.MustParseCIDR("100.64.0.0/16"),
+					Subnets: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+						azA: {CIDR: ipnet.MustParseCIDR("100.64.0.0/19")},
+						azB: {CIDR: ipnet.MustParseCIDR("100.64.32.0/19")},
+					}),
+				}
+			})
+
+			It("adds the pod CIDR to the VPC", func() {
+				Expect(vpcTemplate.Resources).To(HaveKey("PodSubnetsCIDR"))
+				Expect(vpcTemplate.Resources["PodSubnetsCIDR"].Properties.CidrBlock).To(Equal("100.64.0.0/16"))
+			})
+
+			It("adds a pod subnet for each AZ, in the private route table of its AZ", func() {
+				for alias, routeTable := range map[string]string{
+					"USWEST2A": privRouteTableA,
+					"USWEST2B": privRouteTableB,
+				} {
+					subnet := vpcTemplate.Resources["SubnetPod"+alias]
+					Expect(subnet.DependsOn).To(ConsistOf("PodSubnetsCIDR"))
+					association := vpcTemplate.Resources["RouteTableAssociationPod"+alias].Properties
+					Expect(association.SubnetID).To(Equal(makeRef("SubnetPod" + alias)))
+					Expect(association.RouteTableID).To(Equal(makeRef(routeTable)))
+				}
+				Expect(vpcTemplate.Resources["SubnetPodUSWEST2B"].Properties.AvailabilityZone).To(Equal(azB))
+				Expect(vpcTemplate.Resources["SubnetPodUSWEST2B"].Properties.CidrBlock).To(Equal("100.64.32.0/19"))
+			})
+		})
+
 		Context("when the vpc is fully private", func() {
 			BeforeEach(func() {
 				cfg.PrivateCluster.Enabled = true
@@ -78,6 +78,9 @@ type StackCollection struct {
 	asgAPI            autoscalingiface.AutoScalingAPI
 	spec              *api.ClusterConfig
 	disableRollback   bool
+	previewChanges    bool
+	confirmChanges    func(stackName string) (bool, error)
+	resumeCreation    bool
 	roleARN           string
 	region            string
 	waitTimeout       time.Duration
@@ -108,6 +111,9 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) *
 		cloudTrailAPI:     provider.CloudTrail(),
 		asgAPI:            provider.ASG(),
 		disableRollback:   provider.CloudFormationDisableRollback(),
+		previewChanges:    provider.CloudFormationPreviewChanges(),
+		confirmChanges:    promptForConfirmation,
+		resumeCreation:    provider.ResumeCreation(),
 		roleARN:           provider.CloudFormationRoleARN(),
 		region:            provider.Region(),
 		waitTimeout:       provider.WaitTimeout(),
@@ -168,6 +174,17 @@ func (c *StackCollection) DoCreateStackRequest(i *Stack, templateData TemplateDa
 // assume completion, do not expect more then one error value on the
 // channel, it's closed immediately after it is written to
 func (c *StackCollection) CreateStack(stackName string, resourceSet builder.ResourceSet, tags, parameters map[string]string, errs chan error) error {
+	if c.resumeCreation {
+		stack, err := c.resumableStack(stackName, true)
+		if err != nil {
+			return err
+		}
+		if stack != nil {
+			go c.reuseStack(stack, resourceSet, errs)
+			return nil
+		}
+	}
+
 	stack, err := c.createStackRequest(stackName, resourceSet, tags, parameters)
 	if err != nil {
 		return err
@@ -179,6 +196,19 @@ func (c *StackCollection) CreateStack(stackName string, resourceSet builder.Reso
 
 // createClusterStack creates the cluster stack
 func (c *StackCollection) createClusterStack(stackName string, resourceSet builder.ResourceSet, errCh chan error) error {
+	if c.resumeCreation {
+		// a failed cluster stack is not retried, no other stacks can have been
+		// created after it so the cluster can be deleted and created again instead
+		stack, err := c.resumableStack(stackName, false)
+		if err != nil {
+			return err
+		}
+		if stack != nil {
+			go c.reuseStack(stack, resourceSet, errCh)
+			return nil
+		}
+	}
+
 	// Unlike with `createNodeGroupTask`, all tags are already set for the cluster stack
 	stack, err := c.createStackRequest(stackName, resourceSet, nil, nil)
 	if err != nil {
@@ -264,6 +294,11 @@ func (c *StackCollection) UpdateStack(stackName, changeSetName, description stri
 		return err
 	}
 	logger.Debug("changes = %#v", changeSet.Changes)
+	if c.previewChanges {
+		if err := c.previewChangeSet(stackName, changeSet); err != nil {
+			return err
+		}
+	}
 	if err := c.doExecuteChangeSet(stackName, changeSetName); err != nil {
 		logger.Warning("error executing Cloudformation changeSet %s in stack %s. Check the Cloudformation console for further details", changeSetName, stackName)
 		return err
@@ -681,7 +716,7 @@ func (c *StackCollection) doExecuteChangeSet(stackName string, changeSetName str
 	return nil
 }
 
-// DescribeStackChangeSet describes a ChangeSet by name
+// DescribeStackChangeSet describes a ChangeSet by name, with the changes of all its pages
 func (c *StackCollection) DescribeStackChangeSet(i *Stack, changeSetName string) (*ChangeSet, error) {
 	input := &cloudformation.DescribeChangeSetInput{
 		StackName:     i.StackName,
@@ -690,9 +725,22 @@ func (c *StackCollection) DescribeStackChangeSet(i *Stack, changeSetName string)
 	if api.IsSetAndNonEmptyString(i.StackId) {
 		input.StackName = i.StackId
 	}
-	resp, err := c.cloudformationAPI.DescribeChangeSet(input)
-	if err != nil {
-		return nil, errors.Wrapf(err, "describing CloudFormation ChangeSet %s for stack %s", changeSetName, *i.StackName)
+	var changeSet *ChangeSet
+	for {
+		resp, err := c.cloudformationAPI.DescribeChangeSet(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing CloudFormation ChangeSet %s for stack %s", changeSetName, *i.StackName)
+		}
+		if changeSet == nil {
+			changeSet = resp
+		} else {
+			changeSet.Changes = append(changeSet.Changes, resp.Changes...)
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
 	}
-	return resp, nil
+	changeSet.NextToken = nil
+	return changeSet, nil
 }
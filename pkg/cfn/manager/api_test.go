@@ -49,6 +49,122 @@ var _ = Describe("StackCollection", func() {
 			err := sm.UpdateStack(stackName, changeSetName, "description", TemplateBody(""), nil)
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("does not execute the changes when the preview is not confirmed", func() {
+			// Order of AWS SDK invocation
+			// 1) DescribeStacks
+			// 2) CreateChangeSet
+			// 3) DescribeChangeSetRequest (until CREATE_COMPLETE)
+			// 4) DescribeChangeSet
+			// 5) DeleteChangeSet
+
+			stackName := "eksctl-stack"
+			changeSetName := "eksctl-changeset"
+			describeInput := &cfn.DescribeStacksInput{StackName: &stackName}
+			describeOutput := &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:   &stackName,
+				StackStatus: aws.String(cfn.StackStatusCreateComplete),
+			}}}
+			describeChangeSetCreateCompleteOutput := &cfn.DescribeChangeSetOutput{
+				StackName:     &stackName,
+				ChangeSetName: &changeSetName,
+				Status:        aws.String(cfn.ChangeSetStatusCreateComplete),
+				Changes: []*cfn.Change{{
+					ResourceChange: &cfn.ResourceChange{
+						Action:            aws.String(cfn.ChangeActionModify),
+						LogicalResourceId: aws.String("NodeGroup"),
+						ResourceType:      aws.String("AWS::EKS::Nodegroup"),
+						Replacement:       aws.String(cfn.ReplacementTrue),
+					},
+				}},
+			}
+			deleteChangeSetInput := &cfn.DeleteChangeSetInput{
+				ChangeSetName: &changeSetName,
+				StackName:     &stackName,
+			}
+
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", describeInput).Return(describeOutput, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+			req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, describeChangeSetCreateCompleteOutput)
+			p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).Return(req, describeChangeSetCreateCompleteOutput)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(describeChangeSetCreateCompleteOutput, nil)
+			p.MockCloudFormation().On("DeleteChangeSet", deleteChangeSetInput).Return(nil, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			sm.previewChanges = true
+			sm.confirmChanges = func(string) (bool, error) { return false, nil }
+			err := sm.UpdateStack(stackName, changeSetName, "description", TemplateBody(""), nil)
+			Expect(err).To(MatchError(`changes to stack "eksctl-stack" were not confirmed`))
+			p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteChangeSet", deleteChangeSetInput)
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything)
+		})
+	})
+
+	It("describes the changes of every page of a ChangeSet", func() {
+		stackName := "eksctl-stack"
+		changeSetName := "eksctl-changeset"
+		change := func(logicalID string) *cfn.Change {
+			return &cfn.Change{ResourceChange: &cfn.ResourceChange{
+				Action:            aws.String(cfn.ChangeActionAdd),
+				LogicalResourceId: aws.String(logicalID),
+			}}
+		}
+
+		p := mockprovider.NewMockProvider()
+		p.MockCloudFormation().On("DescribeChangeSet", &cfn.DescribeChangeSetInput{
+			StackName:     &stackName,
+			ChangeSetName: &changeSetName,
+		}).Return(&cfn.DescribeChangeSetOutput{
+			ChangeSetName: &changeSetName,
+			Changes:       []*cfn.Change{change("NodeGroup")},
+			NextToken:     aws.String("page-2"),
+		}, nil)
+		p.MockCloudFormation().On("DescribeChangeSet", &cfn.DescribeChangeSetInput{
+			StackName:     &stackName,
+			ChangeSetName: &changeSetName,
+			NextToken:     aws.String("page-2"),
+		}).Return(&cfn.DescribeChangeSetOutput{
+			ChangeSetName: &changeSetName,
+			Changes:       []*cfn.Change{change("PolicyTagging")},
+		}, nil)
+
+		sm := NewStackCollection(p, api.NewClusterConfig())
+		changeSet, err := sm.DescribeStackChangeSet(&Stack{StackName: &stackName}, changeSetName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changeSet.NextToken).To(BeNil())
+		Expect(resourceChanges(changeSet)).To(Equal([]resourceChange{
+			{Action: "Add", LogicalID: "NodeGroup", Replacement: "-"},
+			{Action: "Add", LogicalID: "PolicyTagging", Replacement: "-"},
+		}))
+	})
+
+	Context("resourceChanges", func() {
+		It("includes the replacement flag of modified resources", func() {
+			changeSet := &ChangeSet{
+				Changes: []*cfn.Change{
+					{
+						ResourceChange: &cfn.ResourceChange{
+							Action:            aws.String(cfn.ChangeActionModify),
+							LogicalResourceId: aws.String("NodeGroup"),
+							ResourceType:      aws.String("AWS::AutoScaling::AutoScalingGroup"),
+							Replacement:       aws.String(cfn.ReplacementConditional),
+						},
+					},
+					{
+						ResourceChange: &cfn.ResourceChange{
+							Action:            aws.String(cfn.ChangeActionAdd),
+							LogicalResourceId: aws.String("PolicyTagging"),
+							ResourceType:      aws.String("AWS::IAM::Policy"),
+						},
+					},
+				},
+			}
+			Expect(resourceChanges(changeSet)).To(Equal([]resourceChange{
+				{Action: "Modify", LogicalID: "NodeGroup", ResourceType: "AWS::AutoScaling::AutoScalingGroup", Replacement: "Conditional"},
+				{Action: "Add", LogicalID: "PolicyTagging", ResourceType: "AWS::IAM::Policy", Replacement: "-"},
+			}))
+		})
 	})
 
 	It("updates tags (existing + metadata + auto)", func() {
@@ -0,0 +1,101 @@
+package manager
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+// resourceChange describes a change that a ChangeSet will make to a single resource
+type resourceChange struct {
+	Action       string
+	LogicalID    string
+	ResourceType string
+	// Replacement is one of True, Conditional or False, it is only set for modified resources
+	Replacement string
+}
+
+// resourceChanges returns the resource changes of a ChangeSet
+func resourceChanges(changeSet *ChangeSet) []resourceChange {
+	var changes []resourceChange
+	for _, change := range changeSet.Changes {
+		if change.ResourceChange == nil {
+			continue
+		}
+		replacement := aws.StringValue(change.ResourceChange.Replacement)
+		if replacement == "" {
+			replacement = "-"
+		}
+		changes = append(changes, resourceChange{
+			Action:       aws.StringValue(change.ResourceChange.Action),
+			LogicalID:    aws.StringValue(change.ResourceChange.LogicalResourceId),
+			ResourceType: aws.StringValue(change.ResourceChange.ResourceType),
+			Replacement:  replacement,
+		})
+	}
+	return changes
+}
+
+// previewLock makes sure that previews of stacks updated in parallel are not interleaved
+var previewLock sync.Mutex
+
+// previewChangeSet shows the changes to the user and asks for confirmation before the
+// ChangeSet is executed; the ChangeSet is deleted if the changes are not confirmed. The preview
+// is written to stderr, so that it doesn't mix with a plan printed to stdout
+func (c *StackCollection) previewChangeSet(stackName string, changeSet *ChangeSet) error {
+	previewLock.Lock()
+	defer previewLock.Unlock()
+
+	printer := printers.NewTablePrinter().(*printers.TablePrinter)
+	printer.AddColumn("ACTION", func(c resourceChange) string { return c.Action })
+	printer.AddColumn("LOGICAL ID", func(c resourceChange) string { return c.LogicalID })
+	printer.AddColumn("RESOURCE TYPE", func(c resourceChange) string { return c.ResourceType })
+	printer.AddColumn("REPLACEMENT", func(c resourceChange) string { return c.Replacement })
+
+	changeSetName := aws.StringValue(changeSet.ChangeSetName)
+	logger.Info("ChangeSet %q will make the following changes to stack %q", changeSetName, stackName)
+	if err := printer.PrintObjWithKind("changes", resourceChanges(changeSet), os.Stderr); err != nil {
+		return err
+	}
+
+	confirmed, err := c.confirmChanges(stackName)
+	if err != nil {
+		return err
+	}
+	if confirmed {
+		return nil
+	}
+
+	input := &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: &changeSetName,
+		StackName:     &stackName,
+	}
+	if _, err := c.cloudformationAPI.DeleteChangeSet(input); err != nil {
+		logger.Warning("failed to delete ChangeSet %q for stack %q: %v", changeSetName, stackName, err)
+	}
+	return fmt.Errorf("changes to stack %q were not confirmed", stackName)
+}
+
+func promptForConfirmation(stackName string) (bool, error) {
+	fmt.Fprintf(os.Stderr, "Do you want to execute the changes to stack %q? [y/N]: ", stackName)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, errors.Wrap(err, "reading confirmation")
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -142,6 +142,10 @@ func (d *DeleteUnownedNodegroupTask) Describe() string {
 	return d.info
 }
 
+func (d *DeleteUnownedNodegroupTask) Target() tasks.Target {
+	return tasks.Target{Action: tasks.ActionDelete, Resource: fmt.Sprintf("nodegroup/%s", d.nodegroup)}
+}
+
 func (d *DeleteUnownedNodegroupTask) Do() error {
 	out, err := d.eksAPI.DeleteNodegroup(&eks.DeleteNodegroupInput{
 		ClusterName:   &d.cluster,
@@ -271,6 +275,7 @@ func (c *StackCollection) NewTasksToDeleteIAMServiceAccounts(serviceAccounts []s
 		}
 		saTasks.Append(&kubernetesTask{
 			info:       fmt.Sprintf("delete serviceaccount %q", serviceAccount),
+			action:     tasks.ActionDelete,
 			kubernetes: clientSetGetter,
 			objectMeta: meta.AsObjectMeta(),
 			call:       kubernetes.MaybeDeleteServiceAccount,
@@ -0,0 +1,81 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+)
+
+// driftDetectionDelay is the delay between checks of the drift detection status
+var driftDetectionDelay waiter.NextDelay = func(_ int) time.Duration {
+	return 5 * time.Second
+}
+
+// DetectStackDrift runs drift detection on the stack, waits for it to complete and returns
+// the resources that were modified or deleted outside of CloudFormation
+func (c *StackCollection) DetectStackDrift(s *Stack) ([]*cloudformation.StackResourceDrift, error) {
+	stackName := s.StackName
+	if api.IsSetAndNonEmptyString(s.StackId) {
+		stackName = s.StackId
+	}
+
+	output, err := c.cloudformationAPI.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: stackName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "detecting drift of CloudFormation stack %q", *s.StackName)
+	}
+
+	logger.Info("waiting for drift detection of stack %q to complete", *s.StackName)
+	var status *cloudformation.DescribeStackDriftDetectionStatusOutput
+	w := waiter.Waiter{
+		NextDelay: driftDetectionDelay,
+		Operation: func() (bool, error) {
+			status, err = c.cloudformationAPI.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+				StackDriftDetectionId: output.StackDriftDetectionId,
+			})
+			if err != nil {
+				return false, err
+			}
+			return *status.DetectionStatus != cloudformation.StackDriftDetectionStatusDetectionInProgress, nil
+		},
+	}
+	if err := w.WaitWithTimeout(c.waitTimeout); err != nil {
+		if err == context.DeadlineExceeded {
+			return nil, errors.Errorf("timed out waiting for drift detection of stack %q after %s", *s.StackName, c.waitTimeout)
+		}
+		return nil, errors.Wrapf(err, "waiting for drift detection of stack %q", *s.StackName)
+	}
+
+	if *status.DetectionStatus == cloudformation.StackDriftDetectionStatusDetectionFailed {
+		// detection fails when some resources do not support drift detection,
+		// the resources that do support it have still been checked
+		logger.Warning("drift detection of stack %q did not complete: %s", *s.StackName, aws.StringValue(status.DetectionStatusReason))
+	}
+
+	input := &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: stackName,
+		StackResourceDriftStatusFilters: aws.StringSlice([]string{
+			cloudformation.StackResourceDriftStatusModified,
+			cloudformation.StackResourceDriftStatusDeleted,
+		}),
+	}
+
+	var drifts []*cloudformation.StackResourceDrift
+	pager := func(p *cloudformation.DescribeStackResourceDriftsOutput, _ bool) bool {
+		drifts = append(drifts, p.StackResourceDrifts...)
+		return true
+	}
+	if err := c.cloudformationAPI.DescribeStackResourceDriftsPages(input, pager); err != nil {
+		return nil, errors.Wrapf(err, "describing drifted resources of CloudFormation stack %q", *s.StackName)
+	}
+
+	return drifts, nil
+}
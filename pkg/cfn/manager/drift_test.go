@@ -0,0 +1,89 @@
+package manager
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection drift detection", func() {
+	var (
+		p            *mockprovider.MockProvider
+		sm           *StackCollection
+		stack        *Stack
+		originalWait waiter.NextDelay
+	)
+
+	BeforeEach(func() {
+		originalWait = driftDetectionDelay
+		driftDetectionDelay = func(_ int) time.Duration {
+			return 0
+		}
+
+		p = mockprovider.NewMockProvider()
+		sm = NewStackCollection(p, api.NewClusterConfig())
+		stack = &Stack{
+			StackName: aws.String("eksctl-cluster-1-cluster"),
+			StackId:   aws.String("eksctl-cluster-1-cluster-id"),
+		}
+
+		p.MockCloudFormation().On("DetectStackDrift", &cfn.DetectStackDriftInput{
+			StackName: stack.StackId,
+		}).Return(&cfn.DetectStackDriftOutput{StackDriftDetectionId: aws.String("detection-1")}, nil)
+	})
+
+	AfterEach(func() {
+		driftDetectionDelay = originalWait
+	})
+
+	mockDetectionStatus := func(statuses ...string) {
+		for _, status := range statuses {
+			p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything).Return(&cfn.DescribeStackDriftDetectionStatusOutput{
+				DetectionStatus: aws.String(status),
+			}, nil).Once()
+		}
+	}
+
+	It("waits for drift detection to complete and returns the drifted resources", func() {
+		mockDetectionStatus(cfn.StackDriftDetectionStatusDetectionInProgress, cfn.StackDriftDetectionStatusDetectionComplete)
+		p.MockCloudFormation().On("DescribeStackResourceDriftsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			input := args[0].(*cfn.DescribeStackResourceDriftsInput)
+			Expect(aws.StringValueSlice(input.StackResourceDriftStatusFilters)).To(ConsistOf(
+				cfn.StackResourceDriftStatusModified,
+				cfn.StackResourceDriftStatusDeleted,
+			))
+			consume := args[1].(func(p *cfn.DescribeStackResourceDriftsOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStackResourceDriftsOutput{
+				StackResourceDrifts: []*cfn.StackResourceDrift{{
+					LogicalResourceId:        aws.String("ControlPlane"),
+					StackResourceDriftStatus: aws.String(cfn.StackResourceDriftStatusModified),
+				}},
+			}, true)
+		}).Return(nil)
+
+		drifts, err := sm.DetectStackDrift(stack)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(HaveLen(1))
+		Expect(*drifts[0].LogicalResourceId).To(Equal("ControlPlane"))
+		p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStackDriftDetectionStatus", 2)
+	})
+
+	It("returns an error when drift detection cannot be started", func() {
+		p = mockprovider.NewMockProvider()
+		sm = NewStackCollection(p, api.NewClusterConfig())
+		p.MockCloudFormation().On("DetectStackDrift", mock.Anything).Return(nil, errors.New("not allowed"))
+
+		_, err := sm.DetectStackDrift(stack)
+		Expect(err).To(MatchError(ContainSubstring("not allowed")))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStackDriftDetectionStatus", mock.Anything)
+	})
+})
@@ -220,6 +220,19 @@ type FakeStackManager struct {
 		result1 []*cloudformation.Stack
 		result2 error
 	}
+	DetectStackDriftStub        func(*cloudformation.Stack) ([]*cloudformation.StackResourceDrift, error)
+	detectStackDriftMutex       sync.RWMutex
+	detectStackDriftArgsForCall []struct {
+		arg1 *cloudformation.Stack
+	}
+	detectStackDriftReturns struct {
+		result1 []*cloudformation.StackResourceDrift
+		result2 error
+	}
+	detectStackDriftReturnsOnCall map[int]struct {
+		result1 []*cloudformation.StackResourceDrift
+		result2 error
+	}
 	DoCreateStackRequestStub        func(*cloudformation.Stack, manager.TemplateData, map[string]string, map[string]string, bool, bool) error
 	doCreateStackRequestMutex       sync.RWMutex
 	doCreateStackRequestArgsForCall []struct {
@@ -1700,6 +1713,70 @@ func (fake *FakeStackManager) DescribeStacksReturnsOnCall(i int, result1 []*clou
 	}{result1, result2}
 }
 
+func (fake *FakeStackManager) DetectStackDrift(arg1 *cloudformation.Stack) ([]*cloudformation.StackResourceDrift, error) {
+	fake.detectStackDriftMutex.Lock()
+	ret, specificReturn := fake.detectStackDriftReturnsOnCall[len(fake.detectStackDriftArgsForCall)]
+	fake.detectStackDriftArgsForCall = append(fake.detectStackDriftArgsForCall, struct {
+		arg1 *cloudformation.Stack
+	}{arg1})
+	stub := fake.DetectStackDriftStub
+	fakeReturns := fake.detectStackDriftReturns
+	fake.recordInvocation("DetectStackDrift", []interface{}{arg1})
+	fake.detectStackDriftMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) DetectStackDriftCallCount() int {
+	fake.detectStackDriftMutex.RLock()
+	defer fake.detectStackDriftMutex.RUnlock()
+	return len(fake.detectStackDriftArgsForCall)
+}
+
+func (fake *FakeStackManager) DetectStackDriftCalls(stub func(*cloudformation.Stack) ([]*cloudformation.StackResourceDrift, error)) {
+	fake.detectStackDriftMutex.Lock()
+	defer fake.detectStackDriftMutex.Unlock()
+	fake.DetectStackDriftStub = stub
+}
+
+func (fake *FakeStackManager) DetectStackDriftArgsForCall(i int) *cloudformation.Stack {
+	fake.detectStackDriftMutex.RLock()
+	defer fake.detectStackDriftMutex.RUnlock()
+	argsForCall := fake.detectStackDriftArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) DetectStackDriftReturns(result1 []*cloudformation.StackResourceDrift, result2 error) {
+	fake.detectStackDriftMutex.Lock()
+	defer fake.detectStackDriftMutex.Unlock()
+	fake.DetectStackDriftStub = nil
+	fake.detectStackDriftReturns = struct {
+		result1 []*cloudformation.StackResourceDrift
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) DetectStackDriftReturnsOnCall(i int, result1 []*cloudformation.StackResourceDrift, result2 error) {
+	fake.detectStackDriftMutex.Lock()
+	defer fake.detectStackDriftMutex.Unlock()
+	fake.DetectStackDriftStub = nil
+	if fake.detectStackDriftReturnsOnCall == nil {
+		fake.detectStackDriftReturnsOnCall = make(map[int]struct {
+			result1 []*cloudformation.StackResourceDrift
+			result2 error
+		})
+	}
+	fake.detectStackDriftReturnsOnCall[i] = struct {
+		result1 []*cloudformation.StackResourceDrift
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) DoCreateStackRequest(arg1 *cloudformation.Stack, arg2 manager.TemplateData, arg3 map[string]string, arg4 map[string]string, arg5 bool, arg6 bool) error {
 	fake.doCreateStackRequestMutex.Lock()
 	ret, specificReturn := fake.doCreateStackRequestReturnsOnCall[len(fake.doCreateStackRequestArgsForCall)]
@@ -4209,6 +4286,8 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.describeStackEventsMutex.RUnlock()
 	fake.describeStacksMutex.RLock()
 	defer fake.describeStacksMutex.RUnlock()
+	fake.detectStackDriftMutex.RLock()
+	defer fake.detectStackDriftMutex.RUnlock()
 	fake.doCreateStackRequestMutex.RLock()
 	defer fake.doCreateStackRequestMutex.RUnlock()
 	fake.doWaitUntilStackIsCreatedMutex.RLock()
@@ -43,6 +43,7 @@ type StackManager interface {
 	HasClusterStackUsingCachedList(clusterStackNames []string) (bool, error)
 	DescribeStackEvents(i *Stack) ([]*cloudformation.StackEvent, error)
 	LookupCloudTrailEvents(i *Stack) ([]*cloudtrail.Event, error)
+	DetectStackDrift(s *Stack) ([]*cloudformation.StackResourceDrift, error)
 	DescribeStackChangeSet(i *Stack, changeSetName string) (*ChangeSet, error)
 	MakeChangeSetName(action string) string
 	DescribeClusterStack() (*Stack, error)
@@ -0,0 +1,65 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+// resumableStack looks up a stack left behind by an earlier attempt to create the cluster;
+// a stack that was created successfully is returned so that it can be reused, while a stack
+// that failed to be created is deleted, so that it can be created again, if retryFailed is set;
+// nil is returned when the stack needs to be created
+func (c *StackCollection) resumableStack(stackName string, retryFailed bool) (*Stack, error) {
+	stacks, err := c.ListStacksMatching(fmt.Sprintf("^%s$", stackName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "checking for existing stack %q", stackName)
+	}
+	if len(stacks) == 0 {
+		return nil, nil
+	}
+	stack := stacks[0]
+
+	switch status := *stack.StackStatus; status {
+	case cloudformation.StackStatusCreateComplete,
+		cloudformation.StackStatusUpdateComplete,
+		cloudformation.StackStatusUpdateRollbackComplete:
+		logger.Info("stack %q was already created, it will be reused", stackName)
+		return stack, nil
+
+	case cloudformation.StackStatusCreateFailed,
+		cloudformation.StackStatusRollbackComplete,
+		cloudformation.StackStatusRollbackFailed,
+		cloudformation.StackStatusDeleteFailed:
+		if !retryFailed {
+			return nil, fmt.Errorf("stack %q is in %s state and cannot be resumed; delete the cluster and create it again", stackName, status)
+		}
+		logger.Info("stack %q is in %s state, it will be deleted and created again", stackName, status)
+		if _, err := c.DeleteStackBySpec(stack); err != nil {
+			return nil, err
+		}
+		logger.Info("waiting for stack %q to get deleted", stackName)
+		if err := c.doWaitUntilStackIsDeleted(stack); err != nil {
+			return nil, err
+		}
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("stack %q is in %s state; wait for the operation to finish before resuming", stackName, status)
+	}
+}
+
+// reuseStack collects the outputs of a stack that was already created
+func (c *StackCollection) reuseStack(stack *Stack, resourceSet builder.ResourceSet, errs chan error) {
+	defer close(errs)
+
+	if err := resourceSet.GetAllOutputs(*stack); err != nil {
+		errs <- errors.Wrapf(err, "getting stack %q outputs", *stack.StackName)
+		return
+	}
+	errs <- nil
+}
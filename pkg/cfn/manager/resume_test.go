@@ -0,0 +1,123 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+type outputsResourceSet struct {
+	stack *cfn.Stack
+}
+
+func (*outputsResourceSet) AddAllResources() error      { return nil }
+func (*outputsResourceSet) WithIAM() bool               { return false }
+func (*outputsResourceSet) WithNamedIAM() bool          { return false }
+func (*outputsResourceSet) RenderJSON() ([]byte, error) { return []byte("{}"), nil }
+func (r *outputsResourceSet) GetAllOutputs(stack cfn.Stack) error {
+	r.stack = &stack
+	return nil
+}
+
+var _ = Describe("StackCollection resuming creation", func() {
+	const (
+		clusterName = "resumed"
+		stackName   = "eksctl-resumed-nodegroup-ng-1"
+	)
+
+	var (
+		p  *mockprovider.MockProvider
+		sm *StackCollection
+	)
+
+	mockExistingStack := func(status string) *cfn.Stack {
+		stack := &cfn.Stack{
+			StackName:   aws.String(stackName),
+			StackId:     aws.String(stackName + "-id"),
+			StackStatus: aws.String(status),
+			Tags: []*cfn.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String(clusterName)},
+			},
+		}
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.ListStacksOutput{
+				StackSummaries: []*cfn.StackSummary{{StackName: stack.StackName, StackId: stack.StackId}},
+			}, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{stack},
+		}, nil)
+		return stack
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		sm = NewStackCollection(p, cfg)
+		sm.resumeCreation = true
+	})
+
+	It("reuses a stack that was already created", func() {
+		mockExistingStack(cfn.StackStatusCreateComplete)
+
+		resourceSet := &outputsResourceSet{}
+		errs := make(chan error)
+		Expect(sm.CreateStack(stackName, resourceSet, nil, nil, errs)).To(Succeed())
+		Expect(<-errs).NotTo(HaveOccurred())
+
+		Expect(resourceSet.stack).NotTo(BeNil())
+		Expect(*resourceSet.stack.StackName).To(Equal(stackName))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything)
+	})
+
+	It("deletes a stack that failed to be created and creates it again", func() {
+		mockExistingStack(cfn.StackStatusRollbackComplete)
+
+		mockStackStatus := func(stackID, status string) {
+			output := &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:   aws.String(stackName),
+				StackStatus: aws.String(status),
+			}}}
+			req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, output)
+			p.MockCloudFormation().On("DescribeStacksRequest", &cfn.DescribeStacksInput{StackName: aws.String(stackID)}).Return(req, output)
+		}
+		mockStackStatus(stackName+"-id", cfn.StackStatusDeleteComplete)
+		mockStackStatus(stackName+"-new-id", cfn.StackStatusCreateComplete)
+		p.MockCloudFormation().On("DeleteStack", mock.Anything).Return(nil, nil)
+		p.MockCloudFormation().On("CreateStack", mock.Anything).Return(&cfn.CreateStackOutput{
+			StackId: aws.String(stackName + "-new-id"),
+		}, nil)
+
+		errs := make(chan error)
+		Expect(sm.CreateStack(stackName, &outputsResourceSet{}, nil, nil, errs)).To(Succeed())
+		Expect(<-errs).NotTo(HaveOccurred())
+
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteStack", &cfn.DeleteStackInput{StackName: aws.String(stackName + "-id")})
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "CreateStack", mock.Anything)
+	})
+
+	It("does not resume a stack that is still being created", func() {
+		mockExistingStack(cfn.StackStatusCreateInProgress)
+
+		err := sm.CreateStack(stackName, &outputsResourceSet{}, nil, nil, make(chan error, 1))
+		Expect(err).To(MatchError(ContainSubstring("is in CREATE_IN_PROGRESS state")))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything)
+	})
+
+	It("does not retry a cluster stack that failed to be created", func() {
+		mockExistingStack(cfn.StackStatusRollbackComplete)
+
+		err := sm.createClusterStack(stackName, &outputsResourceSet{}, make(chan error, 1))
+		Expect(err).To(MatchError(ContainSubstring("cannot be resumed")))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DeleteStack", mock.Anything)
+	})
+})
@@ -9,6 +9,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
@@ -20,6 +21,10 @@ type createClusterTask struct {
 
 func (t *createClusterTask) Describe() string { return t.info }
 
+func (t *createClusterTask) Target() tasks.Target {
+	return tasks.Target{Action: tasks.ActionCreate, Resource: tasks.StackResource(t.stackCollection.MakeClusterStackName())}
+}
+
 func (t *createClusterTask) Do(errorCh chan error) error {
 	return t.stackCollection.createClusterTask(errorCh, t.supportsManagedNodes)
 }
@@ -33,6 +38,9 @@ type nodeGroupTask struct {
 }
 
 func (t *nodeGroupTask) Describe() string { return t.info }
+func (t *nodeGroupTask) Target() tasks.Target {
+	return tasks.Target{Action: tasks.ActionCreate, Resource: tasks.StackResource(t.stackCollection.makeNodeGroupStackName(t.nodeGroup.Name))}
+}
 func (t *nodeGroupTask) Do(errs chan error) error {
 	return t.stackCollection.createNodeGroupTask(errs, t.nodeGroup, t.forceAddCNIPolicy, t.vpcImporter)
 }
@@ -47,6 +55,10 @@ type managedNodeGroupTask struct {
 
 func (t *managedNodeGroupTask) Describe() string { return t.info }
 
+func (t *managedNodeGroupTask) Target() tasks.Target {
+	return tasks.Target{Action: tasks.ActionCreate, Resource: tasks.StackResource(t.stackCollection.makeNodeGroupStackName(t.nodeGroup.Name))}
+}
+
 func (t *managedNodeGroupTask) Do(errorCh chan error) error {
 	return t.stackCollection.createManagedNodeGroupTask(errorCh, t.nodeGroup, t.forceAddCNIPolicy, t.vpcImporter)
 }
@@ -58,6 +70,10 @@ type clusterCompatTask struct {
 
 func (t *clusterCompatTask) Describe() string { return t.info }
 
+func (t *clusterCompatTask) Target() tasks.Target {
+	return tasks.Target{Action: tasks.ActionUpdate, Resource: tasks.StackResource(t.stackCollection.MakeClusterStackName())}
+}
+
 func (t *clusterCompatTask) Do(errorCh chan error) error {
 	defer close(errorCh)
 	return t.stackCollection.FixClusterCompatibility()
@@ -71,6 +87,10 @@ type taskWithClusterIAMServiceAccountSpec struct {
 }
 
 func (t *taskWithClusterIAMServiceAccountSpec) Describe() string { return t.info }
+func (t *taskWithClusterIAMServiceAccountSpec) Target() tasks.Target {
+	stackName := t.stackCollection.makeIAMServiceAccountStackName(t.serviceAccount.Namespace, t.serviceAccount.Name)
+	return tasks.Target{Action: tasks.ActionCreate, Resource: tasks.StackResource(stackName)}
+}
 func (t *taskWithClusterIAMServiceAccountSpec) Do(errs chan error) error {
 	return t.stackCollection.createIAMServiceAccountTask(errs, t.serviceAccount, t.oidc)
 }
@@ -82,6 +102,9 @@ type taskWithStackSpec struct {
 }
 
 func (t *taskWithStackSpec) Describe() string { return t.info }
+func (t *taskWithStackSpec) Target() tasks.Target {
+	return tasks.Target{Action: tasks.ActionDelete, Resource: tasks.StackResource(*t.stack.StackName)}
+}
 func (t *taskWithStackSpec) Do(errs chan error) error {
 	return t.call(t.stack, errs)
 }
@@ -93,6 +116,9 @@ type asyncTaskWithStackSpec struct {
 }
 
 func (t *asyncTaskWithStackSpec) Describe() string { return t.info + " [async]" }
+func (t *asyncTaskWithStackSpec) Target() tasks.Target {
+	return tasks.Target{Action: tasks.ActionDelete, Resource: tasks.StackResource(*t.stack.StackName)}
+}
 func (t *asyncTaskWithStackSpec) Do(errs chan error) error {
 	_, err := t.call(t.stack)
 	close(errs)
@@ -113,12 +139,16 @@ func (t *asyncTaskWithoutParams) Do(errs chan error) error {
 
 type kubernetesTask struct {
 	info       string
+	action     tasks.Action
 	kubernetes kubewrapper.ClientSetGetter
 	objectMeta v1.ObjectMeta
 	call       func(kubernetes.Interface, v1.ObjectMeta) error
 }
 
 func (t *kubernetesTask) Describe() string { return t.info }
+func (t *kubernetesTask) Target() tasks.Target {
+	return tasks.Target{Action: t.action, Resource: fmt.Sprintf("serviceaccount/%s/%s", t.objectMeta.Namespace, t.objectMeta.Name)}
+}
 func (t *kubernetesTask) Do(errs chan error) error {
 	if t.kubernetes == nil {
 		return fmt.Errorf("cannot start task %q as Kubernetes client configurtaion wasn't provided", t.Describe())
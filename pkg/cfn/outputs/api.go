@@ -19,6 +19,8 @@ const (
 	ClusterSubnetsPrivate       = string("Subnets" + api.SubnetTopologyPrivate)
 	ClusterSubnetsPublic        = string("Subnets" + api.SubnetTopologyPublic)
 	ClusterFullyPrivate         = "ClusterFullyPrivate"
+	ClusterPodSubnetsCIDR       = "PodSubnetsCIDR"
+	ClusterSubnetsPod           = "SubnetsPod"
 
 	ClusterSubnetsPublicLegacy = "Subnets"
 
@@ -29,11 +31,15 @@ const (
 	ClusterSharedNodeSecurityGroup  = "SharedNodeSecurityGroup"
 	ClusterServiceRoleARN           = "ServiceRoleARN"
 	ClusterFeatureNATMode           = "FeatureNATMode"
+	ClusterServiceIPv6CIDR          = "ServiceIPv6CIDR"
 
 	// outputs from nodegroup stack
 	NodeGroupInstanceRoleARN    = "InstanceRoleARN"
 	NodeGroupInstanceProfileARN = "InstanceProfileARN"
 
+	NodeGroupSpotInterruptionQueueURL = "SpotInterruptionQueueURL"
+	NodeGroupSpotInterruptionQueueARN = "SpotInterruptionQueueARN"
+
 	// outputs to indicate configuration attributes that may have critical effect
 	// on critical effect on forward-compatibility with respect to overall functionality
 	// and integrity, e.g. networking
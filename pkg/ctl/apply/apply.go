@@ -0,0 +1,70 @@
+package apply
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/apply"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+// Command will create the `apply` command
+func Command(cmd *cmdutils.Cmd) {
+	applyCmdWithRunFunc(cmd, doApply)
+}
+
+func applyCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, options apply.Options) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options apply.Options
+
+	cmd.SetDescription("apply", "Apply a ClusterConfig to an existing cluster",
+		"Compare the given ClusterConfig against the cluster and create, update or delete nodegroups, managed nodegroups, "+
+			"Fargate profiles, addons, IAM service accounts and identity providers so that the cluster matches the config")
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		if err := cmdutils.NewApplyLoader(cmd).Load(); err != nil {
+			return err
+		}
+		options.Plan = cmd.Plan
+		options.Wait = cmd.Wait
+		options.PlanOutput = cmd.PlanOutput
+		return runFunc(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
+		fs.BoolVar(&options.Prune, "prune", false, "delete resources that exist in the cluster but are not present in the config")
+		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPreviewChangesFlag(fs, &cmd.ProviderConfig)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
+}
+
+func doApply(cmd *cmdutils.Cmd, options apply.Options) error {
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	return apply.New(cfg, ctl, clientSet).Apply(options)
+}
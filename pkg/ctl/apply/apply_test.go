@@ -0,0 +1,80 @@
+package apply
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/apply"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/ctltest"
+)
+
+var _ = Describe("apply", func() {
+	var (
+		configFile string
+		options    apply.Options
+	)
+
+	newMockApplyCmd := func(args ...string) *ctltest.MockCmd {
+		return ctltest.NewMockCmd(func(cmd *cmdutils.Cmd, runFunc func(*cmdutils.Cmd) error) {
+			applyCmdWithRunFunc(cmd, func(cmd *cmdutils.Cmd, o apply.Options) error {
+				options = o
+				return runFunc(cmd)
+			})
+		}, "eksctl", args...)
+	}
+
+	BeforeEach(func() {
+		cfg := &api.ClusterConfig{
+			TypeMeta: api.ClusterConfigTypeMeta(),
+			Metadata: &api.ClusterMeta{
+				Name:   "cluster-1",
+				Region: "us-west-2",
+			},
+		}
+		configFile = ctltest.CreateConfigFile(cfg)
+	})
+
+	AfterEach(func() {
+		os.Remove(configFile)
+	})
+
+	It("runs in plan mode by default", func() {
+		cmd := newMockApplyCmd("apply", "-f", configFile)
+		_, err := cmd.Execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmd.Cmd.ClusterConfig.Metadata.Name).To(Equal("cluster-1"))
+		Expect(options.Plan).To(BeTrue())
+		Expect(options.Prune).To(BeFalse())
+	})
+
+	It("accepts --approve and --prune", func() {
+		cmd := newMockApplyCmd("apply", "-f", configFile, "--approve", "--prune")
+		_, err := cmd.Execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.Plan).To(BeFalse())
+		Expect(options.Prune).To(BeTrue())
+	})
+
+	It("accepts --output for the plan", func() {
+		cmd := newMockApplyCmd("apply", "-f", configFile, "--output", "json")
+		_, err := cmd.Execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.PlanOutput).To(Equal("json"))
+	})
+
+	It("rejects unsupported plan output formats", func() {
+		cmd := newMockApplyCmd("apply", "-f", configFile, "--output", "table")
+		_, err := cmd.Execute()
+		Expect(err).To(MatchError(`unknown plan output type: expected {"json","yaml"} but got "table"`))
+	})
+
+	It("requires a config file", func() {
+		cmd := newMockApplyCmd("apply")
+		_, err := cmd.Execute()
+		Expect(err).To(MatchError("--config-file must be set"))
+	})
+})
@@ -6,6 +6,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
 // Cmd holds attributes that are common between commands;
@@ -16,6 +17,8 @@ type Cmd struct {
 
 	Plan, Wait, Validate bool
 
+	PlanOutput printers.Type
+
 	NameArg string
 
 	ClusterConfigFile string
@@ -92,6 +92,17 @@ func AddApproveFlag(fs *pflag.FlagSet, cmd *Cmd) {
 	})
 }
 
+// AddPlanOutputFlag adds common `--output` flag for printing the plan as a structured document
+func AddPlanOutputFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	fs.StringVarP(&cmd.PlanOutput, "output", "o", "", "print the plan in the given format (valid options: json, yaml)")
+	AddPreRun(cmd.CobraCommand, func(_ *cobra.Command, _ []string) {
+		if cmd.PlanOutput != "" {
+			// log to stderr so the plan can be parsed from stdout
+			logger.Writer = os.Stderr
+		}
+	})
+}
+
 // GetNameArg tests to ensure there is only 1 name argument
 func GetNameArg(args []string) string {
 	if len(args) > 1 {
@@ -121,6 +132,11 @@ func AddCommonFlagsForAWS(group *NamedFlagSetGroup, p *api.ProviderConfig, addCf
 	})
 }
 
+// AddPreviewChangesFlag adds common `--preview-changes` flag for commands that update CloudFormation stacks
+func AddPreviewChangesFlag(fs *pflag.FlagSet, p *api.ProviderConfig) {
+	fs.BoolVar(&p.CloudFormationPreviewChanges, "preview-changes", false, "show the changes to CloudFormation stacks, including resource replacements, and ask for confirmation before executing them")
+}
+
 // AddTimeoutFlagWithValue configures the timeout flag with the provided value.
 func AddTimeoutFlagWithValue(fs *pflag.FlagSet, p *time.Duration, value time.Duration) {
 	fs.DurationVar(p, "timeout", value, "maximum waiting time for any long-running operation")
@@ -17,6 +17,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/utils/names"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 // AddConfigFileFlag adds common --config-file flag
@@ -109,6 +110,10 @@ func (l *commonClusterConfigLoader) Load() error {
 		return err
 	}
 
+	if err := tasks.ValidatePlanOutput(l.PlanOutput); err != nil {
+		return err
+	}
+
 	if l.ClusterConfigFile == "" {
 		if flagName, found := findChangedFlag(l.CobraCommand, l.flagsIncompatibleWithoutConfigFile.List()); found {
 			return errors.Errorf("cannot use --%s unless a config file is specified via --config-file/-f", flagName)
@@ -224,6 +229,7 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 			"auto-kubeconfig",
 			"install-vpc-controllers",
 			"kubeconfig",
+			"resume",
 			"set-kubeconfig-context",
 			"write-kubeconfig",
 		}, commonCreateFlagsIncompatibleWithDryRun...)
@@ -826,6 +832,97 @@ func NewUpdateNodegroupLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewUpgradeNodeGroupLoader will load config or use flags for 'eksctl upgrade nodegroup'; the nodegroup to
+// upgrade is selected with --name even when a config file is used
+func NewUpgradeNodeGroupLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Delete("name")
+
+	l.validateWithoutConfigFile = func() error {
+		if cmd.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet(ClusterNameFlag(cmd))
+		}
+		return nil
+	}
+
+	return l
+}
+
+// NewApplyLoader will load config for 'eksctl apply', which always requires a config file
+func NewApplyLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+
+	return l
+}
+
+// NewUtilsValidateVPCLoader will load config for 'eksctl utils validate-vpc', which requires a config file
+// that uses an existing VPC
+func NewUtilsValidateVPCLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithConfigFile = func() error {
+		cfg := l.ClusterConfig
+		if cfg.VPC == nil || !cfg.HasAnySubnets() {
+			return errors.New("vpc.subnets must be set to validate the subnets of an existing VPC")
+		}
+		if cfg.HasSubnetsForNewVPC() {
+			return errors.New("none of vpc.subnets has an id, they describe the subnets of a VPC that eksctl creates")
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+
+	return l
+}
+
+// NewReplaceNodeGroupLoader will load config for 'eksctl replace nodegroup'; the successor of the nodegroup
+// is defined by the nodegroup with the same name in the config file, or with the name it was originally created with
+func NewReplaceNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Delete("name")
+
+	l.validateWithConfigFile = func() error {
+		if err := validateNameArgument(cmd, ng); err != nil {
+			return err
+		}
+
+		definition := l.ClusterConfig.FindNodegroup(ng.Name)
+		if definition == nil {
+			definition = l.ClusterConfig.FindNodegroup(names.BaseNodeGroupName(ng.Name))
+		}
+		if definition == nil {
+			for _, mng := range l.ClusterConfig.ManagedNodeGroups {
+				if mng.Name == ng.Name {
+					return fmt.Errorf("managed nodegroup %s cannot be replaced, use 'eksctl upgrade nodegroup' instead", ng.Name)
+				}
+			}
+			return fmt.Errorf("node group %s not found", ng.Name)
+		}
+
+		successor := definition.DeepCopy()
+		successor.Name = names.ForSuccessorNodeGroup(ng.Name)
+		l.ClusterConfig.NodeGroups = []*api.NodeGroup{successor}
+		l.ClusterConfig.ManagedNodeGroups = nil
+		l.Plan = false
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+
+	return l
+}
+
 // validateSupportedConfigFields parses a config file's fields, evaluates if non-empty fields are supported,
 // and returns an error if a field is not supported.
 func validateSupportedConfigFields(obj interface{}, supportedFields []string, unsupportedFields []string) ([]string, error) {
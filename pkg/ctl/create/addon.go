@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/pflag"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 func createAddonCmd(cmd *cmdutils.Cmd) {
@@ -38,6 +39,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 	})
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
@@ -84,19 +86,46 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 			return err
 		}
 
-		addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, clientSet, cmd.ProviderConfig.WaitTimeout)
-		if err != nil {
-			return err
-		}
-
+		var eksAddons, clusterAutoscalerAddons []*api.Addon
 		for _, a := range cmd.ClusterConfig.Addons {
-			if force { //force is specified at cmdline level
-				a.Force = true
+			if a.IsClusterAutoscaler() {
+				clusterAutoscalerAddons = append(clusterAutoscalerAddons, a)
+			} else {
+				eksAddons = append(eksAddons, a)
 			}
-			err := addonManager.Create(a, wait)
+		}
+
+		taskTree := &tasks.TaskTree{Parallel: false}
+		if len(eksAddons) > 0 {
+			addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, clientSet, cmd.ProviderConfig.WaitTimeout)
 			if err != nil {
 				return err
 			}
+
+			for _, a := range eksAddons {
+				a := a
+				if force { //force is specified at cmdline level
+					a.Force = true
+				}
+				taskTree.Append(addon.NewAddonTask(tasks.ActionCreate, a, func() error {
+					return addonManager.Create(a, wait)
+				}))
+			}
+		}
+
+		// cluster-autoscaler is deployed by eksctl, after the EKS addons
+		for _, a := range clusterAutoscalerAddons {
+			a := a
+			taskTree.Append(addon.NewAddonTask(tasks.ActionCreate, a, func() error {
+				return addon.CreateClusterAutoscaler(cmd.ClusterConfig, clusterProvider, clientSet, a)
+			}))
+		}
+
+		if err := tasks.PrintPlan(cmd.PlanOutput, taskTree); err != nil {
+			return err
+		}
+		if errs := taskTree.DoAllSync(); len(errs) > 0 {
+			return errs[0]
 		}
 
 		return nil
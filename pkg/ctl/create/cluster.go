@@ -7,19 +7,25 @@ import (
 	"strings"
 
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/weaveworks/eksctl/pkg/kops"
 	"github.com/weaveworks/eksctl/pkg/utils"
 
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/identityproviders"
 
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/clientcmd"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
@@ -71,6 +77,8 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 		fs.BoolVarP(&params.InstallWindowsVPCController, "install-vpc-controllers", "", false, "Install VPC controller that's required for Windows workloads")
 		fs.BoolVarP(&params.Fargate, "fargate", "", false, "Create a Fargate profile scheduling pods in the default and kube-system namespaces onto Fargate")
 		fs.BoolVarP(&params.DryRun, "dry-run", "", false, "Dry-run mode that skips cluster creation and outputs a ClusterConfig")
+		fs.BoolVar(&cmd.ProviderConfig.ResumeCreation, "resume", false, "Resume the creation of a cluster after a partial failure, reusing the resources that were already created")
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("Initial nodegroup", func(fs *pflag.FlagSet) {
@@ -140,6 +148,10 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		}
 	}
 
+	if err := cfg.ValidateIPv6Version(); err != nil {
+		return err
+	}
+
 	if err := cfg.ValidatePrivateCluster(); err != nil {
 		return err
 	}
@@ -191,8 +203,17 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		eks.LogWindowsCompatibility(kubeNodeGroups, cfg.Metadata)
 	}
 
-	if err := createOrImportVPC(cmd, cfg, params, ctl); err != nil {
-		return err
+	var resumed bool
+	if cmd.ProviderConfig.ResumeCreation {
+		if resumed, err = loadResumableCluster(cfg, ctl); err != nil {
+			return err
+		}
+	}
+
+	if !resumed {
+		if err := createOrImportVPC(cmd, cfg, params, ctl); err != nil {
+			return err
+		}
 	}
 
 	nodeGroupService := eks.NewNodeGroupService(ctl.Provider, selector.New(ctl.Provider.Session()))
@@ -205,7 +226,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		return cmdutils.PrintDryRunConfig(cfg, os.Stdout)
 	}
 
-	if err := nodeGroupService.Normalize(nodePools, cfg.Metadata); err != nil {
+	if err := nodeGroupService.Normalize(nodePools, cfg); err != nil {
 		return err
 	}
 
@@ -260,7 +281,12 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		taskTree = stackManager.NewTasksToCreateClusterWithNodeGroups(cfg.NodeGroups, cfg.ManagedNodeGroups, supportsManagedNodes, postClusterCreationTasks)
 	}
 
+	ngTasks := ctl.ClusterTasksForNodeGroups(cfg, params.InstallNeuronDevicePlugin, params.InstallNvidiaDevicePlugin)
+
 	logger.Info(taskTree.Describe())
+	if err := tasks.PrintPlan(cmd.PlanOutput, taskTree, ngTasks, postNodegroupAddons); err != nil {
+		return err
+	}
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		logger.Warning("%d error(s) occurred and cluster hasn't been created properly, you may wish to check CloudFormation console", len(errs))
 		logger.Info("to cleanup resources, run 'eksctl delete cluster --region=%s --name=%s'", meta.Region, meta.Name)
@@ -295,8 +321,6 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			params.KubeconfigPath = ""
 		}
 
-		ngTasks := ctl.ClusterTasksForNodeGroups(cfg, params.InstallNeuronDevicePlugin, params.InstallNvidiaDevicePlugin)
-
 		logger.Info(ngTasks.Describe())
 		if errs := ngTasks.DoAllSync(); len(errs) > 0 {
 			logger.Warning("%d error(s) occurred and post actions have failed, you may wish to check CloudFormation console", len(errs))
@@ -410,7 +434,11 @@ func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmduti
 		if err := vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones); err != nil {
 			return err
 		}
-		return nil
+		return vpc.SetPodSubnets(cfg.VPC, cfg.AvailabilityZones)
+	}
+
+	if cfg.HasCustomNetworking() && (params.KopsClusterNameForVPC != "" || !cfg.HasSubnetsForNewVPC()) {
+		return errors.New("vpc.podSubnets is only supported in a VPC created by eksctl")
 	}
 
 	if params.KopsClusterNameForVPC != "" {
@@ -448,6 +476,24 @@ func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmduti
 		return nil
 	}
 
+	if cfg.HasSubnetsForNewVPC() {
+		// create a dedicated VPC with the subnets set in the config file
+		if len(params.AvailabilityZones) != 0 {
+			return fmt.Errorf("vpc.subnets and --zones %s", cmdutils.IncompatibleFlags)
+		}
+		zones, err := vpc.UseSubnetsForNewVPC(cfg.VPC)
+		if err != nil {
+			return err
+		}
+		if err := cfg.HasSufficientSubnets(); err != nil {
+			return err
+		}
+		if err := ctl.SetAvailabilityZones(cfg, zones); err != nil {
+			return err
+		}
+		return vpc.SetPodSubnets(cfg.VPC, cfg.AvailabilityZones)
+	}
+
 	// use subnets as specified by --vpc-{private,public}-subnets flags
 
 	if len(params.AvailabilityZones) != 0 {
@@ -479,6 +525,79 @@ func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmduti
 	return nil
 }
 
+// loadResumableCluster inspects the stacks left behind by an earlier attempt to create the cluster;
+// when the cluster stack was created, the VPC is loaded from it and the addons and identity providers
+// that already exist are skipped, completed stacks are reused and failed ones are created again later
+func loadResumableCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider) (bool, error) {
+	stackManager := ctl.NewStackManager(cfg)
+	stacks, err := stackManager.ListStacks()
+	if err != nil {
+		return false, errors.Wrap(err, "listing existing stacks")
+	}
+
+	var clusterStack *manager.Stack
+	for _, s := range stacks {
+		logger.Info("found stack %q in %s state", *s.StackName, *s.StackStatus)
+		if *s.StackName == stackManager.MakeClusterStackName() {
+			clusterStack = s
+		}
+	}
+	if clusterStack == nil {
+		logger.Info("no cluster stack found for %q, nothing to resume", cfg.Metadata.Name)
+		return false, nil
+	}
+
+	switch *clusterStack.StackStatus {
+	case cfn.StackStatusCreateComplete, cfn.StackStatusUpdateComplete, cfn.StackStatusUpdateRollbackComplete:
+	default:
+		return false, fmt.Errorf("cluster stack %q is in %s state and cannot be resumed; to cleanup resources, run 'eksctl delete cluster --region=%s --name=%s'",
+			*clusterStack.StackName, *clusterStack.StackStatus, cfg.Metadata.Region, cfg.Metadata.Name)
+	}
+
+	if err := ctl.LoadClusterVPC(cfg, stackManager); err != nil {
+		return false, err
+	}
+	logger.Success("using %s from cluster stack %q", cfg.SubnetInfo(), *clusterStack.StackName)
+
+	existingAddons, err := ctl.Provider.EKS().ListAddons(&awseks.ListAddonsInput{
+		ClusterName: &cfg.Metadata.Name,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "listing addons")
+	}
+	existingAddonNames := sets.NewString(aws.StringValueSlice(existingAddons.Addons)...)
+	var addons []*api.Addon
+	for _, a := range cfg.Addons {
+		if existingAddonNames.Has(a.Name) {
+			logger.Info("addon %q already exists, it will not be created", a.Name)
+			continue
+		}
+		addons = append(addons, a)
+	}
+	cfg.Addons = addons
+
+	idpManager := identityproviders.NewManager(*cfg.Metadata, ctl.Provider.EKS())
+	existingIdentityProviders, err := idpManager.Get(identityproviders.GetIdentityProvidersOptions{})
+	if err != nil {
+		return false, errors.Wrap(err, "listing identity providers")
+	}
+	existingIdentityProviderNames := sets.NewString()
+	for _, idp := range existingIdentityProviders {
+		existingIdentityProviderNames.Insert(idp.Name)
+	}
+	var identityProviders []api.IdentityProvider
+	for _, idp := range cfg.IdentityProviders {
+		if oidc, ok := idp.Inner.(*api.OIDCIdentityProvider); ok && existingIdentityProviderNames.Has(oidc.Name) {
+			logger.Info("identity provider %q is already associated, it will not be associated again", oidc.Name)
+			continue
+		}
+		identityProviders = append(identityProviders, idp)
+	}
+	cfg.IdentityProviders = identityProviders
+
+	return true, nil
+}
+
 func checkSubnetsGivenAsFlags(params *cmdutils.CreateClusterCmdParams) bool {
 	return len(*params.Subnets[api.SubnetTopologyPrivate])+len(*params.Subnets[api.SubnetTopologyPublic]) != 0
 }
@@ -162,6 +162,7 @@ var _ = Describe("create cluster", func() {
 			Entry("with full-ecr-access flag", "--full-ecr-access", "true"),
 			Entry("with appmesh-access flag", "--appmesh-access", "true"),
 			Entry("with alb-ingress-access flag", "--alb-ingress-access", "true"),
+			Entry("with resume flag", "--resume"),
 		)
 
 		DescribeTable("invalid flags or arguments",
@@ -192,6 +193,10 @@ var _ = Describe("create cluster", func() {
 				args:  []string{"--name=test", "--enable-ssm=false"},
 				error: "SSM agent is now built into EKS AMIs and cannot be disabled",
 			}),
+			Entry("with resume and dry-run flags", invalidParamsCase{
+				args:  []string{"--resume", "--dry-run"},
+				error: "cannot use --resume with --dry-run",
+			}),
 		)
 	})
 })
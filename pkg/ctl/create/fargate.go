@@ -39,6 +39,7 @@ func doCreateFargateProfile(cmd *cmdutils.Cmd) error {
 	cmdutils.LogRegionAndVersionInfo(cmd.ClusterConfig.Metadata)
 
 	manager := actionsfargate.New(cmd.ClusterConfig, ctl, ctl.NewStackManager(cmd.ClusterConfig))
+	manager.SetPlanOutput(cmd.PlanOutput)
 	return manager.Create()
 }
 
@@ -52,6 +53,8 @@ func configureCreateFargateProfileCmd(cmd *cmdutils.Cmd) *fargate.CreateOptions
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPreviewChangesFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 	})
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 	return &options
@@ -10,6 +10,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 func deleteAddonCmd(cmd *cmdutils.Cmd) {
@@ -31,6 +32,7 @@ func deleteAddonCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddClusterFlag(fs, cmd.ClusterConfig.Metadata)
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 	})
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
@@ -63,15 +65,38 @@ func deleteAddon(cmd *cmdutils.Cmd, preserve bool) error {
 	logger.Info("Kubernetes version %q in use by cluster %q", *output.Cluster.Version, cmd.ClusterConfig.Metadata.Name)
 	cmd.ClusterConfig.Metadata.Version = *output.Cluster.Version
 
-	addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, *cmd.ClusterConfig.IAM.WithOIDC, nil, nil, cmd.ProviderConfig.WaitTimeout)
+	a := cmd.ClusterConfig.Addons[0]
+	taskTree := &tasks.TaskTree{Parallel: false}
+	if a.IsClusterAutoscaler() {
+		// cluster-autoscaler is deployed by eksctl rather than EKS
+		if preserve {
+			return fmt.Errorf("--preserve is not supported for %s, which is not an EKS addon", api.ClusterAutoscalerAddon)
+		}
+		clientSet, err := clusterProvider.NewStdClientSet(cmd.ClusterConfig)
+		if err != nil {
+			return err
+		}
+		taskTree.Append(addon.NewAddonTask(tasks.ActionDelete, a, func() error {
+			return addon.DeleteClusterAutoscaler(cmd.ClusterConfig, clusterProvider, clientSet)
+		}))
+	} else {
+		addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, *cmd.ClusterConfig.IAM.WithOIDC, nil, nil, cmd.ProviderConfig.WaitTimeout)
+		if err != nil {
+			return err
+		}
+		taskTree.Append(addon.NewAddonTask(tasks.ActionDelete, a, func() error {
+			if preserve {
+				return addonManager.DeleteWithPreserve(a)
+			}
+			return addonManager.Delete(a)
+		}))
+	}
 
-	if err != nil {
+	if err := tasks.PrintPlan(cmd.PlanOutput, taskTree); err != nil {
 		return err
 	}
-
-	if preserve {
-		return addonManager.DeleteWithPreserve(cmd.ClusterConfig.Addons[0])
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		return errs[0]
 	}
-
-	return addonManager.Delete(cmd.ClusterConfig.Addons[0])
+	return nil
 }
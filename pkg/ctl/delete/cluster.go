@@ -35,6 +35,7 @@ func deleteClusterCmd(cmd *cmdutils.Cmd) {
 
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
@@ -70,5 +71,6 @@ func doDeleteCluster(cmd *cmdutils.Cmd, force bool) error {
 		return err
 	}
 
+	cluster.SetPlanOutput(cmd.PlanOutput)
 	return cluster.Delete(time.Second*20, cmd.Wait, force)
 }
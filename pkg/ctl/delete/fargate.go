@@ -9,6 +9,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 func deleteFargateProfileWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, opts *fargate.Options) error) {
@@ -45,6 +46,7 @@ func configureDeleteFargateProfileCmd(cmd *cmdutils.Cmd) *fargate.Options {
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "wait for the deletion of the Fargate profile, which may take from a couple seconds to a couple minutes.")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 	})
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 	return &opts
@@ -66,13 +68,28 @@ func doDeleteFargateProfile(cmd *cmdutils.Cmd, opts *fargate.Options) error {
 
 	clusterName := cmd.ClusterConfig.Metadata.Name
 	manager := fargate.NewFromProvider(clusterName, ctl.Provider, ctl.NewStackManager(cmd.ClusterConfig))
+	taskTree := &tasks.TaskTree{Parallel: false}
+	taskTree.Append(&tasks.TargetedTask{
+		GenericTask: tasks.GenericTask{
+			Description: deletingFargateProfileMsg(clusterName, opts.ProfileName),
+			Doer: func() error {
+				return manager.DeleteProfile(opts.ProfileName, cmd.Wait)
+			},
+		},
+		Action:   tasks.ActionDelete,
+		Resource: "fargateprofile/" + opts.ProfileName,
+	})
+	if err := tasks.PrintPlan(cmd.PlanOutput, taskTree); err != nil {
+		return err
+	}
+
 	if cmd.Wait {
 		logger.Info(deletingFargateProfileMsg(clusterName, opts.ProfileName))
 	} else {
 		logger.Debug(deletingFargateProfileMsg(clusterName, opts.ProfileName))
 	}
-	if err := manager.DeleteProfile(opts.ProfileName, cmd.Wait); err != nil {
-		return err
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		return errs[0]
 	}
 	logger.Info("deleted Fargate profile %q on EKS cluster %q", opts.ProfileName, clusterName)
 	return nil
@@ -47,6 +47,7 @@ func deleteIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *
 		cmdutils.AddIAMServiceAccountFilterFlags(fs, &cmd.Include, &cmd.Exclude)
 		fs.BoolVar(&onlyMissing, "only-missing", false, "Only delete iamserviceaccounts that are not defined in the given config file")
 		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 
@@ -113,6 +114,7 @@ func doDeleteIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAM
 	saSubset, _ := saFilter.MatchAll(cfg.IAM.ServiceAccounts)
 
 	irsaManager := irsa.New(cfg.Metadata.Name, stackManager, oidc, clientSet)
+	irsaManager.SetPlanOutput(cmd.PlanOutput)
 
 	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
 		return err
@@ -44,6 +44,7 @@ func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cm
 		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup to delete")
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude)
 		fs.BoolVar(&onlyMissing, "only-missing", false, "Only delete nodegroups that are not defined in the given config file")
 		cmdutils.AddUpdateAuthConfigMap(fs, &updateAuthConfigMap, "Remove nodegroup IAM role from aws-auth configmap")
@@ -119,8 +120,15 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 	allNodeGroups := cmdutils.ToKubeNodeGroups(cfg)
 
 	nodeGroupManager := nodegroup.New(cfg, ctl, clientSet)
+	nodeGroupManager.SetPlanOutput(cmd.PlanOutput)
 	if deleteNodeGroupDrain {
-		err := nodeGroupManager.Drain(allNodeGroups, cmd.Plan, maxGracePeriod, disableEviction)
+		err := nodeGroupManager.Drain(&nodegroup.DrainInput{
+			NodeGroups:      allNodeGroups,
+			Plan:            cmd.Plan,
+			MaxGracePeriod:  maxGracePeriod,
+			DisableEviction: disableEviction,
+			Parallel:        1,
+		})
 		if err != nil {
 			return err
 		}
@@ -1,39 +1,65 @@
 package drain
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 
 	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/labels"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 )
 
+// drainNodeGroupOptions are the options of the drain nodegroup command
+type drainNodeGroupOptions struct {
+	nodegroup.DrainInput
+	onlyMissing bool
+	// drainFlags are the drain settings given as flags
+	drainFlags api.DrainConfig
+}
+
 func drainNodeGroupCmd(cmd *cmdutils.Cmd) {
-	drainNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error {
-		return doDrainNodeGroup(cmd, ng, undo, onlyMissing, maxGracePeriod, disableEviction)
+	drainNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, options drainNodeGroupOptions) error {
+		return doDrainNodeGroup(cmd, ng, options)
 	})
 }
 
-func drainNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error) {
+func drainNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, options drainNodeGroupOptions) error) {
 	cfg := api.NewClusterConfig()
 	ng := api.NewNodeGroup()
 	cmd.ClusterConfig = cfg
 
-	var undo, onlyMissing bool
-	var maxGracePeriod time.Duration
-	var disableEviction bool
+	var options drainNodeGroupOptions
+	var forceDeleteMinutes int
 
 	cmd.SetDescription("nodegroup", "Cordon and drain a nodegroup", "", "ng")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, ng, undo, onlyMissing, maxGracePeriod, disableEviction)
+		if options.Parallel < 1 {
+			return fmt.Errorf("--parallel must be at least 1")
+		}
+		if options.NodeSelector.LabelSelector != "" {
+			if _, err := labels.Parse(options.NodeSelector.LabelSelector); err != nil {
+				return errors.Wrap(err, "invalid --node-selector")
+			}
+		}
+		if options.drainFlags.SkipPodSelector != "" {
+			if _, err := labels.Parse(options.drainFlags.SkipPodSelector); err != nil {
+				return errors.Wrap(err, "invalid --skip-pod-selector")
+			}
+		}
+		if forceDeleteMinutes > 0 {
+			options.drainFlags.ForceDeletePodsOlderThanMinutes = &forceDeleteMinutes
+		}
+		return runFunc(cmd, ng, options)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -43,19 +69,29 @@ func drainNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddApproveFlag(fs, cmd)
 		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude)
-		fs.BoolVar(&onlyMissing, "only-missing", false, "Only drain nodegroups that are not defined in the given config file")
-		fs.BoolVar(&undo, "undo", false, "Uncordon the nodegroup")
+		fs.BoolVar(&options.onlyMissing, "only-missing", false, "Only drain nodegroups that are not defined in the given config file")
+		fs.BoolVar(&options.Undo, "undo", false, "Uncordon the nodegroup")
 		defaultMaxGracePeriod, _ := time.ParseDuration("10m")
-		fs.DurationVar(&maxGracePeriod, "max-grace-period", defaultMaxGracePeriod, "Maximum pods termination grace period")
+		fs.DurationVar(&options.MaxGracePeriod, "max-grace-period", defaultMaxGracePeriod, "Maximum pods termination grace period")
 		defaultDisableEviction := false
-		fs.BoolVar(&disableEviction, "disable-eviction", defaultDisableEviction, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+		fs.BoolVar(&options.DisableEviction, "disable-eviction", defaultDisableEviction, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+		fs.IntVar(&options.Parallel, "parallel", 1, "Number of nodes to drain at once")
+		fs.StringVar(&options.drainFlags.SkipPodSelector, "skip-pod-selector", "", "Label selector of pods that are not evicted")
+		fs.StringSliceVar(&options.drainFlags.SkipNamespaces, "skip-namespaces", nil, "Namespaces whose pods are not evicted")
+		fs.IntVar(&forceDeleteMinutes, "force-delete-pods-older-than-minutes", 0, "Delete rather than evict pods that have been running for longer than the given number of minutes, bypassing PodDisruptionBudgets")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
+	cmd.FlagSetGroup.InFlagSet("Node selection", func(fs *pflag.FlagSet) {
+		fs.StringVar(&options.NodeSelector.LabelSelector, "node-selector", "", "Only drain the nodes matching this label selector")
+		fs.StringVar(&options.NodeSelector.AvailabilityZone, "availability-zone", "", "Only drain the nodes in this availability zone")
+		fs.StringSliceVar(&options.NodeSelector.InstanceIDs, "instance-ids", nil, "Only drain the nodes of these EC2 instances")
+	})
+
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error {
+func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, options drainNodeGroupOptions) error {
 	ngFilter := filter.NewNodeGroupFilter()
 
 	if err := cmdutils.NewDeleteNodeGroupLoader(cmd, ng, ngFilter).Load(); err != nil {
@@ -63,6 +99,7 @@ func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bo
 	}
 
 	cfg := cmd.ClusterConfig
+	mergeDrainFlags(cfg, &options.drainFlags)
 
 	ctl, err := cmd.NewCtl()
 	if err != nil {
@@ -83,7 +120,7 @@ func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bo
 
 	if cmd.ClusterConfigFile != "" {
 		logger.Info("comparing %d nodegroups defined in the given config (%q) against remote state", len(cfg.NodeGroups), cmd.ClusterConfigFile)
-		if onlyMissing {
+		if options.onlyMissing {
 			err = ngFilter.SetOnlyRemote(ctl.Provider.EKS(), stackManager, cfg)
 			if err != nil {
 				return err
@@ -99,7 +136,7 @@ func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bo
 	logFiltered := cmdutils.ApplyFilter(cfg, ngFilter)
 
 	verb := "drain"
-	if undo {
+	if options.Undo {
 		verb = "uncordon"
 	}
 
@@ -116,7 +153,26 @@ func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bo
 	if cmd.Plan {
 		return nil
 	}
-	allNodeGroups := cmdutils.ToKubeNodeGroups(cfg)
+	options.NodeGroups = cmdutils.ToKubeNodeGroups(cfg)
+	options.Plan = cmd.Plan
+	return nodegroup.New(cfg, ctl, clientSet).Drain(&options.DrainInput)
+}
 
-	return nodegroup.New(cfg, ctl, clientSet).Drain(allNodeGroups, cmd.Plan, maxGracePeriod, disableEviction)
+// mergeDrainFlags sets the drain settings given as flags, overriding those of the config file
+func mergeDrainFlags(cfg *api.ClusterConfig, drainFlags *api.DrainConfig) {
+	if drainFlags.SkipPodSelector == "" && len(drainFlags.SkipNamespaces) == 0 && drainFlags.ForceDeletePodsOlderThanMinutes == nil {
+		return
+	}
+	if cfg.Drain == nil {
+		cfg.Drain = &api.DrainConfig{}
+	}
+	if drainFlags.SkipPodSelector != "" {
+		cfg.Drain.SkipPodSelector = drainFlags.SkipPodSelector
+	}
+	if len(drainFlags.SkipNamespaces) > 0 {
+		cfg.Drain.SkipNamespaces = drainFlags.SkipNamespaces
+	}
+	if drainFlags.ForceDeletePodsOlderThanMinutes != nil {
+		cfg.Drain.ForceDeletePodsOlderThanMinutes = drainFlags.ForceDeletePodsOlderThanMinutes
+	}
 }
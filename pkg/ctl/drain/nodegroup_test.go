@@ -2,8 +2,8 @@ package drain
 
 import (
 	"fmt"
-	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -24,7 +24,7 @@ var _ = Describe("drain node group", func() {
 			cmd := newMockEmptyCmd(args...)
 			count := 0
 			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-				drainNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, undo, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error {
+				drainNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, options drainNodeGroupOptions) error {
 					Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("clusterName"))
 					Expect(ng.Name).To(Equal("ng"))
 					count++
@@ -37,6 +37,9 @@ var _ = Describe("drain node group", func() {
 		},
 		Entry("with valid details", "nodegroup", "--cluster", "clusterName", "--name", "ng"),
 		Entry("with deprecated flag --only", "nodegroup", "--cluster", "clusterName", "--name", "ng", "--only", "ng"),
+		Entry("with --parallel", "nodegroup", "--cluster", "clusterName", "--name", "ng", "--parallel", "3"),
+		Entry("with node selection", "nodegroup", "--cluster", "clusterName", "--name", "ng", "--node-selector", "role=worker", "--availability-zone", "us-west-2a", "--instance-ids", "i-1,i-2"),
+		Entry("with pod filters", "nodegroup", "--cluster", "clusterName", "--name", "ng", "--skip-pod-selector", "app=web", "--skip-namespaces", "monitoring,logging", "--force-delete-pods-older-than-minutes", "30"),
 	)
 
 	DescribeTable("invalid flags or arguments",
@@ -54,5 +57,53 @@ var _ = Describe("drain node group", func() {
 			args:  []string{"nodegroup", "ng", "--cluster", "dummy", "--name", "ng"},
 			error: fmt.Errorf("Error: --name=ng and argument ng cannot be used at the same time"),
 		}),
+		Entry("setting an invalid --node-selector", invalidParamsCase{
+			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng", "--node-selector", "role in worker"},
+			error: fmt.Errorf("Error: invalid --node-selector"),
+		}),
+		Entry("setting an invalid --skip-pod-selector", invalidParamsCase{
+			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng", "--skip-pod-selector", "app in web"},
+			error: fmt.Errorf("Error: invalid --skip-pod-selector"),
+		}),
+		Entry("setting --parallel to less than 1", invalidParamsCase{
+			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng", "--parallel", "0"},
+			error: fmt.Errorf("Error: --parallel must be at least 1"),
+		}),
 	)
+
+	It("loads the options", func() {
+		cmd := newMockEmptyCmd("nodegroup", "--cluster", "clusterName", "--name", "ng", "--undo", "--parallel", "2",
+			"--availability-zone", "us-west-2a", "--instance-ids", "i-1,i-2", "--skip-namespaces", "monitoring")
+		var options drainNodeGroupOptions
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+			drainNodeGroupWithRunFunc(cmd, func(_ *cmdutils.Cmd, _ *v1alpha5.NodeGroup, o drainNodeGroupOptions) error {
+				options = o
+				return nil
+			})
+		})
+		_, err := cmd.execute()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(options.Undo).To(BeTrue())
+		Expect(options.Parallel).To(Equal(2))
+		Expect(options.NodeSelector.AvailabilityZone).To(Equal("us-west-2a"))
+		Expect(options.NodeSelector.InstanceIDs).To(Equal([]string{"i-1", "i-2"}))
+		Expect(options.drainFlags.SkipNamespaces).To(Equal([]string{"monitoring"}))
+	})
+
+	It("overrides the drain settings of the config file with the flags", func() {
+		cfg := v1alpha5.NewClusterConfig()
+		cfg.Drain = &v1alpha5.DrainConfig{
+			SkipPodSelector: "app=db",
+			SkipNamespaces:  []string{"kube-system"},
+			PreDrainHooks:   []v1alpha5.DrainHook{{URL: "https://approvals.example.com"}},
+		}
+
+		mergeDrainFlags(cfg, &v1alpha5.DrainConfig{SkipPodSelector: "app=web", ForceDeletePodsOlderThanMinutes: aws.Int(30)})
+
+		Expect(cfg.Drain.SkipPodSelector).To(Equal("app=web"))
+		Expect(cfg.Drain.SkipNamespaces).To(Equal([]string{"kube-system"}))
+		Expect(*cfg.Drain.ForceDeletePodsOlderThanMinutes).To(Equal(30))
+		Expect(cfg.Drain.PreDrainHooks).To(HaveLen(1))
+	})
 })
@@ -12,6 +12,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
@@ -80,13 +81,29 @@ func getAddon(cmd *cmdutils.Cmd, params *getCmdParams) error {
 	}
 
 	var summaries []addon.Summary
-	if cmd.ClusterConfig.Addons[0].Name == "" {
+	if a := cmd.ClusterConfig.Addons[0]; a.Name == "" {
 		summaries, err = addonManager.GetAll()
 		if err != nil {
 			return err
 		}
+		// cluster-autoscaler is deployed by eksctl rather than EKS, so it can only be listed with access to the cluster
+		summary, ok, err := getClusterAutoscaler(cmd.ClusterConfig, clusterProvider)
+		if err != nil {
+			logger.Warning("unable to check whether %s is deployed: %v", api.ClusterAutoscalerAddon, err)
+		} else if ok {
+			summaries = append(summaries, summary)
+		}
+	} else if a.IsClusterAutoscaler() {
+		summary, ok, err := getClusterAutoscaler(cmd.ClusterConfig, clusterProvider)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%s is not deployed to cluster %q", api.ClusterAutoscalerAddon, cmd.ClusterConfig.Metadata.Name)
+		}
+		summaries = []addon.Summary{summary}
 	} else {
-		summary, err := addonManager.Get(cmd.ClusterConfig.Addons[0])
+		summary, err := addonManager.Get(a)
 		summaries = []addon.Summary{summary}
 		if err != nil {
 			return err
@@ -124,6 +141,14 @@ func getAddon(cmd *cmdutils.Cmd, params *getCmdParams) error {
 	return nil
 }
 
+func getClusterAutoscaler(cfg *api.ClusterConfig, clusterProvider *eks.ClusterProvider) (addon.Summary, bool, error) {
+	clientSet, err := clusterProvider.NewStdClientSet(cfg)
+	if err != nil {
+		return addon.Summary{}, false, err
+	}
+	return addon.GetClusterAutoscaler(cfg, clusterProvider, clientSet)
+}
+
 func addAddonSummaryTableColumns(printer *printers.TablePrinter) {
 	printer.AddColumn("NAME", func(s addon.Summary) string {
 		return s.Name
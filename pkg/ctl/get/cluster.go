@@ -9,6 +9,7 @@ import (
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/weaveworks/eksctl/pkg/actions/export"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/printers"
 
@@ -23,7 +24,10 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
-	var listAllRegions bool
+	var (
+		listAllRegions bool
+		full           bool
+	)
 
 	params := &getCmdParams{}
 
@@ -31,12 +35,13 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doGetCluster(cmd, params, listAllRegions)
+		return doGetCluster(cmd, params, listAllRegions, full)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&cfg.Metadata.Name, "name", "n", "", "EKS cluster name")
 		fs.BoolVarP(&listAllRegions, "all-regions", "A", false, "List clusters across all supported regions")
+		fs.BoolVar(&full, "full", false, "Export the full ClusterConfig of the cluster, including nodegroups, Fargate profiles, addons, iamserviceaccounts and identity providers")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
@@ -45,10 +50,19 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
-func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool) error {
+func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions, full bool) error {
 	cfg := cmd.ClusterConfig
 	regionGiven := cfg.Metadata.Region != "" // eks.New resets this field, so we need to check if it was set in the first place
 
+	if full {
+		if cfg.Metadata.Name == "" && cmd.NameArg == "" {
+			return fmt.Errorf("--full requires a cluster name")
+		}
+		if params.output == printers.TableType {
+			return fmt.Errorf("--full requires --output=yaml or --output=json")
+		}
+	}
+
 	ctl, err := cmd.NewCtl()
 	if err != nil {
 		return err
@@ -83,6 +97,10 @@ func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool)
 		return getAndPrinterClusters(ctl, params, listAllRegions)
 	}
 
+	if full {
+		return exportAndPrintCluster(cfg, ctl, params)
+	}
+
 	return getAndPrintCluster(cfg, ctl, params)
 }
 
@@ -135,6 +153,20 @@ func getAndPrintCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, params
 	return printer.PrintObjWithKind("clusters", []*awseks.Cluster{cluster}, os.Stdout)
 }
 
+func exportAndPrintCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, params *getCmdParams) error {
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	exported, err := export.New(cfg, ctl, ctl.NewStackManager(cfg)).Export()
+	if err != nil {
+		return err
+	}
+
+	return printer.PrintObj(exported, os.Stdout)
+}
+
 func addGetClusterSummaryTableColumns(printer *printers.TablePrinter) {
 	printer.AddColumn("NAME", func(c *awseks.Cluster) string {
 		return *c.Name
@@ -13,5 +13,19 @@ var _ = Describe("get", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Error: unknown flag: --invalid"))
 		})
+
+		It("with --full and no cluster name", func() {
+			cmd := newMockCmd("cluster", "--full", "--output", "yaml")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: --full requires a cluster name"))
+		})
+
+		It("with --full and table output", func() {
+			cmd := newMockCmd("cluster", "--full", "--name", "cluster-1")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: --full requires --output=yaml or --output=json"))
+		})
 	})
 })
@@ -3,6 +3,7 @@ package get
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kris-nova/logger"
@@ -24,12 +25,13 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	params := &getCmdParams{}
+	checkAMI := &checkAMIOptions{}
 
 	cmd.SetDescription("nodegroup", "Get nodegroup(s)", "", "ng", "nodegroups")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doGetNodeGroup(cmd, ng, params)
+		return doGetNodeGroup(cmd, ng, params, checkAMI)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -40,10 +42,21 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
+	cmd.FlagSetGroup.InFlagSet("AMI check", func(fs *pflag.FlagSet) {
+		fs.BoolVar(&checkAMI.enabled, "check-ami", false, "Compare the AMI of each nodegroup with the latest EKS-optimized AMI for it")
+		fs.IntVar(&checkAMI.maxReleasesBehind, "max-releases-behind", -1, "Fail if a nodegroup is more than this number of AMI releases behind, requires --check-ami")
+	})
+
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
-func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams) error {
+// checkAMIOptions holds the flags used to check how old the AMIs of the nodegroups are
+type checkAMIOptions struct {
+	enabled           bool
+	maxReleasesBehind int
+}
+
+func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams, checkAMI *checkAMIOptions) error {
 	cfg := cmd.ClusterConfig
 
 	// TODO: move this into a loader when --config-file gets added to this command
@@ -59,6 +72,10 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 		ng.Name = cmd.NameArg
 	}
 
+	if checkAMI.maxReleasesBehind >= 0 && !checkAMI.enabled {
+		return errors.New("--max-releases-behind can only be used with --check-ami")
+	}
+
 	// prevent creation of invalid config object with unnamed nodegroup
 	if ng.Name != "" {
 		cfg.NodeGroups = append(cfg.NodeGroups, ng)
@@ -104,6 +121,20 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 		return err
 	}
 
+	if checkAMI.enabled {
+		statuses, err := nodegroup.New(cfg, ctl, clientSet).CheckAMIs(summaries)
+		if err != nil {
+			return err
+		}
+		if params.output == printers.TableType {
+			addAMIStatusTableColumns(printer.(*printers.TablePrinter))
+		}
+		if err := printer.PrintObjWithKind("nodegroups", statuses, os.Stdout); err != nil {
+			return err
+		}
+		return checkReleasesBehind(statuses, checkAMI.maxReleasesBehind)
+	}
+
 	if params.output == printers.TableType {
 		// Empty summary implies no nodegroups
 		// We only error if the output is table, since if the output
@@ -152,3 +183,48 @@ func addSummaryTableColumns(printer *printers.TablePrinter) {
 		return s.AutoScalingGroupName
 	})
 }
+
+func addAMIStatusTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NODEGROUP", func(s *nodegroup.AMIStatus) string {
+		return s.NodeGroup
+	})
+	printer.AddColumn("CURRENT", func(s *nodegroup.AMIStatus) string {
+		return s.Current
+	})
+	printer.AddColumn("LATEST", func(s *nodegroup.AMIStatus) string {
+		if s.Note != "" {
+			return "-"
+		}
+		return s.Latest
+	})
+	printer.AddColumn("RELEASES BEHIND", func(s *nodegroup.AMIStatus) string {
+		if s.Note != "" {
+			return s.Note
+		}
+		return strconv.Itoa(s.ReleasesBehind)
+	})
+	printer.AddColumn("FIXED CVES", func(s *nodegroup.AMIStatus) string {
+		if len(s.FixedCVEs) == 0 {
+			return "-"
+		}
+		return strings.Join(s.FixedCVEs, ",")
+	})
+}
+
+// checkReleasesBehind returns an error if any nodegroup is more than maxReleasesBehind AMI releases behind,
+// a negative maxReleasesBehind disables the check
+func checkReleasesBehind(statuses []*nodegroup.AMIStatus, maxReleasesBehind int) error {
+	if maxReleasesBehind < 0 {
+		return nil
+	}
+	var outdated []string
+	for _, s := range statuses {
+		if s.ReleasesBehind > maxReleasesBehind {
+			outdated = append(outdated, s.NodeGroup)
+		}
+	}
+	if len(outdated) > 0 {
+		return errors.Errorf("nodegroup(s) %s are more than %d AMI release(s) behind", strings.Join(outdated, ", "), maxReleasesBehind)
+	}
+	return nil
+}
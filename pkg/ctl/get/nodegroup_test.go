@@ -21,6 +21,13 @@ var _ = Describe("get", func() {
 			Expect(err.Error()).To(ContainSubstring("Error: --name=ng and argument ng cannot be used at the same time"))
 		})
 
+		It("setting --max-releases-behind without --check-ami", func() {
+			cmd := newMockCmd("nodegroup", "--cluster", "dummy", "--max-releases-behind", "2")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: --max-releases-behind can only be used with --check-ami"))
+		})
+
 		It("invalid flag", func() {
 			cmd := newMockCmd("nodegroup", "--invalid", "dummy")
 			_, err := cmd.execute()
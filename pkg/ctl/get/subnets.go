@@ -0,0 +1,414 @@
+package get
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+const (
+	warmIPTargetEnv  = "WARM_IP_TARGET"
+	warmENITargetEnv = "WARM_ENI_TARGET"
+
+	// fargateProfileLabel is set by EKS on Fargate pods to the name of their profile
+	fargateProfileLabel = "eks.amazonaws.com/fargate-profile"
+)
+
+func getSubnetsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var output printers.Type
+
+	cmd.SetDescription("subnets", "Get the free IP addresses of the subnets of a cluster and forecast their use at max scale", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGetSubnets(cmd, output)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&output, "output", "o", printers.TableType, "specifies the output format (valid option: table, json, yaml)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doGetSubnets(cmd *cmdutils.Cmd, output printers.Type) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	if output == printers.TableType {
+		cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+	} else {
+		// log warnings and errors to stderr
+		logger.Writer = os.Stderr
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	summaries, err := nodegroup.New(cfg, ctl, clientSet).GetAll()
+	if err != nil {
+		return err
+	}
+
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "listing nodes")
+	}
+	maxPods := maxPodsByNodeGroup(nodes.Items)
+
+	cni := vpc.DefaultCNIConfig()
+	awsNode, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), defaultaddons.AWSNode, metav1.GetOptions{})
+	if err != nil {
+		logger.Warning("getting %q, using the default VPC CNI settings: %v", defaultaddons.AWSNode, err)
+	} else {
+		cni = cniConfigFromDaemonSet(awsNode)
+	}
+
+	stackManager := manager.NewStackCollection(ctl.Provider, cfg)
+	fargateClient := fargate.NewFromProvider(cfg.Metadata.Name, ctl.Provider, stackManager)
+	profiles, err := fargateClient.ReadProfiles()
+	if err != nil {
+		return err
+	}
+
+	podSubnetIDs, err := clusterPodSubnets(stackManager)
+	if err != nil {
+		return err
+	}
+
+	subnetIDs := sets.NewString(aws.StringValueSlice(ctl.Status.ClusterInfo.Cluster.ResourcesVpcConfig.SubnetIds)...)
+	subnetIDs.Insert(podSubnetIDs...)
+	asgs := map[string][]*autoscaling.Group{}
+	var instanceIDs []string
+	for _, summary := range summaries {
+		if summary.AutoScalingGroupName == "" {
+			continue
+		}
+		asgOutput, err := ctl.Provider.ASG().DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice(strings.Split(summary.AutoScalingGroupName, ",")),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "describing Auto Scaling groups of nodegroup %q", summary.Name)
+		}
+		asgs[summary.Name] = asgOutput.AutoScalingGroups
+		for _, asg := range asgOutput.AutoScalingGroups {
+			subnetIDs.Insert(asgSubnets(asg)...)
+			for _, instance := range asg.Instances {
+				instanceIDs = append(instanceIDs, aws.StringValue(instance.InstanceId))
+			}
+		}
+	}
+	for _, profile := range profiles {
+		subnetIDs.Insert(profile.Subnets...)
+	}
+
+	subnets, err := ctl.Provider.EC2().DescribeSubnets(&ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(subnetIDs.List()),
+	})
+	if err != nil {
+		return errors.Wrap(err, "describing subnets")
+	}
+	if len(podSubnetIDs) > 0 {
+		podSubnets := sets.NewString(podSubnetIDs...)
+		cni.PodSubnets = map[string]string{}
+		for _, s := range subnets.Subnets {
+			if podSubnets.Has(*s.SubnetId) {
+				cni.PodSubnets[*s.AvailabilityZone] = *s.SubnetId
+			}
+		}
+	}
+
+	instanceSubnets, err := describeInstanceSubnets(ctl.Provider.EC2(), instanceIDs)
+	if err != nil {
+		return err
+	}
+	var usages []vpc.NodeGroupIPUsage
+	for _, summary := range summaries {
+		usages = append(usages, nodeGroupIPUsage(summary, asgs[summary.Name], instanceSubnets, maxPods[summary.Name]))
+	}
+
+	fargatePods, err := clientSet.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: fargateProfileLabel,
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing Fargate pods")
+	}
+	forecasts := vpc.ForecastSubnetIPs(subnets.Subnets, usages, fargateProfileIPUsage(profiles, fargatePods.Items, subnets.Subnets), cni)
+
+	if output == printers.TableType {
+		addSubnetForecastTableColumns(printer.(*printers.TablePrinter))
+	}
+	if err := printer.PrintObjWithKind("subnets", forecasts, os.Stdout); err != nil {
+		return err
+	}
+
+	warnInsufficientSubnets(forecasts)
+	return nil
+}
+
+func warnInsufficientSubnets(forecasts []vpc.SubnetIPForecast) {
+	for _, f := range forecasts {
+		if f.Fits() {
+			continue
+		}
+		var nodeGroups []string
+		for _, ng := range f.NodeGroups {
+			nodeGroups = append(nodeGroups, ng.Name)
+		}
+		logger.Warning("subnet %s (%s) has %d free IP addresses, but nodegroups %s need %d to scale to their max size",
+			f.SubnetID, f.AvailabilityZone, f.FreeIPs, strings.Join(nodeGroups, ", "), f.RequiredIPs)
+	}
+}
+
+// cniConfigFromDaemonSet returns the settings of the VPC CNI set in the aws-node container
+func cniConfigFromDaemonSet(awsNode *appsv1.DaemonSet) vpc.CNIConfig {
+	cni := vpc.DefaultCNIConfig()
+	for _, container := range awsNode.Spec.Template.Spec.Containers {
+		if container.Name != defaultaddons.AWSNode {
+			continue
+		}
+		for _, env := range container.Env {
+			value, err := strconv.Atoi(env.Value)
+			if err != nil {
+				continue
+			}
+			switch env.Name {
+			case warmIPTargetEnv:
+				cni.WarmIPTarget = value
+			case warmENITargetEnv:
+				cni.WarmENITarget = value
+			}
+		}
+	}
+	return cni
+}
+
+// maxPodsByNodeGroup returns the highest pod capacity of the nodes of each nodegroup
+func maxPodsByNodeGroup(nodes []corev1.Node) map[string]int {
+	maxPods := map[string]int{}
+	for _, node := range nodes {
+		name, ok := node.Labels[api.NodeGroupNameLabel]
+		if !ok {
+			name, ok = node.Labels[api.EKSNodeGroupNameLabel]
+		}
+		pods, hasPods := node.Status.Capacity[corev1.ResourcePods]
+		if !ok || !hasPods {
+			continue
+		}
+		if current, found := maxPods[name]; !found || int(pods.Value()) > current {
+			maxPods[name] = int(pods.Value())
+		}
+	}
+	return maxPods
+}
+
+// clusterPodSubnets returns the pod subnets of VPC CNI custom networking from the cluster stack, if any
+func clusterPodSubnets(stackManager manager.StackManager) ([]string, error) {
+	stack, err := stackManager.DescribeClusterStack()
+	if err != nil {
+		return nil, errors.Wrap(err, "describing cluster stack")
+	}
+	var podSubnets []string
+	if stack == nil {
+		return podSubnets, nil
+	}
+	err = outputs.Collect(*stack, nil, map[string]outputs.Collector{
+		outputs.ClusterSubnetsPod: func(v string) error {
+			podSubnets = strings.Split(v, ",")
+			return nil
+		},
+	})
+	return podSubnets, err
+}
+
+// describeInstanceSubnets returns the subnet of each instance
+func describeInstanceSubnets(ec2API ec2iface.EC2API, instanceIDs []string) (map[string]string, error) {
+	instanceSubnets := map[string]string{}
+	if len(instanceIDs) == 0 {
+		return instanceSubnets, nil
+	}
+	err := ec2API.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		InstanceIds: aws.StringSlice(instanceIDs),
+	}, func(output *ec2.DescribeInstancesOutput, _ bool) bool {
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				instanceSubnets[aws.StringValue(instance.InstanceId)] = aws.StringValue(instance.SubnetId)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "describing instances")
+	}
+	return instanceSubnets, nil
+}
+
+// nodeGroupIPUsage returns the IP usage of a nodegroup from its Auto Scaling groups, whose running instances are
+// counted in their subnet
+func nodeGroupIPUsage(summary *manager.NodeGroupSummary, asgs []*autoscaling.Group, instanceSubnets map[string]string, maxPods int) vpc.NodeGroupIPUsage {
+	usage := vpc.NodeGroupIPUsage{
+		Name:         summary.Name,
+		MaxPods:      maxPods,
+		MaxSize:      summary.MaxSize,
+		RunningNodes: map[string]int{},
+	}
+
+	instanceTypes := sets.NewString()
+	for _, t := range strings.Split(summary.InstanceType, ",") {
+		if t != "" && t != "-" {
+			instanceTypes.Insert(t)
+		}
+	}
+	for _, asg := range asgs {
+		if asg.MixedInstancesPolicy != nil && asg.MixedInstancesPolicy.LaunchTemplate != nil {
+			for _, override := range asg.MixedInstancesPolicy.LaunchTemplate.Overrides {
+				if override.InstanceType != nil {
+					instanceTypes.Insert(*override.InstanceType)
+				}
+			}
+		}
+
+		usage.Subnets = append(usage.Subnets, asgSubnets(asg)...)
+		for _, instance := range asg.Instances {
+			if subnet, ok := instanceSubnets[aws.StringValue(instance.InstanceId)]; ok {
+				usage.RunningNodes[subnet]++
+			}
+		}
+	}
+	usage.InstanceTypes = instanceTypes.List()
+	return usage
+}
+
+// fargateProfileIPUsage returns the IP usage of the Fargate profiles, whose running pods are counted in the subnet
+// their IP address belongs to
+func fargateProfileIPUsage(profiles []*api.FargateProfile, pods []corev1.Pod, subnets []*ec2.Subnet) []vpc.FargateProfileIPUsage {
+	subnetCIDRs := map[string]*net.IPNet{}
+	for _, s := range subnets {
+		if _, cidr, err := net.ParseCIDR(aws.StringValue(s.CidrBlock)); err == nil {
+			subnetCIDRs[aws.StringValue(s.SubnetId)] = cidr
+		}
+	}
+
+	var usages []vpc.FargateProfileIPUsage
+	for _, profile := range profiles {
+		usage := vpc.FargateProfileIPUsage{
+			Name:        profile.Name,
+			Subnets:     profile.Subnets,
+			RunningPods: map[string]int{},
+		}
+		for _, pod := range pods {
+			ip := net.ParseIP(pod.Status.PodIP)
+			if pod.Labels[fargateProfileLabel] != profile.Name || ip == nil {
+				continue
+			}
+			for _, id := range profile.Subnets {
+				if cidr, ok := subnetCIDRs[id]; ok && cidr.Contains(ip) {
+					usage.RunningPods[id]++
+					break
+				}
+			}
+		}
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+func asgSubnets(asg *autoscaling.Group) []string {
+	var subnets []string
+	for _, s := range strings.Split(aws.StringValue(asg.VPCZoneIdentifier), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			subnets = append(subnets, s)
+		}
+	}
+	return subnets
+}
+
+func addSubnetForecastTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("SUBNET", func(f vpc.SubnetIPForecast) string {
+		return f.SubnetID
+	})
+	printer.AddColumn("AZ", func(f vpc.SubnetIPForecast) string {
+		return f.AvailabilityZone
+	})
+	printer.AddColumn("CIDR", func(f vpc.SubnetIPForecast) string {
+		return f.CIDR
+	})
+	printer.AddColumn("FREE IPS", func(f vpc.SubnetIPForecast) string {
+		return strconv.Itoa(f.FreeIPs)
+	})
+	printer.AddColumn("REQUIRED AT MAX SIZE", func(f vpc.SubnetIPForecast) string {
+		return strconv.Itoa(f.RequiredIPs)
+	})
+	printer.AddColumn("NODEGROUPS", func(f vpc.SubnetIPForecast) string {
+		var nodeGroups []string
+		for _, ng := range f.NodeGroups {
+			nodeGroups = append(nodeGroups, fmt.Sprintf("%s (%d nodes x %d IPs)", ng.Name, ng.Nodes, ng.PerNode))
+		}
+		return strings.Join(nodeGroups, ", ")
+	})
+	printer.AddColumn("FARGATE PROFILES", func(f vpc.SubnetIPForecast) string {
+		var profiles []string
+		for _, fp := range f.FargateProfiles {
+			profiles = append(profiles, fmt.Sprintf("%s (%d pods)", fp.Name, fp.RunningPods))
+		}
+		return strings.Join(profiles, ", ")
+	})
+	printer.AddColumn("STATUS", func(f vpc.SubnetIPForecast) string {
+		if f.Fits() {
+			return "OK"
+		}
+		return "INSUFFICIENT"
+	})
+}
@@ -0,0 +1,109 @@
+package get
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("get subnets", func() {
+	It("fails when --cluster is not set", func() {
+		cmd := newMockCmd("subnets")
+		_, err := cmd.execute()
+		Expect(err).To(MatchError(ContainSubstring("--cluster must be set")))
+	})
+
+	It("reads the VPC CNI settings of aws-node", func() {
+		awsNode := &appsv1.DaemonSet{}
+		awsNode.Spec.Template.Spec.Containers = []corev1.Container{{
+			Name: "aws-node",
+			Env: []corev1.EnvVar{
+				{Name: "WARM_IP_TARGET", Value: "5"},
+				{Name: "AWS_VPC_K8S_CNI_LOGLEVEL", Value: "DEBUG"},
+			},
+		}}
+		Expect(cniConfigFromDaemonSet(awsNode)).To(Equal(vpc.CNIConfig{WarmIPTarget: 5, WarmENITarget: 1}))
+	})
+
+	It("uses the highest pod capacity of the nodes of a nodegroup", func() {
+		node := func(nodeGroup string, pods int64) corev1.Node {
+			return corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{api.NodeGroupNameLabel: nodeGroup}},
+				Status: corev1.NodeStatus{Capacity: corev1.ResourceList{
+					corev1.ResourcePods: *resource.NewQuantity(pods, resource.DecimalSI),
+				}},
+			}
+		}
+		Expect(maxPodsByNodeGroup([]corev1.Node{node("ng-1", 29), node("ng-1", 17), node("ng-2", 58)})).To(Equal(map[string]int{
+			"ng-1": 29,
+			"ng-2": 58,
+		}))
+	})
+
+	It("takes the subnets, instance types and running nodes of a nodegroup from its Auto Scaling group", func() {
+		summary := &manager.NodeGroupSummary{Name: "ng-1", MaxSize: 6, InstanceType: "m5.large"}
+		asg := &autoscaling.Group{
+			VPCZoneIdentifier: aws.String("subnet-a1,subnet-a2,subnet-b"),
+			Instances: []*autoscaling.Instance{
+				{InstanceId: aws.String("i-1"), AvailabilityZone: aws.String("us-west-2a")},
+				{InstanceId: aws.String("i-2"), AvailabilityZone: aws.String("us-west-2a")},
+				{InstanceId: aws.String("i-3"), AvailabilityZone: aws.String("us-west-2b")},
+				{InstanceId: aws.String("i-pending"), AvailabilityZone: aws.String("us-west-2b")},
+			},
+			MixedInstancesPolicy: &autoscaling.MixedInstancesPolicy{
+				LaunchTemplate: &autoscaling.LaunchTemplate{
+					Overrides: []*autoscaling.LaunchTemplateOverrides{{InstanceType: aws.String("m5.xlarge")}},
+				},
+			},
+		}
+		instanceSubnets := map[string]string{"i-1": "subnet-a1", "i-2": "subnet-a2", "i-3": "subnet-b"}
+
+		Expect(nodeGroupIPUsage(summary, []*autoscaling.Group{asg}, instanceSubnets, 29)).To(Equal(vpc.NodeGroupIPUsage{
+			Name:          "ng-1",
+			InstanceTypes: []string{"m5.large", "m5.xlarge"},
+			MaxPods:       29,
+			MaxSize:       6,
+			Subnets:       []string{"subnet-a1", "subnet-a2", "subnet-b"},
+			RunningNodes:  map[string]int{"subnet-a1": 1, "subnet-a2": 1, "subnet-b": 1},
+		}))
+	})
+
+	It("counts the running pods of a Fargate profile in the subnets of their IP addresses", func() {
+		pod := func(profile, ip string) corev1.Pod {
+			return corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{fargateProfileLabel: profile}},
+				Status:     corev1.PodStatus{PodIP: ip},
+			}
+		}
+		profiles := []*api.FargateProfile{
+			{Name: "fp-1", Subnets: []string{"subnet-a", "subnet-b"}},
+			{Name: "fp-2", Subnets: []string{"subnet-b"}},
+		}
+		subnets := []*ec2.Subnet{
+			{SubnetId: aws.String("subnet-a"), CidrBlock: aws.String("192.168.0.0/19")},
+			{SubnetId: aws.String("subnet-b"), CidrBlock: aws.String("192.168.32.0/19")},
+		}
+		pods := []corev1.Pod{
+			pod("fp-1", "192.168.1.10"),
+			pod("fp-1", "192.168.33.10"),
+			pod("fp-1", "192.168.34.10"),
+			pod("fp-1", ""),
+			pod("fp-2", "192.168.35.10"),
+		}
+
+		Expect(fargateProfileIPUsage(profiles, pods, subnets)).To(Equal([]vpc.FargateProfileIPUsage{
+			{Name: "fp-1", Subnets: []string{"subnet-a", "subnet-b"}, RunningPods: map[string]int{"subnet-a": 1, "subnet-b": 2}},
+			{Name: "fp-2", Subnets: []string{"subnet-b"}, RunningPods: map[string]int{"subnet-b": 1}},
+		}))
+	})
+})
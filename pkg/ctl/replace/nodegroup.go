@@ -0,0 +1,78 @@
+package replace
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+)
+
+func replaceNodeGroupCmd(cmd *cmdutils.Cmd) {
+	replaceNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, options nodegroup.ReplaceOpts) error {
+		return doReplaceNodeGroup(cmd, ng, options)
+	})
+}
+
+func replaceNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, options nodegroup.ReplaceOpts) error) {
+	cfg := api.NewClusterConfig()
+	ng := api.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	var options nodegroup.ReplaceOpts
+
+	cmd.SetDescription("nodegroup", "Replace a nodegroup with a new one", "Creates a successor of a nodegroup from its definition in the config file, waits for its nodes to become ready, then drains and deletes the nodegroup", "ng")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return runFunc(cmd, ng, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup to replace")
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		defaultMaxGracePeriod, _ := time.ParseDuration("10m")
+		fs.DurationVar(&options.MaxGracePeriod, "max-grace-period", defaultMaxGracePeriod, "Maximum pods termination grace period")
+		fs.BoolVar(&options.DisableEviction, "disable-eviction", false, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Addons", func(fs *pflag.FlagSet) {
+		fs.BoolVarP(&options.InstallNeuronDevicePlugin, "install-neuron-plugin", "", true, "install Neuron plugin for Inferentia nodes")
+		fs.BoolVarP(&options.InstallNvidiaDevicePlugin, "install-nvidia-plugin", "", true, "install Nvidia plugin for GPU nodes")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
+}
+
+func doReplaceNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, options nodegroup.ReplaceOpts) error {
+	if err := cmdutils.NewReplaceNodeGroupLoader(cmd, ng).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	options.ConfigFileProvided = true
+	return nodegroup.New(cfg, ctl, clientSet).Replace(ng, options, filter.NewNodeGroupFilter())
+}
@@ -0,0 +1,83 @@
+package replace
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+type invalidParamsCase struct {
+	args  []string
+	error error
+}
+
+const replaceConfigFile = "../cmdutils/test_data/replace-ng-test.yaml"
+
+var _ = Describe("replace nodegroup", func() {
+	DescribeTable("loads the successor from the config file",
+		func(name, expectedInstanceType string, args ...string) {
+			cmd := newMockEmptyCmd(args...)
+			count := 0
+			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+				replaceNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, options nodegroup.ReplaceOpts) error {
+					Expect(cmdutils.NewReplaceNodeGroupLoader(cmd, ng).Load()).To(Succeed())
+					Expect(ng.Name).To(Equal(name))
+					Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("test-cluster-1"))
+					Expect(cmd.ClusterConfig.ManagedNodeGroups).To(BeEmpty())
+					Expect(cmd.ClusterConfig.NodeGroups).To(HaveLen(1))
+					successor := cmd.ClusterConfig.NodeGroups[0]
+					Expect(successor.Name).To(MatchRegexp("^ng-1-[abcdef0123456789]{5}$"))
+					Expect(successor.Name).NotTo(Equal(name))
+					Expect(successor.InstanceType).To(Equal(expectedInstanceType))
+					count++
+					return nil
+				})
+			})
+			_, err := cmd.execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		},
+		Entry("with the name of the nodegroup", "ng-1", "m5.xlarge", "nodegroup", "--name", "ng-1", "-f", replaceConfigFile),
+		Entry("with the name as an argument", "ng-1", "m5.xlarge", "nodegroup", "ng-1", "-f", replaceConfigFile),
+		Entry("with the name of a successor", "ng-1-3f9a2", "m5.xlarge", "nodegroup", "--name", "ng-1-3f9a2", "-f", replaceConfigFile),
+	)
+
+	DescribeTable("invalid flags or arguments",
+		func(c invalidParamsCase) {
+			cmd := newDefaultCmd(c.args...)
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(c.error.Error()))
+		},
+		Entry("without a config file", invalidParamsCase{
+			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng-1"},
+			error: fmt.Errorf("Error: --config-file must be set"),
+		}),
+		Entry("without a name", invalidParamsCase{
+			args:  []string{"nodegroup", "-f", replaceConfigFile},
+			error: fmt.Errorf("Error: --name must be set"),
+		}),
+		Entry("setting --name and argument at the same time", invalidParamsCase{
+			args:  []string{"nodegroup", "ng-1", "--name", "ng-1", "-f", replaceConfigFile},
+			error: fmt.Errorf("Error: --name=ng-1 and argument ng-1 cannot be used at the same time"),
+		}),
+		Entry("with config file and cluster flag", invalidParamsCase{
+			args:  []string{"nodegroup", "--name", "ng-1", "-f", replaceConfigFile, "--cluster", "dummy"},
+			error: fmt.Errorf("Error: cannot use --cluster when --config-file/-f is set"),
+		}),
+		Entry("with a nodegroup that is not in the config file", invalidParamsCase{
+			args:  []string{"nodegroup", "--name", "ng-3", "-f", replaceConfigFile},
+			error: fmt.Errorf("Error: node group ng-3 not found"),
+		}),
+		Entry("with a managed nodegroup", invalidParamsCase{
+			args:  []string{"nodegroup", "--name", "mng-1", "-f", replaceConfigFile},
+			error: fmt.Errorf("Error: managed nodegroup mng-1 cannot be replaced, use 'eksctl upgrade nodegroup' instead"),
+		}),
+	)
+})
@@ -0,0 +1,63 @@
+package replace
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+var _ = Describe("replace", func() {
+	Describe("invalid-resource", func() {
+		It("with no flag", func() {
+			cmd := newDefaultCmd("invalid-resource")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: unknown command \"invalid-resource\" for \"replace\""))
+			Expect(err.Error()).To(ContainSubstring("usage"))
+		})
+		It("with invalid-resource and some flag", func() {
+			cmd := newDefaultCmd("invalid-resource", "--invalid-flag", "foo")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: unknown command \"invalid-resource\" for \"replace\""))
+			Expect(err.Error()).To(ContainSubstring("usage"))
+		})
+	})
+})
+
+func newDefaultCmd(args ...string) *mockVerbCmd {
+	flagGrouping := cmdutils.NewGrouping()
+	cmd := Command(flagGrouping)
+	cmd.SetArgs(args)
+	return &mockVerbCmd{
+		parentCmd: cmd,
+	}
+}
+
+func newMockEmptyCmd(args ...string) *mockVerbCmd {
+	cmd := cmdutils.NewVerbCmd("replace", "Replace resource(s)", "")
+	cmd.SetArgs(args)
+	return &mockVerbCmd{
+		parentCmd: cmd,
+	}
+}
+
+type mockVerbCmd struct {
+	parentCmd *cobra.Command
+}
+
+func (c mockVerbCmd) execute() (string, error) {
+	outBuf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	c.parentCmd.SetOut(outBuf)
+	c.parentCmd.SetErr(errBuf)
+	err := c.parentCmd.Execute()
+	if err != nil {
+		err = errors.New(errBuf.String())
+	}
+	return outBuf.String(), err
+}
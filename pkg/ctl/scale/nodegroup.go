@@ -67,5 +67,7 @@ func doScaleNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup) error {
 		return err
 	}
 
-	return nodegroup.New(cfg, ctl, nil).Scale(ng)
+	return nodegroup.New(cfg, ctl, nil).Scale(ng, nodegroup.ScaleOpts{
+		ConfigFileProvided: cmd.ClusterConfigFile != "",
+	})
 }
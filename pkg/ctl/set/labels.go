@@ -2,9 +2,11 @@ package set
 
 import (
 	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/weaveworks/eksctl/pkg/actions/label"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/managed"
 
@@ -37,6 +39,7 @@ func setLabelsCmd(cmd *cmdutils.Cmd) {
 
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPreviewChangesFlag(fs, &cmd.ProviderConfig)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
@@ -70,6 +73,10 @@ func setLabels(cmd *cmdutils.Cmd, options labelOptions) error {
 		return err
 	}
 
+	if err := nodegroup.New(cfg, ctl, nil).UpdateNodeTemplateLabelTags(options.nodeGroupName, options.labels, nil); err != nil {
+		return errors.Wrap(err, "updating node-template tags")
+	}
+
 	logger.Info("done")
 	return nil
 }
@@ -2,9 +2,11 @@ package unset
 
 import (
 	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/weaveworks/eksctl/pkg/actions/label"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/managed"
 
@@ -36,6 +38,7 @@ func unsetLabelsCmd(cmd *cmdutils.Cmd) {
 
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPreviewChangesFlag(fs, &cmd.ProviderConfig)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
@@ -68,6 +71,10 @@ func unsetLabels(cmd *cmdutils.Cmd, nodeGroupName string, removeLabels []string)
 		return err
 	}
 
+	if err := nodegroup.New(cfg, ctl, nil).UpdateNodeTemplateLabelTags(nodeGroupName, nil, removeLabels); err != nil {
+		return errors.Wrap(err, "updating node-template tags")
+	}
+
 	logger.Info("done")
 	return nil
 }
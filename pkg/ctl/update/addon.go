@@ -11,6 +11,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 func updateAddonCmd(cmd *cmdutils.Cmd) {
@@ -36,6 +37,8 @@ func updateAddonCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPreviewChangesFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 	})
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
@@ -87,15 +90,33 @@ func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
 		return err
 	}
 
+	taskTree := &tasks.TaskTree{Parallel: false}
 	for _, a := range cmd.ClusterConfig.Addons {
+		a := a
+		if a.IsClusterAutoscaler() {
+			// cluster-autoscaler is deployed by eksctl rather than EKS, deploying it again replaces its resources
+			clientSet, err := clusterProvider.NewStdClientSet(cmd.ClusterConfig)
+			if err != nil {
+				return err
+			}
+			taskTree.Append(addon.NewAddonTask(tasks.ActionUpdate, a, func() error {
+				return addon.CreateClusterAutoscaler(cmd.ClusterConfig, clusterProvider, clientSet, a)
+			}))
+			continue
+		}
 		if force { //force is specified at cmdline level
 			a.Force = true
 		}
-		err := addonManager.Update(a, wait)
-		if err != nil {
-			return err
-		}
+		taskTree.Append(addon.NewAddonTask(tasks.ActionUpdate, a, func() error {
+			return addonManager.Update(a, wait)
+		}))
 	}
 
+	if err := tasks.PrintPlan(cmd.PlanOutput, taskTree); err != nil {
+		return err
+	}
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		return errs[0]
+	}
 	return nil
 }
@@ -39,6 +39,7 @@ func updateClusterCmd(cmd *cmdutils.Cmd) {
 		_ = fs.MarkDeprecated("wait", "--wait is no longer respected; the cluster update always waits to complete")
 		// updating from 1.15 to 1.16 has been observed to take longer than the default value of 25 minutes
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, 35*time.Minute)
+		cmdutils.AddPreviewChangesFlag(fs, &cmd.ProviderConfig)
 	})
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
@@ -49,7 +50,7 @@ func updateClusterCmd(cmd *cmdutils.Cmd) {
 			return err
 		}
 
-		return upgrade.DoUpgradeCluster(cmd)
+		return upgrade.DoUpgradeCluster(cmd, false)
 	}
 
 }
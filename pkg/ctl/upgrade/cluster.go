@@ -1,11 +1,14 @@
 package upgrade
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/cluster"
 
 	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -17,21 +20,34 @@ import (
 // increased to 50 for flex fleet changes
 const upgradeClusterTimeout = 65 * time.Minute
 
+// upgradeClusterOptions controls the upgrade of a cluster across several versions with --all
+type upgradeClusterOptions struct {
+	cluster.UpgradeAllOptions
+	all bool
+}
+
 func upgradeCluster(cmd *cmdutils.Cmd) {
-	upgradeClusterWithRunFunc(cmd, DoUpgradeCluster)
+	upgradeClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, options upgradeClusterOptions) error {
+		if options.all {
+			return doUpgradeAll(cmd, options.UpgradeAllOptions)
+		}
+		return DoUpgradeCluster(cmd, options.Force)
+	})
 }
 
-func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, options upgradeClusterOptions) error) {
 	cfg := api.NewClusterConfig()
 	// Reset version
 	cfg.Metadata.Version = ""
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("cluster", "Upgrade control plane to the next version",
-		"Upgrade control plane to the next Kubernetes version if available. Will also perform any updates needed in the cluster stack if resources are missing.")
+		"Upgrade control plane to the next Kubernetes version if available. Will also perform any updates needed in the cluster stack if resources are missing. "+
+			"With --all, upgrade the control plane, the default add-ons and the nodegroups one version at a time until --to-version is reached.")
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
+	var options upgradeClusterOptions
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&cfg.Metadata.Name, "name", "n", "", "EKS cluster name")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
@@ -43,6 +59,16 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		cmdutils.AddApproveFlag(fs, cmd)
 
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, upgradeClusterTimeout)
+		cmdutils.AddPreviewChangesFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
+		fs.BoolVar(&options.Force, "force", false, "Upgrade the control plane even if objects use API versions removed in the new version, or nodes would be too far behind it")
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Upgrade all", func(fs *pflag.FlagSet) {
+		fs.BoolVar(&options.all, "all", false, "Upgrade the control plane, the default add-ons and the nodegroups one version at a time until --to-version is reached")
+		fs.StringVar(&options.ToVersion, "to-version", "", fmt.Sprintf("Kubernetes version to upgrade to with --all (valid options: %s)", strings.Join(api.SupportedVersions(), ", ")))
+		fs.DurationVar(&options.MaxGracePeriod, "max-grace-period", 10*time.Minute, "Maximum pods termination grace period when draining nodegroups with --all")
+		fs.BoolVar(&options.DisableEviction, "disable-eviction", false, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
 	})
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
@@ -51,13 +77,32 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 			return err
 		}
-		return runFunc(cmd)
+
+		if options.all {
+			meta := cmd.ClusterConfig.Metadata
+			if options.ToVersion == "" {
+				// the version of the config file is the version to upgrade to
+				options.ToVersion = meta.Version
+			}
+			if options.ToVersion == "" {
+				return cmdutils.ErrMustBeSet("--to-version")
+			}
+			if meta.Version != "" && meta.Version != options.ToVersion {
+				return fmt.Errorf("--to-version %q does not match version %q", options.ToVersion, meta.Version)
+			}
+		} else if options.ToVersion != "" {
+			return errors.New("--to-version can only be used with --all")
+		}
+		options.DryRun = cmd.Plan
+		options.PlanOutput = cmd.PlanOutput
+
+		return runFunc(cmd, options)
 	}
 }
 
 // DoUpgradeCluster made public so that it can be shared with update/cluster.go until this is deprecated
 // TODO Once `eksctl update cluster` is officially deprecated this can be made package private again
-func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
+func DoUpgradeCluster(cmd *cmdutils.Cmd, force bool) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
@@ -80,5 +125,27 @@ func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	return c.Upgrade(cmd.Plan)
+	c.SetPlanOutput(cmd.PlanOutput)
+	return c.Upgrade(cmd.Plan, force)
+}
+
+func doUpgradeAll(cmd *cmdutils.Cmd, options cluster.UpgradeAllOptions) error {
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	return cluster.UpgradeAll(cfg, ctl, clientSet, options)
 }
@@ -8,13 +8,21 @@ import (
 	. "github.com/onsi/gomega"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/ctltest"
 )
 
 var _ = Describe("upgrade cluster", func() {
 
+	var options upgradeClusterOptions
+
 	newMockUpgradeClusterCmd := func(args ...string) *ctltest.MockCmd {
-		return ctltest.NewMockCmd(upgradeClusterWithRunFunc, "upgrade", args...)
+		return ctltest.NewMockCmd(func(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+			upgradeClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, o upgradeClusterOptions) error {
+				options = o
+				return runFunc(cmd)
+			})
+		}, "upgrade", args...)
 	}
 
 	Describe("without a config file", func() {
@@ -70,6 +78,57 @@ var _ = Describe("upgrade cluster", func() {
 			Expect(cmd.Cmd.ProviderConfig.Region).To(Equal("us-west-2"))
 			Expect(cmd.Cmd.Plan).To(BeFalse())
 			Expect(cmd.Cmd.ProviderConfig.WaitTimeout).To(Equal(123 * time.Minute))
+			Expect(options.all).To(BeFalse())
+			Expect(options.Force).To(BeFalse())
+		})
+
+		It("accepts --force flag", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--force")
+			_, err := cmd.Execute()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options.Force).To(BeTrue())
+		})
+
+		It("loads the --all flags correctly", func() {
+			cmd := newMockUpgradeClusterCmd("cluster",
+				"--name", "clus-1",
+				"--all",
+				"--to-version", "1.21",
+				"--max-grace-period", "5m",
+				"--approve",
+			)
+			_, err := cmd.Execute()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(options.all).To(BeTrue())
+			Expect(options.ToVersion).To(Equal("1.21"))
+			Expect(options.MaxGracePeriod).To(Equal(5 * time.Minute))
+			Expect(options.DryRun).To(BeFalse())
+		})
+
+		It("runs --all in plan mode without --approve", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--all", "--to-version", "1.21")
+			_, err := cmd.Execute()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options.DryRun).To(BeTrue())
+		})
+
+		It("fails with --all and without --to-version", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--all")
+			_, err := cmd.Execute()
+			Expect(err).To(MatchError("--to-version must be set"))
+		})
+
+		It("fails with --to-version and without --all", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--to-version", "1.21")
+			_, err := cmd.Execute()
+			Expect(err).To(MatchError("--to-version can only be used with --all"))
+		})
+
+		It("fails when --version and --to-version don't match", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--all", "--to-version", "1.21", "--version", "1.20")
+			_, err := cmd.Execute()
+			Expect(err).To(MatchError(`--to-version "1.21" does not match version "1.20"`))
 		})
 	})
 
@@ -163,5 +222,15 @@ var _ = Describe("upgrade cluster", func() {
 			loadedCfg := cmd.Cmd.ClusterConfig.Metadata
 			Expect(loadedCfg.Version).To(Equal(""))
 		})
+
+		It("upgrades to the version of the config file with --all", func() {
+			cfg.Metadata.Version = "1.21"
+			configFile = ctltest.CreateConfigFile(cfg)
+
+			cmd := newMockUpgradeClusterCmd("cluster", "--config-file", configFile, "--all")
+			_, err := cmd.Execute()
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(options.ToVersion).To(Equal("1.21"))
+		})
 	})
 })
@@ -5,10 +5,11 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
-	"github.com/weaveworks/eksctl/pkg/managed"
 
+	"github.com/weaveworks/eksctl/pkg/ami"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 )
@@ -21,10 +22,16 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("nodegroup", "Upgrade nodegroup", "")
 
-	var options managed.UpgradeOptions
+	var (
+		options        nodegroup.UpgradeOpts
+		customAMIFlags customAMIOptions
+	)
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return upgradeNodeGroup(cmd, options)
+		if customAMIFlags.ssmParameter != "" && customAMIFlags.namePattern != "" {
+			return errors.New("only one of --custom-ami-ssm-parameter or --custom-ami-name-pattern can be specified")
+		}
+		return upgradeNodeGroup(cmd, options, customAMIFlags)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("Nodegroup", func(fs *pflag.FlagSet) {
@@ -33,28 +40,60 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 		fs.StringVar(&options.KubernetesVersion, "kubernetes-version", "", "Kubernetes version")
 		fs.BoolVar(&options.ForceUpgrade, "force-upgrade", false, "Force the update if the existing node group's pods are unable to be drained due to a pod disruption budget issue")
 		fs.StringVar(&options.ReleaseVersion, "release-version", "", "AMI version of the EKS optimized AMI to use")
+
+		maxUnavailable := fs.Int("max-unavailable", 1, "Maximum number of instances of an unmanaged nodegroup to replace at a time")
+		maxUnavailablePercentage := fs.Int("max-unavailable-percentage", 0, "Maximum percentage of instances of an unmanaged nodegroup to replace at a time")
+		cmdutils.AddPreRun(cmd.CobraCommand, func(cobraCmd *cobra.Command, args []string) {
+			if f := cobraCmd.Flag("max-unavailable"); f.Changed {
+				options.UpdateConfig = &api.NodeGroupUpdateConfig{MaxUnavailable: maxUnavailable}
+			}
+			if f := cobraCmd.Flag("max-unavailable-percentage"); f.Changed {
+				if options.UpdateConfig == nil {
+					options.UpdateConfig = &api.NodeGroupUpdateConfig{}
+				}
+				options.UpdateConfig.MaxUnavailablePercentage = maxUnavailablePercentage
+			}
+		})
+		fs.DurationVar(&options.MaxGracePeriod, "max-grace-period", 10*time.Minute, "Maximum pods termination grace period when draining the instances of an unmanaged nodegroup")
+		fs.BoolVar(&options.DisableEviction, "disable-eviction", false, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Custom AMI", func(fs *pflag.FlagSet) {
+		fs.StringVar(&customAMIFlags.ssmParameter, "custom-ami-ssm-parameter", "", "Upgrade a managed nodegroup that uses a custom AMI to the AMI whose ID is held by this SSM parameter")
+		fs.StringVar(&customAMIFlags.namePattern, "custom-ami-name-pattern", "", "Upgrade a managed nodegroup that uses a custom AMI to the newest AMI whose name matches this pattern")
+		fs.StringVar(&customAMIFlags.owner, "custom-ami-owner", "self", "Account that owns the AMIs matching --custom-ami-name-pattern")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		cmdutils.AddClusterFlag(fs, cmd.ClusterConfig.Metadata)
 
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmd.Wait = true
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "nodegroup upgrade to complete")
 
 		// found with experimentation
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, upgradeNodegroupTimeout)
+		cmdutils.AddPreviewChangesFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
 }
 
-func upgradeNodeGroup(cmd *cmdutils.Cmd, options managed.UpgradeOptions) error {
-	cfg := cmd.ClusterConfig
-	if cfg.Metadata.Name == "" {
-		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+// customAMIOptions are the flags that set how the new AMI of a nodegroup that uses a custom AMI is resolved
+type customAMIOptions struct {
+	ssmParameter string
+	namePattern  string
+	owner        string
+}
+
+func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOpts, customAMIFlags customAMIOptions) error {
+	if err := cmdutils.NewUpgradeNodeGroupLoader(cmd).Load(); err != nil {
+		return err
 	}
+	cfg := cmd.ClusterConfig
 
 	if options.NodegroupName != "" && cmd.NameArg != "" {
 		return cmdutils.ErrFlagAndArg("--name", options.NodegroupName, cmd.NameArg)
@@ -82,6 +121,15 @@ func upgradeNodeGroup(cmd *cmdutils.Cmd, options managed.UpgradeOptions) error {
 		return err
 	}
 
-	return nodegroup.New(cfg, ctl, clientSet).Upgrade(options, cmd.Wait)
+	switch {
+	case customAMIFlags.ssmParameter != "":
+		options.CustomAMIResolver = ami.NewCustomSSMResolver(ctl.Provider.SSM(), customAMIFlags.ssmParameter)
+	case customAMIFlags.namePattern != "":
+		options.CustomAMIResolver = ami.NewCustomImageResolver(ctl.Provider.EC2(), customAMIFlags.owner, customAMIFlags.namePattern)
+	}
+
+	nodeGroupManager := nodegroup.New(cfg, ctl, clientSet)
+	nodeGroupManager.SetPlanOutput(cmd.PlanOutput)
+	return nodeGroupManager.Upgrade(options, cmd.Wait)
 
 }
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// DriftedResource is a stack resource that was modified or deleted outside of CloudFormation
+type DriftedResource struct {
+	StackName           string
+	LogicalResourceID   string
+	PhysicalResourceID  string
+	ResourceType        string
+	DriftStatus         string
+	PropertyDifferences []*cloudformation.PropertyDifference
+}
+
+func detectDriftCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var output printers.Type
+
+	cmd.SetDescription("detect-drift", "Detect changes made outside of CloudFormation to the stacks of a cluster", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doDetectDrift(cmd, output)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&output, "output", "o", printers.TableType, "specifies the output format (valid option: table, json, yaml)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doDetectDrift(cmd *cmdutils.Cmd, output printers.Type) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	if output == printers.TableType {
+		cmdutils.LogRegionAndVersionInfo(meta)
+	} else {
+		// log warnings and errors to stderr
+		logger.Writer = os.Stderr
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	stacks, err := stackManager.DescribeStacks()
+	if err != nil {
+		return err
+	}
+	if len(stacks) == 0 {
+		return fmt.Errorf("no CloudFormation stacks found for cluster %q", meta.Name)
+	}
+
+	drifted, err := detectDrift(stackManager, stacks)
+	if err != nil {
+		return err
+	}
+
+	if output == printers.TableType {
+		addDriftedResourceTableColumns(printer.(*printers.TablePrinter))
+	}
+	if err := printer.PrintObjWithKind("drifted resources", drifted, os.Stdout); err != nil {
+		return err
+	}
+
+	if len(drifted) > 0 {
+		return fmt.Errorf("%d resource(s) of cluster %q have drifted from their CloudFormation templates", len(drifted), meta.Name)
+	}
+	logger.Success("no drift detected in %d stack(s) of cluster %q", len(stacks), meta.Name)
+	return nil
+}
+
+// detectDrift runs drift detection on all stacks in parallel
+func detectDrift(stackManager manager.StackManager, stacks []*manager.Stack) ([]DriftedResource, error) {
+	var mu sync.Mutex
+	drifted := []DriftedResource{}
+	taskTree := &tasks.TaskTree{Parallel: true}
+	for _, s := range stacks {
+		s := s
+		if !stackManager.StackStatusIsNotTransitional(s) {
+			logger.Warning("skipping stack %q as it is in %s state", *s.StackName, *s.StackStatus)
+			continue
+		}
+		taskTree.Append(&tasks.GenericTask{
+			Description: fmt.Sprintf("detect drift of stack %q", *s.StackName),
+			Doer: func() error {
+				drifts, err := stackManager.DetectStackDrift(s)
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				for _, d := range drifts {
+					drifted = append(drifted, DriftedResource{
+						StackName:           *s.StackName,
+						LogicalResourceID:   aws.StringValue(d.LogicalResourceId),
+						PhysicalResourceID:  aws.StringValue(d.PhysicalResourceId),
+						ResourceType:        aws.StringValue(d.ResourceType),
+						DriftStatus:         aws.StringValue(d.StackResourceDriftStatus),
+						PropertyDifferences: d.PropertyDifferences,
+					})
+				}
+				return nil
+			},
+		})
+	}
+
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return nil, fmt.Errorf("failed to detect drift of %d stack(s)", len(errs))
+	}
+
+	sort.Slice(drifted, func(i, j int) bool {
+		if drifted[i].StackName != drifted[j].StackName {
+			return drifted[i].StackName < drifted[j].StackName
+		}
+		return drifted[i].LogicalResourceID < drifted[j].LogicalResourceID
+	})
+	return drifted, nil
+}
+
+func addDriftedResourceTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("STACK", func(r DriftedResource) string {
+		return r.StackName
+	})
+	printer.AddColumn("LOGICAL ID", func(r DriftedResource) string {
+		return r.LogicalResourceID
+	})
+	printer.AddColumn("PHYSICAL ID", func(r DriftedResource) string {
+		return r.PhysicalResourceID
+	})
+	printer.AddColumn("RESOURCE TYPE", func(r DriftedResource) string {
+		return r.ResourceType
+	})
+	printer.AddColumn("DRIFT STATUS", func(r DriftedResource) string {
+		return r.DriftStatus
+	})
+	printer.AddColumn("PROPERTY DIFFERENCES", func(r DriftedResource) string {
+		var differences []string
+		for _, d := range r.PropertyDifferences {
+			differences = append(differences, fmt.Sprintf("%s (%s)", aws.StringValue(d.PropertyPath), strings.ToLower(aws.StringValue(d.DifferenceType))))
+		}
+		return strings.Join(differences, ", ")
+	})
+}
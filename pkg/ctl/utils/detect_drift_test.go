@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"bytes"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+var _ = Describe("detect-drift", func() {
+	var (
+		fakeStackManager *fakes.FakeStackManager
+		stacks           []*manager.Stack
+	)
+
+	BeforeEach(func() {
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.StackStatusIsNotTransitionalStub = func(s *manager.Stack) bool {
+			return *s.StackStatus != cfn.StackStatusUpdateInProgress
+		}
+		fakeStackManager.DetectStackDriftStub = func(s *manager.Stack) ([]*cfn.StackResourceDrift, error) {
+			if *s.StackName != "eksctl-cluster-1-nodegroup-ng-1" {
+				return nil, nil
+			}
+			return []*cfn.StackResourceDrift{{
+				LogicalResourceId:        aws.String("SG"),
+				PhysicalResourceId:       aws.String("sg-123"),
+				ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+				StackResourceDriftStatus: aws.String(cfn.StackResourceDriftStatusModified),
+				PropertyDifferences: []*cfn.PropertyDifference{{
+					PropertyPath:   aws.String("/SecurityGroupIngress/0"),
+					DifferenceType: aws.String(cfn.DifferenceTypeAdd),
+				}},
+			}}, nil
+		}
+		stacks = []*manager.Stack{
+			{StackName: aws.String("eksctl-cluster-1-cluster"), StackStatus: aws.String(cfn.StackStatusCreateComplete)},
+			{StackName: aws.String("eksctl-cluster-1-nodegroup-ng-1"), StackStatus: aws.String(cfn.StackStatusUpdateComplete)},
+			{StackName: aws.String("eksctl-cluster-1-nodegroup-ng-2"), StackStatus: aws.String(cfn.StackStatusUpdateInProgress)},
+		}
+	})
+
+	It("reports the drifted resources of all stacks that are not being updated", func() {
+		drifted, err := detectDrift(fakeStackManager, stacks)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeStackManager.DetectStackDriftCallCount()).To(Equal(2))
+		Expect(drifted).To(Equal([]DriftedResource{{
+			StackName:          "eksctl-cluster-1-nodegroup-ng-1",
+			LogicalResourceID:  "SG",
+			PhysicalResourceID: "sg-123",
+			ResourceType:       "AWS::EC2::SecurityGroup",
+			DriftStatus:        cfn.StackResourceDriftStatusModified,
+			PropertyDifferences: []*cfn.PropertyDifference{{
+				PropertyPath:   aws.String("/SecurityGroupIngress/0"),
+				DifferenceType: aws.String(cfn.DifferenceTypeAdd),
+			}},
+		}}))
+
+		printer := printers.NewTablePrinter()
+		addDriftedResourceTableColumns(printer.(*printers.TablePrinter))
+		out := &bytes.Buffer{}
+		Expect(printer.PrintObjWithKind("drifted resources", drifted, out)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("/SecurityGroupIngress/0 (add)"))
+	})
+
+	It("returns an empty list when no resources have drifted", func() {
+		drifted, err := detectDrift(fakeStackManager, stacks[:1])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifted).To(BeEmpty())
+	})
+})
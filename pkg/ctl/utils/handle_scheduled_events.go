@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func handleScheduledEventsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options nodegroup.ScheduledEventsOptions
+
+	cmd.SetDescription("handle-scheduled-events", "Replace the nodegroup instances that have scheduled retirement, reboot or maintenance events",
+		"Cordons, drains and replaces the instances of the cluster's nodegroups that have scheduled EC2 events, ahead of the events")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if options.Within < 0 {
+			return fmt.Errorf("--within cannot be negative")
+		}
+		return doHandleScheduledEvents(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		fs.DurationVar(&options.Within, "within", 0, "Only replace the instances whose events are due to start within this duration, e.g. 72h (default: all events)")
+		defaultMaxGracePeriod, _ := time.ParseDuration("10m")
+		fs.DurationVar(&options.MaxGracePeriod, "max-grace-period", defaultMaxGracePeriod, "Maximum pods termination grace period")
+		fs.BoolVar(&options.DisableEviction, "disable-eviction", false, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doHandleScheduledEvents(cmd *cmdutils.Cmd, options nodegroup.ScheduledEventsOptions) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	options.Plan = cmd.Plan
+	report, err := nodegroup.New(cfg, ctl, clientSet).HandleScheduledEvents(options)
+	if len(report) > 0 {
+		printer := printers.NewTablePrinter().(*printers.TablePrinter)
+		addScheduledEventTableColumns(printer)
+		if err := printer.PrintObj(report, os.Stdout); err != nil {
+			logger.Warning("failed to print the report of scheduled events: %v", err)
+		}
+	}
+	return err
+}
+
+func addScheduledEventTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NODEGROUP", func(e nodegroup.ScheduledEvent) string {
+		return e.NodeGroup
+	})
+	printer.AddColumn("INSTANCE", func(e nodegroup.ScheduledEvent) string {
+		return e.InstanceID
+	})
+	printer.AddColumn("NODE", func(e nodegroup.ScheduledEvent) string {
+		return e.NodeName
+	})
+	printer.AddColumn("EVENT", func(e nodegroup.ScheduledEvent) string {
+		return e.Code
+	})
+	printer.AddColumn("NOT BEFORE", func(e nodegroup.ScheduledEvent) string {
+		return e.NotBefore.Format(time.RFC3339)
+	})
+	printer.AddColumn("ACTION", func(e nodegroup.ScheduledEvent) string {
+		return e.Action
+	})
+}
@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+func validateVPCCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var output printers.Type
+
+	cmd.SetDescription("validate-vpc", "Check that an existing VPC is ready for a cluster before creating it",
+		"Checks DNS support, routes, load balancer tags, availability zones and free IP addresses of the subnets of an existing VPC")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doValidateVPC(cmd, output)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&output, "output", "o", printers.TableType, "specifies the output format (valid option: table, json, yaml)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doValidateVPC(cmd *cmdutils.Cmd, output printers.Type) error {
+	if err := cmdutils.NewUtilsValidateVPCLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	if output == printers.TableType {
+		cmdutils.LogRegionAndVersionInfo(meta)
+	} else {
+		// log warnings and errors to stderr
+		logger.Writer = os.Stderr
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	// importing the subnets adds their zones to the availability zones, keep the ones that were chosen
+	availabilityZones := append([]string{}, cfg.AvailabilityZones...)
+	if err := vpc.ImportSubnetsFromSpec(ctl.Provider, cfg); err != nil {
+		return err
+	}
+
+	checks, err := vpc.CheckReadiness(ctl.Provider.EC2(), cfg, availabilityZones)
+	if err != nil {
+		return err
+	}
+
+	if output == printers.TableType {
+		addReadinessCheckTableColumns(printer.(*printers.TablePrinter))
+	}
+	if err := printer.PrintObjWithKind("readiness checks", checks, os.Stdout); err != nil {
+		return err
+	}
+
+	return reportReadiness(cfg.VPC.ID, checks)
+}
+
+func reportReadiness(vpcID string, checks []vpc.ReadinessCheck) error {
+	failed, warned := 0, 0
+	for _, c := range checks {
+		switch c.Status {
+		case vpc.ReadinessFail:
+			failed++
+		case vpc.ReadinessWarn:
+			warned++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("VPC %q failed %d of %d readiness check(s)", vpcID, failed, len(checks))
+	}
+	if warned > 0 {
+		logger.Warning("VPC %q passed all readiness checks, with %d warning(s)", vpcID, warned)
+		return nil
+	}
+	logger.Success("VPC %q passed all %d readiness checks", vpcID, len(checks))
+	return nil
+}
+
+func addReadinessCheckTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("RESOURCE", func(c vpc.ReadinessCheck) string {
+		return c.Resource
+	})
+	printer.AddColumn("CHECK", func(c vpc.ReadinessCheck) string {
+		return c.Check
+	})
+	printer.AddColumn("STATUS", func(c vpc.ReadinessCheck) string {
+		return string(c.Status)
+	})
+	printer.AddColumn("MESSAGE", func(c vpc.ReadinessCheck) string {
+		return c.Message
+	})
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("validate-vpc", func() {
+	checks := []vpc.ReadinessCheck{
+		{Resource: "vpc-1", Check: "enableDnsSupport", Status: vpc.ReadinessPass, Message: "enableDnsSupport is enabled"},
+		{Resource: "subnet-1", Check: "tags", Status: vpc.ReadinessWarn, Message: "not tagged with kubernetes.io/role/elb"},
+	}
+
+	It("prints the readiness checks", func() {
+		printer := printers.NewTablePrinter()
+		addReadinessCheckTableColumns(printer.(*printers.TablePrinter))
+		out := &bytes.Buffer{}
+		Expect(printer.PrintObjWithKind("readiness checks", checks, out)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("subnet-1"))
+		Expect(out.String()).To(ContainSubstring("WARN"))
+	})
+
+	It("does not fail on warnings", func() {
+		Expect(reportReadiness("vpc-1", checks)).To(Succeed())
+	})
+
+	It("fails when a check fails", func() {
+		failed := append(checks, vpc.ReadinessCheck{Resource: "subnet-2", Check: "routes", Status: vpc.ReadinessFail})
+		Expect(reportReadiness("vpc-1", failed)).To(MatchError(`VPC "vpc-1" failed 1 of 3 readiness check(s)`))
+	})
+})
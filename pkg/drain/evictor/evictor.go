@@ -53,9 +53,22 @@ type Evictor struct {
 
 	policyAPIGroupVersion string
 	UseEvictions          bool
+
+	podFilters PodFilters
+}
+
+// PodFilters select pods that are handled differently from the defaults
+type PodFilters struct {
+	// SkipPodSelector selects pods that are not evicted
+	SkipPodSelector labels.Selector
+	// SkipNamespaces are the namespaces whose pods are not evicted
+	SkipNamespaces []string
+	// ForceDeleteOlderThan, when non-zero, makes pods that have been running for longer than it be
+	// deleted rather than evicted
+	ForceDeleteOlderThan time.Duration
 }
 
-func New(clientSet kubernetes.Interface, maxGracePeriod time.Duration, ignoreDaemonSets []metav1.ObjectMeta, disableEviction bool) *Evictor {
+func New(clientSet kubernetes.Interface, maxGracePeriod time.Duration, ignoreDaemonSets []metav1.ObjectMeta, disableEviction bool, podFilters PodFilters) *Evictor {
 	return &Evictor{
 		client: clientSet,
 		// TODO: force, DeleteLocalData & IgnoreAllDaemonSets shouldn't
@@ -69,6 +82,7 @@ func New(clientSet kubernetes.Interface, maxGracePeriod time.Duration, ignoreDae
 		maxGracePeriodSeconds: int(maxGracePeriod.Seconds()),
 		ignoreDaemonSets:      ignoreDaemonSets,
 		disableEviction:       disableEviction,
+		podFilters:            podFilters,
 	}
 }
 
@@ -126,12 +140,20 @@ func (d *Evictor) makeDeleteOptions(pod corev1.Pod) metav1.DeleteOptions {
 // EvictOrDeletePod will evict Pod if policy API is available, otherwise deletes it. If disableEviction is true, we skip straight to the delete step
 // NOTE: CanUseEvictions must be called prior to this
 func (d *Evictor) EvictOrDeletePod(pod corev1.Pod) error {
-	if d.UseEvictions {
+	if d.UseEvictions && !d.isForceDeleted(pod) {
 		return d.evictPod(pod)
 	}
 	return d.deletePod(pod)
 }
 
+// isForceDeleted returns true if the pod has been running for long enough to be deleted rather than evicted
+func (d *Evictor) isForceDeleted(pod corev1.Pod) bool {
+	if d.podFilters.ForceDeleteOlderThan == 0 || pod.CreationTimestamp.IsZero() {
+		return false
+	}
+	return time.Since(pod.CreationTimestamp.Time) > d.podFilters.ForceDeleteOlderThan
+}
+
 // evictPod will evict the give Pod, or return an error if it couldn't
 // NOTE: CanUseEvictions must be called prior to this
 func (d *Evictor) evictPod(pod corev1.Pod) error {
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 const (
@@ -141,6 +142,7 @@ func makePodDeleteStatusWithError(message string) PodDeleteStatus {
 
 func (d *Evictor) makeFilters() []podFilter {
 	return []podFilter{
+		d.skipFilter,
 		d.annotationFilter,
 		d.daemonSetFilter,
 		d.mirrorPodFilter,
@@ -175,6 +177,19 @@ func (d *Evictor) annotationFilter(pod corev1.Pod) PodDeleteStatus {
 	return makePodDeleteStatusOkay()
 }
 
+// skipFilter is our custom addition, it skips the pods selected by PodFilters
+func (d *Evictor) skipFilter(pod corev1.Pod) PodDeleteStatus {
+	for _, namespace := range d.podFilters.SkipNamespaces {
+		if pod.Namespace == namespace {
+			return makePodDeleteStatusSkip()
+		}
+	}
+	if selector := d.podFilters.SkipPodSelector; selector != nil && !selector.Empty() && selector.Matches(labels.Set(pod.Labels)) {
+		return makePodDeleteStatusSkip()
+	}
+	return makePodDeleteStatusOkay()
+}
+
 func (d *Evictor) daemonSetFilter(pod corev1.Pod) PodDeleteStatus {
 	// Note that we return false in cases where the Pod is DaemonSet managed,
 	// regardless of flags.
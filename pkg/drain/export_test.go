@@ -3,3 +3,8 @@ package drain
 func (n *NodeGroupDrainer) SetDrainer(drainer Evictor) {
 	n.evictor = drainer
 }
+
+// BlockingPDBs evicts the pods of a node and returns the PodDisruptionBudgets that prevented evictions
+func (n *NodeGroupDrainer) BlockingPDBs(node string) []string {
+	return n.evictPods(node).blockingPDBs()
+}
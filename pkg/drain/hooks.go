@@ -0,0 +1,96 @@
+package drain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// drainHookRequest is the body of the requests sent to HTTP drain hooks
+type drainHookRequest struct {
+	Phase     string `json:"phase"`
+	NodeGroup string `json:"nodegroup"`
+	Node      string `json:"node"`
+}
+
+// runDrainHooks runs the given hooks one after the other, and stops at the first one that fails
+func runDrainHooks(hooks []api.DrainHook, phase, nodeGroupName, node string) error {
+	request := drainHookRequest{
+		Phase:     phase,
+		NodeGroup: nodeGroupName,
+		Node:      node,
+	}
+	for i, hook := range hooks {
+		if err := runDrainHook(hook, request); err != nil {
+			return errors.Wrapf(err, "%s hook %d failed for node %s", phase, i, node)
+		}
+	}
+	return nil
+}
+
+func runDrainHook(hook api.DrainHook, request drainHookRequest) error {
+	timeoutSeconds := api.DefaultDrainHookTimeoutSeconds
+	if hook.TimeoutSeconds != nil {
+		timeoutSeconds = *hook.TimeoutSeconds
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	if hook.URL != "" {
+		return callDrainHookURL(ctx, hook.URL, request)
+	}
+	return runDrainHookCommand(ctx, hook.Command, request)
+}
+
+func callDrainHookURL(ctx context.Context, url string, request drainHookRequest) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// include the start of the response, which may explain why the node must not be drained
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s responded with %s: %s", url, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func runDrainHookCommand(ctx context.Context, command []string, request drainHookRequest) error {
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Env = append(os.Environ(),
+		"DRAIN_PHASE="+request.Phase,
+		"NODEGROUP_NAME="+request.NodeGroup,
+		"NODE_NAME="+request.Node,
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s: %v: %s", strings.Join(command, " "), err, strings.TrimSpace(string(out)))
+	}
+	logger.Debug("%s hook %q for node %s: %s", request.Phase, strings.Join(command, " "), request.Node, out)
+	return nil
+}
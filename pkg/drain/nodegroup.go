@@ -3,10 +3,12 @@ package drain
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/drain/evictor"
 
 	corev1 "k8s.io/api/core/v1"
@@ -15,6 +17,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaveworks/eksctl/pkg/eks"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 )
@@ -39,9 +43,19 @@ type NodeGroupDrainer struct {
 	ng          eks.KubeNodeGroup
 	waitTimeout time.Duration
 	undo        bool
+	parallel    int
+
+	preDrainHooks  []api.DrainHook
+	postDrainHooks []api.DrainHook
+	// preDrainedNodes are the nodes whose pre-drain hooks have succeeded
+	preDrainedNodes sets.String
+	// err is an invalid drain config, returned by Drain before any node is touched
+	err error
 }
 
-func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, waitTimeout time.Duration, maxGracePeriod time.Duration, undo bool, disableEviction bool) NodeGroupDrainer {
+// NewNodeGroupDrainer creates a drainer for a nodegroup that drains up to parallel nodes at once;
+// drainConfig is optional and adds pod filters and drain hooks
+func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, waitTimeout time.Duration, maxGracePeriod time.Duration, undo bool, disableEviction bool, parallel int, drainConfig *api.DrainConfig) NodeGroupDrainer {
 	ignoreDaemonSets := []metav1.ObjectMeta{
 		{
 			Namespace: "kube-system",
@@ -68,17 +82,49 @@ func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, w
 		},
 	}
 
-	return NodeGroupDrainer{
-		evictor:     evictor.New(clientSet, maxGracePeriod, ignoreDaemonSets, disableEviction),
-		clientSet:   clientSet,
-		ng:          ng,
-		waitTimeout: waitTimeout,
-		undo:        undo,
+	filters, err := podFilters(drainConfig)
+
+	drainer := NodeGroupDrainer{
+		evictor:         evictor.New(clientSet, maxGracePeriod, ignoreDaemonSets, disableEviction, filters),
+		clientSet:       clientSet,
+		ng:              ng,
+		waitTimeout:     waitTimeout,
+		undo:            undo,
+		parallel:        parallel,
+		preDrainedNodes: sets.NewString(),
+		err:             err,
+	}
+	if drainConfig != nil {
+		drainer.preDrainHooks = drainConfig.PreDrainHooks
+		drainer.postDrainHooks = drainConfig.PostDrainHooks
+	}
+	return drainer
+}
+
+func podFilters(drainConfig *api.DrainConfig) (evictor.PodFilters, error) {
+	var filters evictor.PodFilters
+	if drainConfig == nil {
+		return filters, nil
+	}
+	filters.SkipNamespaces = drainConfig.SkipNamespaces
+	if drainConfig.SkipPodSelector != "" {
+		selector, err := labels.Parse(drainConfig.SkipPodSelector)
+		if err != nil {
+			return filters, errors.Wrapf(err, "invalid drain.skipPodSelector %q", drainConfig.SkipPodSelector)
+		}
+		filters.SkipPodSelector = selector
 	}
+	if drainConfig.ForceDeletePodsOlderThanMinutes != nil {
+		filters.ForceDeleteOlderThan = time.Duration(*drainConfig.ForceDeletePodsOlderThanMinutes) * time.Minute
+	}
+	return filters, nil
 }
 
 // Drain drains a nodegroup
 func (n *NodeGroupDrainer) Drain() error {
+	if n.err != nil {
+		return n.err
+	}
 	if err := n.evictor.CanUseEvictions(); err != nil {
 		return errors.Wrap(err, "checking if cluster implements policy API")
 	}
@@ -100,6 +146,7 @@ func (n *NodeGroupDrainer) Drain() error {
 	}
 
 	drainedNodes := sets.NewString()
+	progress := newDrainProgress(n.ng.NameString())
 	// loop until all nodes are drained to handle accidental scale-up
 	// or any other changes in the ASG
 	timer := time.NewTimer(n.waitTimeout)
@@ -108,6 +155,7 @@ func (n *NodeGroupDrainer) Drain() error {
 	for {
 		select {
 		case <-timer.C:
+			progress.logSummary()
 			return fmt.Errorf("timed out (after %s) waiting for nodegroup %q to be drained", n.waitTimeout, n.ng.NameString())
 		default:
 			nodes, err := n.clientSet.CoreV1().Nodes().List(context.TODO(), listOptions)
@@ -132,20 +180,109 @@ func (n *NodeGroupDrainer) Drain() error {
 			logger.Debug("already drained: %v", drainedNodes.List())
 			logger.Debug("will drain: %v", newPendingNodes.List())
 
-			for _, node := range newPendingNodes.List() {
-				pending, err := n.evictPods(node)
-				if err != nil {
-					logger.Warning("pod eviction error (%q) on node %s", err, node)
-					time.Sleep(retryDelay)
-					continue
-				}
-				logger.Debug("%d pods to be evicted from %s", pending, node)
-				if pending == 0 {
-					drainedNodes.Insert(node)
+			n.drainRound(newPendingNodes.List(), drainedNodes, progress)
+		}
+	}
+}
+
+// DrainNodes cordons and drains the given nodes of the nodegroup, or uncordons them when undo is set; unlike
+// Drain it ignores any other nodes, so that a nodegroup can be drained a few nodes at a time
+func (n *NodeGroupDrainer) DrainNodes(nodeNames []string) error {
+	if n.err != nil {
+		return n.err
+	}
+	if err := n.evictor.CanUseEvictions(); err != nil {
+		return errors.Wrap(err, "checking if cluster implements policy API")
+	}
+
+	nodes := &corev1.NodeList{}
+	for _, name := range nodeNames {
+		node, err := n.clientSet.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		nodes.Items = append(nodes.Items, *node)
+	}
+	if n.undo {
+		n.toggleCordon(false, nodes)
+		return nil // no need to kill any pods
+	}
+	n.toggleCordon(true, nodes)
+
+	drainedNodes := sets.NewString()
+	progress := newDrainProgress(n.ng.NameString())
+	timer := time.NewTimer(n.waitTimeout)
+	defer timer.Stop()
+
+	for drainedNodes.Len() < len(nodeNames) {
+		select {
+		case <-timer.C:
+			progress.logSummary()
+			return fmt.Errorf("timed out (after %s) waiting for nodes %v of nodegroup %q to be drained", n.waitTimeout, nodeNames, n.ng.NameString())
+		default:
+			var pendingNodes []string
+			for _, node := range nodeNames {
+				if !drainedNodes.Has(node) {
+					pendingNodes = append(pendingNodes, node)
 				}
 			}
+			n.drainRound(pendingNodes, drainedNodes, progress)
 		}
 	}
+	logger.Success("drained nodes: %v", drainedNodes.List())
+	return nil
+}
+
+// drainRound evicts the pods of the given nodes, draining up to n.parallel nodes at once, and
+// adds the nodes that have no pods left to drainedNodes
+func (n *NodeGroupDrainer) drainRound(nodeNames []string, drainedNodes sets.String, progress *drainProgress) {
+	parallel := n.parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	nodeGroupName := n.ng.NameString()
+	statuses := make([]nodeDrainStatus, len(nodeNames))
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	for i, node := range nodeNames {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, node string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			statuses[i] = n.drainNode(nodeGroupName, node)
+		}(i, node)
+	}
+	wg.Wait()
+
+	failed := false
+	for _, status := range statuses {
+		if status.preDrained {
+			n.preDrainedNodes.Insert(status.node)
+		}
+		if status.err != nil {
+			logger.Warning(status.err.Error())
+			failed = true
+			continue
+		}
+		logger.Debug("%d pods to be evicted from %s", len(status.pods), status.node)
+		if status.drained() {
+			drainedNodes.Insert(status.node)
+			if err := runDrainHooks(n.postDrainHooks, api.PostDrainPhase, nodeGroupName, status.node); err != nil {
+				logger.Warning(err.Error())
+			}
+		} else if status.blocked() {
+			failed = true
+		}
+	}
+	progress.update(statuses)
+
+	if failed {
+		time.Sleep(retryDelay)
+	}
 }
 
 func (n *NodeGroupDrainer) toggleCordon(cordon bool, nodes *corev1.NodeList) {
@@ -167,23 +304,71 @@ func (n *NodeGroupDrainer) toggleCordon(cordon bool, nodes *corev1.NodeList) {
 
 }
 
-func (n *NodeGroupDrainer) evictPods(node string) (int, error) {
+// drainNode runs the pre-drain hooks of a node, unless they have already succeeded, and evicts its pods
+func (n *NodeGroupDrainer) drainNode(nodeGroupName, node string) nodeDrainStatus {
+	if !n.preDrainedNodes.Has(node) {
+		if err := runDrainHooks(n.preDrainHooks, api.PreDrainPhase, nodeGroupName, node); err != nil {
+			return nodeDrainStatus{node: node, err: err}
+		}
+	}
+	status := n.evictPods(node)
+	status.preDrained = true
+	return status
+}
+
+// evictPods evicts the pods of a node that can be evicted, and returns the pods that are still running on the node;
+// unlike kubectl, it goes on with the other pods when the eviction of a pod fails, and finds the PodDisruptionBudgets
+// that prevent evictions
+func (n *NodeGroupDrainer) evictPods(node string) nodeDrainStatus {
+	status := nodeDrainStatus{node: node}
 	list, errs := n.evictor.GetPodsForEviction(node)
 	if len(errs) > 0 {
-		return 0, fmt.Errorf("errs: %v", errs) // TODO: improve formatting
+		status.err = fmt.Errorf("pod eviction error on node %s: %v", node, errs) // TODO: improve formatting
+		return status
 	}
 	if w := list.Warnings(); w != "" {
 		logger.Warning(w)
 	}
-	pods := list.Pods()
-	pending := len(pods)
-	for _, pod := range pods {
+	for _, pod := range list.Pods() {
+		pending := pendingPod{
+			node:      node,
+			namespace: pod.Namespace,
+			name:      pod.Name,
+		}
 		// TODO: handle API rate limiter error
 		if err := n.evictor.EvictOrDeletePod(pod); err != nil {
-			return pending, errors.Wrapf(err, "error evicting pod: %s/%s", pod.Namespace, pod.Name)
+			pending.err = errors.Wrapf(err, "error evicting pod: %s/%s", pod.Namespace, pod.Name)
+			if apierrors.IsTooManyRequests(err) {
+				// reported in the drain status
+				pending.blockingPDBs = n.findBlockingPDBs(pod)
+				logger.Debug(pending.err.Error())
+			} else {
+				logger.Warning("pod eviction error (%q) on node %s", pending.err, node)
+			}
+		}
+		status.pods = append(status.pods, pending)
+	}
+	return status
+}
+
+// findBlockingPDBs returns the PodDisruptionBudgets that select a pod whose eviction was refused
+func (n *NodeGroupDrainer) findBlockingPDBs(pod corev1.Pod) []string {
+	pdbs, err := n.clientSet.PolicyV1beta1().PodDisruptionBudgets(pod.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		logger.Debug("listing PodDisruptionBudgets in namespace %q: %v", pod.Namespace, err)
+		return nil
+	}
+	var blocking []string
+	for _, pdb := range pdbs.Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		if !selector.Empty() && selector.Matches(labels.Set(pod.Labels)) {
+			blocking = append(blocking, fmt.Sprintf("%s/%s", pdb.Namespace, pdb.Name))
 		}
 	}
-	return pending, nil
+	return blocking
 }
 
 func cordonStatus(desired bool) string {
@@ -2,10 +2,19 @@ package drain_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/weaveworks/eksctl/pkg/drain/evictor"
 
@@ -16,6 +25,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/drain"
 	"github.com/weaveworks/eksctl/pkg/eks/mocks"
 	"k8s.io/client-go/kubernetes/fake"
@@ -73,7 +84,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("does not error", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, false)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, false, 1, nil)
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain()
@@ -117,7 +128,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("times out and errors", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*2, time.Second, false, false)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*2, time.Second, false, false, 1, nil)
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain()
@@ -131,7 +142,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("errors", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, false, false)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, false, false, 1, nil)
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain()
@@ -175,7 +186,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("does not error", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, true)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, true, 1, nil)
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain()
@@ -205,7 +216,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("uncordons all the nodes", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, true, false)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, true, false, 1, nil)
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain()
@@ -219,4 +230,264 @@ var _ = Describe("Drain", func() {
 			Expect(fakeEvictor.EvictOrDeletePodCallCount()).To(BeZero())
 		})
 	})
+
+	When("draining some of the nodes", func() {
+		BeforeEach(func() {
+			for _, name := range []string{nodeName, "node-2"} {
+				_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: name,
+					},
+				}, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			fakeEvictor.GetPodsForEvictionReturns(&evictor.PodDeleteList{}, nil)
+		})
+
+		It("only cordons and drains the given nodes", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, false, 1, nil)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			err := nodeGroupDrainer.DrainNodes([]string{nodeName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeEvictor.GetPodsForEvictionCallCount()).To(Equal(1))
+			Expect(fakeEvictor.GetPodsForEvictionArgsForCall(0)).To(Equal(nodeName))
+
+			node, err := fakeClientSet.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+
+			node, err = fakeClientSet.CoreV1().Nodes().Get(context.TODO(), "node-2", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+		})
+	})
+
+	When("draining several nodes at once", func() {
+		BeforeEach(func() {
+			for _, name := range []string{"node-1", "node-2", "node-3"} {
+				_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: name,
+					},
+				}, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			fakeEvictor.GetPodsForEvictionReturns(&evictor.PodDeleteList{}, nil)
+		})
+
+		It("drains all the nodes", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, false, 2, nil)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			err := nodeGroupDrainer.Drain()
+			Expect(err).NotTo(HaveOccurred())
+
+			var drainedNodes []string
+			for i := 0; i < fakeEvictor.GetPodsForEvictionCallCount(); i++ {
+				drainedNodes = append(drainedNodes, fakeEvictor.GetPodsForEvictionArgsForCall(i))
+			}
+			Expect(drainedNodes).To(ConsistOf("node-1", "node-2", "node-3"))
+		})
+	})
+
+	When("a PodDisruptionBudget blocks an eviction", func() {
+		BeforeEach(func() {
+			pod := corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "pod-1",
+					Labels:    map[string]string{"app": "web"},
+				},
+			}
+			fakeEvictor.GetPodsForEvictionReturns(&evictor.PodDeleteList{
+				Items: []evictor.PodDelete{
+					{
+						Pod: pod,
+						Status: evictor.PodDeleteStatus{
+							Delete: true,
+						},
+					},
+				},
+			}, nil)
+			fakeEvictor.EvictOrDeletePodReturns(apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10))
+
+			for _, pdb := range []*policyv1beta1.PodDisruptionBudget{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+					Spec: policyv1beta1.PodDisruptionBudgetSpec{
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "db"},
+					Spec: policyv1beta1.PodDisruptionBudgetSpec{
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+					},
+				},
+			} {
+				_, err := fakeClientSet.PolicyV1beta1().PodDisruptionBudgets("default").Create(context.TODO(), pdb, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		It("finds the PodDisruptionBudgets that select the pod", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, false, 1, nil)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			Expect(nodeGroupDrainer.BlockingPDBs(nodeName)).To(Equal([]string{"default/web"}))
+		})
+	})
+
+	When("drain hooks are configured", func() {
+		var (
+			server      *httptest.Server
+			mu          sync.Mutex
+			requests    []string
+			preStatus   int
+			drainConfig *api.DrainConfig
+		)
+
+		BeforeEach(func() {
+			requests = nil
+			preStatus = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]string
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				mu.Lock()
+				requests = append(requests, fmt.Sprintf("%s %s %s", body["phase"], body["nodegroup"], body["node"]))
+				mu.Unlock()
+				if r.URL.Path == "/pre" {
+					w.WriteHeader(preStatus)
+				}
+			}))
+			drainConfig = &api.DrainConfig{
+				PreDrainHooks:  []api.DrainHook{{URL: server.URL + "/pre"}},
+				PostDrainHooks: []api.DrainHook{{URL: server.URL + "/post"}},
+			}
+
+			_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			fakeEvictor.GetPodsForEvictionReturns(&evictor.PodDeleteList{}, nil)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("calls the hooks before and after draining each node", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, false, 1, drainConfig)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			Expect(nodeGroupDrainer.Drain()).To(Succeed())
+			Expect(requests).To(Equal([]string{"pre-drain node-1 node-1", "post-drain node-1 node-1"}))
+		})
+
+		It("does not drain a node until its pre-drain hooks succeed", func() {
+			preStatus = http.StatusForbidden
+
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*2, time.Second, false, false, 1, drainConfig)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			err := nodeGroupDrainer.Drain()
+			Expect(err).To(MatchError("timed out (after 2s) waiting for nodegroup \"node-1\" to be drained"))
+			Expect(fakeEvictor.GetPodsForEvictionCallCount()).To(BeZero())
+			Expect(requests).To(ContainElement("pre-drain node-1 node-1"))
+			Expect(requests).NotTo(ContainElement("post-drain node-1 node-1"))
+		})
+
+		It("runs commands with the details of the node", func() {
+			dir, err := ioutil.TempDir("", "drain_hooks")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+			out := filepath.Join(dir, "hook.out")
+			drainConfig.PreDrainHooks = []api.DrainHook{
+				{Command: []string{"sh", "-c", `echo "$DRAIN_PHASE $NODEGROUP_NAME $NODE_NAME" > ` + out}},
+			}
+
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, false, 1, drainConfig)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			Expect(nodeGroupDrainer.Drain()).To(Succeed())
+			Expect(ioutil.ReadFile(out)).To(BeEquivalentTo("pre-drain node-1 node-1\n"))
+		})
+
+		It("does not drain any node when the skip pod selector is invalid", func() {
+			drainConfig.SkipPodSelector = "app in (web"
+
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, false, 1, drainConfig)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			Expect(nodeGroupDrainer.Drain()).To(MatchError(ContainSubstring("invalid drain.skipPodSelector")))
+			Expect(fakeEvictor.GetPodsForEvictionCallCount()).To(BeZero())
+			Expect(requests).To(BeEmpty())
+		})
+	})
+
+	When("selecting some of the nodes", func() {
+		BeforeEach(func() {
+			for _, node := range []corev1.Node{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "node-a",
+						Labels: map[string]string{corev1.LabelTopologyZone: "us-west-2a", "role": "web"},
+					},
+					Spec: corev1.NodeSpec{ProviderID: "aws:///us-west-2a/i-aaa", Unschedulable: true},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "node-b",
+						Labels: map[string]string{corev1.LabelFailureDomainBetaZone: "us-west-2b", "role": "web"},
+					},
+					Spec: corev1.NodeSpec{ProviderID: "aws:///us-west-2b/i-bbb", Unschedulable: true},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "node-c",
+						Labels: map[string]string{corev1.LabelTopologyZone: "us-west-2a", "role": "db"},
+					},
+					Spec: corev1.NodeSpec{ProviderID: "aws:///us-west-2a/i-ccc", Unschedulable: true},
+				},
+			} {
+				node := node
+				_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), &node, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		DescribeTable("selects the matching nodes",
+			func(selector drain.NodeSelector, expected []string) {
+				nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, false, 1, nil)
+
+				nodeNames, err := nodeGroupDrainer.SelectNodes(selector)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nodeNames).To(Equal(expected))
+			},
+			Entry("by availability zone", drain.NodeSelector{AvailabilityZone: "us-west-2a"}, []string{"node-a", "node-c"}),
+			Entry("by instance ID", drain.NodeSelector{InstanceIDs: []string{"i-bbb", "i-ccc", "i-ddd"}}, []string{"node-b", "node-c"}),
+			Entry("by label", drain.NodeSelector{LabelSelector: "role=web"}, []string{"node-a", "node-b"}),
+			Entry("by several criteria", drain.NodeSelector{LabelSelector: "role=web", AvailabilityZone: "us-west-2b"}, []string{"node-b"}),
+		)
+
+		It("only uncordons the given nodes when undo is set", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, true, false, 1, nil)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			Expect(nodeGroupDrainer.DrainNodes([]string{"node-a"})).To(Succeed())
+			Expect(fakeEvictor.GetPodsForEvictionCallCount()).To(BeZero())
+
+			node, err := fakeClientSet.CoreV1().Nodes().Get(context.TODO(), "node-a", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+
+			node, err = fakeClientSet.CoreV1().Nodes().Get(context.TODO(), "node-b", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+		})
+	})
 })
@@ -0,0 +1,149 @@
+package drain
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+// pendingPod is a pod that is still running on a node being drained
+type pendingPod struct {
+	node      string
+	namespace string
+	name      string
+	// err is set when the pod could not be evicted
+	err error
+	// blockingPDBs are the PodDisruptionBudgets that prevented the eviction of the pod
+	blockingPDBs []string
+}
+
+// reason describes why the pod is still running on the node
+func (p pendingPod) reason() string {
+	switch {
+	case len(p.blockingPDBs) > 0:
+		return "eviction blocked by PodDisruptionBudget " + strings.Join(p.blockingPDBs, ", ")
+	case p.err != nil:
+		return p.err.Error()
+	default:
+		return "waiting for the pod to terminate"
+	}
+}
+
+// nodeDrainStatus is the outcome of an attempt to evict the pods of a node
+type nodeDrainStatus struct {
+	node string
+	pods []pendingPod
+	err  error
+	// preDrained is true once the pre-drain hooks of the node have succeeded
+	preDrained bool
+}
+
+func (s nodeDrainStatus) drained() bool {
+	return s.err == nil && len(s.pods) == 0
+}
+
+// blocked returns true when the eviction of any of the pods failed
+func (s nodeDrainStatus) blocked() bool {
+	for _, p := range s.pods {
+		if p.err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (s nodeDrainStatus) blockingPDBs() []string {
+	var pdbs []string
+	seen := map[string]bool{}
+	for _, p := range s.pods {
+		for _, pdb := range p.blockingPDBs {
+			if !seen[pdb] {
+				seen[pdb] = true
+				pdbs = append(pdbs, pdb)
+			}
+		}
+	}
+	sort.Strings(pdbs)
+	return pdbs
+}
+
+// drainProgress keeps track of the nodes of a nodegroup that are being drained, and reports their status
+type drainProgress struct {
+	nodeGroupName string
+	nodes         map[string]nodeDrainStatus
+	lastReport    string
+}
+
+func newDrainProgress(nodeGroupName string) *drainProgress {
+	return &drainProgress{
+		nodeGroupName: nodeGroupName,
+		nodes:         map[string]nodeDrainStatus{},
+	}
+}
+
+// update records the latest status of the given nodes, and logs the status of all nodes being drained
+// whenever it changes
+func (p *drainProgress) update(statuses []nodeDrainStatus) {
+	for _, s := range statuses {
+		p.nodes[s.node] = s
+	}
+
+	printer := printers.NewTablePrinter().(*printers.TablePrinter)
+	printer.AddColumn("NODE", func(s nodeDrainStatus) string { return s.node })
+	printer.AddColumn("PENDING PODS", func(s nodeDrainStatus) int { return len(s.pods) })
+	printer.AddColumn("BLOCKING PDBS", func(s nodeDrainStatus) string {
+		if pdbs := s.blockingPDBs(); len(pdbs) > 0 {
+			return strings.Join(pdbs, ",")
+		}
+		return "-"
+	})
+
+	b := &bytes.Buffer{}
+	if err := printer.PrintObj(p.sortedNodes(), b); err != nil {
+		logger.Debug("printing drain status: %v", err)
+		return
+	}
+	if report := b.String(); report != p.lastReport {
+		p.lastReport = report
+		logger.Info("drain status of nodegroup %q:\n%s", p.nodeGroupName, strings.ReplaceAll(report, "%", "%%"))
+	}
+}
+
+// logSummary logs the pods that could not be moved off the nodes being drained
+func (p *drainProgress) logSummary() {
+	var pods []pendingPod
+	for _, s := range p.sortedNodes() {
+		if s.err != nil {
+			pods = append(pods, pendingPod{node: s.node, namespace: "-", name: "-", err: s.err})
+		}
+		pods = append(pods, s.pods...)
+	}
+	if len(pods) == 0 {
+		return
+	}
+
+	printer := printers.NewTablePrinter().(*printers.TablePrinter)
+	printer.AddColumn("NODE", func(p pendingPod) string { return p.node })
+	printer.AddColumn("NAMESPACE", func(p pendingPod) string { return p.namespace })
+	printer.AddColumn("POD", func(p pendingPod) string { return p.name })
+	printer.AddColumn("REASON", func(p pendingPod) string { return p.reason() })
+	if err := printer.LogObj(logger.Warning, fmt.Sprintf("the following pods could not be moved off the nodes of nodegroup %q:", p.nodeGroupName)+"\n%s", pods); err != nil {
+		logger.Debug("printing drain summary: %v", err)
+	}
+}
+
+func (p *drainProgress) sortedNodes() []nodeDrainStatus {
+	var statuses []nodeDrainStatus
+	for _, s := range p.nodes {
+		statuses = append(statuses, s)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].node < statuses[j].node
+	})
+	return statuses
+}
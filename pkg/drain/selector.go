@@ -0,0 +1,75 @@
+package drain
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// NodeSelector selects a subset of the nodes of a nodegroup, nodes must match all the criteria that are set
+type NodeSelector struct {
+	// LabelSelector is a label selector of nodes
+	LabelSelector string
+	// AvailabilityZone is the availability zone of nodes
+	AvailabilityZone string
+	// InstanceIDs are the IDs of the EC2 instances of nodes
+	InstanceIDs []string
+}
+
+// IsEmpty returns true if the selector doesn't set any criteria, i.e. it selects all nodes
+func (s NodeSelector) IsEmpty() bool {
+	return s.LabelSelector == "" && s.AvailabilityZone == "" && len(s.InstanceIDs) == 0
+}
+
+// SelectNodes returns the names of the nodes of the nodegroup that match the selector
+func (n *NodeGroupDrainer) SelectNodes(selector NodeSelector) ([]string, error) {
+	listOptions := n.ng.ListOptions()
+	switch {
+	case selector.LabelSelector == "":
+	case listOptions.LabelSelector == "":
+		listOptions.LabelSelector = selector.LabelSelector
+	default:
+		listOptions.LabelSelector += "," + selector.LabelSelector
+	}
+	nodes, err := n.clientSet.CoreV1().Nodes().List(context.TODO(), listOptions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing nodes of nodegroup %q", n.ng.NameString())
+	}
+
+	instanceIDs := sets.NewString(selector.InstanceIDs...)
+	foundInstanceIDs := sets.NewString()
+	var nodeNames []string
+	for _, node := range nodes.Items {
+		if selector.AvailabilityZone != "" && nodeZone(node) != selector.AvailabilityZone {
+			continue
+		}
+		if instanceIDs.Len() > 0 {
+			instanceID := eks.InstanceIDFromProviderID(node.Spec.ProviderID)
+			if !instanceIDs.Has(instanceID) {
+				continue
+			}
+			foundInstanceIDs.Insert(instanceID)
+		}
+		nodeNames = append(nodeNames, node.Name)
+	}
+
+	if missing := instanceIDs.Difference(foundInstanceIDs); missing.Len() > 0 {
+		logger.Warning("instance(s) %s are not nodes of nodegroup %q", strings.Join(missing.List(), ", "), n.ng.NameString())
+	}
+	sort.Strings(nodeNames)
+	return nodeNames, nil
+}
+
+func nodeZone(node corev1.Node) string {
+	if zone, ok := node.Labels[corev1.LabelTopologyZone]; ok {
+		return zone
+	}
+	return node.Labels[corev1.LabelFailureDomainBetaZone]
+}
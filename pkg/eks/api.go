@@ -98,6 +98,11 @@ func (p ProviderServices) CloudFormationDisableRollback() bool {
 	return p.spec.CloudFormationDisableRollback
 }
 
+// CloudFormationPreviewChanges returns whether stack changes should be previewed and confirmed before they are executed
+func (p ProviderServices) CloudFormationPreviewChanges() bool {
+	return p.spec.CloudFormationPreviewChanges
+}
+
 // ASG returns a representation of the AutoScaling API
 func (p ProviderServices) ASG() autoscalingiface.AutoScalingAPI { return p.asg }
 
@@ -134,6 +139,9 @@ func (p ProviderServices) Profile() string { return p.spec.Profile }
 // WaitTimeout returns provider-level duration after which any wait operation has to timeout
 func (p ProviderServices) WaitTimeout() time.Duration { return p.spec.WaitTimeout }
 
+// ResumeCreation returns whether resources left behind by an earlier attempt to create the cluster should be reused
+func (p ProviderServices) ResumeCreation() bool { return p.spec.ResumeCreation }
+
 func (p ProviderServices) ConfigProvider() client.ConfigProvider {
 	return p.session
 }
@@ -313,7 +321,7 @@ func (c *ClusterProvider) checkAuth() error {
 }
 
 // ResolveAMI ensures that the node AMI is set and is available
-func ResolveAMI(provider api.ClusterProvider, version string, np api.NodePool) error {
+func ResolveAMI(provider api.ClusterProvider, version string, np api.NodePool, catalog *api.AMICatalog) error {
 	var resolver ami.Resolver
 	ng := np.BaseNodeGroup()
 	switch ng.AMI {
@@ -321,6 +329,11 @@ func ResolveAMI(provider api.ClusterProvider, version string, np api.NodePool) e
 		resolver = ami.NewAutoResolver(provider.EC2())
 	case api.NodeImageResolverAutoSSM:
 		resolver = ami.NewSSMResolver(provider.SSM())
+	case api.NodeImageResolverCustomCatalog:
+		if catalog == nil {
+			return errors.Errorf("amiCatalog must be set to use ami: %s", api.NodeImageResolverCustomCatalog)
+		}
+		resolver = ami.NewCatalogResolver(provider.SSM(), provider.EC2(), catalog)
 	case "":
 		resolver = ami.NewMultiResolver(
 			ami.NewSSMResolver(provider.SSM()),
@@ -93,7 +93,7 @@ var _ = Describe("eksctl API", func() {
 		})
 
 		testEnsureAMI := func(matcher gomegatypes.GomegaMatcher) {
-			err := ResolveAMI(provider, "1.14", ng)
+			err := ResolveAMI(provider, "1.14", ng, nil)
 			ExpectWithOffset(1, err).ToNot(HaveOccurred())
 			ExpectWithOffset(1, ng.AMI).To(matcher)
 		}
@@ -127,6 +127,28 @@ var _ = Describe("eksctl API", func() {
 
 			testEnsureAMI(Equal("ami-auto"))
 		})
+
+		It("should resolve the AMI from the catalog when AMI is custom-catalog", func() {
+			ng.AMI = api.NodeImageResolverCustomCatalog
+			provider.MockSSM().On("GetParameter", &ssm.GetParameterInput{
+				Name: aws.String("/my-org/eks/1.14/x86_64/image_id"),
+			}).Return(&ssm.GetParameterOutput{
+				Parameter: &ssm.Parameter{
+					Value: aws.String("ami-catalog"),
+				},
+			}, nil)
+
+			err := ResolveAMI(provider, "1.14", ng, &api.AMICatalog{
+				SSMParameter: "/my-org/eks/{kubernetesVersion}/{architecture}/image_id",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ng.AMI).To(Equal("ami-catalog"))
+		})
+
+		It("should fail when AMI is custom-catalog and there is no catalog", func() {
+			ng.AMI = api.NodeImageResolverCustomCatalog
+			Expect(ResolveAMI(provider, "1.14", ng, nil)).To(MatchError("amiCatalog must be set to use ami: custom-catalog"))
+		})
 	})
 
 })
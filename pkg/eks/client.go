@@ -171,7 +171,7 @@ func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng KubeNo
 			logger.Debug("event = %#v", event)
 			if event.Object != nil && event.Type != watch.Deleted {
 				if node, ok := event.Object.(*corev1.Node); ok {
-					if isNodeReady(node) {
+					if IsNodeReady(node) {
 						readyNodes.Insert(node.Name)
 						counter = readyNodes.Len()
 						logger.Debug("node %q is ready in %q", node.Name, ng.NameString())
@@ -0,0 +1,136 @@
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// SyncNodeTemplateTags sets the node-template tags of an ASG discovered by cluster-autoscaler to the given tags,
+// removing the node-template tags of labels and taints the nodes no longer have. Resources tags are never removed,
+// as they may describe resources eksctl does not know about. ASGs that are not tagged for cluster-autoscaler are
+// left untouched
+func SyncNodeTemplateTags(asgAPI autoscalingiface.AutoScalingAPI, asgName string, nodeTemplateTags []api.ClusterAutoscalerTag) error {
+	var (
+		discovered bool
+		current    = map[string]string{}
+	)
+	err := asgAPI.DescribeTagsPages(&autoscaling.DescribeTagsInput{
+		Filters: []*autoscaling.Filter{
+			{
+				Name:   aws.String("auto-scaling-group"),
+				Values: aws.StringSlice([]string{asgName}),
+			},
+		},
+	}, func(output *autoscaling.DescribeTagsOutput, _ bool) bool {
+		for _, tag := range output.Tags {
+			key := aws.StringValue(tag.Key)
+			if key == api.ClusterAutoscalerEnabledTag {
+				discovered = true
+			} else if api.IsClusterAutoscalerNodeTemplateTag(key) {
+				current[key] = aws.StringValue(tag.Value)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing tags of ASG %q", asgName)
+	}
+	if !discovered {
+		logger.Debug("ASG %q is not tagged for cluster-autoscaler, not syncing its node-template tags", asgName)
+		return nil
+	}
+
+	newTag := func(key, value string) *autoscaling.Tag {
+		return &autoscaling.Tag{
+			ResourceId:        aws.String(asgName),
+			ResourceType:      aws.String("auto-scaling-group"),
+			Key:               aws.String(key),
+			Value:             aws.String(value),
+			PropagateAtLaunch: aws.Bool(false),
+		}
+	}
+
+	var tagsToUpdate, tagsToDelete []*autoscaling.Tag
+	desired := map[string]bool{}
+	for _, tag := range nodeTemplateTags {
+		desired[tag.Key] = true
+		if value, ok := current[tag.Key]; !ok || value != tag.Value {
+			tagsToUpdate = append(tagsToUpdate, newTag(tag.Key, tag.Value))
+		}
+	}
+	for key, value := range current {
+		if !desired[key] && !api.IsClusterAutoscalerNodeTemplateResourceTag(key) {
+			tagsToDelete = append(tagsToDelete, newTag(key, value))
+		}
+	}
+
+	if len(tagsToUpdate) > 0 {
+		logger.Info("updating %d node-template tag(s) of ASG %q", len(tagsToUpdate), asgName)
+		if _, err := asgAPI.CreateOrUpdateTags(&autoscaling.CreateOrUpdateTagsInput{Tags: tagsToUpdate}); err != nil {
+			return errors.Wrapf(err, "updating node-template tags of ASG %q", asgName)
+		}
+	}
+	if len(tagsToDelete) > 0 {
+		logger.Info("deleting %d stale node-template tag(s) of ASG %q", len(tagsToDelete), asgName)
+		if _, err := asgAPI.DeleteTags(&autoscaling.DeleteTagsInput{Tags: tagsToDelete}); err != nil {
+			return errors.Wrapf(err, "deleting node-template tags of ASG %q", asgName)
+		}
+	}
+	return nil
+}
+
+// SyncManagedNodeTemplateTags syncs the node-template tags of the ASGs of a managed nodegroup with the labels,
+// taints and disk size EKS reports for the nodegroup
+func SyncManagedNodeTemplateTags(provider api.ClusterProvider, clusterName, nodeGroupName string) error {
+	return UpdateManagedNodeTemplateTags(provider, clusterName, nodeGroupName, nil, nil)
+}
+
+// UpdateManagedNodeTemplateTags is like SyncManagedNodeTemplateTags, but applies the given label changes to the
+// labels EKS reports, which do not reflect an update of the nodegroup that is still in progress
+func UpdateManagedNodeTemplateTags(provider api.ClusterProvider, clusterName, nodeGroupName string, labelsToAdd map[string]string, labelsToRemove []string) error {
+	output, err := provider.EKS().DescribeNodegroup(&awseks.DescribeNodegroupInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(nodeGroupName),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing managed nodegroup %q", nodeGroupName)
+	}
+	nodeGroup := output.Nodegroup
+	if nodeGroup.Resources == nil {
+		return nil
+	}
+
+	var taints []api.NodeGroupTaint
+	for _, taint := range nodeGroup.Taints {
+		taints = append(taints, api.NodeGroupTaint{
+			Key:    aws.StringValue(taint.Key),
+			Value:  aws.StringValue(taint.Value),
+			Effect: api.TaintEffectFromEKS(aws.StringValue(taint.Effect)),
+		})
+	}
+	var resources map[string]string
+	if nodeGroup.DiskSize != nil {
+		resources = api.NodeTemplateResources(aws.Int(int(*nodeGroup.DiskSize)))
+	}
+	labels := aws.StringValueMap(nodeGroup.Labels)
+	for key, value := range labelsToAdd {
+		labels[key] = value
+	}
+	for _, key := range labelsToRemove {
+		delete(labels, key)
+	}
+	tags := api.ClusterAutoscalerNodeTemplateTags(labels, taints, resources)
+
+	for _, asg := range nodeGroup.Resources.AutoScalingGroups {
+		if err := SyncNodeTemplateTags(provider.ASG(), aws.StringValue(asg.Name), tags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
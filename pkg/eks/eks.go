@@ -315,8 +315,14 @@ func (c *ClusterProvider) loadClusterKubernetesNetworkConfig(spec *api.ClusterCo
 	}
 	knCfg := c.Status.ClusterInfo.Cluster.KubernetesNetworkConfig
 	if knCfg != nil {
+		// the IP family is not returned by the EKS API of the AWS SDK in use, keep the one loaded from the cluster stack
+		var ipFamily string
+		if spec.KubernetesNetworkConfig != nil {
+			ipFamily = spec.KubernetesNetworkConfig.IPFamily
+		}
 		spec.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
 			ServiceIPv4CIDR: aws.StringValue(knCfg.ServiceIpv4Cidr),
+			IPFamily:        ipFamily,
 		}
 	}
 	return nil
@@ -0,0 +1,27 @@
+package eks
+
+import (
+	"github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// NewSpotInterruptionHandlerTask creates the task that deploys the spot interruption handler of a nodegroup.
+func NewSpotInterruptionHandlerTask(nodeGroupName string, stackManager manager.StackManager, newHandler func(queueURL, queueARN string) (*addons.SpotInterruptionHandler, error)) tasks.Task {
+	return &spotInterruptionHandlerTask{
+		nodeGroupName: nodeGroupName,
+		stackManager:  stackManager,
+		newHandler:    newHandler,
+	}
+}
+
+// NewClusterConfigTask creates a task that applies a configuration update to the cluster.
+func NewClusterConfigTask(call func(*api.ClusterConfig) error, resume bool) tasks.Task {
+	return &clusterConfigTask{
+		info:   "update cluster",
+		spec:   api.NewClusterConfig(),
+		call:   call,
+		resume: resume,
+	}
+}
@@ -56,11 +56,11 @@ type FakeNodeGroupInitialiser struct {
 	newAWSSelectorSessionArgsForCall []struct {
 		arg1 v1alpha5.ClusterProvider
 	}
-	NormalizeStub        func([]v1alpha5.NodePool, *v1alpha5.ClusterMeta) error
+	NormalizeStub        func([]v1alpha5.NodePool, *v1alpha5.ClusterConfig) error
 	normalizeMutex       sync.RWMutex
 	normalizeArgsForCall []struct {
 		arg1 []v1alpha5.NodePool
-		arg2 *v1alpha5.ClusterMeta
+		arg2 *v1alpha5.ClusterConfig
 	}
 	normalizeReturns struct {
 		result1 error
@@ -328,7 +328,7 @@ func (fake *FakeNodeGroupInitialiser) NewAWSSelectorSessionArgsForCall(i int) v1
 	return argsForCall.arg1
 }
 
-func (fake *FakeNodeGroupInitialiser) Normalize(arg1 []v1alpha5.NodePool, arg2 *v1alpha5.ClusterMeta) error {
+func (fake *FakeNodeGroupInitialiser) Normalize(arg1 []v1alpha5.NodePool, arg2 *v1alpha5.ClusterConfig) error {
 	var arg1Copy []v1alpha5.NodePool
 	if arg1 != nil {
 		arg1Copy = make([]v1alpha5.NodePool, len(arg1))
@@ -338,7 +338,7 @@ func (fake *FakeNodeGroupInitialiser) Normalize(arg1 []v1alpha5.NodePool, arg2 *
 	ret, specificReturn := fake.normalizeReturnsOnCall[len(fake.normalizeArgsForCall)]
 	fake.normalizeArgsForCall = append(fake.normalizeArgsForCall, struct {
 		arg1 []v1alpha5.NodePool
-		arg2 *v1alpha5.ClusterMeta
+		arg2 *v1alpha5.ClusterConfig
 	}{arg1Copy, arg2})
 	stub := fake.NormalizeStub
 	fakeReturns := fake.normalizeReturns
@@ -359,13 +359,13 @@ func (fake *FakeNodeGroupInitialiser) NormalizeCallCount() int {
 	return len(fake.normalizeArgsForCall)
 }
 
-func (fake *FakeNodeGroupInitialiser) NormalizeCalls(stub func([]v1alpha5.NodePool, *v1alpha5.ClusterMeta) error) {
+func (fake *FakeNodeGroupInitialiser) NormalizeCalls(stub func([]v1alpha5.NodePool, *v1alpha5.ClusterConfig) error) {
 	fake.normalizeMutex.Lock()
 	defer fake.normalizeMutex.Unlock()
 	fake.NormalizeStub = stub
 }
 
-func (fake *FakeNodeGroupInitialiser) NormalizeArgsForCall(i int) ([]v1alpha5.NodePool, *v1alpha5.ClusterMeta) {
+func (fake *FakeNodeGroupInitialiser) NormalizeArgsForCall(i int) ([]v1alpha5.NodePool, *v1alpha5.ClusterConfig) {
 	fake.normalizeMutex.RLock()
 	defer fake.normalizeMutex.RUnlock()
 	argsForCall := fake.normalizeArgsForCall[i]
@@ -55,36 +55,44 @@ func (fpt *fargateProfilesTask) Do(errCh chan error) error {
 
 // DoCreateFargateProfiles creates fargate profiles as specified in the config
 func DoCreateFargateProfiles(config *api.ClusterConfig, fargateClient FargateClient) error {
-	clusterName := config.Metadata.Name
 	for _, profile := range config.FargateProfiles {
-		logger.Info("creating Fargate profile %q on EKS cluster %q", profile.Name, clusterName)
-
-		// Default the pod execution role ARN to be the same as the cluster
-		// role defined in CloudFormation:
-		if profile.PodExecutionRoleARN == "" {
-			profile.PodExecutionRoleARN = strings.EmptyIfNil(config.IAM.FargatePodExecutionRoleARN)
-		}
-		// Linearise the initial creation of Fargate profiles by passing
-		// wait = true, as the API otherwise errors out with a ResourceInUseException
-		//
-		// In the case that a ResourceInUseException is thrown on a profile which was
-		// created on an earlier call, we do not error but continue to the next one
-		var e *eks.ResourceInUseException
-		err := fargateClient.CreateProfile(profile, true)
-		switch {
-		case err == nil:
-			logger.Info("created Fargate profile %q on EKS cluster %q", profile.Name, clusterName)
-		case errors.As(err, &e):
-			logger.Info("Either Fargate profile %q already exists on EKS cluster %q or another profile is being created/deleted, no action taken", profile.Name, clusterName)
-		case fargate.IsUnauthorizedError(err):
-			return errors.Wrapf(err, "either account is not authorized to use Fargate or region %s is not supported", config.Metadata.Region)
-		default:
-			return errors.Wrapf(err, "failed to create Fargate profile %q on EKS cluster %q", profile.Name, clusterName)
+		if err := DoCreateFargateProfile(config, fargateClient, profile); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// DoCreateFargateProfile creates a single fargate profile of the config
+func DoCreateFargateProfile(config *api.ClusterConfig, fargateClient FargateClient, profile *api.FargateProfile) error {
+	clusterName := config.Metadata.Name
+	logger.Info("creating Fargate profile %q on EKS cluster %q", profile.Name, clusterName)
+
+	// Default the pod execution role ARN to be the same as the cluster
+	// role defined in CloudFormation:
+	if profile.PodExecutionRoleARN == "" {
+		profile.PodExecutionRoleARN = strings.EmptyIfNil(config.IAM.FargatePodExecutionRoleARN)
+	}
+	// Linearise the initial creation of Fargate profiles by passing
+	// wait = true, as the API otherwise errors out with a ResourceInUseException
+	//
+	// In the case that a ResourceInUseException is thrown on a profile which was
+	// created on an earlier call, we do not error but continue to the next one
+	var e *eks.ResourceInUseException
+	err := fargateClient.CreateProfile(profile, true)
+	switch {
+	case err == nil:
+		logger.Info("created Fargate profile %q on EKS cluster %q", profile.Name, clusterName)
+	case errors.As(err, &e):
+		logger.Info("Either Fargate profile %q already exists on EKS cluster %q or another profile is being created/deleted, no action taken", profile.Name, clusterName)
+	case fargate.IsUnauthorizedError(err):
+		return errors.Wrapf(err, "either account is not authorized to use Fargate or region %s is not supported", config.Metadata.Region)
+	default:
+		return errors.Wrapf(err, "failed to create Fargate profile %q on EKS cluster %q", profile.Name, clusterName)
+	}
+	return nil
+}
+
 func ScheduleCoreDNSOnFargateIfRelevant(config *api.ClusterConfig, ctl *ClusterProvider, clientSet kubernetes.Interface) error {
 	if coredns.IsSchedulableOnFargate(config.FargateProfiles) {
 		scheduled, err := coredns.IsScheduledOnFargate(clientSet)
@@ -27,7 +27,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
-func isNodeReady(node *corev1.Node) bool {
+// IsNodeReady returns true if the node has a true Ready condition
+func IsNodeReady(node *corev1.Node) bool {
 	for _, c := range node.Status.Conditions {
 		if c.Type == corev1.NodeReady && c.Status == corev1.ConditionTrue {
 			return true
@@ -36,6 +37,12 @@ func isNodeReady(node *corev1.Node) bool {
 	return false
 }
 
+// InstanceIDFromProviderID returns the instance ID of a node's provider ID,
+// e.g. i-0123456789abcdef0 for aws:///us-west-2a/i-0123456789abcdef0
+func InstanceIDFromProviderID(providerID string) string {
+	return providerID[strings.LastIndex(providerID, "/")+1:]
+}
+
 func getNodes(clientSet kubernetes.Interface, ng KubeNodeGroup) (int, error) {
 	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), ng.ListOptions())
 	if err != nil {
@@ -45,7 +52,7 @@ func getNodes(clientSet kubernetes.Interface, ng KubeNodeGroup) (int, error) {
 	counter := 0
 	for _, node := range nodes.Items {
 		ready := "not ready"
-		if isNodeReady(&node) {
+		if IsNodeReady(&node) {
 			ready = "ready"
 			counter++
 		}
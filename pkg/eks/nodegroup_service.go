@@ -31,7 +31,7 @@ type InstanceSelector interface {
 //counterfeiter:generate -o fakes/fake_nodegroup_initialiser.go . NodeGroupInitialiser
 // NodeGroupInitialiser is an interface that provides helpers for nodegroup creation.
 type NodeGroupInitialiser interface {
-	Normalize(nodePools []api.NodePool, clusterMeta *api.ClusterMeta) error
+	Normalize(nodePools []api.NodePool, clusterConfig *api.ClusterConfig) error
 	ExpandInstanceSelectorOptions(nodePools []api.NodePool, clusterAZs []string) error
 	NewAWSSelectorSession(provider api.ClusterProvider)
 	ValidateLegacySubnetsForNodeGroups(spec *api.ClusterConfig, provider api.ClusterProvider) error
@@ -62,20 +62,21 @@ func (m *NodeGroupService) NewAWSSelectorSession(provider api.ClusterProvider) {
 }
 
 // Normalize normalizes nodegroups
-func (m *NodeGroupService) Normalize(nodePools []api.NodePool, clusterMeta *api.ClusterMeta) error {
+func (m *NodeGroupService) Normalize(nodePools []api.NodePool, clusterConfig *api.ClusterConfig) error {
+	clusterMeta := clusterConfig.Metadata
 	for _, np := range nodePools {
 		switch ng := np.(type) {
 		case *api.ManagedNodeGroup:
 			hasNativeAMIFamilySupport := ng.AMIFamily == api.NodeImageFamilyAmazonLinux2
-			if !hasNativeAMIFamilySupport && !api.IsAMI(ng.AMI) {
-				if err := ResolveAMI(m.Provider, clusterMeta.Version, np); err != nil {
+			if (!hasNativeAMIFamilySupport && !api.IsAMI(ng.AMI)) || ng.AMI == api.NodeImageResolverCustomCatalog {
+				if err := ResolveAMI(m.Provider, clusterMeta.Version, np, clusterConfig.AMICatalog); err != nil {
 					return err
 				}
 			}
 
 		case *api.NodeGroup:
 			if !api.IsAMI(ng.AMI) {
-				if err := ResolveAMI(m.Provider, clusterMeta.Version, ng); err != nil {
+				if err := ResolveAMI(m.Provider, clusterMeta.Version, ng, clusterConfig.AMICatalog); err != nil {
 					return err
 				}
 			} else {
@@ -3,20 +3,26 @@ package eks
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+
 	"github.com/weaveworks/eksctl/pkg/actions/identityproviders"
 	"github.com/weaveworks/eksctl/pkg/actions/irsa"
 
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/weaveworks/eksctl/pkg/addons"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
 	"github.com/weaveworks/eksctl/pkg/fargate"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/utils"
@@ -30,6 +36,8 @@ type clusterConfigTask struct {
 	info string
 	spec *api.ClusterConfig
 	call func(*api.ClusterConfig) error
+	// resume tolerates configuration that was already applied by an earlier attempt to create the cluster
+	resume bool
 }
 
 func (t *clusterConfigTask) Describe() string { return t.info }
@@ -37,9 +45,20 @@ func (t *clusterConfigTask) Describe() string { return t.info }
 func (t *clusterConfigTask) Do(errs chan error) error {
 	err := t.call(t.spec)
 	close(errs)
+	if t.resume && isNoChangesNeededError(err) {
+		// the configuration was already applied by an earlier attempt to create the cluster
+		logger.Info("%s: no changes needed", t.info)
+		return nil
+	}
 	return err
 }
 
+func isNoChangesNeededError(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awseks.ErrCodeInvalidParameterException &&
+		strings.Contains(awsErr.Message(), "No changes needed")
+}
+
 // VPCControllerTask represents a task to install the VPC controller
 type VPCControllerTask struct {
 	Info            string
@@ -80,6 +99,105 @@ func (v *VPCControllerTask) Do(errCh chan error) error {
 	return nil
 }
 
+// spotInterruptionHandlerTask deploys the spot interruption handler of a nodegroup
+type spotInterruptionHandlerTask struct {
+	nodeGroupName string
+	stackManager  manager.StackManager
+	newHandler    func(queueURL, queueARN string) (*addons.SpotInterruptionHandler, error)
+}
+
+func (t *spotInterruptionHandlerTask) Describe() string {
+	return fmt.Sprintf("install spot interruption handler for nodegroup %s", t.nodeGroupName)
+}
+
+func (t *spotInterruptionHandlerTask) Do(errCh chan error) error {
+	defer close(errCh)
+	stack, err := t.stackManager.DescribeNodeGroupStack(t.nodeGroupName)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't describe nodegroup stack for nodegroup %s", t.nodeGroupName)
+	}
+	var queueURL, queueARN string
+	if err := outputs.Collect(*stack, map[string]outputs.Collector{
+		outputs.NodeGroupSpotInterruptionQueueURL: func(v string) error {
+			queueURL = v
+			return nil
+		},
+		outputs.NodeGroupSpotInterruptionQueueARN: func(v string) error {
+			queueARN = v
+			return nil
+		},
+	}, nil); err != nil {
+		return errors.Wrapf(err, "couldn't find the spot interruption queue of nodegroup %s", t.nodeGroupName)
+	}
+
+	handler, err := t.newHandler(queueURL, queueARN)
+	if err != nil {
+		return err
+	}
+	if err := handler.Deploy(); err != nil {
+		return errors.Wrapf(err, "error installing spot interruption handler for nodegroup %s", t.nodeGroupName)
+	}
+	return nil
+}
+
+// NewSpotInterruptionHandler creates the spot interruption handler of a nodegroup, processing the queue with
+// queueURL and queueARN; both can be empty to delete the handler
+func (c *ClusterProvider) NewSpotInterruptionHandler(spec *api.ClusterConfig, nodeGroupName, queueURL, queueARN string) (*addons.SpotInterruptionHandler, error) {
+	rawClient, err := c.NewRawClient(spec)
+	if err != nil {
+		return nil, err
+	}
+	oidc, err := c.NewOpenIDConnectManager(spec)
+	if err != nil {
+		return nil, err
+	}
+	clientSet, err := c.NewStdClientSet(spec)
+	if err != nil {
+		return nil, err
+	}
+	stackCollection := manager.NewStackCollection(c.Provider, spec)
+	irsaManager := irsa.New(spec.Metadata.Name, stackCollection, oidc, clientSet)
+	irsa := addons.NewIRSAHelper(oidc, stackCollection, irsaManager, spec.Metadata.Name)
+
+	return addons.NewSpotInterruptionHandler(rawClient, irsa, c.Provider.Region(), nodeGroupName, queueURL, queueARN, false), nil
+}
+
+type nodeTemplateTagsTask struct {
+	clusterProvider *ClusterProvider
+	spec            *api.ClusterConfig
+	nodeGroup       *api.ManagedNodeGroup
+}
+
+func (t *nodeTemplateTagsTask) Describe() string {
+	return "sync node-template tags of the ASGs of managed nodegroup " + t.nodeGroup.Name
+}
+
+func (t *nodeTemplateTagsTask) Do(errCh chan error) error {
+	defer close(errCh)
+	return SyncManagedNodeTemplateTags(t.clusterProvider.Provider, t.spec.Metadata.Name, t.nodeGroup.Name)
+}
+
+// customNetworkingTask creates the ENIConfigs of the pod subnets and enables VPC CNI custom networking
+type customNetworkingTask struct {
+	clusterProvider *ClusterProvider
+	spec            *api.ClusterConfig
+}
+
+func (t *customNetworkingTask) Describe() string {
+	return "enable VPC CNI custom networking"
+}
+
+func (t *customNetworkingTask) Do(errCh chan error) error {
+	defer close(errCh)
+	rawClient, err := t.clusterProvider.NewRawClient(t.spec)
+	if err != nil {
+		return err
+	}
+	// pods use the security group that EKS attaches to managed nodes, and eksctl to unmanaged nodes
+	securityGroups := []string{*t.clusterProvider.Status.ClusterInfo.Cluster.ResourcesVpcConfig.ClusterSecurityGroupId}
+	return defaultaddons.EnableCustomNetworking(rawClient, t.spec.VPC.PodSubnets.Subnets, securityGroups, false)
+}
+
 type devicePluginTask struct {
 	kind            string
 	clusterProvider *ClusterProvider
@@ -210,9 +328,10 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(cfg *api.ClusterConfig,
 
 	if len(cfg.Metadata.Tags) > 0 {
 		newTasks.Append(&clusterConfigTask{
-			info: "tag cluster",
-			spec: cfg,
-			call: c.UpdateClusterTags,
+			info:   "tag cluster",
+			spec:   cfg,
+			call:   c.UpdateClusterTags,
+			resume: c.Provider.ResumeCreation(),
 		})
 	}
 	if !cfg.HasClusterCloudWatchLogging() {
@@ -221,18 +340,20 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(cfg *api.ClusterConfig,
 
 	} else {
 		newTasks.Append(&clusterConfigTask{
-			info: "update CloudWatch logging configuration",
-			spec: cfg,
-			call: c.UpdateClusterConfigForLogging,
+			info:   "update CloudWatch logging configuration",
+			spec:   cfg,
+			call:   c.UpdateClusterConfigForLogging,
+			resume: c.Provider.ResumeCreation(),
 		})
 	}
 	c.maybeAppendTasksForEndpointAccessUpdates(cfg, newTasks)
 
 	if len(cfg.VPC.PublicAccessCIDRs) > 0 {
 		newTasks.Append(&clusterConfigTask{
-			info: "update public access CIDRs",
-			spec: cfg,
-			call: c.UpdatePublicAccessCIDRs,
+			info:   "update public access CIDRs",
+			spec:   cfg,
+			call:   c.UpdatePublicAccessCIDRs,
+			resume: c.Provider.ResumeCreation(),
 		})
 	}
 
@@ -254,6 +375,13 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(cfg *api.ClusterConfig,
 		newTasks.Append(identityproviders.NewAssociateProvidersTask(*cfg.Metadata, cfg.IdentityProviders, c.Provider.EKS()))
 	}
 
+	if cfg.HasCustomNetworking() {
+		newTasks.Append(&customNetworkingTask{
+			clusterProvider: c,
+			spec:            cfg,
+		})
+	}
+
 	if installVPCController {
 		newTasks.Append(&VPCControllerTask{
 			Info:            "install Windows VPC controller",
@@ -315,6 +443,27 @@ func (c *ClusterProvider) ClusterTasksForNodeGroups(cfg *api.ClusterConfig, inst
 		}
 	}
 
+	for _, ng := range cfg.NodeGroups {
+		if ng.HasSpotInterruptionHandler() {
+			ng := ng
+			tasks.Append(&spotInterruptionHandlerTask{
+				nodeGroupName: ng.Name,
+				stackManager:  manager.NewStackCollection(c.Provider, cfg),
+				newHandler: func(queueURL, queueARN string) (*addons.SpotInterruptionHandler, error) {
+					return c.NewSpotInterruptionHandler(cfg, ng.Name, queueURL, queueARN)
+				},
+			})
+		}
+	}
+
+	for _, ng := range cfg.ManagedNodeGroups {
+		tasks.Append(&nodeTemplateTagsTask{
+			clusterProvider: c,
+			spec:            cfg,
+			nodeGroup:       ng,
+		})
+	}
+
 	if efaEnabled {
 		tasks.Append(newEFADevicePluginTask(c, cfg))
 	}
@@ -337,7 +486,13 @@ func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(cfg *api.Cluste
 			if err != nil {
 				return err
 			}
-			if err := oidc.CreateProvider(); err != nil {
+			providerExists, err := oidc.CheckProviderExists()
+			if err != nil {
+				return err
+			}
+			if providerExists {
+				logger.Info("IAM OIDC provider is already associated with cluster %q", cfg.Metadata.Name)
+			} else if err := oidc.CreateProvider(); err != nil {
 				return err
 			}
 			*oidcPlaceholder = *oidc
@@ -351,6 +506,7 @@ func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(cfg *api.Cluste
 			}
 			return nil
 		},
+		resume: c.Provider.ResumeCreation(),
 	})
 
 	clientSet := &kubernetes.CallbackClientSet{
@@ -390,9 +546,10 @@ func (c *ClusterProvider) maybeAppendTasksForEndpointAccessUpdates(cfg *api.Clus
 		logger.Info(cfg.CustomEndpointsMsg())
 
 		tasks.Append(&clusterConfigTask{
-			info: "update cluster VPC endpoint access configuration",
-			spec: cfg,
-			call: c.UpdateClusterConfigForEndpoints,
+			info:   "update cluster VPC endpoint access configuration",
+			spec:   cfg,
+			call:   c.UpdateClusterConfigForEndpoints,
+			resume: c.Provider.ResumeCreation(),
 		})
 	}
 }
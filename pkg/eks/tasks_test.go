@@ -0,0 +1,122 @@
+package eks_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	addonfakes "github.com/weaveworks/eksctl/pkg/addons/fakes"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("spot interruption handler task", func() {
+	const (
+		queueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/ng-1-queue"
+		queueARN = "arn:aws:sqs:us-west-2:123456789012:ng-1-queue"
+	)
+
+	var (
+		fakeStackManager *fakes.FakeStackManager
+		fakeIRSAHelper   *addonfakes.FakeIRSAHelper
+		rawClient        *testutils.FakeRawClient
+		handlerQueues    []string
+		task             tasks.Task
+	)
+
+	BeforeEach(func() {
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.DescribeNodeGroupStackReturns(&manager.Stack{
+			Outputs: []*cfn.Output{
+				{OutputKey: aws.String(outputs.NodeGroupSpotInterruptionQueueURL), OutputValue: aws.String(queueURL)},
+				{OutputKey: aws.String(outputs.NodeGroupSpotInterruptionQueueARN), OutputValue: aws.String(queueARN)},
+			},
+		}, nil)
+		fakeIRSAHelper = new(addonfakes.FakeIRSAHelper)
+		fakeIRSAHelper.IsSupportedReturns(true, nil)
+		rawClient = testutils.NewFakeRawClient()
+		rawClient.UseUnionTracker = true
+		handlerQueues = nil
+
+		task = NewSpotInterruptionHandlerTask("ng-1", fakeStackManager, func(queueURL, queueARN string) (*addons.SpotInterruptionHandler, error) {
+			handlerQueues = []string{queueURL, queueARN}
+			return addons.NewSpotInterruptionHandler(rawClient, fakeIRSAHelper, "us-west-2", "ng-1", queueURL, queueARN, false), nil
+		})
+	})
+
+	doTask := func() []error {
+		return (&tasks.TaskTree{Tasks: []tasks.Task{task}}).DoAllSync()
+	}
+
+	It("describes the nodegroup", func() {
+		Expect(task.Describe()).To(Equal("install spot interruption handler for nodegroup ng-1"))
+	})
+
+	It("deploys the handler with the queue of the nodegroup stack", func() {
+		Expect(doTask()).To(BeEmpty())
+
+		Expect(fakeStackManager.DescribeNodeGroupStackArgsForCall(0)).To(Equal("ng-1"))
+		Expect(handlerQueues).To(Equal([]string{queueURL, queueARN}))
+		Expect(fakeIRSAHelper.CreateOrUpdateCallCount()).To(Equal(1))
+		Expect(rawClient.Collection.Created()).To(HaveKey("POST [/namespaces/kube-system/deployments] (spot-interruption-handler-ng-1)"))
+	})
+
+	It("fails if the nodegroup stack can't be described", func() {
+		fakeStackManager.DescribeNodeGroupStackReturns(nil, errors.New("throttled"))
+
+		errs := doTask()
+		Expect(errs).To(ConsistOf(MatchError(ContainSubstring("couldn't describe nodegroup stack for nodegroup ng-1: throttled"))))
+		Expect(handlerQueues).To(BeNil())
+	})
+
+	It("fails if the nodegroup stack has no spot interruption queue", func() {
+		fakeStackManager.DescribeNodeGroupStackReturns(&manager.Stack{}, nil)
+
+		errs := doTask()
+		Expect(errs).To(ConsistOf(MatchError(ContainSubstring("couldn't find the spot interruption queue of nodegroup ng-1"))))
+		Expect(handlerQueues).To(BeNil())
+	})
+
+	It("fails if the handler can't be deployed", func() {
+		fakeIRSAHelper.IsSupportedReturns(false, nil)
+
+		errs := doTask()
+		Expect(errs).To(ConsistOf(MatchError(ContainSubstring("error installing spot interruption handler for nodegroup ng-1"))))
+		Expect(rawClient.Collection.Created()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("cluster config task", func() {
+	noChangesNeeded := func(*api.ClusterConfig) error {
+		return awserr.New(awseks.ErrCodeInvalidParameterException, "No changes needed for the logging config provided", nil)
+	}
+
+	doTask := func(task tasks.Task) []error {
+		return (&tasks.TaskTree{Tasks: []tasks.Task{task}}).DoAllSync()
+	}
+
+	It("fails when no changes are needed", func() {
+		Expect(doTask(NewClusterConfigTask(noChangesNeeded, false))).To(ConsistOf(MatchError(ContainSubstring("No changes needed"))))
+	})
+
+	It("tolerates configuration applied by an earlier attempt when resuming", func() {
+		Expect(doTask(NewClusterConfigTask(noChangesNeeded, true))).To(BeEmpty())
+	})
+
+	It("fails on other errors when resuming", func() {
+		Expect(doTask(NewClusterConfigTask(func(*api.ClusterConfig) error {
+			return errors.New("access denied")
+		}, true))).To(ConsistOf(MatchError(ContainSubstring("access denied"))))
+	})
+})
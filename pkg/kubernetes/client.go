@@ -319,11 +319,14 @@ func (r *RawResource) CreateOrReplace(plan bool) (string, error) {
 		return r.LogAction(plan, "created"), nil
 	}
 
-	convertedObj, err := scheme.Scheme.ConvertToVersion(r.Info.Object, r.GVK.GroupVersion())
-	if err != nil {
-		return "", errors.Wrapf(err, "converting object")
+	// objects of custom resources, e.g. ENIConfigs, are unstructured and cannot be converted
+	if _, ok := r.Info.Object.(*unstructured.Unstructured); !ok {
+		convertedObj, err := scheme.Scheme.ConvertToVersion(r.Info.Object, r.GVK.GroupVersion())
+		if err != nil {
+			return "", errors.Wrapf(err, "converting object")
+		}
+		scheme.Scheme.Default(convertedObj)
 	}
-	scheme.Scheme.Default(convertedObj)
 	if !plan {
 		if _, err := r.Helper.Replace(r.Info.Namespace, r.Info.Name, true, r.Info.Object); err != nil {
 			return "", err
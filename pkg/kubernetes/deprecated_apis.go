@@ -0,0 +1,178 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+// RemovedAPI is an API version of a resource that is no longer served from a given Kubernetes version,
+// see https://kubernetes.io/docs/reference/using-api/deprecation-guide/
+type RemovedAPI struct {
+	GroupVersion string
+	Resource     string
+	Kind         string
+	// RemovedIn is the first Kubernetes version that doesn't serve the API version
+	RemovedIn string
+	// Replacement is the API version to migrate to
+	Replacement string
+}
+
+var removedAPIs = []RemovedAPI{
+	{"extensions/v1beta1", "daemonsets", "DaemonSet", "1.16", "apps/v1"},
+	{"extensions/v1beta1", "deployments", "Deployment", "1.16", "apps/v1"},
+	{"extensions/v1beta1", "replicasets", "ReplicaSet", "1.16", "apps/v1"},
+	{"extensions/v1beta1", "networkpolicies", "NetworkPolicy", "1.16", "networking.k8s.io/v1"},
+	{"extensions/v1beta1", "podsecuritypolicies", "PodSecurityPolicy", "1.16", "policy/v1beta1"},
+	{"apps/v1beta1", "deployments", "Deployment", "1.16", "apps/v1"},
+	{"apps/v1beta1", "statefulsets", "StatefulSet", "1.16", "apps/v1"},
+	{"apps/v1beta2", "daemonsets", "DaemonSet", "1.16", "apps/v1"},
+	{"apps/v1beta2", "deployments", "Deployment", "1.16", "apps/v1"},
+	{"apps/v1beta2", "replicasets", "ReplicaSet", "1.16", "apps/v1"},
+	{"apps/v1beta2", "statefulsets", "StatefulSet", "1.16", "apps/v1"},
+
+	{"extensions/v1beta1", "ingresses", "Ingress", "1.22", "networking.k8s.io/v1"},
+	{"networking.k8s.io/v1beta1", "ingresses", "Ingress", "1.22", "networking.k8s.io/v1"},
+	{"networking.k8s.io/v1beta1", "ingressclasses", "IngressClass", "1.22", "networking.k8s.io/v1"},
+	{"apiextensions.k8s.io/v1beta1", "customresourcedefinitions", "CustomResourceDefinition", "1.22", "apiextensions.k8s.io/v1"},
+	{"admissionregistration.k8s.io/v1beta1", "mutatingwebhookconfigurations", "MutatingWebhookConfiguration", "1.22", "admissionregistration.k8s.io/v1"},
+	{"admissionregistration.k8s.io/v1beta1", "validatingwebhookconfigurations", "ValidatingWebhookConfiguration", "1.22", "admissionregistration.k8s.io/v1"},
+	{"apiregistration.k8s.io/v1beta1", "apiservices", "APIService", "1.22", "apiregistration.k8s.io/v1"},
+	{"certificates.k8s.io/v1beta1", "certificatesigningrequests", "CertificateSigningRequest", "1.22", "certificates.k8s.io/v1"},
+	{"coordination.k8s.io/v1beta1", "leases", "Lease", "1.22", "coordination.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "clusterroles", "ClusterRole", "1.22", "rbac.authorization.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "clusterrolebindings", "ClusterRoleBinding", "1.22", "rbac.authorization.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "roles", "Role", "1.22", "rbac.authorization.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "rolebindings", "RoleBinding", "1.22", "rbac.authorization.k8s.io/v1"},
+	{"scheduling.k8s.io/v1beta1", "priorityclasses", "PriorityClass", "1.22", "scheduling.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", "csidrivers", "CSIDriver", "1.22", "storage.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", "csinodes", "CSINode", "1.22", "storage.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", "storageclasses", "StorageClass", "1.22", "storage.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", "volumeattachments", "VolumeAttachment", "1.22", "storage.k8s.io/v1"},
+}
+
+// DeprecatedAPIObject is a live object that was written with an API version that is removed in a later
+// Kubernetes version
+type DeprecatedAPIObject struct {
+	RemovedAPI
+	Namespace string
+	Name      string
+}
+
+// ObjectMetadataLister lists the objects of the resources served by the API server
+type ObjectMetadataLister interface {
+	// ServerResourcesForGroupVersion returns the resources served by an API group version
+	ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error)
+	// ListMetadata returns the metadata of all objects of a resource served by an API group version
+	ListMetadata(groupVersion, resource string) ([]metav1.PartialObjectMetadata, error)
+}
+
+// FindDeprecatedAPIObjects returns the objects that were written with an API version that is still served, but is
+// removed in targetVersion or earlier; an object is considered to use an API version when one of its managed fields
+// entries or its last applied configuration refers to it, as the API server serves every object under all API versions
+func FindDeprecatedAPIObjects(lister ObjectMetadataLister, targetVersion string) ([]DeprecatedAPIObject, error) {
+	var objects []DeprecatedAPIObject
+	for _, removed := range removedAPIs {
+		if c, err := utils.CompareVersions(removed.RemovedIn, targetVersion); err != nil {
+			return nil, err
+		} else if c > 0 {
+			continue
+		}
+
+		served, err := isServed(lister, removed)
+		if err != nil {
+			return nil, err
+		}
+		if !served {
+			continue
+		}
+
+		items, err := lister.ListMetadata(removed.GroupVersion, removed.Resource)
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing %s in %s", removed.Resource, removed.GroupVersion)
+		}
+		for _, item := range items {
+			if usesAPIVersion(item.ObjectMeta, removed.GroupVersion) {
+				objects = append(objects, DeprecatedAPIObject{
+					RemovedAPI: removed,
+					Namespace:  item.Namespace,
+					Name:       item.Name,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		if objects[i].Kind != objects[j].Kind {
+			return objects[i].Kind < objects[j].Kind
+		}
+		if objects[i].Namespace != objects[j].Namespace {
+			return objects[i].Namespace < objects[j].Namespace
+		}
+		return objects[i].Name < objects[j].Name
+	})
+	return objects, nil
+}
+
+func isServed(lister ObjectMetadataLister, removed RemovedAPI) (bool, error) {
+	resources, err := lister.ServerResourcesForGroupVersion(removed.GroupVersion)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "discovering resources of %s", removed.GroupVersion)
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Name == removed.Resource {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func usesAPIVersion(meta metav1.ObjectMeta, groupVersion string) bool {
+	for _, entry := range meta.ManagedFields {
+		if entry.APIVersion == groupVersion {
+			return true
+		}
+	}
+
+	lastApplied, ok := meta.Annotations[corev1.LastAppliedConfigAnnotation]
+	if !ok {
+		return false
+	}
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal([]byte(lastApplied), &typeMeta); err != nil {
+		return false
+	}
+	return typeMeta.APIVersion == groupVersion
+}
+
+// ServerResourcesForGroupVersion returns the resources served by an API group version
+func (c *RawClient) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	return c.ClientSet().Discovery().ServerResourcesForGroupVersion(groupVersion)
+}
+
+// ListMetadata returns the metadata of all objects of a resource served by an API group version, in all namespaces;
+// the objects are not decoded into their types, so that API versions unknown to the client can be listed
+func (c *RawClient) ListMetadata(groupVersion, resource string) ([]metav1.PartialObjectMetadata, error) {
+	data, err := c.ClientSet().Discovery().RESTClient().Get().
+		AbsPath(fmt.Sprintf("/apis/%s/%s", groupVersion, resource)).
+		DoRaw(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+	var list metav1.PartialObjectMetadataList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, errors.Wrapf(err, "decoding list of %s in %s", resource, groupVersion)
+	}
+	return list.Items, nil
+}
@@ -0,0 +1,105 @@
+package kubernetes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+type fakeObjectMetadataLister struct {
+	resources map[string][]string
+	objects   map[string][]metav1.PartialObjectMetadata
+}
+
+func (l *fakeObjectMetadataLister) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	resources, ok := l.resources[groupVersion]
+	if !ok {
+		return nil, apierrs.NewNotFound(schema.GroupResource{}, groupVersion)
+	}
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, r := range resources {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: r})
+	}
+	return list, nil
+}
+
+func (l *fakeObjectMetadataLister) ListMetadata(groupVersion, resource string) ([]metav1.PartialObjectMetadata, error) {
+	return l.objects[groupVersion+"/"+resource], nil
+}
+
+var _ = Describe("Deprecated APIs", func() {
+	var lister *fakeObjectMetadataLister
+
+	newObject := func(namespace, name string, managedBy ...string) metav1.PartialObjectMetadata {
+		o := metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+		}
+		for _, apiVersion := range managedBy {
+			o.ManagedFields = append(o.ManagedFields, metav1.ManagedFieldsEntry{
+				Manager:    "kubectl",
+				APIVersion: apiVersion,
+			})
+		}
+		return o
+	}
+
+	BeforeEach(func() {
+		// the API server serves every Ingress under both API versions
+		ingresses := []metav1.PartialObjectMetadata{
+			newObject("default", "old", "extensions/v1beta1"),
+			newObject("default", "new", "networking.k8s.io/v1"),
+		}
+		lister = &fakeObjectMetadataLister{
+			resources: map[string][]string{
+				"extensions/v1beta1":           {"ingresses"},
+				"networking.k8s.io/v1":         {"ingresses"},
+				"apiextensions.k8s.io/v1beta1": {"customresourcedefinitions"},
+			},
+			objects: map[string][]metav1.PartialObjectMetadata{
+				"extensions/v1beta1/ingresses":   ingresses,
+				"networking.k8s.io/v1/ingresses": ingresses,
+				"apiextensions.k8s.io/v1beta1/customresourcedefinitions": {
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "crontabs.stable.example.com",
+							Annotations: map[string]string{
+								corev1.LastAppliedConfigAnnotation: `{"apiVersion":"apiextensions.k8s.io/v1beta1","kind":"CustomResourceDefinition"}`,
+							},
+						},
+					},
+					newObject("", "widgets.example.com", "apiextensions.k8s.io/v1"),
+				},
+			},
+		}
+	})
+
+	It("finds the objects written with API versions removed in the target version", func() {
+		objects, err := kubernetes.FindDeprecatedAPIObjects(lister, "1.22")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(HaveLen(2))
+
+		Expect(objects[0].Kind).To(Equal("CustomResourceDefinition"))
+		Expect(objects[0].Name).To(Equal("crontabs.stable.example.com"))
+		Expect(objects[0].Replacement).To(Equal("apiextensions.k8s.io/v1"))
+
+		Expect(objects[1].Kind).To(Equal("Ingress"))
+		Expect(objects[1].Namespace).To(Equal("default"))
+		Expect(objects[1].Name).To(Equal("old"))
+		Expect(objects[1].GroupVersion).To(Equal("extensions/v1beta1"))
+		Expect(objects[1].RemovedIn).To(Equal("1.22"))
+	})
+
+	It("ignores API versions that are still served in the target version", func() {
+		objects, err := kubernetes.FindDeprecatedAPIObjects(lister, "1.21")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(BeEmpty())
+	})
+})
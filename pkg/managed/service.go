@@ -2,8 +2,10 @@ package managed
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
@@ -36,6 +38,7 @@ import (
 type Service struct {
 	eksAPI                eksiface.EKSAPI
 	ssmAPI                ssmiface.SSMAPI
+	ec2API                ec2iface.EC2API
 	launchTemplateFetcher *builder.LaunchTemplateFetcher
 	clusterName           string
 	stackCollection       manager.StackManager
@@ -60,6 +63,8 @@ type UpgradeOptions struct {
 	ForceUpgrade bool
 	// ReleaseVersion AMI version of the EKS optimized AMI to use
 	ReleaseVersion string
+	// CustomAMIResolver resolves the AMI to upgrade a nodegroup that uses a custom AMI to
+	CustomAMIResolver ami.CustomResolver
 }
 
 // TODO use goformation types
@@ -72,6 +77,7 @@ func NewService(eksAPI eksiface.EKSAPI, ssmAPI ssmiface.SSMAPI, ec2API ec2iface.
 	return &Service{
 		eksAPI:                eksAPI,
 		ssmAPI:                ssmAPI,
+		ec2API:                ec2API,
 		stackCollection:       stackCollection,
 		launchTemplateFetcher: builder.NewLaunchTemplateFetcher(ec2API),
 		clusterName:           clusterName,
@@ -228,7 +234,22 @@ func (m *Service) UpgradeNodeGroup(options UpgradeOptions) error {
 		return errors.New("cannot specify kubernetes-version or release-version when using a custom AMI")
 	}
 
-	if options.ReleaseVersion != "" {
+	if options.CustomAMIResolver != nil {
+		if !usesCustomAMI {
+			return errors.New("a custom AMI can only be resolved for nodegroups that use a custom AMI")
+		}
+		if options.LaunchTemplateVersion != "" {
+			return errors.New("cannot specify launch-template-version when resolving a custom AMI")
+		}
+		upToDate, err := m.upgradeCustomAMI(options.CustomAMIResolver, ltResources, ngResource)
+		if err != nil {
+			return err
+		}
+		if upToDate {
+			logger.Info("nodegroup %q is already up-to-date", *nodeGroup.NodegroupName)
+			return nil
+		}
+	} else if options.ReleaseVersion != "" {
 		ngResource.ReleaseVersion = gfnt.NewString(options.ReleaseVersion)
 	} else if !usesCustomAMI {
 		kubernetesVersion := options.KubernetesVersion
@@ -240,7 +261,7 @@ func (m *Service) UpgradeNodeGroup(options UpgradeOptions) error {
 			}
 			kubernetesVersion = fmt.Sprintf("%v.%v", version.Major, version.Minor)
 		}
-		latestReleaseVersion, err := m.getLatestReleaseVersion(kubernetesVersion, nodeGroup)
+		latestReleaseVersion, err := m.GetLatestReleaseVersion(kubernetesVersion, nodeGroup)
 		if err != nil {
 			return err
 		}
@@ -336,7 +357,8 @@ func (m *Service) requiresStackUpdate(nodeGroupName string) (bool, error) {
 	return !ver.EQ(curVer), nil
 }
 
-func (m *Service) getLatestReleaseVersion(kubernetesVersion string, nodeGroup *eks.Nodegroup) (string, error) {
+// GetLatestReleaseVersion returns the release version of the latest EKS-optimized AMI for the nodegroup's AMI type
+func (m *Service) GetLatestReleaseVersion(kubernetesVersion string, nodeGroup *eks.Nodegroup) (string, error) {
 	ssmParameterName, err := ami.MakeManagedSSMParameterName(kubernetesVersion, api.NodeImageFamilyAmazonLinux2, *nodeGroup.AmiType)
 	if err != nil {
 		return "", err
@@ -374,6 +396,59 @@ func (m *Service) usesCustomAMI(ltResources map[string]*gfnec2.LaunchTemplate, n
 	return customLaunchTemplate.ImageId != nil, nil
 }
 
+// upgradeCustomAMI updates the launch template of a nodegroup that uses a custom AMI to use the AMI returned by
+// the resolver. When the launch template is part of the nodegroup stack, its image is updated in the stack template,
+// otherwise a new version of the launch template is created with the new image. Either way the nodegroup resource is
+// changed to use the new launch template version, which triggers a rolling update of the nodegroup.
+// It returns true if the nodegroup already uses the resolved AMI
+func (m *Service) upgradeCustomAMI(resolver ami.CustomResolver, ltResources map[string]*gfnec2.LaunchTemplate, ng *gfneks.Nodegroup) (bool, error) {
+	imageID, err := resolver.ResolveCustom()
+	if err != nil {
+		return false, errors.Wrap(err, "error resolving custom AMI")
+	}
+
+	if lt, ok := ltResources["LaunchTemplate"]; ok {
+		if lt.LaunchTemplateData.ImageId.String() == imageID {
+			return true, nil
+		}
+		logger.Info("updating launch template of nodegroup stack from AMI %s to %s", lt.LaunchTemplateData.ImageId.String(), imageID)
+		lt.LaunchTemplateData.ImageId = gfnt.NewString(imageID)
+		ng.LaunchTemplate.Version = gfnt.MakeFnGetAttString("LaunchTemplate", "LatestVersionNumber")
+		return false, nil
+	}
+
+	launchTemplateID := ng.LaunchTemplate.Id.String()
+	sourceVersion := "$Default"
+	if version := ng.LaunchTemplate.Version; version != nil {
+		sourceVersion = version.String()
+	}
+	launchTemplateData, err := m.launchTemplateFetcher.Fetch(&api.LaunchTemplate{
+		ID:      launchTemplateID,
+		Version: aws.String(sourceVersion),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "error fetching launch template data")
+	}
+	if aws.StringValue(launchTemplateData.ImageId) == imageID {
+		return true, nil
+	}
+
+	logger.Info("creating a version of launch template %q to update it from AMI %s to %s", launchTemplateID, aws.StringValue(launchTemplateData.ImageId), imageID)
+	output, err := m.ec2API.CreateLaunchTemplateVersion(&ec2.CreateLaunchTemplateVersionInput{
+		LaunchTemplateId:   aws.String(launchTemplateID),
+		SourceVersion:      aws.String(sourceVersion),
+		VersionDescription: aws.String(fmt.Sprintf("%s upgraded by eksctl", imageID)),
+		LaunchTemplateData: &ec2.RequestLaunchTemplateData{
+			ImageId: aws.String(imageID),
+		},
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "error creating a version of launch template %q", launchTemplateID)
+	}
+	ng.LaunchTemplate.Version = gfnt.NewString(strconv.FormatInt(aws.Int64Value(output.LaunchTemplateVersion.VersionNumber), 10))
+	return false, nil
+}
+
 func IsNotFound(err error) bool {
 	awsError, ok := err.(awserr.Error)
 	return ok && awsError.Code() == eks.ErrCodeResourceNotFoundException
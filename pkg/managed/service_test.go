@@ -0,0 +1,156 @@
+package managed
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/weaveworks/goformation/v4"
+	gfn "github.com/weaveworks/goformation/v4/cloudformation"
+	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
+	gfneks "github.com/weaveworks/goformation/v4/cloudformation/eks"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+type staticCustomResolver string
+
+func (r staticCustomResolver) ResolveCustom() (string, error) {
+	return string(r), nil
+}
+
+var _ = Describe("Upgrade nodegroup with a custom AMI", func() {
+	var (
+		p                *mockprovider.MockProvider
+		fakeStackManager *fakes.FakeStackManager
+		service          *Service
+	)
+
+	setTemplate := func(resources gfn.Resources) {
+		template := gfn.NewTemplate()
+		template.Resources = resources
+		templateBody, err := template.JSON()
+		Expect(err).NotTo(HaveOccurred())
+		fakeStackManager.GetManagedNodeGroupTemplateReturns(string(templateBody), nil)
+	}
+
+	updatedNodeGroup := func() (*gfn.Template, *gfneks.Nodegroup) {
+		Expect(fakeStackManager.UpdateNodeGroupStackCallCount()).To(Equal(1))
+		_, templateBody := fakeStackManager.UpdateNodeGroupStackArgsForCall(0)
+		template, err := goformation.ParseJSON([]byte(templateBody))
+		Expect(err).NotTo(HaveOccurred())
+		ng, ok := template.GetAllEKSNodegroupResources()[builder.ManagedNodeGroupResourceName]
+		Expect(ok).To(BeTrue())
+		return template, ng
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.DescribeNodeGroupStackReturns(&manager.Stack{
+			Tags: []*cloudformation.Tag{{
+				Key:   aws.String(api.EksctlVersionTag),
+				Value: aws.String(version.GetVersion()),
+			}},
+		}, nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: &eks.Nodegroup{
+				NodegroupName: aws.String("ng-1"),
+				Version:       aws.String("1.20"),
+			},
+		}, nil)
+		service = NewService(p.MockEKS(), p.MockSSM(), p.MockEC2(), fakeStackManager, "my-cluster")
+	})
+
+	Context("when the launch template is part of the nodegroup stack", func() {
+		BeforeEach(func() {
+			setTemplate(gfn.Resources{
+				"LaunchTemplate": &gfnec2.LaunchTemplate{
+					LaunchTemplateData: &gfnec2.LaunchTemplate_LaunchTemplateData{
+						ImageId: gfnt.NewString("ami-old"),
+					},
+				},
+				builder.ManagedNodeGroupResourceName: &gfneks.Nodegroup{
+					LaunchTemplate: &gfneks.Nodegroup_LaunchTemplateSpecification{
+						Id: gfnt.MakeRef("LaunchTemplate"),
+					},
+				},
+			})
+		})
+
+		It("updates the image of the launch template and the launch template version of the nodegroup", func() {
+			Expect(service.UpgradeNodeGroup(UpgradeOptions{
+				NodegroupName:     "ng-1",
+				CustomAMIResolver: staticCustomResolver("ami-new"),
+			})).To(Succeed())
+
+			template, ng := updatedNodeGroup()
+			Expect(template.GetAllEC2LaunchTemplateResources()["LaunchTemplate"].LaunchTemplateData.ImageId).To(Equal(gfnt.NewString("ami-new")))
+			Expect(ng.LaunchTemplate.Version).To(Equal(gfnt.MakeFnGetAttString("LaunchTemplate", "LatestVersionNumber")))
+		})
+
+		It("does nothing when the nodegroup already uses the resolved AMI", func() {
+			Expect(service.UpgradeNodeGroup(UpgradeOptions{
+				NodegroupName:     "ng-1",
+				CustomAMIResolver: staticCustomResolver("ami-old"),
+			})).To(Succeed())
+			Expect(fakeStackManager.UpdateNodeGroupStackCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the nodegroup uses a launch template created outside of eksctl", func() {
+		BeforeEach(func() {
+			setTemplate(gfn.Resources{
+				builder.ManagedNodeGroupResourceName: &gfneks.Nodegroup{
+					LaunchTemplate: &gfneks.Nodegroup_LaunchTemplateSpecification{
+						Id:      gfnt.NewString("lt-1234"),
+						Version: gfnt.NewString("3"),
+					},
+				},
+			})
+			p.MockEC2().On("DescribeLaunchTemplateVersions", mock.Anything).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+				LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{{
+					LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+						ImageId: aws.String("ami-old"),
+					},
+				}},
+			}, nil)
+		})
+
+		It("creates a launch template version with the new image and upgrades the nodegroup to it", func() {
+			p.MockEC2().On("CreateLaunchTemplateVersion", mock.MatchedBy(func(input *ec2.CreateLaunchTemplateVersionInput) bool {
+				return *input.LaunchTemplateId == "lt-1234" && *input.SourceVersion == "3" && *input.LaunchTemplateData.ImageId == "ami-new"
+			})).Return(&ec2.CreateLaunchTemplateVersionOutput{
+				LaunchTemplateVersion: &ec2.LaunchTemplateVersion{
+					VersionNumber: aws.Int64(4),
+				},
+			}, nil)
+
+			Expect(service.UpgradeNodeGroup(UpgradeOptions{
+				NodegroupName:     "ng-1",
+				CustomAMIResolver: staticCustomResolver("ami-new"),
+			})).To(Succeed())
+
+			_, ng := updatedNodeGroup()
+			Expect(ng.LaunchTemplate.Version).To(Equal(gfnt.NewString("4")))
+		})
+
+		It("rejects a launch template version", func() {
+			err := service.UpgradeNodeGroup(UpgradeOptions{
+				NodegroupName:         "ng-1",
+				LaunchTemplateVersion: "5",
+				CustomAMIResolver:     staticCustomResolver("ami-new"),
+			})
+			Expect(err).To(MatchError("cannot specify launch-template-version when resolving a custom AMI"))
+		})
+	})
+})
@@ -13,12 +13,14 @@ const (
 type AmazonLinux2 struct {
 	clusterConfig *api.ClusterConfig
 	ng            *api.NodeGroup
+	maxPods       int
 }
 
-func NewAL2Bootstrapper(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) *AmazonLinux2 {
+func NewAL2Bootstrapper(clusterConfig *api.ClusterConfig, ng *api.NodeGroup, maxPods int) *AmazonLinux2 {
 	return &AmazonLinux2{
 		clusterConfig: clusterConfig,
 		ng:            ng,
+		maxPods:       maxPods,
 	}
 }
 
@@ -29,7 +31,7 @@ func (b *AmazonLinux2) UserData() (string, error) {
 		scripts = append(scripts, "efa.al2.sh")
 	}
 
-	body, err := linuxConfig(b.clusterConfig, al2BootScript, b.ng, scripts...)
+	body, err := linuxConfig(b.clusterConfig, al2BootScript, b.ng, b.maxPods, scripts...)
 	if err != nil {
 		return "", errors.Wrap(err, "encoding user data")
 	}
@@ -30,6 +30,32 @@ var _ = Describe("AmazonLinux2 User Data", func() {
 		}
 	})
 
+	When("VPC CNI custom networking is enabled", func() {
+		BeforeEach(func() {
+			clusterConfig.VPC.PodSubnets = &api.PodSubnets{}
+			ng.InstanceType = "m5.large"
+		})
+
+		kubeletEnv := func() []string {
+			userData, err := newBootstrapper(clusterConfig, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+			file := decode(userData).WriteFiles[1]
+			Expect(file.Path).To(Equal("/etc/eksctl/kubelet.env"))
+			return strings.Split(file.Content, "\n")
+		}
+
+		It("lowers the max pods of the nodes without changing the nodegroup", func() {
+			Expect(kubeletEnv()).To(ContainElement("MAX_PODS=20"))
+			Expect(ng.MaxPodsPerNode).To(BeZero())
+		})
+
+		It("keeps maxPodsPerNode when it is set", func() {
+			ng.MaxPodsPerNode = 25
+			Expect(kubeletEnv()).To(ContainElement("MAX_PODS=25"))
+			Expect(ng.MaxPodsPerNode).To(Equal(25))
+		})
+	})
+
 	When("SSM is enabled", func() {
 		BeforeEach(func() {
 			ng.SSH.EnableSSM = api.Enabled()
@@ -291,6 +317,25 @@ CONTAINER_RUNTIME=`, "\n")))
 		})
 	})
 
+	When("the cluster is an IPv6 cluster", func() {
+		BeforeEach(func() {
+			clusterConfig.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{IPFamily: api.IPV6Family}
+			clusterConfig.Status.ServiceIPv6CIDR = "fd12:3456:789a::/108"
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("adds the IP family, the service CIDR and the IPv6 DNS address to the env file", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[1].Path).To(Equal("/etc/eksctl/kubelet.env"))
+			Expect(cloudCfg.WriteFiles[1].Content).To(ContainSubstring("IP_FAMILY=ipv6"))
+			Expect(cloudCfg.WriteFiles[1].Content).To(ContainSubstring("SERVICE_IPV6_CIDR=fd12:3456:789a::/108"))
+			Expect(cloudCfg.WriteFiles[1].Content).To(ContainSubstring("CLUSTER_DNS=fd12:3456:789a::a"))
+		})
+	})
+
 	When("PreBootstrapCommands are set", func() {
 		BeforeEach(func() {
 			ng.PreBootstrapCommands = []string{"echo 'rubarb'"}
@@ -6,13 +6,18 @@ set -o nounset
 
 source /var/lib/cloud/scripts/eksctl/bootstrap.helper.sh
 
+BOOTSTRAP_ARGS=()
+# only pass the IP family to AMIs that need it, older bootstrap scripts do not support it
+[[ -n "${IP_FAMILY}" ]] && BOOTSTRAP_ARGS+=(--ip-family "${IP_FAMILY}" --service-ipv6-cidr "${SERVICE_IPV6_CIDR}")
+
 echo "eksctl: running /etc/eks/bootstrap"
 /etc/eks/bootstrap.sh "${CLUSTER_NAME}" \
   --apiserver-endpoint "${API_SERVER_URL}" \
   --b64-cluster-ca "${B64_CLUSTER_CA}" \
   --dns-cluster-ip "${CLUSTER_DNS}" \
   --kubelet-extra-args "${KUBELET_EXTRA_ARGS}" \
-  --container-runtime "${CONTAINER_RUNTIME}"
+  --container-runtime "${CONTAINER_RUNTIME}" \
+  ${BOOTSTRAP_ARGS[@]+"${BOOTSTRAP_ARGS[@]}"}
 
 echo "eksctl: merging user options into kubelet-config.json"
 trap 'rm -f ${TMP_KUBE_CONF}' EXIT
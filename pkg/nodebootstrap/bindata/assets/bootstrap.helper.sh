@@ -19,6 +19,8 @@ INSTANCE_LIFECYCLE="$(get_metadata instance-life-cycle)"
 CLUSTER_DNS="${CLUSTER_DNS:-}"
 NODE_TAINTS="${NODE_TAINTS:-}"
 MAX_PODS="${MAX_PODS:-}"
+IP_FAMILY="${IP_FAMILY:-}"
+SERVICE_IPV6_CIDR="${SERVICE_IPV6_CIDR:-}"
 NODE_LABELS="${NODE_LABELS},node-lifecycle=${INSTANCE_LIFECYCLE},alpha.eksctl.io/instance-id=${INSTANCE_ID}"
 
 KUBELET_ARGS=("--node-labels=${NODE_LABELS}")
@@ -13,12 +13,14 @@ import (
 type Bottlerocket struct {
 	clusterConfig *api.ClusterConfig
 	np            api.NodePool
+	maxPods       int
 }
 
-func NewBottlerocketBootstrapper(clusterConfig *api.ClusterConfig, np api.NodePool) *Bottlerocket {
+func NewBottlerocketBootstrapper(clusterConfig *api.ClusterConfig, np api.NodePool, maxPods int) *Bottlerocket {
 	return &Bottlerocket{
 		clusterConfig: clusterConfig,
 		np:            np,
+		maxPods:       maxPods,
 	}
 }
 
@@ -32,7 +34,7 @@ func (b *Bottlerocket) UserData() (string, error) {
 	// Update settings based on NodeGroup configuration. Values set here are not
 	// allowed to be set by the user - the values are owned by the NodeGroup and
 	// expressly written into settings.
-	if err := setDerivedBottlerocketSettings(b.np); err != nil {
+	if err := setDerivedBottlerocketSettings(b.np, b.maxPods); err != nil {
 		return "", err
 	}
 
@@ -56,7 +58,7 @@ func (b *Bottlerocket) UserData() (string, error) {
 	return base64.StdEncoding.EncodeToString([]byte(data)), nil
 }
 
-func setDerivedBottlerocketSettings(np api.NodePool) error {
+func setDerivedBottlerocketSettings(np api.NodePool, maxPods int) error {
 	ng := np.BaseNodeGroup()
 	settings := *ng.Bottlerocket.Settings
 
@@ -75,8 +77,8 @@ func setDerivedBottlerocketSettings(np api.NodePool) error {
 	if len(ng.Labels) != 0 {
 		kubernetesSettings["node-labels"] = ng.Labels
 	}
-	if ng.MaxPodsPerNode != 0 {
-		kubernetesSettings["max-pods"] = ng.MaxPodsPerNode
+	if maxPods != 0 {
+		kubernetesSettings["max-pods"] = maxPods
 	}
 	if taints := np.NGTaints(); len(taints) != 0 {
 		kubernetesSettings["node-taints"] = taintsToMap(taints)
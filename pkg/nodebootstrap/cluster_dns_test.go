@@ -9,13 +9,14 @@ import (
 
 type clusterDNSEntry struct {
 	clusterStatus *api.ClusterStatus
+	networkConfig *api.KubernetesNetworkConfig
 
 	expectedClusterDNS string
 	expectedErr        string
 }
 
 var _ = DescribeTable("Cluster DNS", func(c clusterDNSEntry) {
-	clusterDNS, err := nodebootstrap.GetClusterDNS(&api.ClusterConfig{Status: c.clusterStatus})
+	clusterDNS, err := nodebootstrap.GetClusterDNS(&api.ClusterConfig{Status: c.clusterStatus, KubernetesNetworkConfig: c.networkConfig})
 	if c.expectedErr != "" {
 		Expect(err).To(HaveOccurred())
 		Expect(err).To(MatchError(ContainSubstring(c.expectedErr)))
@@ -57,4 +58,18 @@ var _ = DescribeTable("Cluster DNS", func(c clusterDNSEntry) {
 
 		expectedErr: "unexpected error",
 	}),
+
+	Entry("ServiceIPv6CIDR", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			ServiceIPv6CIDR: "fd12:3456:789a::/108",
+		},
+		networkConfig:      &api.KubernetesNetworkConfig{IPFamily: api.IPV6Family},
+		expectedClusterDNS: "fd12:3456:789a::a",
+	}),
+
+	Entry("empty ServiceIPv6CIDR", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{},
+		networkConfig: &api.KubernetesNetworkConfig{IPFamily: api.IPV6Family},
+		expectedErr:   "the service IPv6 CIDR of the cluster is unknown",
+	}),
 )
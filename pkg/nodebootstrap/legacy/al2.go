@@ -13,19 +13,21 @@ import (
 type AL2Bootstrapper struct {
 	clusterSpec *api.ClusterConfig
 	ng          *api.NodeGroup
+	maxPods     int
 }
 
-func NewAL2Bootstrapper(clusterSpec *api.ClusterConfig, ng *api.NodeGroup) AL2Bootstrapper {
+func NewAL2Bootstrapper(clusterSpec *api.ClusterConfig, ng *api.NodeGroup, maxPods int) AL2Bootstrapper {
 	return AL2Bootstrapper{
 		clusterSpec: clusterSpec,
 		ng:          ng,
+		maxPods:     maxPods,
 	}
 }
 
 func (b AL2Bootstrapper) UserData() (string, error) {
 	config := cloudconfig.New()
 
-	files, err := makeAmazonLinux2Config(b.clusterSpec, b.ng)
+	files, err := makeAmazonLinux2Config(b.clusterSpec, b.ng, b.maxPods)
 	if err != nil {
 		return "", err
 	}
@@ -58,7 +60,7 @@ func (b AL2Bootstrapper) UserData() (string, error) {
 	return body, nil
 }
 
-func makeAmazonLinux2Config(spec *api.ClusterConfig, ng *api.NodeGroup) ([]configFile, error) {
+func makeAmazonLinux2Config(spec *api.ClusterConfig, ng *api.NodeGroup, maxPods int) ([]configFile, error) {
 	clientConfigData, err := makeClientConfigData(spec, kubeconfig.AWSEKSAuthenticator)
 	if err != nil {
 		return nil, err
@@ -84,7 +86,7 @@ func makeAmazonLinux2Config(spec *api.ClusterConfig, ng *api.NodeGroup) ([]confi
 	}, {
 		dir:      configDir,
 		name:     "kubelet.env",
-		contents: strings.Join(makeCommonKubeletEnvParams(ng), "\n"),
+		contents: strings.Join(makeCommonKubeletEnvParams(ng, maxPods), "\n"),
 	}, {
 		dir:      configDir,
 		name:     "kubelet.yaml",
@@ -0,0 +1,408 @@
+package legacy
+
+// This file was generated by maxpods_generate.go; DO NOT EDIT.
+
+// Source: https://raw.github.com/aws/amazon-vpc-cni-k8s/master/pkg/awsutils/vpc_ip_resource_limit.go
+var eniLimitsPerNodeType = map[string]ENILimits{
+	"a1.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"a1.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"a1.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"a1.medium":     {ENIs: 2, IPv4AddressesPerENI: 4},
+	"a1.metal":      {ENIs: 8, IPv4AddressesPerENI: 30},
+	"a1.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c1.medium":     {ENIs: 2, IPv4AddressesPerENI: 6},
+	"c1.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c3.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c3.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c3.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c3.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"c3.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c4.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c4.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c4.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c4.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"c4.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c5.12xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5.18xlarge":   {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5.24xlarge":   {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c5.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5.9xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"c5.metal":      {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c5a.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5a.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5a.24xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5a.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c5a.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5a.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5a.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"c5a.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5a.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c5ad.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5ad.16xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5ad.24xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5ad.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c5ad.4xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5ad.8xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5ad.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"c5ad.metal":    {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5ad.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c5d.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5d.18xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5d.24xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5d.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c5d.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5d.9xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5d.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"c5d.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5d.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c5n.18xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5n.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c5n.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5n.9xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c5n.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"c5n.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c5n.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c6g.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c6g.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c6g.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c6g.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c6g.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c6g.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"c6g.medium":    {ENIs: 2, IPv4AddressesPerENI: 4},
+	"c6g.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c6g.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c6gd.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c6gd.16xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c6gd.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c6gd.4xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c6gd.8xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c6gd.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"c6gd.medium":   {ENIs: 2, IPv4AddressesPerENI: 4},
+	"c6gd.metal":    {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c6gd.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c6gn.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c6gn.16xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"c6gn.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"c6gn.4xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c6gn.8xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"c6gn.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"c6gn.medium":   {ENIs: 2, IPv4AddressesPerENI: 4},
+	"c6gn.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"cc2.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"cr1.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"d2.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"d2.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"d2.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"d2.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"d3.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 5},
+	"d3.4xlarge":    {ENIs: 4, IPv4AddressesPerENI: 10},
+	"d3.8xlarge":    {ENIs: 3, IPv4AddressesPerENI: 20},
+	"d3.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 3},
+	"d3en.12xlarge": {ENIs: 3, IPv4AddressesPerENI: 30},
+	"d3en.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 5},
+	"d3en.4xlarge":  {ENIs: 4, IPv4AddressesPerENI: 10},
+	"d3en.6xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"d3en.8xlarge":  {ENIs: 4, IPv4AddressesPerENI: 20},
+	"d3en.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 3},
+	"f1.16xlarge":   {ENIs: 8, IPv4AddressesPerENI: 50},
+	"f1.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"f1.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"g2.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"g2.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"g3.16xlarge":   {ENIs: 15, IPv4AddressesPerENI: 50},
+	"g3.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"g3.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"g3s.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"g4ad.16xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"g4ad.4xlarge":  {ENIs: 3, IPv4AddressesPerENI: 10},
+	"g4ad.8xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"g4dn.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"g4dn.16xlarge": {ENIs: 4, IPv4AddressesPerENI: 15},
+	"g4dn.2xlarge":  {ENIs: 3, IPv4AddressesPerENI: 10},
+	"g4dn.4xlarge":  {ENIs: 3, IPv4AddressesPerENI: 10},
+	"g4dn.8xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"g4dn.metal":    {ENIs: 15, IPv4AddressesPerENI: 50},
+	"g4dn.xlarge":   {ENIs: 3, IPv4AddressesPerENI: 10},
+	"h1.16xlarge":   {ENIs: 15, IPv4AddressesPerENI: 50},
+	"h1.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"h1.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"h1.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"hs1.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"i2.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"i2.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"i2.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"i2.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"i3.16xlarge":   {ENIs: 15, IPv4AddressesPerENI: 50},
+	"i3.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"i3.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"i3.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"i3.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"i3.metal":      {ENIs: 15, IPv4AddressesPerENI: 50},
+	"i3.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"i3en.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"i3en.24xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"i3en.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"i3en.3xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"i3en.6xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"i3en.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"i3en.metal":    {ENIs: 15, IPv4AddressesPerENI: 50},
+	"i3en.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"inf1.24xlarge": {ENIs: 11, IPv4AddressesPerENI: 30},
+	"inf1.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 10},
+	"inf1.6xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"inf1.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 10},
+	"m1.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m1.medium":     {ENIs: 2, IPv4AddressesPerENI: 6},
+	"m1.small":      {ENIs: 2, IPv4AddressesPerENI: 4},
+	"m1.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m2.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 30},
+	"m2.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m2.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m3.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 30},
+	"m3.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m3.medium":     {ENIs: 2, IPv4AddressesPerENI: 6},
+	"m3.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m4.10xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m4.16xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m4.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m4.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m4.large":      {ENIs: 2, IPv4AddressesPerENI: 10},
+	"m4.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5.12xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5.16xlarge":   {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5.24xlarge":   {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m5.metal":      {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5a.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5a.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5a.24xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5a.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5a.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5a.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5a.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m5a.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5ad.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5ad.16xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5ad.24xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5ad.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5ad.4xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5ad.8xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5ad.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m5ad.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5d.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5d.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5d.24xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5d.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5d.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5d.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5d.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m5d.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5d.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5dn.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5dn.16xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5dn.24xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5dn.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5dn.4xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5dn.8xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5dn.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m5dn.metal":    {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5dn.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5n.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5n.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5n.24xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5n.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5n.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5n.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5n.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m5n.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5n.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5zn.12xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5zn.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m5zn.3xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5zn.6xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m5zn.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m5zn.metal":    {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m5zn.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m6g.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m6g.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m6g.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m6g.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m6g.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m6g.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m6g.medium":    {ENIs: 2, IPv4AddressesPerENI: 4},
+	"m6g.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m6g.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m6gd.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m6gd.16xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m6gd.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"m6gd.4xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m6gd.8xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"m6gd.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"m6gd.medium":   {ENIs: 2, IPv4AddressesPerENI: 4},
+	"m6gd.metal":    {ENIs: 15, IPv4AddressesPerENI: 50},
+	"m6gd.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"mac1.metal":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"p2.16xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"p2.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"p2.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"p3.16xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"p3.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"p3.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"p3dn.24xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"p4d.24xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r3.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r3.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r3.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r3.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r3.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r4.16xlarge":   {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r4.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r4.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r4.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r4.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r4.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5.12xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5.16xlarge":   {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5.24xlarge":   {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5.4xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5.8xlarge":    {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5.large":      {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r5.metal":      {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5a.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5a.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5a.24xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5a.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5a.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5a.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5a.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r5a.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5ad.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5ad.16xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5ad.24xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5ad.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5ad.4xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5ad.8xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5ad.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r5ad.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5b.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5b.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5b.24xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5b.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5b.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5b.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5b.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r5b.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5b.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5d.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5d.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5d.24xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5d.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5d.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5d.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5d.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r5d.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5d.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5dn.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5dn.16xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5dn.24xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5dn.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5dn.4xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5dn.8xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5dn.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r5dn.metal":    {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5dn.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5n.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5n.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5n.24xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5n.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r5n.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5n.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r5n.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r5n.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r5n.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r6g.12xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r6g.16xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r6g.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r6g.4xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r6g.8xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r6g.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r6g.medium":    {ENIs: 2, IPv4AddressesPerENI: 4},
+	"r6g.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r6g.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r6gd.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r6gd.16xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r6gd.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"r6gd.4xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r6gd.8xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"r6gd.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"r6gd.medium":   {ENIs: 2, IPv4AddressesPerENI: 4},
+	"r6gd.metal":    {ENIs: 15, IPv4AddressesPerENI: 50},
+	"r6gd.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"t1.micro":      {ENIs: 2, IPv4AddressesPerENI: 2},
+	"t2.2xlarge":    {ENIs: 3, IPv4AddressesPerENI: 15},
+	"t2.large":      {ENIs: 3, IPv4AddressesPerENI: 12},
+	"t2.medium":     {ENIs: 3, IPv4AddressesPerENI: 6},
+	"t2.micro":      {ENIs: 2, IPv4AddressesPerENI: 2},
+	"t2.nano":       {ENIs: 2, IPv4AddressesPerENI: 2},
+	"t2.small":      {ENIs: 3, IPv4AddressesPerENI: 4},
+	"t2.xlarge":     {ENIs: 3, IPv4AddressesPerENI: 15},
+	"t3.2xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"t3.large":      {ENIs: 3, IPv4AddressesPerENI: 12},
+	"t3.medium":     {ENIs: 3, IPv4AddressesPerENI: 6},
+	"t3.micro":      {ENIs: 2, IPv4AddressesPerENI: 2},
+	"t3.nano":       {ENIs: 2, IPv4AddressesPerENI: 2},
+	"t3.small":      {ENIs: 3, IPv4AddressesPerENI: 4},
+	"t3.xlarge":     {ENIs: 4, IPv4AddressesPerENI: 15},
+	"t3a.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"t3a.large":     {ENIs: 3, IPv4AddressesPerENI: 12},
+	"t3a.medium":    {ENIs: 3, IPv4AddressesPerENI: 6},
+	"t3a.micro":     {ENIs: 2, IPv4AddressesPerENI: 2},
+	"t3a.nano":      {ENIs: 2, IPv4AddressesPerENI: 2},
+	"t3a.small":     {ENIs: 2, IPv4AddressesPerENI: 4},
+	"t3a.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"t4g.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"t4g.large":     {ENIs: 3, IPv4AddressesPerENI: 12},
+	"t4g.medium":    {ENIs: 3, IPv4AddressesPerENI: 6},
+	"t4g.micro":     {ENIs: 2, IPv4AddressesPerENI: 2},
+	"t4g.nano":      {ENIs: 2, IPv4AddressesPerENI: 2},
+	"t4g.small":     {ENIs: 3, IPv4AddressesPerENI: 4},
+	"t4g.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+	"u-12tb1.metal": {ENIs: 5, IPv4AddressesPerENI: 30},
+	"u-18tb1.metal": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"u-24tb1.metal": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"u-6tb1.metal":  {ENIs: 5, IPv4AddressesPerENI: 30},
+	"u-9tb1.metal":  {ENIs: 5, IPv4AddressesPerENI: 30},
+	"x1.16xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"x1.32xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"x1e.16xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"x1e.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"x1e.32xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"x1e.4xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"x1e.8xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"x1e.xlarge":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"x2gd.12xlarge": {ENIs: 8, IPv4AddressesPerENI: 30},
+	"x2gd.16xlarge": {ENIs: 15, IPv4AddressesPerENI: 50},
+	"x2gd.2xlarge":  {ENIs: 4, IPv4AddressesPerENI: 15},
+	"x2gd.4xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"x2gd.8xlarge":  {ENIs: 8, IPv4AddressesPerENI: 30},
+	"x2gd.large":    {ENIs: 3, IPv4AddressesPerENI: 10},
+	"x2gd.medium":   {ENIs: 2, IPv4AddressesPerENI: 4},
+	"x2gd.metal":    {ENIs: 15, IPv4AddressesPerENI: 50},
+	"x2gd.xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"z1d.12xlarge":  {ENIs: 15, IPv4AddressesPerENI: 50},
+	"z1d.2xlarge":   {ENIs: 4, IPv4AddressesPerENI: 15},
+	"z1d.3xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"z1d.6xlarge":   {ENIs: 8, IPv4AddressesPerENI: 30},
+	"z1d.large":     {ENIs: 3, IPv4AddressesPerENI: 10},
+	"z1d.metal":     {ENIs: 15, IPv4AddressesPerENI: 50},
+	"z1d.xlarge":    {ENIs: 4, IPv4AddressesPerENI: 15},
+}
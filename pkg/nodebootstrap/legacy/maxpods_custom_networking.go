@@ -0,0 +1,23 @@
+package legacy
+
+// ENILimits holds the number of ENIs of an instance type and the number of IPv4 addresses per ENI
+type ENILimits struct {
+	ENIs                int
+	IPv4AddressesPerENI int
+}
+
+// ENILimitsPerNodeType returns the ENI limits of an instance type, and whether the instance type is known
+func ENILimitsPerNodeType(instanceType string) (ENILimits, bool) {
+	limits, ok := eniLimitsPerNodeType[instanceType]
+	return limits, ok
+}
+
+// MaxPodsPerNodeWithCustomNetworking returns the max pods of an instance type with VPC CNI custom networking,
+// where the primary ENI of a node is not used for pods, and whether the instance type is known
+func MaxPodsPerNodeWithCustomNetworking(instanceType string) (int, bool) {
+	limits, ok := ENILimitsPerNodeType(instanceType)
+	if !ok {
+		return 0, false
+	}
+	return (limits.ENIs-1)*(limits.IPv4AddressesPerENI-1) + 2, true
+}
@@ -0,0 +1,35 @@
+package legacy
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Max pods with custom networking", func() {
+	It("has the ENI limits of every instance type the max pods are computed from", func() {
+		for instanceType, maxPods := range maxPodsPerNodeType {
+			Expect(eniLimitsPerNodeType).To(HaveKey(instanceType))
+			limits := eniLimitsPerNodeType[instanceType]
+			Expect(limits.ENIs*(limits.IPv4AddressesPerENI-1)+2).To(Equal(maxPods), "ENI limits of %s", instanceType)
+		}
+		Expect(eniLimitsPerNodeType).To(HaveLen(len(maxPodsPerNodeType)))
+	})
+
+	DescribeTable("does not count the primary ENI", func(instanceType string, expected int) {
+		maxPods, ok := MaxPodsPerNodeWithCustomNetworking(instanceType)
+		Expect(ok).To(BeTrue())
+		Expect(maxPods).To(Equal(expected))
+	},
+		Entry("t3.medium", "t3.medium", 12),
+		Entry("m5.large", "m5.large", 20),
+		Entry("m5.4xlarge", "m5.4xlarge", 205),
+		Entry("d3.xlarge", "d3.xlarge", 8),
+		Entry("inf1.24xlarge", "inf1.24xlarge", 292),
+	)
+
+	It("does not know unknown instance types", func() {
+		_, ok := MaxPodsPerNodeWithCustomNetworking("x9.unknown")
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -4,6 +4,9 @@ package main
 
 import (
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io/ioutil"
 	"log"
 	"strconv"
@@ -14,18 +17,24 @@ import (
 	. "github.com/dave/jennifer/jen"
 )
 
-const maxPodsPerNodeTypeSourceText = "https://raw.github.com/awslabs/amazon-eks-ami/master/files/eni-max-pods.txt"
+const (
+	maxPodsPerNodeTypeSourceText = "https://raw.github.com/awslabs/amazon-eks-ami/master/files/eni-max-pods.txt"
+	// eni-max-pods.txt is generated from the ENI limits of the VPC CNI, which are read from the same release
+	eniLimitsPerNodeTypeSource = "https://raw.github.com/aws/amazon-vpc-cni-k8s/master/pkg/awsutils/vpc_ip_resource_limit.go"
+)
 
 func main() {
 	fmt.Println("Generating maxpods.go file...")
 	maxPodsMap := generateMap()
 	renderGoMap(maxPodsMap)
-}
 
-func generateMap() map[string]int {
-	dict := make(map[string]int)
+	fmt.Println("Generating eni_limits.go file...")
+	eniLimits := generateENILimits(maxPodsMap)
+	renderENILimits(eniLimits)
+}
 
-	resp, err := http.Get(maxPodsPerNodeTypeSourceText)
+func download(url string) []byte {
+	resp, err := http.Get(url)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -34,6 +43,13 @@ func generateMap() map[string]int {
 	if err != nil {
 		log.Fatal(err.Error())
 	}
+	return body
+}
+
+func generateMap() map[string]int {
+	dict := make(map[string]int)
+
+	body := download(maxPodsPerNodeTypeSourceText)
 
 	for _, line := range strings.Split(string(body), "\n") {
 		if strings.HasPrefix(line, "#") {
@@ -54,8 +70,77 @@ func generateMap() map[string]int {
 	return dict
 }
 
+type eniLimits struct {
+	enis                int
+	ipv4AddressesPerENI int
+}
+
+// generateENILimits reads the number of ENIs and IPv4 addresses per ENI of the instance types in maxPodsMap,
+// and checks that they add up to the max pods of each instance type, so that both files stay consistent
+func generateENILimits(maxPodsMap map[string]int) map[string]eniLimits {
+	file, err := parser.ParseFile(token.NewFileSet(), "vpc_ip_resource_limit.go", download(eniLimitsPerNodeTypeSource), 0)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	enis := readIntMap(file, "InstanceENIsAvailable")
+	ipv4Addresses := readIntMap(file, "InstanceIPsAvailable")
+
+	dict := make(map[string]eniLimits)
+	for instanceType, maxPods := range maxPodsMap {
+		limits := eniLimits{enis: enis[instanceType], ipv4AddressesPerENI: ipv4Addresses[instanceType]}
+		if limits.enis == 0 || limits.ipv4AddressesPerENI == 0 {
+			log.Fatalf("no ENI limits found for instance type %q", instanceType)
+		}
+		if expected := limits.enis*(limits.ipv4AddressesPerENI-1) + 2; expected != maxPods {
+			log.Fatalf("the ENI limits of instance type %q allow %d pods, but its max pods is %d", instanceType, expected, maxPods)
+		}
+		dict[instanceType] = limits
+	}
+	return dict
+}
+
+// readIntMap returns the entries of a package-level map[string]int variable
+func readIntMap(file *ast.File, name string) map[string]int {
+	dict := make(map[string]int)
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || spec.Names[0].Name != name || len(spec.Values) != 1 {
+			return true
+		}
+		lit, ok := spec.Values[0].(*ast.CompositeLit)
+		if !ok {
+			log.Fatalf("%s is not a map literal", name)
+		}
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			key, keyOK := kv.Key.(*ast.BasicLit)
+			value, valueOK := kv.Value.(*ast.BasicLit)
+			if !keyOK || !valueOK || key.Kind != token.STRING || value.Kind != token.INT {
+				continue
+			}
+			instanceType, err := strconv.Unquote(key.Value)
+			if err != nil {
+				log.Fatal(err.Error())
+			}
+			count, err := strconv.Atoi(value.Value)
+			if err != nil {
+				log.Fatal(err.Error())
+			}
+			dict[instanceType] = count
+		}
+		return false
+	})
+	if len(dict) == 0 {
+		log.Fatalf("%s not found in %s", name, eniLimitsPerNodeTypeSource)
+	}
+	return dict
+}
+
 func renderGoMap(maxPodsMap map[string]int) {
-	f := NewFile("nodebootstrap")
+	f := NewFile("legacy")
 
 	f.Comment("This file was generated by maxpods_generate.go; DO NOT EDIT.")
 	f.Line()
@@ -73,3 +158,26 @@ func renderGoMap(maxPodsMap map[string]int) {
 		log.Fatal(err.Error())
 	}
 }
+
+func renderENILimits(eniLimitsMap map[string]eniLimits) {
+	f := NewFile("legacy")
+
+	f.Comment("This file was generated by maxpods_generate.go; DO NOT EDIT.")
+	f.Line()
+	f.Comment("Source: " + eniLimitsPerNodeTypeSource)
+
+	dict := Dict{}
+	for k, v := range eniLimitsMap {
+		dict[Lit(k)] = Values(
+			Id("ENIs").Op(":").Lit(v.enis),
+			Id("IPv4AddressesPerENI").Op(":").Lit(v.ipv4AddressesPerENI),
+		)
+	}
+
+	f.Var().Id("eniLimitsPerNodeType").Op("=").
+		Map(String()).Id("ENILimits").Values(dict)
+
+	if err := f.Save("eni_limits.go"); err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -16,19 +16,21 @@ const ubuntu2004ResolveConfPath = "/run/systemd/resolve/resolv.conf"
 type UbuntuBootstrapper struct {
 	clusterSpec *api.ClusterConfig
 	ng          *api.NodeGroup
+	maxPods     int
 }
 
-func NewUbuntuBootstrapper(clusterSpec *api.ClusterConfig, ng *api.NodeGroup) UbuntuBootstrapper {
+func NewUbuntuBootstrapper(clusterSpec *api.ClusterConfig, ng *api.NodeGroup, maxPods int) UbuntuBootstrapper {
 	return UbuntuBootstrapper{
 		clusterSpec: clusterSpec,
 		ng:          ng,
+		maxPods:     maxPods,
 	}
 }
 
 func (b UbuntuBootstrapper) UserData() (string, error) {
 	config := cloudconfig.New()
 
-	files, err := makeUbuntuConfig(b.clusterSpec, b.ng)
+	files, err := makeUbuntuConfig(b.clusterSpec, b.ng, b.maxPods)
 	if err != nil {
 		return "", err
 	}
@@ -58,7 +60,7 @@ func (b UbuntuBootstrapper) UserData() (string, error) {
 	return body, nil
 }
 
-func makeUbuntuConfig(spec *api.ClusterConfig, ng *api.NodeGroup) ([]configFile, error) {
+func makeUbuntuConfig(spec *api.ClusterConfig, ng *api.NodeGroup, maxPods int) ([]configFile, error) {
 	clientConfigData, err := makeClientConfigData(spec, kubeconfig.AWSEKSAuthenticator)
 	if err != nil {
 		return nil, err
@@ -68,7 +70,7 @@ func makeUbuntuConfig(spec *api.ClusterConfig, ng *api.NodeGroup) ([]configFile,
 		return nil, errors.New("invalid cluster config: missing CertificateAuthorityData")
 	}
 
-	kubeletEnvParams := makeCommonKubeletEnvParams(ng)
+	kubeletEnvParams := makeCommonKubeletEnvParams(ng, maxPods)
 
 	if ng.ClusterDNS != "" {
 		kubeletEnvParams = append(kubeletEnvParams, fmt.Sprintf("CLUSTER_DNS=%s", ng.ClusterDNS))
@@ -166,14 +166,14 @@ func kvs(kv map[string]string) string {
 	return strings.Join(params, ",")
 }
 
-func makeCommonKubeletEnvParams(ng *api.NodeGroup) []string {
+func makeCommonKubeletEnvParams(ng *api.NodeGroup, maxPods int) []string {
 	variables := []string{
 		fmt.Sprintf("NODE_LABELS=%s", kvs(ng.Labels)),
 		fmt.Sprintf("NODE_TAINTS=%s", utils.FormatTaints(ng.Taints)),
 	}
 
-	if ng.MaxPodsPerNode != 0 {
-		variables = append(variables, fmt.Sprintf("MAX_PODS=%d", ng.MaxPodsPerNode))
+	if maxPods != 0 {
+		variables = append(variables, fmt.Sprintf("MAX_PODS=%d", maxPods))
 	}
 	return variables
 }
@@ -15,15 +15,17 @@ import (
 
 // ManagedAL2 is a bootstrapper for managed Amazon Linux 2 nodegroups
 type ManagedAL2 struct {
-	ng *api.ManagedNodeGroup
+	ng      *api.ManagedNodeGroup
+	maxPods int
 	// UserDataMimeBoundary sets the MIME boundary for user data
 	UserDataMimeBoundary string
 }
 
 // NewManagedAL2Bootstrapper creates a new ManagedAL2 bootstrapper
-func NewManagedAL2Bootstrapper(ng *api.ManagedNodeGroup) *ManagedAL2 {
+func NewManagedAL2Bootstrapper(ng *api.ManagedNodeGroup, maxPods int) *ManagedAL2 {
 	return &ManagedAL2{
-		ng: ng,
+		ng:      ng,
+		maxPods: maxPods,
 	}
 }
 
@@ -48,8 +50,8 @@ func (m *ManagedAL2) UserData() (string, error) {
 
 	if ng.OverrideBootstrapCommand != nil {
 		scripts = append(scripts, *ng.OverrideBootstrapCommand)
-	} else if ng.MaxPodsPerNode != 0 {
-		scripts = append(scripts, makeMaxPodsScript(ng.MaxPodsPerNode))
+	} else if m.maxPods != 0 {
+		scripts = append(scripts, makeMaxPodsScript(m.maxPods))
 	}
 
 	if api.IsEnabled(ng.EFAEnabled) {
@@ -13,17 +13,19 @@ const (
 type Ubuntu struct {
 	clusterConfig *api.ClusterConfig
 	np            api.NodePool
+	maxPods       int
 }
 
-func NewUbuntuBootstrapper(clusterConfig *api.ClusterConfig, np api.NodePool) *Ubuntu {
+func NewUbuntuBootstrapper(clusterConfig *api.ClusterConfig, np api.NodePool, maxPods int) *Ubuntu {
 	return &Ubuntu{
 		clusterConfig: clusterConfig,
 		np:            np,
+		maxPods:       maxPods,
 	}
 }
 
 func (b *Ubuntu) UserData() (string, error) {
-	body, err := linuxConfig(b.clusterConfig, ubuntuBootScript, b.np)
+	body, err := linuxConfig(b.clusterConfig, ubuntuBootScript, b.np, b.maxPods)
 	if err != nil {
 		return "", errors.Wrap(err, "encoding user data")
 	}
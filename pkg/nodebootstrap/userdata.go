@@ -52,23 +52,24 @@ func NewBootstrapper(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) (Boots
 	if api.IsWindowsImage(ng.AMIFamily) {
 		return NewWindowsBootstrapper(clusterConfig.Metadata.Name, ng), nil
 	}
+	maxPods := maxPodsPerNode(clusterConfig, ng.NodeGroupBase, ng.InstanceTypeList())
 	switch ng.AMIFamily {
 	case api.NodeImageFamilyUbuntu2004, api.NodeImageFamilyUbuntu1804:
 		// TODO remove
 		if ng.CustomAMI {
 			logger.Warning("Custom AMI detected for nodegroup %s, using legacy nodebootstrap mechanism. Please refer to https://github.com/weaveworks/eksctl/issues/3563 for upcoming breaking changes", ng.Name)
-			return legacy.NewUbuntuBootstrapper(clusterConfig, ng), nil
+			return legacy.NewUbuntuBootstrapper(clusterConfig, ng, maxPods), nil
 		}
-		return NewUbuntuBootstrapper(clusterConfig, ng), nil
+		return NewUbuntuBootstrapper(clusterConfig, ng, maxPods), nil
 	case api.NodeImageFamilyBottlerocket:
-		return NewBottlerocketBootstrapper(clusterConfig, ng), nil
+		return NewBottlerocketBootstrapper(clusterConfig, ng, maxPods), nil
 	case api.NodeImageFamilyAmazonLinux2:
 		// TODO remove
 		if ng.CustomAMI {
 			logger.Warning("Custom AMI detected for nodegroup %s, using legacy nodebootstrap mechanism. Please refer to https://github.com/weaveworks/eksctl/issues/3563 for upcoming breaking changes", ng.Name)
-			return legacy.NewAL2Bootstrapper(clusterConfig, ng), nil
+			return legacy.NewAL2Bootstrapper(clusterConfig, ng, maxPods), nil
 		}
-		return NewAL2Bootstrapper(clusterConfig, ng), nil
+		return NewAL2Bootstrapper(clusterConfig, ng, maxPods), nil
 	default:
 		return nil, errors.Errorf("unrecognized AMI family %q for creating bootstrapper", ng.AMIFamily)
 
@@ -77,19 +78,44 @@ func NewBootstrapper(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) (Boots
 
 // NewManagedBootstrapper creates a new bootstrapper for managed nodegroups based on the AMI family
 func NewManagedBootstrapper(clusterConfig *api.ClusterConfig, ng *api.ManagedNodeGroup) Bootstrapper {
+	maxPods := maxPodsPerNode(clusterConfig, ng.NodeGroupBase, ng.InstanceTypeList())
 	switch ng.AMIFamily {
 	case api.NodeImageFamilyAmazonLinux2:
-		return NewManagedAL2Bootstrapper(ng)
+		return NewManagedAL2Bootstrapper(ng, maxPods)
 	case api.NodeImageFamilyBottlerocket:
-		return NewBottlerocketBootstrapper(clusterConfig, ng)
+		return NewBottlerocketBootstrapper(clusterConfig, ng, maxPods)
 	case api.NodeImageFamilyUbuntu1804, api.NodeImageFamilyUbuntu2004:
-		return NewUbuntuBootstrapper(clusterConfig, ng)
+		return NewUbuntuBootstrapper(clusterConfig, ng, maxPods)
 	}
 	return nil
 }
 
+// maxPodsPerNode returns the max pods of the nodes, lowered when VPC CNI custom networking is enabled as the
+// primary ENI of a node is then not used for pods. The lowest max pods of the instance types is used, unless
+// maxPodsPerNode is set
+func maxPodsPerNode(clusterConfig *api.ClusterConfig, ng *api.NodeGroupBase, instanceTypes []string) int {
+	if !clusterConfig.HasCustomNetworking() || ng.MaxPodsPerNode != 0 {
+		return ng.MaxPodsPerNode
+	}
+	maxPods := 0
+	for _, instanceType := range instanceTypes {
+		instanceMaxPods, ok := legacy.MaxPodsPerNodeWithCustomNetworking(instanceType)
+		if !ok {
+			logger.Warning("max pods of instance type %s with custom networking is unknown, set maxPodsPerNode for nodegroup %s", instanceType, ng.Name)
+			return 0
+		}
+		if maxPods == 0 || instanceMaxPods < maxPods {
+			maxPods = instanceMaxPods
+		}
+	}
+	return maxPods
+}
+
 // GetClusterDNS returns the DNS address to use
 func GetClusterDNS(clusterConfig *api.ClusterConfig) (string, error) {
+	if clusterConfig.IPv6Enabled() {
+		return getClusterIPv6DNS(clusterConfig.Status.ServiceIPv6CIDR)
+	}
 	networkConfig := clusterConfig.Status.KubernetesNetworkConfig
 	if networkConfig == nil {
 		return "", nil
@@ -104,7 +130,21 @@ func GetClusterDNS(clusterConfig *api.ClusterConfig) (string, error) {
 	return ip.String(), nil
 }
 
-func linuxConfig(clusterConfig *api.ClusterConfig, bootScript string, np api.NodePool, scripts ...string) (string, error) {
+// getClusterIPv6DNS returns the DNS address of an IPv6 cluster, the address ending in `a` in its service CIDR
+func getClusterIPv6DNS(serviceIPv6CIDR string) (string, error) {
+	if serviceIPv6CIDR == "" {
+		return "", errors.New("the service IPv6 CIDR of the cluster is unknown")
+	}
+	ip, _, err := net.ParseCIDR(serviceIPv6CIDR)
+	if err != nil {
+		return "", errors.Wrapf(err, "unexpected error parsing the service IPv6 CIDR: %q", serviceIPv6CIDR)
+	}
+	ip = ip.To16()
+	ip[net.IPv6len-1] = 0xa
+	return ip.String(), nil
+}
+
+func linuxConfig(clusterConfig *api.ClusterConfig, bootScript string, np api.NodePool, maxPods int, scripts ...string) (string, error) {
 	config := cloudconfig.New()
 	ng := np.BaseNodeGroup()
 
@@ -130,7 +170,7 @@ func linuxConfig(clusterConfig *api.ClusterConfig, bootScript string, np api.Nod
 			return "", err
 		}
 		files = append(files, kubeletConf)
-		envFile := makeBootstrapEnv(clusterConfig, np)
+		envFile := makeBootstrapEnv(clusterConfig, np, maxPods)
 		files = append(files, envFile)
 	}
 
@@ -166,7 +206,7 @@ func makeKubeletExtraConf(kubeletExtraConf *api.InlineDocument) (cloudconfig.Fil
 	}, nil
 }
 
-func makeBootstrapEnv(clusterConfig *api.ClusterConfig, np api.NodePool) cloudconfig.File {
+func makeBootstrapEnv(clusterConfig *api.ClusterConfig, np api.NodePool, maxPods int) cloudconfig.File {
 	ng := np.BaseNodeGroup()
 	variables := map[string]string{
 		"CLUSTER_NAME":   clusterConfig.Metadata.Name,
@@ -176,14 +216,19 @@ func makeBootstrapEnv(clusterConfig *api.ClusterConfig, np api.NodePool) cloudco
 		"NODE_TAINTS":    utils.FormatTaints(np.NGTaints()),
 	}
 
-	if ng.MaxPodsPerNode > 0 {
-		variables["MAX_PODS"] = strconv.Itoa(ng.MaxPodsPerNode)
+	if maxPods > 0 {
+		variables["MAX_PODS"] = strconv.Itoa(maxPods)
 	}
 
 	if unmanaged, ok := np.(*api.NodeGroup); ok && unmanaged.ClusterDNS != "" {
 		variables["CLUSTER_DNS"] = unmanaged.ClusterDNS
 	}
 
+	if clusterConfig.IPv6Enabled() {
+		variables["IP_FAMILY"] = "ipv6"
+		variables["SERVICE_IPV6_CIDR"] = clusterConfig.Status.ServiceIPv6CIDR
+	}
+
 	if unmanaged, ok := np.(*api.NodeGroup); ok && ng.AMIFamily == api.NodeImageFamilyAmazonLinux2 {
 		variables["CONTAINER_RUNTIME"] = unmanaged.GetContainerRuntime()
 	}
@@ -95,6 +95,11 @@ func (m MockProvider) CloudFormationDisableRollback() bool {
 	return false
 }
 
+// CloudFormationPreviewChanges returns whether stack changes should be previewed and confirmed before they are executed
+func (m MockProvider) CloudFormationPreviewChanges() bool {
+	return false
+}
+
 // MockCloudFormation returns a mocked CloudFormation API
 func (m MockProvider) MockCloudFormation() *mocks.CloudFormationAPI {
 	return m.CloudFormation().(*mocks.CloudFormationAPI)
@@ -169,6 +174,9 @@ func (m *MockProvider) SetRegion(r string) {
 // WaitTimeout returns current timeout setting
 func (m MockProvider) WaitTimeout() time.Duration { return ProviderConfig.WaitTimeout }
 
+// ResumeCreation returns whether resources left behind by an earlier attempt to create the cluster should be reused
+func (m MockProvider) ResumeCreation() bool { return ProviderConfig.ResumeCreation }
+
 // ConfigProvider returns a representation of the ConfigProvider
 func (m MockProvider) ConfigProvider() client.ConfigProvider {
 	return m.configProvider
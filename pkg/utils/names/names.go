@@ -3,6 +3,7 @@ package names
 import (
 	"fmt"
 	"math/rand"
+	"regexp"
 	"time"
 
 	"github.com/kubicorn/kubicorn/pkg/namer"
@@ -11,9 +12,15 @@ import (
 const (
 	randNodeGroupNameLength     = 8
 	randNodeGroupNameComponents = "abcdef0123456789"
+
+	successorNodeGroupSuffixLength = 5
 )
 
-var r = rand.New(rand.NewSource(time.Now().UnixNano()))
+var (
+	r = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	successorNodeGroupSuffix = regexp.MustCompile(fmt.Sprintf("-[%s]{%d}$", randNodeGroupNameComponents, successorNodeGroupSuffixLength))
+)
 
 // ForCluster generates a name string when a and b are empty strings.
 // If either a or b are non-empty, it returns whichever is non-empty.
@@ -37,6 +44,19 @@ func ForNodeGroup(a, b string) string {
 	})
 }
 
+// ForSuccessorNodeGroup generates the name of a nodegroup replacing the given one,
+// e.g. ng-1-3f9a2 for ng-1; the suffix of a previous successor is replaced, so that
+// names don't grow when a nodegroup is replaced several times
+func ForSuccessorNodeGroup(name string) string {
+	return fmt.Sprintf("%s-%s", BaseNodeGroupName(name), RandomName(successorNodeGroupSuffixLength, randNodeGroupNameComponents))
+}
+
+// BaseNodeGroupName returns the name of a nodegroup without the suffix
+// added by ForSuccessorNodeGroup
+func BaseNodeGroupName(name string) string {
+	return successorNodeGroupSuffix.ReplaceAllString(name, "")
+}
+
 // ForFargateProfile returns the provided name if non-empty, or else generates
 // a random name matching: fp-[abcdef0123456789]{8}
 func ForFargateProfile(name string) string {
@@ -33,6 +33,23 @@ var _ = Describe("name", func() {
 		})
 	})
 
+	Describe("ForSuccessorNodeGroup", func() {
+		It("appends a random suffix to the name", func() {
+			name := names.ForSuccessorNodeGroup("ng-1")
+			Expect(name).To(MatchRegexp("^ng-1-[abcdef0123456789]{5}$"))
+		})
+
+		It("replaces the suffix of a previous successor", func() {
+			name := names.ForSuccessorNodeGroup("ng-1-3f9a2")
+			Expect(name).To(MatchRegexp("^ng-1-[abcdef0123456789]{5}$"))
+			Expect(names.BaseNodeGroupName(name)).To(Equal("ng-1"))
+		})
+
+		It("keeps names generated by ForNodeGroup", func() {
+			Expect(names.BaseNodeGroupName("ng-3f9a2b7c")).To(Equal("ng-3f9a2b7c"))
+		})
+	})
+
 	Describe("ForFargateProfile", func() {
 		It("returns the provided name if non-empty", func() {
 			name := names.ForFargateProfile("my-favourite-name")
@@ -0,0 +1,146 @@
+package tasks
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+// Action is the kind of change a task makes to its target
+type Action string
+
+const (
+	// ActionCreate means the task creates its target
+	ActionCreate = Action("create")
+	// ActionUpdate means the task updates its target
+	ActionUpdate = Action("update")
+	// ActionDelete means the task deletes its target
+	ActionDelete = Action("delete")
+)
+
+// Target describes what a task acts upon, e.g. a CloudFormation stack
+// or a Kubernetes resource
+type Target struct {
+	Action   Action
+	Resource string
+}
+
+// Targeted is implemented by tasks that can describe their target, it
+// is used to build a structured plan
+type Targeted interface {
+	Target() Target
+}
+
+// TargetedTask is a GenericTask that describes its target in a plan
+type TargetedTask struct {
+	GenericTask
+	Action   Action
+	Resource string
+}
+
+// Target returns the target of the task
+func (t *TargetedTask) Target() Target {
+	return Target{Action: t.Action, Resource: t.Resource}
+}
+
+// StackResource returns the resource name used for CloudFormation stacks in a plan
+func StackResource(stackName string) string {
+	return "stack/" + stackName
+}
+
+// PlannedTask is a single task in a plan
+type PlannedTask struct {
+	ID          string   `json:"id"`
+	Description string   `json:"description"`
+	Action      Action   `json:"action,omitempty"`
+	Resource    string   `json:"resource,omitempty"`
+	DependsOn   []string `json:"dependsOn,omitempty"`
+}
+
+// Plan is a machine-readable representation of a task tree, listing each
+// task along with the tasks it depends on
+type Plan struct {
+	Tasks []PlannedTask `json:"tasks"`
+}
+
+// Plan flattens the tree into a list of tasks, where each task depends on
+// the tasks that have to complete before it can start
+func (t *TaskTree) Plan() *Plan {
+	return NewPlan(t)
+}
+
+// NewPlan flattens the task trees into a single plan, where each tree depends on the tasks
+// that complete the tree before it
+func NewPlan(taskTrees ...*TaskTree) *Plan {
+	plan := &Plan{Tasks: []PlannedTask{}}
+	var ends []string
+	for _, taskTree := range taskTrees {
+		ends = plan.addTree(taskTree, ends)
+	}
+	return plan
+}
+
+// addTree adds all tasks of the tree to the plan and returns the IDs
+// of the tasks that complete the tree
+func (p *Plan) addTree(t *TaskTree, dependsOn []string) []string {
+	if t.Len() == 0 {
+		return dependsOn
+	}
+	if t.Parallel {
+		var ends []string
+		for _, task := range t.Tasks {
+			ends = append(ends, p.addTask(task, dependsOn)...)
+		}
+		return ends
+	}
+	ends := dependsOn
+	for _, task := range t.Tasks {
+		ends = p.addTask(task, ends)
+	}
+	return ends
+}
+
+func (p *Plan) addTask(task Task, dependsOn []string) []string {
+	if tree, ok := task.(*TaskTree); ok {
+		return p.addTree(tree, dependsOn)
+	}
+	plannedTask := PlannedTask{
+		ID:          fmt.Sprintf("task-%d", len(p.Tasks)+1),
+		Description: task.Describe(),
+		DependsOn:   dependsOn,
+	}
+	if targeted, ok := task.(Targeted); ok {
+		target := targeted.Target()
+		plannedTask.Action = target.Action
+		plannedTask.Resource = target.Resource
+	}
+	p.Tasks = append(p.Tasks, plannedTask)
+	return []string{plannedTask.ID}
+}
+
+// ValidatePlanOutput checks that the plan can be printed in the given format
+func ValidatePlanOutput(output printers.Type) error {
+	switch output {
+	case "", printers.JSONType, printers.YAMLType:
+		return nil
+	default:
+		return fmt.Errorf("unknown plan output type: expected {%q,%q} but got %q", printers.JSONType, printers.YAMLType, output)
+	}
+}
+
+// PrintPlan prints the plan of the task trees to stdout, it does nothing if output is empty; the trees
+// are planned as if they ran one after another
+func PrintPlan(output printers.Type, taskTrees ...*TaskTree) error {
+	if output == "" {
+		return nil
+	}
+	if err := ValidatePlanOutput(output); err != nil {
+		return err
+	}
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+	return printer.PrintObj(NewPlan(taskTrees...), os.Stdout)
+}
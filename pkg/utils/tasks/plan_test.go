@@ -0,0 +1,97 @@
+package tasks
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type targetedTask struct {
+	TaskWithoutParams
+	target Target
+}
+
+func (t *targetedTask) Target() Target { return t.target }
+
+var _ = Describe("Plan", func() {
+	It("should be empty for an empty tree", func() {
+		tasks := &TaskTree{Parallel: false}
+		Expect(tasks.Plan().Tasks).To(BeEmpty())
+	})
+
+	It("should list tasks along with their targets and dependencies", func() {
+		tasks := &TaskTree{Parallel: false}
+		tasks.Append(&targetedTask{
+			TaskWithoutParams: TaskWithoutParams{Info: "create cluster"},
+			target:            Target{Action: ActionCreate, Resource: StackResource("eksctl-test-cluster")},
+		})
+
+		nodeGroupTasks := &TaskTree{Parallel: true, IsSubTask: true}
+		nodeGroupTasks.Append(&TaskWithoutParams{Info: "create ng-1"})
+		nodeGroupTasks.Append(&TaskWithoutParams{Info: "create ng-2"})
+		tasks.Append(nodeGroupTasks)
+		tasks.Append(&TaskTree{Parallel: false, IsSubTask: true})
+		tasks.Append(&TaskWithoutParams{Info: "update auth configmap"})
+
+		Expect(tasks.Plan().Tasks).To(Equal([]PlannedTask{
+			{
+				ID:          "task-1",
+				Description: "create cluster",
+				Action:      ActionCreate,
+				Resource:    "stack/eksctl-test-cluster",
+			},
+			{
+				ID:          "task-2",
+				Description: "create ng-1",
+				DependsOn:   []string{"task-1"},
+			},
+			{
+				ID:          "task-3",
+				Description: "create ng-2",
+				DependsOn:   []string{"task-1"},
+			},
+			{
+				ID:          "task-4",
+				Description: "update auth configmap",
+				DependsOn:   []string{"task-2", "task-3"},
+			},
+		}))
+	})
+
+	It("should plan several trees one after another", func() {
+		clusterTasks := &TaskTree{Parallel: true}
+		clusterTasks.Append(&TaskWithoutParams{Info: "create cluster"})
+		clusterTasks.Append(&TaskWithoutParams{Info: "create nodegroup"})
+
+		addonTasks := &TaskTree{Parallel: false}
+		addonTasks.Append(&TargetedTask{
+			GenericTask: GenericTask{Description: "create addon"},
+			Action:      ActionCreate,
+			Resource:    "addon/vpc-cni",
+		})
+
+		Expect(NewPlan(clusterTasks, nil, &TaskTree{}, addonTasks).Tasks).To(Equal([]PlannedTask{
+			{
+				ID:          "task-1",
+				Description: "create cluster",
+			},
+			{
+				ID:          "task-2",
+				Description: "create nodegroup",
+			},
+			{
+				ID:          "task-3",
+				Description: "create addon",
+				Action:      ActionCreate,
+				Resource:    "addon/vpc-cni",
+				DependsOn:   []string{"task-1", "task-2"},
+			},
+		}))
+	})
+
+	It("should validate the output format", func() {
+		Expect(ValidatePlanOutput("")).To(Succeed())
+		Expect(ValidatePlanOutput("json")).To(Succeed())
+		Expect(ValidatePlanOutput("yaml")).To(Succeed())
+		Expect(ValidatePlanOutput("table")).To(MatchError(`unknown plan output type: expected {"json","yaml"} but got "table"`))
+	})
+})
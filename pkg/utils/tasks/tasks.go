@@ -41,6 +41,14 @@ func (t SynchronousTask) Do(errCh chan error) error {
 	return t.SynchronousTaskIface.Do()
 }
 
+// Target returns the target of the wrapped task, if it has one
+func (t SynchronousTask) Target() Target {
+	if targeted, ok := t.SynchronousTaskIface.(Targeted); ok {
+		return targeted.Target()
+	}
+	return Target{}
+}
+
 // TaskTree wraps a set of tasks
 type TaskTree struct {
 	Tasks     []Task
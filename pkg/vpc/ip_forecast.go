@@ -0,0 +1,224 @@
+package vpc
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap/legacy"
+)
+
+// hostNetworkPods is the number of pods of a node that use the IP address of the node, i.e. aws-node and kube-proxy
+const hostNetworkPods = 2
+
+// CNIConfig holds the settings of the VPC CNI that determine how many IP addresses a node holds
+type CNIConfig struct {
+	// WarmIPTarget is the number of free IP addresses a node keeps, 0 if unset
+	WarmIPTarget int
+	// WarmENITarget is the number of ENIs with free IP addresses a node keeps, used unless WarmIPTarget is set
+	WarmENITarget int
+	// PodSubnets maps availability zones to the pod subnets of VPC CNI custom networking, where the pods of a node
+	// take their IP addresses from the pod subnet in its zone; empty unless custom networking is enabled
+	PodSubnets map[string]string
+}
+
+// DefaultCNIConfig returns the settings of the VPC CNI when none are set
+func DefaultCNIConfig() CNIConfig {
+	return CNIConfig{
+		WarmENITarget: 1,
+	}
+}
+
+// NodeGroupIPUsage describes a nodegroup whose nodes take IP addresses from its subnets
+type NodeGroupIPUsage struct {
+	Name          string
+	InstanceTypes []string
+	// MaxPods is the max pods of the nodes, 0 if unknown
+	MaxPods int
+	MaxSize int
+	Subnets []string
+	// RunningNodes maps the subnets of the nodegroup to the number of nodes running in them
+	RunningNodes map[string]int
+}
+
+// FargateProfileIPUsage describes a Fargate profile whose pods take IP addresses from its subnets
+type FargateProfileIPUsage struct {
+	Name    string
+	Subnets []string
+	// RunningPods maps the subnets of the profile to the number of pods running in them
+	RunningPods map[string]int
+}
+
+// NodeGroupIPForecast is the number of IP addresses a nodegroup needs in a subnet to scale to its max size
+type NodeGroupIPForecast struct {
+	Name        string
+	Nodes       int
+	PerNode     int
+	RequiredIPs int
+}
+
+// FargateProfileIPForecast is the number of IP addresses the running pods of a Fargate profile hold in a subnet,
+// which are already taken from its free IP addresses
+type FargateProfileIPForecast struct {
+	Name        string
+	RunningPods int
+}
+
+// SubnetIPForecast is the number of IP addresses a subnet needs for all its nodegroups to scale to their max size
+type SubnetIPForecast struct {
+	SubnetID         string
+	AvailabilityZone string
+	CIDR             string
+	FreeIPs          int
+	// RequiredIPs is the number of IP addresses needed by the nodes that are not running yet
+	RequiredIPs     int
+	NodeGroups      []NodeGroupIPForecast
+	FargateProfiles []FargateProfileIPForecast
+}
+
+// Fits reports whether the subnet has enough free IP addresses for all its nodegroups to scale to their max size
+func (f SubnetIPForecast) Fits() bool {
+	return f.RequiredIPs <= f.FreeIPs
+}
+
+// ForecastSubnetIPs forecasts the IP addresses the subnets need when every nodegroup scales to its max size;
+// the nodes of a nodegroup are assumed to be spread evenly across its subnets, and every node to hold as many
+// IP addresses as the VPC CNI allocates when the node runs its max pods. With custom networking, a node only holds
+// its own IP address in its subnet, and the IP addresses of its pods in the pod subnet of its zone.
+// Each Fargate pod takes an IP address of the subnets of its profile; as the number of Fargate pods is not bounded,
+// no IP addresses are forecast for them, the IP addresses of the running pods are only reported
+func ForecastSubnetIPs(subnets []*ec2.Subnet, nodeGroups []NodeGroupIPUsage, fargateProfiles []FargateProfileIPUsage, cni CNIConfig) []SubnetIPForecast {
+	forecasts := map[string]*SubnetIPForecast{}
+	var subnetIDs []string
+	for _, subnet := range subnets {
+		id := aws.StringValue(subnet.SubnetId)
+		forecasts[id] = &SubnetIPForecast{
+			SubnetID:         id,
+			AvailabilityZone: aws.StringValue(subnet.AvailabilityZone),
+			CIDR:             aws.StringValue(subnet.CidrBlock),
+			FreeIPs:          int(aws.Int64Value(subnet.AvailableIpAddressCount)),
+		}
+		subnetIDs = append(subnetIDs, id)
+	}
+	sort.Strings(subnetIDs)
+
+	addNodes := func(forecast *SubnetIPForecast, name string, nodes, perNode int) {
+		forecast.RequiredIPs += nodes * perNode
+		for i, ng := range forecast.NodeGroups {
+			if ng.Name == name {
+				forecast.NodeGroups[i].Nodes += nodes
+				forecast.NodeGroups[i].RequiredIPs += nodes * perNode
+				return
+			}
+		}
+		forecast.NodeGroups = append(forecast.NodeGroups, NodeGroupIPForecast{
+			Name:        name,
+			Nodes:       nodes,
+			PerNode:     perNode,
+			RequiredIPs: nodes * perNode,
+		})
+	}
+
+	for _, ng := range nodeGroups {
+		if len(ng.Subnets) == 0 {
+			continue
+		}
+		perNode, podsPerNode, ok := maxIPAddressesPerNode(ng.InstanceTypes, ng.MaxPods, cni)
+		if !ok {
+			logger.Warning("cannot forecast the IP addresses of nodegroup %q, as the ENI limits of instance types %s are unknown",
+				ng.Name, strings.Join(ng.InstanceTypes, ", "))
+			continue
+		}
+		nodesPerSubnet := (ng.MaxSize + len(ng.Subnets) - 1) / len(ng.Subnets)
+		for _, id := range ng.Subnets {
+			forecast, ok := forecasts[id]
+			if !ok {
+				continue
+			}
+			nodes := nodesPerSubnet - ng.RunningNodes[id]
+			if nodes < 0 {
+				nodes = 0
+			}
+			podSubnet, hasPodSubnet := forecasts[cni.PodSubnets[forecast.AvailabilityZone]]
+			if !hasPodSubnet {
+				addNodes(forecast, ng.Name, nodes, perNode)
+				continue
+			}
+			// the primary ENI of a node isn't used for pods, it only holds the IP address of the node
+			addNodes(forecast, ng.Name, nodes, 1)
+			addNodes(podSubnet, ng.Name, nodes, podsPerNode)
+		}
+	}
+
+	for _, profile := range fargateProfiles {
+		for _, id := range profile.Subnets {
+			if forecast, ok := forecasts[id]; ok {
+				forecast.FargateProfiles = append(forecast.FargateProfiles, FargateProfileIPForecast{
+					Name:        profile.Name,
+					RunningPods: profile.RunningPods[id],
+				})
+			}
+		}
+	}
+
+	var result []SubnetIPForecast
+	for _, id := range subnetIDs {
+		result = append(result, *forecasts[id])
+	}
+	return result
+}
+
+// maxIPAddressesPerNode returns the highest number of IP addresses a node of any of instanceTypes holds when it
+// runs maxPods pods, or as many pods as its ENIs allow if maxPods is 0, along with the highest number of IP addresses
+// its pods hold with custom networking, where the primary ENI of a node is not used for pods
+func maxIPAddressesPerNode(instanceTypes []string, maxPods int, cni CNIConfig) (int, int, bool) {
+	highest, highestPods := 0, 0
+	for _, instanceType := range instanceTypes {
+		limits, ok := legacy.ENILimitsPerNodeType(instanceType)
+		if !ok {
+			return 0, 0, false
+		}
+		highest = maxInt(highest, ipAddressesPerNode(limits, maxPods, cni))
+		limits.ENIs--
+		highestPods = maxInt(highestPods, ipAddressesPerNode(limits, maxPods, cni))
+	}
+	return highest, highestPods, highest > 0
+}
+
+// ipAddressesPerNode returns the number of IP addresses a node holds when it runs maxPods pods; every ENI of a node
+// has a primary IP address, and secondary IP addresses for pods. With WARM_IP_TARGET the node keeps that many free
+// secondary IP addresses, otherwise it keeps WARM_ENI_TARGET ENIs with all their IP addresses free
+func ipAddressesPerNode(limits legacy.ENILimits, maxPods int, cni CNIConfig) int {
+	secondaryPerENI := limits.IPv4AddressesPerENI - 1
+	capacity := limits.ENIs * secondaryPerENI
+
+	podIPs := capacity
+	if maxPods > 0 {
+		podIPs = minInt(maxInt(maxPods-hostNetworkPods, 0), capacity)
+	}
+
+	if cni.WarmIPTarget > 0 {
+		secondary := minInt(podIPs+cni.WarmIPTarget, capacity)
+		enis := maxInt((secondary+secondaryPerENI-1)/secondaryPerENI, 1)
+		return secondary + enis
+	}
+	enis := minInt((podIPs+secondaryPerENI-1)/secondaryPerENI+cni.WarmENITarget, limits.ENIs)
+	return maxInt(enis, 1) * limits.IPv4AddressesPerENI
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,99 @@
+package vpc
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap/legacy"
+)
+
+var _ = Describe("ForecastSubnetIPs", func() {
+	// m5.large has 3 ENIs with 10 IPv4 addresses each
+	m5Large := legacy.ENILimits{ENIs: 3, IPv4AddressesPerENI: 10}
+
+	DescribeTable("IP addresses per node", func(maxPods int, cni CNIConfig, expected int) {
+		Expect(ipAddressesPerNode(m5Large, maxPods, cni)).To(Equal(expected))
+	},
+		Entry("all ENIs at max pods", 29, DefaultCNIConfig(), 30),
+		Entry("all ENIs when max pods is unknown", 0, DefaultCNIConfig(), 30),
+		Entry("a warm ENI on top of the ENIs for max pods", 8, DefaultCNIConfig(), 20),
+		Entry("no warm ENI", 8, CNIConfig{}, 10),
+		Entry("warm IP addresses on top of max pods", 12, CNIConfig{WarmIPTarget: 5}, 17),
+		Entry("warm IP addresses up to the ENI limits", 29, CNIConfig{WarmIPTarget: 5}, 30),
+	)
+
+	It("forecasts the IP addresses each subnet needs for its nodegroups to scale to their max size", func() {
+		subnets := []*ec2.Subnet{
+			{SubnetId: aws.String("subnet-b"), AvailabilityZone: aws.String("us-west-2b"), CidrBlock: aws.String("192.168.32.0/19"), AvailableIpAddressCount: aws.Int64(40)},
+			{SubnetId: aws.String("subnet-a"), AvailabilityZone: aws.String("us-west-2a"), CidrBlock: aws.String("192.168.0.0/19"), AvailableIpAddressCount: aws.Int64(100)},
+		}
+		nodeGroups := []NodeGroupIPUsage{
+			{
+				Name:          "ng-1",
+				InstanceTypes: []string{"m5.large"},
+				MaxSize:       4,
+				Subnets:       []string{"subnet-a", "subnet-b"},
+				RunningNodes:  map[string]int{"subnet-a": 1},
+			},
+			{
+				Name:          "ng-unknown",
+				InstanceTypes: []string{"x9.unknown"},
+				MaxSize:       100,
+				Subnets:       []string{"subnet-a"},
+			},
+		}
+		profiles := []FargateProfileIPUsage{{Name: "fp-1", Subnets: []string{"subnet-b"}, RunningPods: map[string]int{"subnet-b": 3}}}
+
+		forecasts := ForecastSubnetIPs(subnets, nodeGroups, profiles, DefaultCNIConfig())
+		Expect(forecasts).To(HaveLen(2))
+
+		Expect(forecasts[0].SubnetID).To(Equal("subnet-a"))
+		Expect(forecasts[0].NodeGroups).To(Equal([]NodeGroupIPForecast{{Name: "ng-1", Nodes: 1, PerNode: 30, RequiredIPs: 30}}))
+		Expect(forecasts[0].Fits()).To(BeTrue())
+
+		Expect(forecasts[1].SubnetID).To(Equal("subnet-b"))
+		// the running Fargate pods already hold 3 of the IP addresses that are not free
+		Expect(forecasts[1].RequiredIPs).To(Equal(60))
+		Expect(forecasts[1].FargateProfiles).To(ConsistOf(FargateProfileIPForecast{Name: "fp-1", RunningPods: 3}))
+		Expect(forecasts[1].Fits()).To(BeFalse())
+	})
+
+	It("charges the pods of the nodes to the pod subnet in their zone with custom networking", func() {
+		subnets := []*ec2.Subnet{
+			{SubnetId: aws.String("subnet-a"), AvailabilityZone: aws.String("us-west-2a"), AvailableIpAddressCount: aws.Int64(100)},
+			{SubnetId: aws.String("subnet-b"), AvailabilityZone: aws.String("us-west-2b"), AvailableIpAddressCount: aws.Int64(100)},
+			{SubnetId: aws.String("subnet-pod-a"), AvailabilityZone: aws.String("us-west-2a"), AvailableIpAddressCount: aws.Int64(1000)},
+		}
+		nodeGroups := []NodeGroupIPUsage{{
+			Name:          "ng-1",
+			InstanceTypes: []string{"m5.large"},
+			MaxSize:       4,
+			Subnets:       []string{"subnet-a", "subnet-b"},
+		}}
+		cni := DefaultCNIConfig()
+		cni.PodSubnets = map[string]string{"us-west-2a": "subnet-pod-a"}
+
+		forecasts := ForecastSubnetIPs(subnets, nodeGroups, nil, cni)
+		Expect(forecasts).To(HaveLen(3))
+
+		// the node subnet only holds the IP address of the primary ENI
+		Expect(forecasts[0].SubnetID).To(Equal("subnet-a"))
+		Expect(forecasts[0].NodeGroups).To(Equal([]NodeGroupIPForecast{{Name: "ng-1", Nodes: 2, PerNode: 1, RequiredIPs: 2}}))
+		// without a pod subnet in its zone, the subnet holds all IP addresses of the nodes
+		Expect(forecasts[1].SubnetID).To(Equal("subnet-b"))
+		Expect(forecasts[1].NodeGroups).To(Equal([]NodeGroupIPForecast{{Name: "ng-1", Nodes: 2, PerNode: 30, RequiredIPs: 60}}))
+		// the two secondary ENIs of m5.large hold the IP addresses of pods
+		Expect(forecasts[2].SubnetID).To(Equal("subnet-pod-a"))
+		Expect(forecasts[2].NodeGroups).To(Equal([]NodeGroupIPForecast{{Name: "ng-1", Nodes: 2, PerNode: 20, RequiredIPs: 40}}))
+	})
+
+	It("uses the instance type that holds the most IP addresses", func() {
+		perNode, podsPerNode, ok := maxIPAddressesPerNode([]string{"t3.medium", "m5.large"}, 0, DefaultCNIConfig())
+		Expect(ok).To(BeTrue())
+		Expect(perNode).To(Equal(30))
+		Expect(podsPerNode).To(Equal(20))
+	})
+})
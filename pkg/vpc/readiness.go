@@ -0,0 +1,377 @@
+package vpc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/az"
+)
+
+// ReadinessStatus is the outcome of a VPC readiness check
+type ReadinessStatus string
+
+// Values for `ReadinessStatus`
+const (
+	ReadinessPass ReadinessStatus = "PASS"
+	ReadinessWarn ReadinessStatus = "WARN"
+	ReadinessFail ReadinessStatus = "FAIL"
+)
+
+const (
+	elbRoleTag         = "kubernetes.io/role/elb"
+	internalELBRoleTag = "kubernetes.io/role/internal-elb"
+	defaultRoute       = "0.0.0.0/0"
+)
+
+// ReadinessCheck is the result of checking whether a VPC or one of its subnets is ready for a cluster
+type ReadinessCheck struct {
+	Resource string
+	Check    string
+	Status   ReadinessStatus
+	Message  string
+}
+
+// CheckReadiness checks whether the existing VPC and subnets of spec are ready for a cluster, i.e. that
+// DNS is enabled in the VPC, that subnets are routed through an internet gateway or a NAT as their topology
+// requires and are tagged for load balancers, that the subnets are in the availability zones chosen in
+// availabilityZones, if any, and that they have enough free IP addresses for the nodegroups of spec; the subnets
+// of spec must have been imported, so that they all have an ID
+func CheckReadiness(ec2API ec2iface.EC2API, spec *api.ClusterConfig, availabilityZones []string) ([]ReadinessCheck, error) {
+	var checks []ReadinessCheck
+
+	dnsChecks, err := checkVPCDNS(ec2API, spec.VPC.ID)
+	if err != nil {
+		return nil, err
+	}
+	checks = append(checks, dnsChecks...)
+
+	subnetIDs := sets.NewString(append(spec.VPC.Subnets.Public.WithIDs(), spec.VPC.Subnets.Private.WithIDs()...)...)
+	subnets, err := describeSubnets(ec2API, spec.VPC.ID, subnetIDs.List(), []string{}, []string{})
+	if err != nil {
+		return nil, errors.Wrap(err, "describing subnets")
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return *subnets[i].SubnetId < *subnets[j].SubnetId
+	})
+	public := sets.NewString(spec.VPC.Subnets.Public.WithIDs()...)
+
+	routeTables, err := describeRouteTables(ec2API, spec.VPC.ID)
+	if err != nil {
+		return nil, err
+	}
+	privateCluster := spec.PrivateCluster != nil && spec.PrivateCluster.Enabled
+	for _, subnet := range subnets {
+		topology := api.SubnetTopologyPrivate
+		if public.Has(*subnet.SubnetId) {
+			topology = api.SubnetTopologyPublic
+		}
+		checks = append(checks, checkSubnetRoutes(subnet, topology, routeTables, privateCluster))
+		checks = append(checks, checkSubnetRoleTag(subnet, topology))
+	}
+
+	checks = append(checks, checkAvailabilityZones(subnets, availabilityZones)...)
+	checks = append(checks, checkFreeIPAddresses(spec, subnets)...)
+	return checks, nil
+}
+
+func checkVPCDNS(ec2API ec2iface.EC2API, vpcID string) ([]ReadinessCheck, error) {
+	var checks []ReadinessCheck
+	for _, attribute := range []string{ec2.VpcAttributeNameEnableDnsSupport, ec2.VpcAttributeNameEnableDnsHostnames} {
+		output, err := ec2API.DescribeVpcAttribute(&ec2.DescribeVpcAttributeInput{
+			VpcId:     aws.String(vpcID),
+			Attribute: aws.String(attribute),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing attribute %s of VPC %q", attribute, vpcID)
+		}
+
+		var enabled *ec2.AttributeBooleanValue
+		if attribute == ec2.VpcAttributeNameEnableDnsSupport {
+			enabled = output.EnableDnsSupport
+		} else {
+			enabled = output.EnableDnsHostnames
+		}
+		check := ReadinessCheck{
+			Resource: vpcID,
+			Check:    attribute,
+			Status:   ReadinessPass,
+			Message:  fmt.Sprintf("%s is enabled", attribute),
+		}
+		if enabled == nil || !aws.BoolValue(enabled.Value) {
+			check.Status = ReadinessFail
+			check.Message = fmt.Sprintf("%s must be enabled for nodes to join the cluster", attribute)
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+func describeRouteTables(ec2API ec2iface.EC2API, vpcID string) ([]*ec2.RouteTable, error) {
+	var routeTables []*ec2.RouteTable
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{vpcID}),
+			},
+		},
+	}
+	err := ec2API.DescribeRouteTablesPages(input, func(output *ec2.DescribeRouteTablesOutput, _ bool) bool {
+		routeTables = append(routeTables, output.RouteTables...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing route tables of VPC %q", vpcID)
+	}
+	return routeTables, nil
+}
+
+// subnetRouteTable returns the route table associated with a subnet, or the main route table of the VPC
+func subnetRouteTable(subnetID string, routeTables []*ec2.RouteTable) *ec2.RouteTable {
+	var main *ec2.RouteTable
+	for _, rt := range routeTables {
+		for _, association := range rt.Associations {
+			if aws.StringValue(association.SubnetId) == subnetID {
+				return rt
+			}
+			if aws.BoolValue(association.Main) {
+				main = rt
+			}
+		}
+	}
+	return main
+}
+
+func checkSubnetRoutes(subnet *ec2.Subnet, topology api.SubnetTopology, routeTables []*ec2.RouteTable, privateCluster bool) ReadinessCheck {
+	check := ReadinessCheck{
+		Resource: *subnet.SubnetId,
+		Check:    "routes",
+	}
+	fail := func(format string, args ...interface{}) ReadinessCheck {
+		check.Status = ReadinessFail
+		check.Message = fmt.Sprintf(format, args...)
+		return check
+	}
+
+	rt := subnetRouteTable(*subnet.SubnetId, routeTables)
+	if rt == nil {
+		return fail("no route table is associated with the %s subnet", strings.ToLower(string(topology)))
+	}
+	var route *ec2.Route
+	for _, r := range rt.Routes {
+		if aws.StringValue(r.DestinationCidrBlock) == defaultRoute && aws.StringValue(r.State) != ec2.RouteStateBlackhole {
+			route = r
+		}
+	}
+	throughIGW := route != nil && strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-")
+
+	check.Status = ReadinessPass
+	switch {
+	case topology == api.SubnetTopologyPublic && throughIGW:
+		check.Message = fmt.Sprintf("routes %s through internet gateway %s", defaultRoute, *route.GatewayId)
+	case topology == api.SubnetTopologyPublic:
+		return fail("public subnets must route %s through an internet gateway, route table %s does not", defaultRoute, *rt.RouteTableId)
+	case throughIGW:
+		return fail("private subnets must not route %s through internet gateway %s, use a NAT gateway", defaultRoute, *route.GatewayId)
+	case privateCluster:
+		check.Message = "fully-private cluster, nodes reach AWS services through VPC endpoints"
+	case route != nil && route.NatGatewayId != nil:
+		check.Message = fmt.Sprintf("routes %s through NAT gateway %s", defaultRoute, *route.NatGatewayId)
+	case route != nil && route.InstanceId != nil:
+		check.Message = fmt.Sprintf("routes %s through NAT instance %s", defaultRoute, *route.InstanceId)
+	case route != nil:
+		check.Status = ReadinessWarn
+		check.Message = fmt.Sprintf("routes %s through neither a NAT gateway nor a NAT instance, make sure nodes can reach the internet", defaultRoute)
+	default:
+		return fail("private subnets must route %s through a NAT, route table %s does not", defaultRoute, *rt.RouteTableId)
+	}
+	return check
+}
+
+func checkSubnetRoleTag(subnet *ec2.Subnet, topology api.SubnetTopology) ReadinessCheck {
+	tag, loadBalancers := internalELBRoleTag, "internal"
+	if topology == api.SubnetTopologyPublic {
+		tag, loadBalancers = elbRoleTag, "internet-facing"
+	}
+	check := ReadinessCheck{
+		Resource: *subnet.SubnetId,
+		Check:    "tags",
+		Status:   ReadinessPass,
+		Message:  fmt.Sprintf("tagged with %s", tag),
+	}
+	for _, t := range subnet.Tags {
+		if aws.StringValue(t.Key) == tag {
+			return check
+		}
+	}
+	check.Status = ReadinessWarn
+	check.Message = fmt.Sprintf("not tagged with %s, %s load balancers will not be placed in this subnet", tag, loadBalancers)
+	return check
+}
+
+func checkAvailabilityZones(subnets []*ec2.Subnet, availabilityZones []string) []ReadinessCheck {
+	var checks []ReadinessCheck
+	subnetZones := sets.NewString()
+	for _, subnet := range subnets {
+		subnetZones.Insert(*subnet.AvailabilityZone)
+	}
+
+	check := ReadinessCheck{
+		Resource: "subnets",
+		Check:    "availability zones",
+		Status:   ReadinessPass,
+		Message:  fmt.Sprintf("subnets are in %s", strings.Join(subnetZones.List(), ", ")),
+	}
+	if subnetZones.Len() < az.MinRequiredAvailabilityZones {
+		check.Status = ReadinessFail
+		check.Message = fmt.Sprintf("subnets must be in at least %d availability zones, they are only in %s",
+			az.MinRequiredAvailabilityZones, strings.Join(subnetZones.List(), ", "))
+	}
+	checks = append(checks, check)
+
+	if len(availabilityZones) == 0 {
+		return checks
+	}
+	zones := sets.NewString(availabilityZones...)
+	for _, subnet := range subnets {
+		if !zones.Has(*subnet.AvailabilityZone) {
+			checks = append(checks, ReadinessCheck{
+				Resource: *subnet.SubnetId,
+				Check:    "availability zones",
+				Status:   ReadinessFail,
+				Message: fmt.Sprintf("subnet is in %s, which is not one of availabilityZones (%s)",
+					*subnet.AvailabilityZone, strings.Join(availabilityZones, ", ")),
+			})
+		}
+	}
+	for _, az := range zones.List() {
+		if !subnetZones.Has(az) {
+			checks = append(checks, ReadinessCheck{
+				Resource: az,
+				Check:    "availability zones",
+				Status:   ReadinessFail,
+				Message:  fmt.Sprintf("%s is one of availabilityZones, but has no subnet", az),
+			})
+		}
+	}
+	return checks
+}
+
+// checkFreeIPAddresses checks that every subnet has enough free IP addresses for the nodegroups that use it
+// at their maximum size, as forecast by ForecastSubnetIPs with the default VPC CNI settings
+func checkFreeIPAddresses(spec *api.ClusterConfig, subnets []*ec2.Subnet) []ReadinessCheck {
+	var checks []ReadinessCheck
+	cni := DefaultCNIConfig()
+
+	var usages []NodeGroupIPUsage
+	addNodeGroup := func(ng *api.NodeGroupBase, instanceTypes []string) {
+		ngSubnets := nodeGroupSubnetIDs(spec, ng)
+		if len(ngSubnets) == 0 {
+			return
+		}
+		var types []string
+		for _, instanceType := range instanceTypes {
+			if instanceType == "" {
+				instanceType = api.DefaultNodeType
+			}
+			types = append(types, instanceType)
+		}
+		if _, _, ok := maxIPAddressesPerNode(types, ng.MaxPodsPerNode, cni); !ok {
+			checks = append(checks, ReadinessCheck{
+				Resource: ng.Name,
+				Check:    "free IP addresses",
+				Status:   ReadinessWarn,
+				Message:  fmt.Sprintf("ENI limits of instance types %s are unknown, the free IP addresses of its subnets are not checked", strings.Join(types, ", ")),
+			})
+			return
+		}
+		usages = append(usages, NodeGroupIPUsage{
+			Name:          ng.Name,
+			InstanceTypes: types,
+			MaxPods:       ng.MaxPodsPerNode,
+			MaxSize:       nodeGroupMaxSize(ng),
+			Subnets:       ngSubnets,
+		})
+	}
+	for _, ng := range spec.NodeGroups {
+		addNodeGroup(ng.NodeGroupBase, ng.InstanceTypeList())
+	}
+	for _, ng := range spec.ManagedNodeGroups {
+		addNodeGroup(ng.NodeGroupBase, ng.InstanceTypeList())
+	}
+
+	for _, forecast := range ForecastSubnetIPs(subnets, usages, nil, cni) {
+		if len(forecast.NodeGroups) == 0 {
+			continue
+		}
+		var nodeGroups []string
+		for _, ng := range forecast.NodeGroups {
+			nodeGroups = append(nodeGroups, ng.Name)
+		}
+		check := ReadinessCheck{
+			Resource: forecast.SubnetID,
+			Check:    "free IP addresses",
+			Status:   ReadinessPass,
+			Message:  fmt.Sprintf("%d free IP addresses, nodegroups %s need up to %d", forecast.FreeIPs, strings.Join(nodeGroups, ", "), forecast.RequiredIPs),
+		}
+		if !forecast.Fits() {
+			check.Status = ReadinessFail
+			check.Message = fmt.Sprintf("only %d free IP addresses, nodegroups %s need up to %d at their maximum size", forecast.FreeIPs, strings.Join(nodeGroups, ", "), forecast.RequiredIPs)
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// nodeGroupSubnetIDs returns the IDs of the subnets of a nodegroup, which are either its subnets, given by
+// name or ID, or the private or public subnets in its availability zones
+func nodeGroupSubnetIDs(spec *api.ClusterConfig, ng *api.NodeGroupBase) []string {
+	var ids []string
+	if len(ng.Subnets) > 0 {
+		for _, s := range ng.Subnets {
+			ids = append(ids, subnetIDByNameOrID(spec.VPC.Subnets, s))
+		}
+		return ids
+	}
+
+	subnets := spec.VPC.Subnets.Public
+	if ng.PrivateNetworking {
+		subnets = spec.VPC.Subnets.Private
+	}
+	zones := sets.NewString(ng.AvailabilityZones...)
+	for _, name := range subnets.Names() {
+		subnet := subnets[name]
+		if subnet.ID != "" && (zones.Len() == 0 || zones.Has(subnet.AZ)) {
+			ids = append(ids, subnet.ID)
+		}
+	}
+	return ids
+}
+
+func subnetIDByNameOrID(subnets *api.ClusterSubnets, nameOrID string) string {
+	for _, mapping := range []api.AZSubnetMapping{subnets.Private, subnets.Public} {
+		if subnet, ok := mapping[nameOrID]; ok && subnet.ID != "" {
+			return subnet.ID
+		}
+	}
+	return nameOrID
+}
+
+// nodeGroupMaxSize returns the maximum size of a nodegroup, falling back to its desired capacity, its minimum
+// size and the default node count
+func nodeGroupMaxSize(ng *api.NodeGroupBase) int {
+	for _, size := range []*int{ng.MaxSize, ng.DesiredCapacity, ng.MinSize} {
+		if size != nil {
+			return *size
+		}
+	}
+	return api.DefaultNodeCount
+}
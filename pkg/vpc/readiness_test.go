@@ -0,0 +1,207 @@
+package vpc
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("CheckReadiness", func() {
+	var (
+		p           *mockprovider.MockProvider
+		cfg         *api.ClusterConfig
+		subnets     []*ec2.Subnet
+		routeTables []*ec2.RouteTable
+		dnsHostname bool
+	)
+
+	newSubnet := func(id, az string, free int64, tags ...string) *ec2.Subnet {
+		subnet := &ec2.Subnet{
+			SubnetId:                aws.String(id),
+			AvailabilityZone:        aws.String(az),
+			VpcId:                   aws.String("vpc-1"),
+			AvailableIpAddressCount: aws.Int64(free),
+		}
+		for _, t := range tags {
+			subnet.Tags = append(subnet.Tags, &ec2.Tag{Key: aws.String(t), Value: aws.String("1")})
+		}
+		return subnet
+	}
+
+	routeTable := func(id string, route *ec2.Route, subnetIDs ...string) *ec2.RouteTable {
+		rt := &ec2.RouteTable{
+			RouteTableId: aws.String(id),
+			Routes:       []*ec2.Route{{DestinationCidrBlock: aws.String("192.168.0.0/16"), GatewayId: aws.String("local")}},
+		}
+		if route != nil {
+			route.DestinationCidrBlock = aws.String("0.0.0.0/0")
+			rt.Routes = append(rt.Routes, route)
+		}
+		for _, id := range subnetIDs {
+			rt.Associations = append(rt.Associations, &ec2.RouteTableAssociation{SubnetId: aws.String(id)})
+		}
+		return rt
+	}
+
+	find := func(checks []ReadinessCheck, resource, check string) ReadinessCheck {
+		for _, c := range checks {
+			if c.Resource == resource && c.Check == check {
+				return c
+			}
+		}
+		Fail("no check " + check + " for " + resource)
+		return ReadinessCheck{}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		dnsHostname = true
+
+		cfg = api.NewClusterConfig()
+		cfg.VPC.ID = "vpc-1"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Public: api.AZSubnetMapping{
+				"us-west-2a": {ID: "subnet-public-a", AZ: "us-west-2a"},
+				"us-west-2b": {ID: "subnet-public-b", AZ: "us-west-2b"},
+			},
+			Private: api.AZSubnetMapping{
+				"us-west-2a": {ID: "subnet-private-a", AZ: "us-west-2a"},
+				"us-west-2b": {ID: "subnet-private-b", AZ: "us-west-2b"},
+			},
+		}
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.large"
+		ng.PrivateNetworking = true
+		ng.MaxSize = aws.Int(10)
+
+		subnets = []*ec2.Subnet{
+			newSubnet("subnet-public-a", "us-west-2a", 1000, "kubernetes.io/role/elb"),
+			newSubnet("subnet-public-b", "us-west-2b", 1000, "kubernetes.io/role/elb"),
+			newSubnet("subnet-private-a", "us-west-2a", 1000, "kubernetes.io/role/internal-elb"),
+			newSubnet("subnet-private-b", "us-west-2b", 1000, "kubernetes.io/role/internal-elb"),
+		}
+		routeTables = []*ec2.RouteTable{
+			routeTable("rtb-public", &ec2.Route{GatewayId: aws.String("igw-1")}, "subnet-public-a", "subnet-public-b"),
+			routeTable("rtb-private", &ec2.Route{NatGatewayId: aws.String("nat-1")}, "subnet-private-a", "subnet-private-b"),
+		}
+	})
+
+	JustBeforeEach(func() {
+		p.MockEC2().On("DescribeVpcAttribute", mock.MatchedBy(func(input *ec2.DescribeVpcAttributeInput) bool {
+			return *input.Attribute == ec2.VpcAttributeNameEnableDnsSupport
+		})).Return(&ec2.DescribeVpcAttributeOutput{
+			EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+		}, nil)
+		p.MockEC2().On("DescribeVpcAttribute", mock.MatchedBy(func(input *ec2.DescribeVpcAttributeInput) bool {
+			return *input.Attribute == ec2.VpcAttributeNameEnableDnsHostnames
+		})).Return(&ec2.DescribeVpcAttributeOutput{
+			EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(dnsHostname)},
+		}, nil)
+		p.MockEC2().On("DescribeSubnets", mock.Anything).Return(&ec2.DescribeSubnetsOutput{Subnets: subnets}, nil)
+		p.MockEC2().On("DescribeRouteTablesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeRouteTablesOutput, bool) bool)
+			consume(&ec2.DescribeRouteTablesOutput{RouteTables: routeTables}, true)
+		}).Return(nil)
+	})
+
+	It("passes all checks of a VPC that is ready", func() {
+		checks, err := CheckReadiness(p.EC2(), cfg, []string{"us-west-2a", "us-west-2b"})
+		Expect(err).NotTo(HaveOccurred())
+		for _, c := range checks {
+			Expect(c.Status).To(Equal(ReadinessPass), "%s %s: %s", c.Resource, c.Check, c.Message)
+		}
+		// 5 nodes of m5.large, holding the 30 IP addresses of their 3 ENIs, in each private subnet
+		Expect(find(checks, "subnet-private-a", "free IP addresses").Message).To(ContainSubstring("need up to 150"))
+	})
+
+	Context("DNS hostnames are disabled", func() {
+		BeforeEach(func() {
+			dnsHostname = false
+		})
+
+		It("fails", func() {
+			checks, err := CheckReadiness(p.EC2(), cfg, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(find(checks, "vpc-1", "enableDnsHostnames").Status).To(Equal(ReadinessFail))
+			Expect(find(checks, "vpc-1", "enableDnsSupport").Status).To(Equal(ReadinessPass))
+		})
+	})
+
+	Context("routes are missing", func() {
+		BeforeEach(func() {
+			routeTables = []*ec2.RouteTable{
+				routeTable("rtb-public", nil, "subnet-public-a", "subnet-public-b"),
+				routeTable("rtb-private", &ec2.Route{GatewayId: aws.String("igw-1")}, "subnet-private-a"),
+				routeTable("rtb-other", &ec2.Route{TransitGatewayId: aws.String("tgw-1")}, "subnet-private-b"),
+			}
+		})
+
+		It("fails public subnets without an internet gateway and private subnets without a NAT", func() {
+			checks, err := CheckReadiness(p.EC2(), cfg, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(find(checks, "subnet-public-a", "routes").Status).To(Equal(ReadinessFail))
+			Expect(find(checks, "subnet-private-a", "routes").Status).To(Equal(ReadinessFail))
+			Expect(find(checks, "subnet-private-b", "routes").Status).To(Equal(ReadinessWarn))
+		})
+	})
+
+	Context("subnets use the main route table", func() {
+		BeforeEach(func() {
+			main := routeTable("rtb-main", &ec2.Route{NatGatewayId: aws.String("nat-1")})
+			main.Associations = []*ec2.RouteTableAssociation{{Main: aws.Bool(true)}}
+			routeTables = []*ec2.RouteTable{
+				routeTable("rtb-public", &ec2.Route{GatewayId: aws.String("igw-1")}, "subnet-public-a", "subnet-public-b"),
+				main,
+			}
+		})
+
+		It("checks the routes of the main route table", func() {
+			checks, err := CheckReadiness(p.EC2(), cfg, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(find(checks, "subnet-private-a", "routes").Message).To(ContainSubstring("nat-1"))
+		})
+	})
+
+	Context("subnets are not tagged for load balancers", func() {
+		BeforeEach(func() {
+			subnets[0].Tags = nil
+		})
+
+		It("warns", func() {
+			checks, err := CheckReadiness(p.EC2(), cfg, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(find(checks, "subnet-public-a", "tags").Status).To(Equal(ReadinessWarn))
+		})
+	})
+
+	Context("subnets are not in the chosen availability zones", func() {
+		It("fails", func() {
+			checks, err := CheckReadiness(p.EC2(), cfg, []string{"us-west-2a", "us-west-2c"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(find(checks, "subnet-private-b", "availability zones").Status).To(Equal(ReadinessFail))
+			Expect(find(checks, "us-west-2c", "availability zones").Status).To(Equal(ReadinessFail))
+		})
+	})
+
+	Context("a subnet does not have enough free IP addresses", func() {
+		BeforeEach(func() {
+			subnets[3].AvailableIpAddressCount = aws.Int64(100)
+		})
+
+		It("fails", func() {
+			checks, err := CheckReadiness(p.EC2(), cfg, nil)
+			Expect(err).NotTo(HaveOccurred())
+			check := find(checks, "subnet-private-b", "free IP addresses")
+			Expect(check.Status).To(Equal(ReadinessFail))
+			Expect(check.Message).To(Equal("only 100 free IP addresses, nodegroups ng-1 need up to 150 at their maximum size"))
+			Expect(find(checks, "subnet-private-a", "free IP addresses").Status).To(Equal(ReadinessPass))
+		})
+	})
+
+})
@@ -76,6 +76,89 @@ func SetSubnets(vpc *api.ClusterVPC, availabilityZones []string) error {
 	return nil
 }
 
+// UseSubnetsForNewVPC uses the subnets set in the spec for the VPC eksctl creates, it checks
+// that they are within the VPC CIDR, and returns the availability zones of the subnets
+func UseSubnetsForNewVPC(vpc *api.ClusterVPC) ([]string, error) {
+	if vpc.CIDR == nil {
+		cidr := api.DefaultCIDR()
+		vpc.CIDR = &cidr
+	}
+	vpcPrefix, _ := vpc.CIDR.Mask.Size()
+
+	availabilityZones := sets.NewString()
+	for _, topology := range api.SubnetTopologies() {
+		subnets := vpc.Subnets.Public
+		if topology == api.SubnetTopologyPrivate {
+			subnets = vpc.Subnets.Private
+		}
+		for _, name := range subnets.Names() {
+			subnet := subnets[name]
+			if subnetPrefix, _ := subnet.CIDR.Mask.Size(); !vpc.CIDR.Contains(subnet.CIDR.IP) || subnetPrefix < vpcPrefix {
+				return nil, fmt.Errorf("CIDR %s of subnet %q is not within the VPC CIDR %s", subnet.CIDR, name, vpc.CIDR)
+			}
+			availabilityZones.Insert(subnet.AZ)
+			logger.Info("subnet %q for %s - %s:%s", name, subnet.AZ, strings.ToLower(string(topology)), subnet.CIDR)
+		}
+	}
+	return availabilityZones.List(), nil
+}
+
+// SetPodSubnets defines the pod subnets of VPC CNI custom networking, one in each availability zone,
+// by splitting the pod CIDR unless the subnets were set in the spec.
+// It must be called after SetAvailabilityZones
+func SetPodSubnets(vpc *api.ClusterVPC, availabilityZones []string) error {
+	podSubnets := vpc.PodSubnets
+	if podSubnets == nil {
+		return nil
+	}
+
+	if len(podSubnets.Subnets) > 0 {
+		for _, az := range availabilityZones {
+			if _, ok := podSubnets.Subnets[az]; !ok {
+				return fmt.Errorf("vpc.podSubnets.subnets must have a subnet in every availability zone of the cluster, %s has none", az)
+			}
+		}
+		zones := sets.NewString(availabilityZones...)
+		for _, az := range podSubnets.Subnets.Names() {
+			if !zones.Has(az) {
+				return fmt.Errorf("pod subnet %q is not in an availability zone of the cluster (%s)", az, strings.Join(availabilityZones, ", "))
+			}
+			logger.Info("pod subnet for %s - %s", az, podSubnets.Subnets[az].CIDR)
+		}
+		return nil
+	}
+
+	prefix, _ := podSubnets.CIDR.Mask.Size()
+	if prefix < 16 || prefix > 24 {
+		return errors.New("vpc.podSubnets.cidr prefix must be between /16 and /24")
+	}
+
+	var (
+		podCIDRs []*net.IPNet
+		err      error
+	)
+	switch zonesTotal := len(availabilityZones); {
+	case zonesTotal <= 8:
+		podCIDRs, err = SplitInto8(&podSubnets.CIDR.IPNet)
+	case zonesTotal <= 16:
+		podCIDRs, err = SplitInto16(&podSubnets.CIDR.IPNet)
+	default:
+		return fmt.Errorf("cannot create more than 16 pod subnets, %d requested", zonesTotal)
+	}
+	if err != nil {
+		return err
+	}
+
+	podSubnets.Subnets = api.NewAZSubnetMapping()
+	for i, az := range availabilityZones {
+		podSubnets.Subnets.SetAZ(az, api.Network{
+			CIDR: &ipnet.IPNet{IPNet: *podCIDRs[i]},
+		})
+		logger.Info("pod subnet for %s - %s", az, podCIDRs[i].String())
+	}
+	return nil
+}
+
 func SplitInto16(parent *net.IPNet) ([]*net.IPNet, error) {
 	networkLength, _ := parent.Mask.Size()
 	networkLength += 4
@@ -245,6 +328,31 @@ func UseFromClusterStack(provider api.ClusterProvider, stack *cfn.Stack, spec *a
 			spec.PrivateCluster.Enabled = v == "true"
 			return nil
 		},
+		outputs.ClusterPodSubnetsCIDR: func(v string) error {
+			cidr, err := ipnet.ParseCIDR(v)
+			if err != nil {
+				return err
+			}
+			if spec.VPC.PodSubnets == nil {
+				spec.VPC.PodSubnets = &api.PodSubnets{}
+			}
+			spec.VPC.PodSubnets.CIDR = cidr
+			return nil
+		},
+		outputs.ClusterSubnetsPod: func(v string) error {
+			return ImportPodSubnetsFromIDList(provider.EC2(), spec, strings.Split(v, ","))
+		},
+		outputs.ClusterServiceIPv6CIDR: func(v string) error {
+			if spec.KubernetesNetworkConfig == nil {
+				spec.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{}
+			}
+			spec.KubernetesNetworkConfig.IPFamily = api.IPV6Family
+			if spec.Status == nil {
+				spec.Status = &api.ClusterStatus{}
+			}
+			spec.Status.ServiceIPv6CIDR = v
+			return nil
+		},
 	}
 
 	if !outputs.Exists(*stack, outputs.ClusterSubnetsPublic) &&
@@ -377,6 +485,37 @@ func ImportSubnetsFromIDList(ec2API ec2iface.EC2API, spec *api.ClusterConfig, to
 	return importSubnetsFromList(ec2API, spec, topology, subnetIDs, []string{}, []string{})
 }
 
+// ImportPodSubnetsFromIDList will update the pod subnets of spec with the given subnets, keyed by
+// their availability zone
+func ImportPodSubnetsFromIDList(ec2API ec2iface.EC2API, spec *api.ClusterConfig, subnetIDs []string) error {
+	subnets, err := describeSubnets(ec2API, spec.VPC.ID, subnetIDs, []string{}, []string{})
+	if err != nil {
+		return err
+	}
+	if spec.VPC.PodSubnets == nil {
+		spec.VPC.PodSubnets = &api.PodSubnets{}
+	}
+	if spec.VPC.PodSubnets.Subnets == nil {
+		spec.VPC.PodSubnets.Subnets = api.NewAZSubnetMapping()
+	}
+	for _, sn := range subnets {
+		az := *sn.AvailabilityZone
+		if subnet, ok := spec.VPC.PodSubnets.Subnets[az]; ok && subnet.ID != "" && subnet.ID != *sn.SubnetId {
+			return fmt.Errorf("pod subnet ID %q is not the same as %q", subnet.ID, *sn.SubnetId)
+		}
+		cidr, err := ipnet.ParseCIDR(*sn.CidrBlock)
+		if err != nil {
+			return err
+		}
+		spec.VPC.PodSubnets.Subnets[az] = api.AZSubnetSpec{
+			ID:   *sn.SubnetId,
+			AZ:   az,
+			CIDR: cidr,
+		}
+	}
+	return nil
+}
+
 func ValidateLegacySubnetsForNodeGroups(spec *api.ClusterConfig, provider api.ClusterProvider) error {
 	subnetsToValidate := sets.NewString()
 
@@ -156,6 +156,90 @@ var _ = Describe("VPC", func() {
 		})
 	})
 
+	Describe("UseSubnetsForNewVPC", func() {
+		var vpc *api.ClusterVPC
+
+		BeforeEach(func() {
+			vpc = api.NewClusterVPC()
+			vpc.Subnets = &api.ClusterSubnets{
+				Private: api.AZSubnetMapping{
+					"workload-b": {AZ: "us-west-2b", CIDR: ipnet.MustParseCIDR("192.168.64.0/19")},
+					"database-b": {AZ: "us-west-2b", CIDR: ipnet.MustParseCIDR("192.168.96.0/19")},
+				},
+				Public: api.AZSubnetMapping{
+					"public-a": {AZ: "us-west-2a", CIDR: ipnet.MustParseCIDR("192.168.0.0/19")},
+				},
+			}
+		})
+
+		It("returns the availability zones of the subnets", func() {
+			zones, err := UseSubnetsForNewVPC(vpc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zones).To(Equal([]string{"us-west-2a", "us-west-2b"}))
+		})
+
+		It("fails when a subnet is not within the VPC CIDR", func() {
+			vpc.Subnets.Private["database-b"] = api.AZSubnetSpec{AZ: "us-west-2b", CIDR: ipnet.MustParseCIDR("10.0.0.0/19")}
+			_, err := UseSubnetsForNewVPC(vpc)
+			Expect(err).To(MatchError(`CIDR 10.0.0.0/19 of subnet "database-b" is not within the VPC CIDR 192.168.0.0/16`))
+		})
+
+		It("fails when a subnet is larger than the VPC", func() {
+			vpc.Subnets.Private["database-b"] = api.AZSubnetSpec{AZ: "us-west-2b", CIDR: ipnet.MustParseCIDR("192.168.0.0/15")}
+			_, err := UseSubnetsForNewVPC(vpc)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("SetPodSubnets", func() {
+		var vpc *api.ClusterVPC
+
+		BeforeEach(func() {
+			vpc = api.NewClusterVPC()
+			vpc.PodSubnets = &api.PodSubnets{
+				CIDR: ipnet.MustParseCIDR("100.64.0.0/16"),
+			}
+		})
+
+		It("splits the pod CIDR into a subnet per availability zone", func() {
+			Expect(SetPodSubnets(vpc, []string{"us-west-2a", "us-west-2b", "us-west-2c"})).To(Succeed())
+			Expect(vpc.PodSubnets.Subnets).To(HaveLen(3))
+			Expect(vpc.PodSubnets.Subnets["us-west-2a"].CIDR.String()).To(Equal("100.64.0.0/19"))
+			Expect(vpc.PodSubnets.Subnets["us-west-2b"].CIDR.String()).To(Equal("100.64.32.0/19"))
+			Expect(vpc.PodSubnets.Subnets["us-west-2c"].CIDR.String()).To(Equal("100.64.64.0/19"))
+			Expect(vpc.PodSubnets.Subnets["us-west-2c"].AZ).To(Equal("us-west-2c"))
+		})
+
+		It("fails when the pod CIDR is too small", func() {
+			vpc.PodSubnets.CIDR = ipnet.MustParseCIDR("100.64.0.0/25")
+			Expect(SetPodSubnets(vpc, []string{"us-west-2a", "us-west-2b"})).To(MatchError("vpc.podSubnets.cidr prefix must be between /16 and /24"))
+		})
+
+		It("uses the given pod subnets", func() {
+			vpc.PodSubnets.Subnets = api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {CIDR: ipnet.MustParseCIDR("100.64.0.0/18")},
+				"us-west-2b": {CIDR: ipnet.MustParseCIDR("100.64.64.0/18")},
+			})
+			Expect(SetPodSubnets(vpc, []string{"us-west-2a", "us-west-2b"})).To(Succeed())
+			Expect(vpc.PodSubnets.Subnets["us-west-2b"].CIDR.String()).To(Equal("100.64.64.0/18"))
+		})
+
+		It("fails when an availability zone has no pod subnet", func() {
+			vpc.PodSubnets.Subnets = api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {CIDR: ipnet.MustParseCIDR("100.64.0.0/18")},
+			})
+			Expect(SetPodSubnets(vpc, []string{"us-west-2a", "us-west-2b"})).To(MatchError("vpc.podSubnets.subnets must have a subnet in every availability zone of the cluster, us-west-2b has none"))
+		})
+
+		It("fails when a pod subnet is not in an availability zone of the cluster", func() {
+			vpc.PodSubnets.Subnets = api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {CIDR: ipnet.MustParseCIDR("100.64.0.0/18")},
+				"us-west-2d": {CIDR: ipnet.MustParseCIDR("100.64.64.0/18")},
+			})
+			Expect(SetPodSubnets(vpc, []string{"us-west-2a"})).To(MatchError(`pod subnet "us-west-2d" is not in an availability zone of the cluster (us-west-2a)`))
+		})
+	})
+
 	DescribeTable("Set subnets",
 		func(subnetsCase setSubnetsCase) {
 			err := SetSubnets(subnetsCase.vpc, subnetsCase.availabilityZones)